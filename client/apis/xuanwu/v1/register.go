@@ -1,5 +1,5 @@
 /*
- Copyright (c) Huawei Technologies Co., Ltd. 2022-2024. All rights reserved.
+ Copyright (c) Huawei Technologies Co., Ltd. 2022-2026. All rights reserved.
 
  Licensed under the Apache License, Version 2.0 (the "License");
  you may not use this file except in compliance with the License.
@@ -51,10 +51,16 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&StorageBackendClaimList{},
 		&StorageBackendContent{},
 		&StorageBackendContentList{},
+		&VolumeFailover{},
+		&VolumeFailoverList{},
+		&VolumeMigration{},
+		&VolumeMigrationList{},
 		&VolumeModifyClaim{},
 		&VolumeModifyClaimList{},
 		&VolumeModifyContent{},
 		&VolumeModifyContentList{},
+		&VolumeSnapshotRollback{},
+		&VolumeSnapshotRollbackList{},
 	)
 	v1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
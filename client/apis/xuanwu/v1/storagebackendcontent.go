@@ -1,5 +1,5 @@
 /*
- Copyright (c) Huawei Technologies Co., Ltd. 2022-2023. All rights reserved.
+ Copyright (c) Huawei Technologies Co., Ltd. 2022-2026. All rights reserved.
 
  Licensed under the Apache License, Version 2.0 (the "License");
  you may not use this file except in compliance with the License.
@@ -110,6 +110,8 @@ const (
 	UsedCapacity CapacityType = "UsedCapacity"
 	// FreeCapacity the total capacity of the storage pool
 	FreeCapacity CapacityType = "FreeCapacity"
+	// SubscribedCapacity the capacity of the thin and thick volumes provisioned in the storage pool
+	SubscribedCapacity CapacityType = "SubscribedCapacity"
 )
 
 // Pool is the schema for storage pool capacity
@@ -0,0 +1,200 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package v1 contains API Schema definitions for the xuanwu v1 API group
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VolumeFailoverSpec defines the desired spec of VolumeFailover
+type VolumeFailoverSpec struct {
+	// Action is the disaster recovery operation, Failover promotes the volumes on the target backend and
+	// Failback resynchronizes the volumes and switches them back to the target backend.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=Failover;Failback
+	Action VolumeFailoverAction `json:"action" protobuf:"bytes,1,name=action"`
+
+	// Source used to config the source resource.
+	// +kubebuilder:validation:Required
+	Source *VolumeFailoverSpecSource `json:"source" protobuf:"bytes,2,name=source"`
+
+	// TargetBackend is the name of the backend which the volumes are switched to,
+	// it must be the replication or hypermetro peer of the backend the volumes belong to.
+	// +kubebuilder:validation:Required
+	TargetBackend string `json:"targetBackend" protobuf:"bytes,3,name=targetBackend"`
+}
+
+// VolumeFailoverSpecSource defines the desired source of VolumeFailover
+type VolumeFailoverSpecSource struct {
+	// Kind is a string value representing the source kind, default StorageClass.
+	// +kubebuilder:default=StorageClass
+	// +kubebuilder:validation:Enum=StorageClass;PersistentVolumeClaim
+	Kind string `json:"kind,omitempty" protobuf:"bytes,1,opt,name=kind"`
+
+	// Name is the name of the resource
+	// +kubebuilder:validation:Required
+	Name string `json:"name" protobuf:"bytes,2,name=name"`
+
+	// NameSpace is the namespace of the resource, required when the kind is PersistentVolumeClaim
+	// +optional
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,3,opt,name=namespace"`
+}
+
+// VolumeFailoverStatus defines the desired status of VolumeFailover
+type VolumeFailoverStatus struct {
+	// phase represents the current phase of VolumeFailover.
+	// +optional
+	Phase VolumeFailoverPhase `json:"phase,omitempty" protobuf:"bytes,1,opt,name=phase"`
+
+	// Volumes used to save the failover status detail of each volume
+	// +optional
+	Volumes []FailoverVolume `json:"volumes,omitempty" protobuf:"bytes,2,opt,name=volumes"`
+
+	// Ready represents the current progress of VolumeFailover. This field is OPTIONAL.
+	// +optional
+	Ready string `json:"ready,omitempty" protobuf:"bytes,3,opt,name=ready"`
+
+	// StartedAt is a timestamp representing the server time when this job was created.
+	// It is represented in RFC3339 form and is in UTC.
+	// Populated by the system.
+	// Read-only.
+	// +optional
+	StartedAt *metav1.Time `json:"startedAt,omitempty" protobuf:"bytes,4,opt,name=startedAt"`
+
+	// CompletedAt is a timestamp representing the server time when this job was completed.
+	// It is represented in RFC3339 form and is in UTC.
+	// Populated by the system.
+	// Read-only.
+	// +optional
+	CompletedAt *metav1.Time `json:"completedAt,omitempty" protobuf:"bytes,5,opt,name=completedAt"`
+}
+
+// FailoverVolume defines the failover status detail of a volume
+type FailoverVolume struct {
+	// PersistentVolumeName used to config the PersistentVolume name.
+	PersistentVolumeName string `json:"persistentVolumeName,omitempty" protobuf:"bytes,1,opt,name=persistentVolumeName"`
+
+	// SourceVolume used to config the source PersistentVolumeClaim, format is <namespace>/<name>.
+	SourceVolume string `json:"sourceVolume,omitempty" protobuf:"bytes,2,opt,name=sourceVolume"`
+
+	// SourceVolumeHandle is the volume handle of the PersistentVolume before switching.
+	SourceVolumeHandle string `json:"sourceVolumeHandle,omitempty" protobuf:"bytes,3,opt,name=sourceVolumeHandle"`
+
+	// TargetVolumeHandle is the volume handle of the PersistentVolume after switching.
+	// +optional
+	TargetVolumeHandle string `json:"targetVolumeHandle,omitempty" protobuf:"bytes,4,opt,name=targetVolumeHandle"`
+
+	// Status represents the current phase of the volume.
+	// +optional
+	Status FailoverVolumePhase `json:"status,omitempty" protobuf:"bytes,5,opt,name=status"`
+
+	// Message is the last error message of the volume.
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,6,opt,name=message"`
+
+	// TargetPersistentVolume is the PersistentVolume which replaces the original one with the target volume handle.
+	// It is recorded before the original one is deleted, so that it can be created again if the rebinding is
+	// interrupted.
+	// +optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Schemaless
+	TargetPersistentVolume *corev1.PersistentVolume `json:"targetPersistentVolume,omitempty" protobuf:"bytes,7,opt,name=targetPersistentVolume"`
+}
+
+// VolumeFailoverAction defines the action of VolumeFailover
+type VolumeFailoverAction string
+
+const (
+	// VolumeFailoverActionFailover promotes the volumes on the target backend
+	VolumeFailoverActionFailover VolumeFailoverAction = "Failover"
+
+	// VolumeFailoverActionFailback resynchronizes the volumes and switches them back to the target backend
+	VolumeFailoverActionFailback VolumeFailoverAction = "Failback"
+)
+
+// VolumeFailoverPhase defines the phase of VolumeFailover
+type VolumeFailoverPhase string
+
+const (
+	// VolumeFailoverPending means the VolumeFailover has been accepted, but the volumes have not been collected yet.
+	VolumeFailoverPending VolumeFailoverPhase = "Pending"
+
+	// VolumeFailoverRunning means one or more of the volumes have not been switched.
+	VolumeFailoverRunning VolumeFailoverPhase = "Running"
+
+	// VolumeFailoverCompleted means all volumes have been switched to the target backend.
+	VolumeFailoverCompleted VolumeFailoverPhase = "Completed"
+
+	// VolumeFailoverFailed means the spec is invalid or one or more of the volumes can not be switched to the
+	// target backend.
+	VolumeFailoverFailed VolumeFailoverPhase = "Failed"
+)
+
+// FailoverVolumePhase defines the phase of a volume in VolumeFailover
+type FailoverVolumePhase string
+
+const (
+	// FailoverVolumePending means the volume has not been processed.
+	FailoverVolumePending FailoverVolumePhase = "Pending"
+
+	// FailoverVolumeSyncing means the data of the volume is synchronizing before switching back.
+	FailoverVolumeSyncing FailoverVolumePhase = "Syncing"
+
+	// FailoverVolumeRebinding means the volume has been switched on storage, and the PersistentVolume is rebinding.
+	FailoverVolumeRebinding FailoverVolumePhase = "Rebinding"
+
+	// FailoverVolumeCompleted means the PersistentVolume has been pointed to the target backend.
+	FailoverVolumeCompleted FailoverVolumePhase = "Completed"
+
+	// FailoverVolumeFailed means the volume can not be switched to the target backend.
+	FailoverVolumeFailed FailoverVolumePhase = "Failed"
+)
+
+// VolumeFailover is the Schema for the VolumeFailover API
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName="vfo"
+// +kubebuilder:printcolumn:name="Action",type=string,JSONPath=`.spec.action`
+// +kubebuilder:printcolumn:name="TargetBackend",type=string,JSONPath=`.spec.targetBackend`
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.ready`
+// +kubebuilder:printcolumn:name="SourceKind",type=string,priority=1,JSONPath=`.spec.source.kind`
+// +kubebuilder:printcolumn:name="SourceName",type=string,priority=1,JSONPath=`.spec.source.name`
+// +kubebuilder:printcolumn:name="StartedAt",type=string,priority=1,JSONPath=`.status.startedAt`
+// +kubebuilder:printcolumn:name="CompletedAt",type=string,priority=1,JSONPath=`.status.completedAt`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+type VolumeFailover struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Spec              VolumeFailoverSpec   `json:"spec,omitempty"`
+	Status            VolumeFailoverStatus `json:"status,omitempty"`
+}
+
+// VolumeFailoverList contains a list of VolumeFailover
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VolumeFailoverList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VolumeFailover `json:"items"`
+}
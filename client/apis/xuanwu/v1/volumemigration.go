@@ -0,0 +1,161 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package v1 contains API Schema definitions for the xuanwu v1 API group
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VolumeMigrationSpec defines the desired spec of VolumeMigration
+type VolumeMigrationSpec struct {
+	// PersistentVolumeClaim used to config the PersistentVolumeClaim whose volume is migrated.
+	// +kubebuilder:validation:Required
+	PersistentVolumeClaim VolumeMigrationSource `json:"persistentVolumeClaim" protobuf:"bytes,1,name=persistentVolumeClaim"`
+
+	// TargetBackend is the name of the backend which the volume is migrated to. Only the volumes of oceanstor-san
+	// backends can be migrated. If it is empty, the volume is migrated online to another pool of the backend it
+	// belongs to. Otherwise the volume is migrated offline to the target backend, which must be an oceanstor-san
+	// backend, and the volume can not be published to any node until the migration is completed or failed.
+	// The volume is copied by LUN copy if the target backend is on the same storage device as the source one,
+	// otherwise it is copied by remote replication, so the target storage device must be added as a remote device
+	// of the source one.
+	// +optional
+	TargetBackend string `json:"targetBackend,omitempty" protobuf:"bytes,2,opt,name=targetBackend"`
+
+	// TargetPool is the name of the storage pool which the volume is migrated to.
+	// +kubebuilder:validation:Required
+	TargetPool string `json:"targetPool" protobuf:"bytes,3,name=targetPool"`
+}
+
+// VolumeMigrationSource defines the PersistentVolumeClaim of VolumeMigration
+type VolumeMigrationSource struct {
+	// Name is the name of the PersistentVolumeClaim
+	// +kubebuilder:validation:Required
+	Name string `json:"name" protobuf:"bytes,1,name=name"`
+
+	// Namespace is the namespace of the PersistentVolumeClaim
+	// +kubebuilder:validation:Required
+	Namespace string `json:"namespace" protobuf:"bytes,2,name=namespace"`
+}
+
+// VolumeMigrationStatus defines the desired status of VolumeMigration
+type VolumeMigrationStatus struct {
+	// phase represents the current phase of VolumeMigration.
+	// +optional
+	Phase VolumeMigrationPhase `json:"phase,omitempty" protobuf:"bytes,1,opt,name=phase"`
+
+	// PersistentVolumeName is the name of the PersistentVolume which is migrated.
+	// +optional
+	PersistentVolumeName string `json:"persistentVolumeName,omitempty" protobuf:"bytes,2,opt,name=persistentVolumeName"`
+
+	// SourceVolumeHandle is the volume handle of the PersistentVolume before the migration.
+	// +optional
+	SourceVolumeHandle string `json:"sourceVolumeHandle,omitempty" protobuf:"bytes,3,opt,name=sourceVolumeHandle"`
+
+	// TargetVolumeHandle is the volume handle of the PersistentVolume after the migration.
+	// +optional
+	TargetVolumeHandle string `json:"targetVolumeHandle,omitempty" protobuf:"bytes,4,opt,name=targetVolumeHandle"`
+
+	// Progress represents the migration progress on storage, in percent.
+	// +optional
+	Progress string `json:"progress,omitempty" protobuf:"bytes,5,opt,name=progress"`
+
+	// Message is the last error message, or the reason why the migration is waiting.
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,6,opt,name=message"`
+
+	// StartedAt is a timestamp representing the server time when the migration was started on storage.
+	// It is represented in RFC3339 form and is in UTC.
+	// Populated by the system.
+	// Read-only.
+	// +optional
+	StartedAt *metav1.Time `json:"startedAt,omitempty" protobuf:"bytes,7,opt,name=startedAt"`
+
+	// CompletedAt is a timestamp representing the server time when the migration was completed.
+	// It is represented in RFC3339 form and is in UTC.
+	// Populated by the system.
+	// Read-only.
+	// +optional
+	CompletedAt *metav1.Time `json:"completedAt,omitempty" protobuf:"bytes,8,opt,name=completedAt"`
+
+	// CopyStarted indicates whether the migration has been started on storage.
+	// The source volume is never deleted by the migration before it is recorded.
+	// +optional
+	CopyStarted bool `json:"copyStarted,omitempty" protobuf:"varint,9,opt,name=copyStarted"`
+
+	// TargetPersistentVolume is the PersistentVolume which replaces the original one with the target volume handle.
+	// It is recorded before the original one is deleted, so that it can be created again if the rebinding is
+	// interrupted.
+	// +optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Schemaless
+	TargetPersistentVolume *corev1.PersistentVolume `json:"targetPersistentVolume,omitempty" protobuf:"bytes,10,opt,name=targetPersistentVolume"`
+}
+
+// VolumeMigrationPhase defines the phase of VolumeMigration
+type VolumeMigrationPhase string
+
+const (
+	// VolumeMigrationPending means the migration has not been started, for example the volume which is migrated
+	// to another backend is still attached to a node.
+	VolumeMigrationPending VolumeMigrationPhase = "Pending"
+
+	// VolumeMigrationRunning means the data of the volume is being copied on storage.
+	VolumeMigrationRunning VolumeMigrationPhase = "Running"
+
+	// VolumeMigrationCompleted means the volume has been migrated to the target pool.
+	VolumeMigrationCompleted VolumeMigrationPhase = "Completed"
+
+	// VolumeMigrationFailed means the volume can not be migrated to the target pool.
+	VolumeMigrationFailed VolumeMigrationPhase = "Failed"
+)
+
+// VolumeMigration is the Schema for the VolumeMigration API
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName="vmig"
+// +kubebuilder:printcolumn:name="Namespace",type=string,JSONPath=`.spec.persistentVolumeClaim.namespace`
+// +kubebuilder:printcolumn:name="PersistentVolumeClaim",type=string,JSONPath=`.spec.persistentVolumeClaim.name`
+// +kubebuilder:printcolumn:name="TargetBackend",type=string,JSONPath=`.spec.targetBackend`
+// +kubebuilder:printcolumn:name="TargetPool",type=string,JSONPath=`.spec.targetPool`
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Progress",type=string,JSONPath=`.status.progress`
+// +kubebuilder:printcolumn:name="PersistentVolume",type=string,priority=1,JSONPath=`.status.persistentVolumeName`
+// +kubebuilder:printcolumn:name="StartedAt",type=string,priority=1,JSONPath=`.status.startedAt`
+// +kubebuilder:printcolumn:name="CompletedAt",type=string,priority=1,JSONPath=`.status.completedAt`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+type VolumeMigration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Spec              VolumeMigrationSpec   `json:"spec,omitempty"`
+	Status            VolumeMigrationStatus `json:"status,omitempty"`
+}
+
+// VolumeMigrationList contains a list of VolumeMigration
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VolumeMigrationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VolumeMigration `json:"items"`
+}
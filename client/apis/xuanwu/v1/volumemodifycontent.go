@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2024. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -70,6 +70,16 @@ type VolumeModifyContentStatus struct {
 	// Read-only.
 	// +optional
 	CompletedAt *metav1.Time `json:"completedAt,omitempty" protobuf:"bytes,3,opt,name=completedAt"`
+
+	// ModifiedParameters are the parameters which have been modified in place on the storage,
+	// such as qos and the export options of the nfs share.
+	// +optional
+	ModifiedParameters map[string]string `json:"modifiedParameters,omitempty" protobuf:"bytes,4,opt,name=modifiedParameters"`
+
+	// PreviousParameters are the values of the modified parameters before the modification,
+	// which are restored when the modification is rolled back, such as qos and the export options of the nfs share.
+	// +optional
+	PreviousParameters map[string]string `json:"previousParameters,omitempty" protobuf:"bytes,5,opt,name=previousParameters"`
 }
 
 // VolumeModifyContentPhase defines the phase of VolumeModifyContent
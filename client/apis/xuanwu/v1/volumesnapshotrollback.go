@@ -0,0 +1,129 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package v1 contains API Schema definitions for the xuanwu v1 API group
+package v1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// VolumeSnapshotRollbackSpec defines the desired spec of VolumeSnapshotRollback
+type VolumeSnapshotRollbackSpec struct {
+	// VolumeSnapshot used to config the VolumeSnapshot which the source volume is rolled back to.
+	// +kubebuilder:validation:Required
+	VolumeSnapshot VolumeSnapshotRollbackSource `json:"volumeSnapshot" protobuf:"bytes,1,name=volumeSnapshot"`
+}
+
+// VolumeSnapshotRollbackSource defines the VolumeSnapshot of VolumeSnapshotRollback
+type VolumeSnapshotRollbackSource struct {
+	// Name is the name of the VolumeSnapshot
+	// +kubebuilder:validation:Required
+	Name string `json:"name" protobuf:"bytes,1,name=name"`
+
+	// Namespace is the namespace of the VolumeSnapshot
+	// +kubebuilder:validation:Required
+	Namespace string `json:"namespace" protobuf:"bytes,2,name=namespace"`
+}
+
+// VolumeSnapshotRollbackStatus defines the desired status of VolumeSnapshotRollback
+type VolumeSnapshotRollbackStatus struct {
+	// phase represents the current phase of VolumeSnapshotRollback.
+	// +optional
+	Phase VolumeSnapshotRollbackPhase `json:"phase,omitempty" protobuf:"bytes,1,opt,name=phase"`
+
+	// PersistentVolumeName is the name of the PersistentVolume which is rolled back.
+	// +optional
+	PersistentVolumeName string `json:"persistentVolumeName,omitempty" protobuf:"bytes,2,opt,name=persistentVolumeName"`
+
+	// VolumeHandle is the volume handle of the PersistentVolume which is rolled back.
+	// +optional
+	VolumeHandle string `json:"volumeHandle,omitempty" protobuf:"bytes,3,opt,name=volumeHandle"`
+
+	// SnapshotHandle is the snapshot handle of the VolumeSnapshotContent which the volume is rolled back to.
+	// +optional
+	SnapshotHandle string `json:"snapshotHandle,omitempty" protobuf:"bytes,4,opt,name=snapshotHandle"`
+
+	// Progress represents the rollback progress on storage, in percent.
+	// +optional
+	Progress string `json:"progress,omitempty" protobuf:"bytes,5,opt,name=progress"`
+
+	// Message is the last error message, or the reason why the rollback is waiting.
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,6,opt,name=message"`
+
+	// StartedAt is a timestamp representing the server time when the rollback was started on storage.
+	// It is represented in RFC3339 form and is in UTC.
+	// Populated by the system.
+	// Read-only.
+	// +optional
+	StartedAt *metav1.Time `json:"startedAt,omitempty" protobuf:"bytes,7,opt,name=startedAt"`
+
+	// CompletedAt is a timestamp representing the server time when the rollback was completed.
+	// It is represented in RFC3339 form and is in UTC.
+	// Populated by the system.
+	// Read-only.
+	// +optional
+	CompletedAt *metav1.Time `json:"completedAt,omitempty" protobuf:"bytes,8,opt,name=completedAt"`
+}
+
+// VolumeSnapshotRollbackPhase defines the phase of VolumeSnapshotRollback
+type VolumeSnapshotRollbackPhase string
+
+const (
+	// VolumeSnapshotRollbackPending means the rollback has not been started, for example the volume is still
+	// attached to a node.
+	VolumeSnapshotRollbackPending VolumeSnapshotRollbackPhase = "Pending"
+
+	// VolumeSnapshotRollbackRunning means the volume is rolling back on storage.
+	VolumeSnapshotRollbackRunning VolumeSnapshotRollbackPhase = "Running"
+
+	// VolumeSnapshotRollbackCompleted means the volume has been rolled back to the snapshot.
+	VolumeSnapshotRollbackCompleted VolumeSnapshotRollbackPhase = "Completed"
+
+	// VolumeSnapshotRollbackFailed means the volume can not be rolled back to the snapshot.
+	VolumeSnapshotRollbackFailed VolumeSnapshotRollbackPhase = "Failed"
+)
+
+// VolumeSnapshotRollback is the Schema for the VolumeSnapshotRollback API
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName="vsr"
+// +kubebuilder:printcolumn:name="Namespace",type=string,JSONPath=`.spec.volumeSnapshot.namespace`
+// +kubebuilder:printcolumn:name="VolumeSnapshot",type=string,JSONPath=`.spec.volumeSnapshot.name`
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Progress",type=string,JSONPath=`.status.progress`
+// +kubebuilder:printcolumn:name="PersistentVolume",type=string,priority=1,JSONPath=`.status.persistentVolumeName`
+// +kubebuilder:printcolumn:name="StartedAt",type=string,priority=1,JSONPath=`.status.startedAt`
+// +kubebuilder:printcolumn:name="CompletedAt",type=string,priority=1,JSONPath=`.status.completedAt`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+type VolumeSnapshotRollback struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Spec              VolumeSnapshotRollbackSpec   `json:"spec,omitempty"`
+	Status            VolumeSnapshotRollbackStatus `json:"status,omitempty"`
+}
+
+// VolumeSnapshotRollbackList contains a list of VolumeSnapshotRollback
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VolumeSnapshotRollbackList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VolumeSnapshotRollback `json:"items"`
+}
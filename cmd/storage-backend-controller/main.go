@@ -1,5 +1,5 @@
 /*
- Copyright (c) Huawei Technologies Co., Ltd. 2022-2024. All rights reserved.
+ Copyright (c) Huawei Technologies Co., Ltd. 2022-2026. All rights reserved.
 
  Licensed under the Apache License, Version 2.0 (the "License");
  you may not use this file except in compliance with the License.
@@ -26,19 +26,26 @@ import (
 	"github.com/sirupsen/logrus"
 	"k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
+	k8sInformers "k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
 	coreV1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/record"
 
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/app"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/lib/drcsi"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/lib/drcsi/rpc"
 	clientSet "github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/client/clientset/versioned"
 	backendScheme "github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/client/clientset/versioned/scheme"
 	backendInformers "github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/client/informers/externalversions"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/failover"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/migration"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/rollback"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/storage-backend/controller"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/utils"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/webhook"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/metrics"
 )
 
 const (
@@ -72,6 +79,8 @@ func main() {
 		log.AddContext(ctx).Infof("set request id failed, error is [%v]", err)
 	}
 
+	metrics.StartServer(ctx, app.GetGlobalConfig().MetricsAddress)
+
 	k8sClient, crdClient, err := utils.GetK8SAndCrdClient(ctx)
 	if err != nil {
 		log.AddContext(ctx).Errorf("GetK8SAndCrdClient failed, error: %v", err)
@@ -111,6 +120,7 @@ func initRecorder(client kubernetes.Interface) record.EventRecorder {
 
 func runController(
 	ctx context.Context,
+	k8sClient kubernetes.Interface,
 	storageBackendClient *clientSet.Clientset,
 	eventRecorder record.EventRecorder, ch chan os.Signal) {
 
@@ -133,6 +143,7 @@ func runController(
 	}
 
 	factory := backendInformers.NewSharedInformerFactory(storageBackendClient, app.GetGlobalConfig().ReSyncPeriod)
+	k8sFactory := k8sInformers.NewSharedInformerFactory(k8sClient, app.GetGlobalConfig().ReSyncPeriod)
 	ctrl := controller.NewBackendController(controller.BackendControllerRequest{
 		ClientSet:       storageBackendClient,
 		ClaimInformer:   factory.Xuanwu().V1().StorageBackendClaims(),
@@ -140,11 +151,57 @@ func runController(
 		ReSyncPeriod:    app.GetGlobalConfig().ReSyncPeriod,
 		EventRecorder:   eventRecorder})
 
+	var failoverCtrl *failover.VolumeFailoverController
+	if app.GetGlobalConfig().EnableVolumeFailover {
+		conn, provider, err := rpc.ConnectProvider()
+		if err != nil {
+			log.AddContext(ctx).Errorf("connect provider error: %v", err)
+			ch <- syscall.SIGINT
+			return
+		}
+		failoverCtrl = failover.NewVolumeFailoverController(ctx, k8sClient, storageBackendClient, factory,
+			failover.Provisioner(provider),
+			failover.ClientOfModify(drcsi.NewModifyVolumeInterfaceClient(conn)),
+			failover.WorkerThreads(app.GetGlobalConfig().WorkerThreads))
+	}
+
+	var rollbackCtrl *rollback.VolumeSnapshotRollbackController
+	if app.GetGlobalConfig().EnableSnapshotRollback {
+		var err error
+		if rollbackCtrl, err = newRollbackController(ctx, k8sClient, storageBackendClient, factory,
+			k8sFactory); err != nil {
+			log.AddContext(ctx).Errorf("init snapshot rollback controller error: %v", err)
+			ch <- syscall.SIGINT
+			return
+		}
+	}
+
+	var migrationCtrl *migration.VolumeMigrationController
+	if app.GetGlobalConfig().EnableVolumeMigration {
+		var err error
+		if migrationCtrl, err = newMigrationController(ctx, k8sClient, storageBackendClient, factory,
+			k8sFactory); err != nil {
+			log.AddContext(ctx).Errorf("init volume migration controller error: %v", err)
+			ch <- syscall.SIGINT
+			return
+		}
+	}
+
 	run := func(ctx context.Context) {
 		// run...
 		stopCh := make(chan struct{})
 		factory.Start(stopCh)
+		k8sFactory.Start(stopCh)
 		go ctrl.Run(ctx, app.GetGlobalConfig().WorkerThreads, stopCh)
+		if failoverCtrl != nil {
+			go failoverCtrl.Run(ctx, stopCh)
+		}
+		if rollbackCtrl != nil {
+			go rollbackCtrl.Run(ctx, stopCh)
+		}
+		if migrationCtrl != nil {
+			go migrationCtrl.Run(ctx, stopCh)
+		}
 
 		// Stop the controller when stop signals are received
 		utils.WaitExitSignal(ctx, "controller")
@@ -155,6 +212,44 @@ func runController(
 	run(ctx)
 }
 
+func newRollbackController(ctx context.Context, k8sClient kubernetes.Interface,
+	storageBackendClient *clientSet.Clientset,
+	factory backendInformers.SharedInformerFactory,
+	k8sFactory k8sInformers.SharedInformerFactory) (*rollback.VolumeSnapshotRollbackController, error) {
+	conn, provider, err := rpc.ConnectProvider()
+	if err != nil {
+		return nil, fmt.Errorf("connect provider error: %w", err)
+	}
+
+	dynamicClient, err := utils.GetDynamicClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return rollback.NewVolumeSnapshotRollbackController(ctx, k8sClient, storageBackendClient, factory,
+		rollback.Provisioner(provider),
+		rollback.ClientOfModify(drcsi.NewModifyVolumeInterfaceClient(conn)),
+		rollback.ClientOfDynamic(dynamicClient),
+		rollback.AttachmentInformer(k8sFactory.Storage().V1().VolumeAttachments()),
+		rollback.WorkerThreads(app.GetGlobalConfig().WorkerThreads)), nil
+}
+
+func newMigrationController(ctx context.Context, k8sClient kubernetes.Interface,
+	storageBackendClient *clientSet.Clientset,
+	factory backendInformers.SharedInformerFactory,
+	k8sFactory k8sInformers.SharedInformerFactory) (*migration.VolumeMigrationController, error) {
+	conn, provider, err := rpc.ConnectProvider()
+	if err != nil {
+		return nil, fmt.Errorf("connect provider error: %w", err)
+	}
+
+	return migration.NewVolumeMigrationController(ctx, k8sClient, storageBackendClient, factory,
+		migration.Provisioner(provider),
+		migration.ClientOfModify(drcsi.NewModifyVolumeInterfaceClient(conn)),
+		migration.AttachmentInformer(k8sFactory.Storage().V1().VolumeAttachments()),
+		migration.WorkerThreads(app.GetGlobalConfig().WorkerThreads)), nil
+}
+
 func ensureCRDExist(ctx context.Context, client *clientSet.Clientset) error {
 	exist := func() (bool, error) {
 		_, err := utils.ListClaim(ctx, client, "")
@@ -188,7 +283,7 @@ func startWithLeaderElectionOnCondition(ctx context.Context, k8sClient *kubernet
 	crdClient *clientSet.Clientset, recorder record.EventRecorder, ch chan os.Signal) {
 	if !app.GetGlobalConfig().EnableLeaderElection {
 		log.AddContext(ctx).Infoln("Start controller without leader election.")
-		go runController(ctx, crdClient, recorder, ch)
+		go runController(ctx, k8sClient, crdClient, recorder, ch)
 	} else {
 		leaderElection := utils.LeaderElectionConf{
 			LeaderName:    leaderLockObjectName,
@@ -198,7 +293,7 @@ func startWithLeaderElectionOnCondition(ctx context.Context, k8sClient *kubernet
 		}
 
 		runFun := func(ctx context.Context, ch chan os.Signal) {
-			runController(ctx, crdClient, recorder, ch)
+			runController(ctx, k8sClient, crdClient, recorder, ch)
 		}
 
 		go utils.RunWithLeaderElection(ctx, leaderElection, k8sClient, recorder, runFun, ch)
@@ -43,6 +43,7 @@ import (
 	storageBackend "github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/storage-backend/handle"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/utils"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
+	pluginMetrics "github.com/Huawei/eSDK_K8S_Plugin/v4/utils/metrics"
 )
 
 const (
@@ -81,6 +82,8 @@ func main() {
 		log.AddContext(ctx).Infof("set request id failed, error is [%v]", err)
 	}
 
+	pluginMetrics.StartServer(ctx, app.GetGlobalConfig().MetricsAddress)
+
 	k8sClient, crdClient, err := utils.GetK8SAndCrdClient(ctx)
 	if err != nil {
 		log.AddContext(ctx).Errorf("GetK8SAndCrdClient failed, error: %v", err)
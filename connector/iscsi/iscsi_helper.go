@@ -60,6 +60,10 @@ const (
 
 var singleGroup = concurrent.NewSingleGroup[connectResult]()
 
+// chapInfos caches the CHAP info of the targets connected by the node, keyed by the portal and the target IQN,
+// so that the sessions logged in again by the path recovery are authenticated the same as they are staged
+var chapInfos sync.Map
+
 type connectResult struct {
 	sessionId  string
 	manualScan bool
@@ -242,10 +246,27 @@ func updateChapInfo(ctx context.Context, tgtPortal, targetIQN string, tgtChapInf
 				utils.MaskSensitiveInfo(tgtChapInfo.authPassword), err)
 			return err
 		}
+
+		chapInfos.Store(fmt.Sprintf("%s::%s", tgtPortal, targetIQN), tgtChapInfo)
 	}
 	return nil
 }
 
+// GetChapInfo returns the CHAP info used to connect the target portal, it is empty if the target is
+// connected without CHAP or not connected since the node plugin started
+func GetChapInfo(tgtPortal, targetIQN string) ChapInfo {
+	value, exist := chapInfos.Load(fmt.Sprintf("%s::%s", tgtPortal, targetIQN))
+	if !exist {
+		return ChapInfo{}
+	}
+
+	chapInfo, ok := value.(ChapInfo)
+	if !ok {
+		return ChapInfo{}
+	}
+	return chapInfo
+}
+
 func getAllISCSISession(ctx context.Context) [][]string {
 	checkExitCode := []string{"exit status 0", "exit status 21", "exit status 255"}
 	allSessions, err := runISCSIBare(ctx, "-m session", checkExitCode)
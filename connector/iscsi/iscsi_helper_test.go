@@ -99,3 +99,21 @@ func Test_findDiskOfUltraPath_Success(t *testing.T) {
 	// assert
 	assert.Equal(t, wantDiskName, gotDiskName)
 }
+
+func Test_updateChapInfo_CacheChapInfo(t *testing.T) {
+	// arrange
+	chapInfo := ChapInfo{authUserName: "user", authPassword: "password", authMethod: "CHAP"}
+
+	// mock
+	patches := gomonkey.NewPatches()
+	defer patches.Reset()
+	patches.ApplyFuncReturn(updateISCSIAdmin, nil)
+
+	// act
+	err := updateChapInfo(context.Background(), "127.0.0.1", "iqn-chap", chapInfo)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, chapInfo, GetChapInfo("127.0.0.1", "iqn-chap"))
+	assert.Equal(t, ChapInfo{}, GetChapInfo("127.0.0.2", "iqn-chap"))
+}
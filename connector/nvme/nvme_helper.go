@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2020-2025. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2020-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -699,3 +699,39 @@ func getPathInfos(ctx context.Context, nvmeConnectInfo map[string]interface{}) m
 
 	return result
 }
+
+// ReconnectPortals connects the nvme portals whose sessions are lost, the portals with live sessions are skipped
+func ReconnectPortals(ctx context.Context, transport string, portals []string) error {
+	if !app.GetGlobalConfig().EnableRoCEConnect {
+		log.AddContext(ctx).Infof("RoCE connect is disabled, skip reconnecting nvme portals %v", portals)
+		return nil
+	}
+
+	existSessions, err := getExistSessions(ctx)
+	if err != nil {
+		return fmt.Errorf("get exist nvme sessions error: %w", err)
+	}
+
+	var errs []error
+	for _, portal := range portals {
+		if existSessions[portal] {
+			continue
+		}
+
+		targetNQN, err := getTargetNQN(ctx, portal, transport)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("discover nvme portal %s error: %w", portal, err))
+			continue
+		}
+
+		err = connectPortal(ctx, connectVolRequest{existSessions: existSessions, portal: portal,
+			transport: transport}, targetNQN)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("connect nvme portal %s error: %w", portal, err))
+			continue
+		}
+		log.AddContext(ctx).Infof("Reconnected nvme portal %s", portal)
+	}
+
+	return errors.Join(errs...)
+}
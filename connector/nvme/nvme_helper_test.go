@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2025-2025. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2025-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -24,6 +24,7 @@ import (
 	"github.com/prashantv/gostub"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/connector"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/app"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/app/config"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
@@ -79,3 +80,36 @@ func Test_parseRoCEInfo(t *testing.T) {
 		mock.Reset()
 	})
 }
+
+func TestReconnectPortals_SkipLiveSession(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	subSysInfo := map[string]interface{}{"Subsystems": []interface{}{map[string]interface{}{
+		"Name": "nvme-subsys0",
+		"Paths": []interface{}{map[string]interface{}{
+			"Name": "nvme0", "Transport": transportOfTcp, "State": "live",
+			"Address": "traddr=127.0.0.1,trsvcid=4420",
+		}},
+	}}}
+	var commands []string
+
+	// mock
+	stubs := gostub.StubFunc(&connector.GetSubSysInfo, subSysInfo, nil)
+	defer stubs.Reset()
+	patches := gomonkey.ApplyFunc(utils.ExecShellCmdFilterLog,
+		func(_ context.Context, format string, _ ...interface{}) (string, error) {
+			commands = append(commands, format)
+			return "subnqn: nqn.2020-02.huawei.nvme:nvm-subsystem-sn-1\n", nil
+		})
+	defer patches.Reset()
+
+	// action
+	err := ReconnectPortals(ctx, transportOfTcp, []string{"127.0.0.1", "127.0.0.2"})
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"nvme discover -t tcp -a 127.0.0.2 -s 4420",
+		"nvme connect -t tcp -a 127.0.0.2 -n nqn.2020-02.huawei.nvme:nvm-subsystem-sn-1 -s 4420",
+	}, commands)
+}
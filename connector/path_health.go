@@ -0,0 +1,176 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package connector
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
+)
+
+const (
+	scsiStateRunning  = "running"
+	nvmeStateLive     = "live"
+	upPathStateNormal = "normal"
+
+	nvmeNativeMultipathDir = "multipath"
+)
+
+// sysBlockDir is the sysfs directory of the block devices
+var sysBlockDir = "/sys/block"
+
+// PathHealth is the health of the physical paths of a device
+type PathHealth struct {
+	// Device is the name of the device, such as dm-0, nvme0n1 or sdb
+	Device string
+	// TotalPaths is the number of the physical paths of the device
+	TotalPaths int
+	// FaultyPaths are the names of the physical paths which are not working
+	FaultyPaths []string
+	// Transport is the transport of the nvme paths, such as tcp or rdma, it is empty for the scsi paths
+	Transport string
+}
+
+// Degraded returns whether some of the paths are faulty
+func (h *PathHealth) Degraded() bool {
+	return len(h.FaultyPaths) > 0
+}
+
+// Message returns the readable description of the path health
+func (h *PathHealth) Message() string {
+	if !h.Degraded() {
+		return fmt.Sprintf("all %d paths of device %s are healthy", h.TotalPaths, h.Device)
+	}
+
+	return fmt.Sprintf("%d of %d paths of device %s are faulty: %s", len(h.FaultyPaths), h.TotalPaths,
+		h.Device, strings.Join(h.FaultyPaths, ","))
+}
+
+// GetDevicePathHealth returns the path health of the device, which may be managed by DM-multipath,
+// UltraPath or NVMe native multipath, or is a single path device
+func GetDevicePathHealth(ctx context.Context, device string) (*PathHealth, error) {
+	switch {
+	case strings.HasPrefix(device, "dm-"):
+		return getDMPathHealth(ctx, device)
+	case strings.HasPrefix(device, "ultrapath"):
+		return getUltraPathHealth(ctx, UltraPathNVMeCommand, device)
+	case strings.HasPrefix(device, "sd") && isUltraPathDevice(ctx, device):
+		return getUltraPathHealth(ctx, UltraPathCommand, device)
+	case strings.HasPrefix(device, "nvme"):
+		return getNVMePathHealth(device)
+	case strings.HasPrefix(device, "sd"):
+		health := &PathHealth{Device: device, TotalPaths: 1}
+		if !isPathStateHealthy(filepath.Join(sysBlockDir, device), scsiStateRunning) {
+			health.FaultyPaths = append(health.FaultyPaths, device)
+		}
+		return health, nil
+	default:
+		return nil, fmt.Errorf("unknown device type of device %s", device)
+	}
+}
+
+func getDMPathHealth(ctx context.Context, dm string) (*PathHealth, error) {
+	slaves, err := getDeviceFromDM(dm)
+	if err != nil {
+		return nil, fmt.Errorf("get slaves of device %s error: %w", dm, err)
+	}
+
+	// the dm device is stacked on another dm device, such as the dm-crypt device on the multipath device
+	if len(slaves) == 1 && strings.HasPrefix(slaves[0], "dm-") {
+		return getDMPathHealth(ctx, slaves[0])
+	}
+
+	health := &PathHealth{Device: dm, TotalPaths: len(slaves)}
+	for _, slave := range slaves {
+		expectState := scsiStateRunning
+		if strings.HasPrefix(slave, "nvme") {
+			expectState = nvmeStateLive
+		}
+		if !isPathStateHealthy(filepath.Join(sysBlockDir, slave), expectState) {
+			health.FaultyPaths = append(health.FaultyPaths, slave)
+		}
+	}
+
+	log.AddContext(ctx).Debugf("Path health of device %s: %s", dm, health.Message())
+	return health, nil
+}
+
+func getNVMePathHealth(device string) (*PathHealth, error) {
+	health := &PathHealth{Device: device}
+	paths, err := filepath.Glob(filepath.Join(sysBlockDir, device, nvmeNativeMultipathDir, "*"))
+	if err != nil {
+		return nil, err
+	}
+
+	// the device is not managed by the NVMe native multipath, it is the only path
+	if len(paths) == 0 {
+		paths = []string{filepath.Join(sysBlockDir, device)}
+	}
+
+	health.TotalPaths = len(paths)
+	for _, path := range paths {
+		if !isPathStateHealthy(path, nvmeStateLive) {
+			health.FaultyPaths = append(health.FaultyPaths, filepath.Base(path))
+		}
+		if health.Transport == "" {
+			health.Transport = readPathAttribute(path, "transport")
+		}
+	}
+
+	return health, nil
+}
+
+func getUltraPathHealth(ctx context.Context, upType, device string) (*PathHealth, error) {
+	vLunID, err := GetVLunIDByDevName(ctx, upType, device)
+	if err != nil {
+		return nil, fmt.Errorf("get vlun id of device %s error: %w", device, err)
+	}
+
+	vLun := &UltrapathVLun{ID: vLunID, upType: upType}
+	paths, err := vLun.getPhysicalPaths(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get physical paths of vlun %s error: %w", vLunID, err)
+	}
+
+	health := &PathHealth{Device: device, TotalPaths: len(paths)}
+	for _, path := range paths {
+		if !strings.EqualFold(path.status, upPathStateNormal) {
+			health.FaultyPaths = append(health.FaultyPaths, path.hctl)
+		}
+	}
+
+	return health, nil
+}
+
+// isPathStateHealthy checks the state of the scsi device or the nvme controller of the path in sysfs
+func isPathStateHealthy(pathDir, expectState string) bool {
+	return readPathAttribute(pathDir, "state") == expectState
+}
+
+// readPathAttribute reads the attribute of the scsi device or the nvme controller of the path in sysfs
+func readPathAttribute(pathDir, attribute string) string {
+	value, err := os.ReadFile(filepath.Join(pathDir, "device", attribute))
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(string(value))
+}
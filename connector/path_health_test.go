@@ -0,0 +1,113 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package connector
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetDevicePathHealth_DMDegraded(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	sysDir := t.TempDir()
+	writePathState(t, filepath.Join(sysDir, "sdb"), "running")
+	writePathState(t, filepath.Join(sysDir, "sdc"), "offline")
+
+	// mock
+	stubs := gostub.Stub(&sysBlockDir, sysDir)
+	defer stubs.Reset()
+	stubs.Stub(&getDeviceFromDM, func(dm string) ([]string, error) {
+		if dm == "dm-1" {
+			return []string{"dm-0"}, nil
+		}
+		return []string{"sdb", "sdc"}, nil
+	})
+
+	// action
+	health, err := GetDevicePathHealth(ctx, "dm-1")
+
+	// assert
+	require.NoError(t, err)
+	assert.True(t, health.Degraded())
+	assert.Equal(t, "dm-0", health.Device)
+	assert.Equal(t, 2, health.TotalPaths)
+	assert.Equal(t, []string{"sdc"}, health.FaultyPaths)
+	assert.Equal(t, "1 of 2 paths of device dm-0 are faulty: sdc", health.Message())
+}
+
+func TestGetDevicePathHealth_NVMeNativeMultipath(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	sysDir := t.TempDir()
+	multipathDir := filepath.Join(sysDir, "nvme0n1", nvmeNativeMultipathDir)
+	writePathState(t, filepath.Join(multipathDir, "nvme0c0n1"), "live")
+	writePathState(t, filepath.Join(multipathDir, "nvme0c1n1"), "live")
+	if err := os.WriteFile(filepath.Join(multipathDir, "nvme0c0n1", "device", "transport"),
+		[]byte("tcp\n"), 0640); err != nil {
+		t.Fatal(err)
+	}
+
+	// mock
+	stubs := gostub.Stub(&sysBlockDir, sysDir)
+	defer stubs.Reset()
+
+	// action
+	health, err := GetDevicePathHealth(ctx, "nvme0n1")
+
+	// assert
+	require.NoError(t, err)
+	assert.False(t, health.Degraded())
+	assert.Equal(t, 2, health.TotalPaths)
+	assert.Equal(t, "tcp", health.Transport)
+}
+
+func TestGetDevicePathHealth_UltraPath(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	pathsOutput := "  Path 0 [7:0:0:1] (up-0)   : Normal\n  Path 1 [8:0:0:1] (up-1)   : Fault\n"
+
+	// mock
+	patches := gomonkey.ApplyFuncReturn(GetVLunIDByDevName, "1", nil).
+		ApplyFuncReturn(runUpCommand, pathsOutput, nil)
+	defer patches.Reset()
+
+	// action
+	health, err := GetDevicePathHealth(ctx, "ultrapathd")
+
+	// assert
+	require.NoError(t, err)
+	assert.Equal(t, 2, health.TotalPaths)
+	assert.Equal(t, []string{"8:0:0:1"}, health.FaultyPaths)
+}
+
+func writePathState(t *testing.T, pathDir, state string) {
+	deviceDir := filepath.Join(pathDir, "device")
+	if err := os.MkdirAll(deviceDir, 0750); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(deviceDir, "state"), []byte(state+"\n"), 0640); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/app"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/metrics"
 )
 
 var (
@@ -44,6 +45,9 @@ const (
 	dirPermission      = 0755
 	getLockInternalSec = 5
 
+	// semaphoreNamePrefix is the name prefix of the connector semaphores in metrics
+	semaphoreNamePrefix = "connector-"
+
 	// GetLockTimeoutSec is the maximum number of seconds to acquire a lock
 	GetLockTimeoutSec = 30
 	// GetSemaphoreTimeout is used to determine whether the acquisition of semaphore is time out
@@ -59,10 +63,11 @@ func InitLock(driverName string) error {
 		return err
 	}
 
+	connectorThreads := app.GetGlobalConfig().ConnectorThreads
 	semaphoreMap = map[string]*utils.Semaphore{
-		connectVolume:    utils.NewSemaphore(app.GetGlobalConfig().ConnectorThreads),
-		disConnectVolume: utils.NewSemaphore(app.GetGlobalConfig().ConnectorThreads),
-		extendVolume:     utils.NewSemaphore(app.GetGlobalConfig().ConnectorThreads),
+		connectVolume:    utils.NewNamedSemaphore(semaphoreNamePrefix+connectVolume, connectorThreads),
+		disConnectVolume: utils.NewNamedSemaphore(semaphoreNamePrefix+disConnectVolume, connectorThreads),
+		extendVolume:     utils.NewNamedSemaphore(semaphoreNamePrefix+extendVolume, connectorThreads),
 	}
 	log.Infoln("Init lock success.")
 	return nil
@@ -83,6 +88,7 @@ func SyncLock(ctx context.Context, lockName, operationType string) error {
 	}
 
 	err = waitGetSemaphore(ctx, operationType)
+	metrics.ObserveLockWait(operationType, startTime)
 	if err != nil {
 		newErr := deleteLockFile(ctx, dir, lockName)
 		if newErr != nil {
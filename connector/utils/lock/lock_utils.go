@@ -153,7 +153,7 @@ func waitGetLock(ctx context.Context, lockDir, lockName string) error {
 	return nil
 }
 
-func acquireSemaphore(ctx context.Context, operationType string) chan int {
+func acquireSemaphore(ctx context.Context, operationType string) *utils.Semaphore {
 	semaphore, exist := semaphoreMap[operationType]
 	if !exist {
 		log.AddContext(ctx).Errorf("Acquire semaphore type: %s not exist in %v.", operationType, semaphoreMap)
@@ -161,7 +161,7 @@ func acquireSemaphore(ctx context.Context, operationType string) chan int {
 	}
 
 	log.AddContext(ctx).Infof("Before acquire, available permits is %d", semaphore.AvailablePermits())
-	return semaphore.GetChannel()
+	return semaphore
 }
 
 func releaseSemaphore(ctx context.Context, operationType string) {
@@ -175,18 +175,18 @@ func releaseSemaphore(ctx context.Context, operationType string) {
 }
 
 func waitGetSemaphore(ctx context.Context, operationType string) error {
-	c := acquireSemaphore(ctx, operationType)
-	if c == nil {
+	semaphore := acquireSemaphore(ctx, operationType)
+	if semaphore == nil {
 		msg := fmt.Sprintf("acquire semaphore failed, wrong type: [%s]", operationType)
 		return pkgUtils.Errorln(ctx, msg)
 	}
 
-	select {
-	case c <- 0:
-		log.AddContext(ctx).Infof("acquire [%s] semaphore finish. Used: [%d]", operationType, len(c))
-		return nil
-	case <-time.After(GetLockTimeoutSec * time.Second):
+	if !semaphore.AcquireWithTimeout(GetLockTimeoutSec * time.Second) {
 		msg := fmt.Sprintf("acquire [%s] semaphore timeout", operationType)
 		return pkgUtils.Errorln(ctx, msg)
 	}
+
+	log.AddContext(ctx).Infof("acquire [%s] semaphore finish. Used: [%d]", operationType,
+		len(semaphore.GetChannel()))
+	return nil
 }
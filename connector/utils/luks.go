@@ -0,0 +1,125 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/connector"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
+)
+
+const (
+	luksMapperPrefix = "luks-"
+	luksMapperDir    = "/dev/mapper"
+	luksActiveStatus = "is active"
+)
+
+// LuksMapperName returns the device mapper name of the encrypted volume, which is unique on the node
+func LuksMapperName(volumeId string) string {
+	return luksMapperPrefix + volumeId
+}
+
+// LuksMapperPath returns the path of the opened encrypted device
+func LuksMapperPath(mapperName string) string {
+	return path.Join(luksMapperDir, mapperName)
+}
+
+// OpenLuksDevice formats the device with LUKS2 if it is empty, then opens it and returns the path of the
+// decrypted device. A device which contains data but is not encrypted is never formatted.
+var OpenLuksDevice = func(ctx context.Context, devPath, mapperName, passphrase string) (string, error) {
+	if passphrase == "" {
+		return "", errors.New("the passphrase of the encrypted device is empty")
+	}
+
+	mapperPath := LuksMapperPath(mapperName)
+	if IsLuksDeviceOpened(ctx, mapperName) {
+		log.AddContext(ctx).Infof("Encrypted device %s is already opened as %s", devPath, mapperPath)
+		return mapperPath, nil
+	}
+
+	if _, err := utils.ExecShellCmd(ctx, "cryptsetup isLuks %s", devPath); err != nil {
+		formatted, err := connector.IsDeviceFormatted(ctx, devPath)
+		if err != nil {
+			return "", fmt.Errorf("check device %s formatted failed, error: %w", devPath, err)
+		}
+		if formatted {
+			return "", fmt.Errorf("device %s contains data but is not a LUKS device, refuse to encrypt it", devPath)
+		}
+
+		output, err := utils.ExecShellCmdWithStdin(ctx, passphrase,
+			"cryptsetup luksFormat --type luks2 --batch-mode --key-file=- %s", devPath)
+		if err != nil {
+			return "", fmt.Errorf("luksFormat device %s failed, output: %s, error: %w", devPath, output, err)
+		}
+		log.AddContext(ctx).Infof("Device %s is formatted with LUKS2", devPath)
+	}
+
+	// the discards are allowed so that fstrim of the spaceReclaim volumes reaches the thin luns
+	output, err := utils.ExecShellCmdWithStdin(ctx, passphrase,
+		"cryptsetup luksOpen --allow-discards --key-file=- %s %s", devPath, mapperName)
+	if err != nil {
+		return "", fmt.Errorf("luksOpen device %s failed, output: %s, error: %w", devPath, output, err)
+	}
+
+	log.AddContext(ctx).Infof("Encrypted device %s is opened as %s", devPath, mapperPath)
+	return mapperPath, nil
+}
+
+// CloseLuksDevice closes the encrypted device, it does nothing if the device is not opened
+var CloseLuksDevice = func(ctx context.Context, mapperName string) error {
+	if !IsLuksDeviceOpened(ctx, mapperName) {
+		return nil
+	}
+
+	output, err := utils.ExecShellCmd(ctx, "cryptsetup luksClose %s", mapperName)
+	if err != nil {
+		return fmt.Errorf("luksClose %s failed, output: %s, error: %w", mapperName, output, err)
+	}
+
+	log.AddContext(ctx).Infof("Encrypted device %s is closed", mapperName)
+	return nil
+}
+
+// ResizeLuksDevice resizes the opened encrypted device to the size of the underlying device. The passphrase
+// is only required when the volume key of the device is not in the kernel keyring.
+var ResizeLuksDevice = func(ctx context.Context, mapperName, passphrase string) error {
+	var output string
+	var err error
+	if passphrase == "" {
+		output, err = utils.ExecShellCmd(ctx, "cryptsetup resize %s", mapperName)
+	} else {
+		output, err = utils.ExecShellCmdWithStdin(ctx, passphrase, "cryptsetup resize --key-file=- %s", mapperName)
+	}
+	if err != nil {
+		return fmt.Errorf("resize encrypted device %s failed, output: %s, error: %w", mapperName, output, err)
+	}
+
+	log.AddContext(ctx).Infof("Encrypted device %s is resized", mapperName)
+	return nil
+}
+
+// IsLuksDeviceOpened checks whether the encrypted device is opened on the node
+var IsLuksDeviceOpened = func(ctx context.Context, mapperName string) bool {
+	output, err := utils.ExecShellCmd(ctx, "cryptsetup status %s", mapperName)
+	return err == nil && strings.Contains(output, luksActiveStatus)
+}
@@ -71,11 +71,30 @@ type serviceConfig struct {
 	HealthMonitorEnabled        bool
 	// EnableVolumeModify indicates whether to enable volume modification feature.
 	EnableVolumeModify bool
+	// EnableVolumeFailover indicates whether to enable volume failover feature.
+	EnableVolumeFailover bool
+	// EnableSnapshotRollback indicates whether to enable snapshot rollback feature.
+	EnableSnapshotRollback bool
+	// EnableVolumeMigration indicates whether to enable volume migration feature.
+	EnableVolumeMigration bool
+	// EnableStorageClassValidation indicates whether to validate the storage classes and pvcs by admission webhooks.
+	EnableStorageClassValidation bool
+	// EnableStorageCapacity indicates whether to advertise the GET_CAPACITY capability for CSIStorageCapacity.
+	EnableStorageCapacity bool
 
 	// KubeAPIQPS is the QPS limit for Kubernetes API requests.
 	KubeAPIQPS float32
 	// KubeAPIBurst is the burst limit for Kubernetes API requests.
 	KubeAPIBurst int
+
+	// MetricsAddress is the listen address of the metrics server, the server is disabled if it is empty.
+	MetricsAddress string
+
+	// SpaceReclaimInterval is the interval of the space reclamation on the node, it is disabled if it is zero.
+	SpaceReclaimInterval time.Duration
+
+	// PathMonitorInterval is the interval of the path health inspection on the node, it is disabled if it is zero.
+	PathMonitorInterval time.Duration
 }
 
 type connectorConfig struct {
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2023. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -23,6 +23,7 @@ import (
 	"os"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/agiledragon/gomonkey/v2"
 	"github.com/sirupsen/logrus"
@@ -139,6 +140,26 @@ func TestValidateFlags_NegativeBurst(t *testing.T) {
 	}
 }
 
+func TestValidateFlags_NegativeSpaceReclaimInterval(t *testing.T) {
+	// Arrange
+	opt := &serviceOptions{
+		kubeApiQps:           5.0,
+		kubeApiBurst:         10,
+		spaceReclaimInterval: -time.Hour,
+	}
+
+	// Act
+	errs := opt.ValidateFlags()
+
+	// Assert
+	if len(errs) != 1 {
+		t.Fatalf("expected one error for negative space reclaim interval, got %v", errs)
+	}
+	if errs[0].Error() != "space-reclaim-interval must be >= 0, got -1h0m0s" {
+		t.Errorf("unexpected error message: %s", errs[0].Error())
+	}
+}
+
 func TestValidateFlags_QPSGteBurst(t *testing.T) {
 	// Arrange
 	opt := &serviceOptions{
@@ -68,14 +68,24 @@ type serviceOptions struct {
 	reSyncPeriod        time.Duration
 	timeout             time.Duration
 
-	kubeletVolumeDevicesDirName string
-	reportNodeIP                bool
-	enablePerNodeSecret         bool
-	healthMonitorEnabled        bool
-	enableVolumeModify          bool
+	kubeletVolumeDevicesDirName  string
+	reportNodeIP                 bool
+	enablePerNodeSecret          bool
+	healthMonitorEnabled         bool
+	enableVolumeModify           bool
+	enableVolumeFailover         bool
+	enableSnapshotRollback       bool
+	enableVolumeMigration        bool
+	enableStorageClassValidation bool
+	enableStorageCapacity        bool
 
 	kubeApiQps   float64
 	kubeApiBurst int
+
+	metricsAddress string
+
+	spaceReclaimInterval time.Duration
+	pathMonitorInterval  time.Duration
 }
 
 // NewServiceOptions returns service configurations
@@ -97,6 +107,7 @@ func (opt *serviceOptions) AddFlags(ff *flag.FlagSet) {
 	opt.addFeatureFlags(ff)
 	opt.addRateLimitingFlags(ff)
 	opt.addHealthMonitorFlag(ff)
+	opt.addMetricsFlags(ff)
 }
 
 func (opt *serviceOptions) addCSIEndpointFlags(ff *flag.FlagSet) {
@@ -175,6 +186,20 @@ func (opt *serviceOptions) addFeatureFlags(ff *flag.FlagSet) {
 	ff.BoolVar(&opt.reportNodeIP, "report-node-ip", false, "Whether to report node IP")
 	ff.BoolVar(&opt.enablePerNodeSecret, "enable-per-node-secret", false, `Whether to enable per-node create secret`)
 	ff.BoolVar(&opt.enableVolumeModify, "enable-volume-modify", false, `Whether to enable volume modify feature`)
+	ff.BoolVar(&opt.enableVolumeFailover, "enable-volume-failover", false,
+		`Whether to enable volume failover feature`)
+	ff.BoolVar(&opt.enableSnapshotRollback, "enable-snapshot-rollback", false,
+		`Whether to enable snapshot rollback feature`)
+	ff.BoolVar(&opt.enableVolumeMigration, "enable-volume-migration", false,
+		`Whether to enable volume migration feature`)
+	ff.BoolVar(&opt.enableStorageClassValidation, "enable-storageclass-validation", false,
+		`Whether to validate the parameters of storage classes and annotations of pvcs by admission webhooks`)
+	ff.BoolVar(&opt.enableStorageCapacity, "enable-storage-capacity", false,
+		`Whether to report the capacity of storage pools for the CSIStorageCapacity objects`)
+	ff.DurationVar(&opt.spaceReclaimInterval, "space-reclaim-interval", 0,
+		"The interval to run fstrim on the volumes with spaceReclaim enabled, such as 24h. Disabled if it is 0")
+	ff.DurationVar(&opt.pathMonitorInterval, "path-monitor-interval", 0,
+		"The interval to inspect and recover the paths of the staged volumes, such as 5m. Disabled if it is 0")
 }
 
 func (opt *serviceOptions) addRateLimitingFlags(ff *flag.FlagSet) {
@@ -186,6 +211,11 @@ func (opt *serviceOptions) addHealthMonitorFlag(ff *flag.FlagSet) {
 	ff.BoolVar(&opt.healthMonitorEnabled, "health-monitor-enabled", false, "Whether to enable health monitor")
 }
 
+func (opt *serviceOptions) addMetricsFlags(ff *flag.FlagSet) {
+	ff.StringVar(&opt.metricsAddress, "metrics-address", "",
+		"The address to expose prometheus metrics on, such as :9100. Metrics are disabled if it is empty")
+}
+
 // ApplyFlags assign the service flags
 func (opt *serviceOptions) ApplyFlags(cfg *config.AppConfig) {
 	cfg.Endpoint = opt.endpoint
@@ -214,9 +244,17 @@ func (opt *serviceOptions) ApplyFlags(cfg *config.AppConfig) {
 	cfg.ReportNodeIP = opt.reportNodeIP
 	cfg.EnablePerNodeSecret = opt.enablePerNodeSecret
 	cfg.EnableVolumeModify = opt.enableVolumeModify
+	cfg.EnableVolumeFailover = opt.enableVolumeFailover
+	cfg.EnableSnapshotRollback = opt.enableSnapshotRollback
+	cfg.EnableVolumeMigration = opt.enableVolumeMigration
+	cfg.EnableStorageClassValidation = opt.enableStorageClassValidation
+	cfg.EnableStorageCapacity = opt.enableStorageCapacity
 	cfg.HealthMonitorEnabled = opt.healthMonitorEnabled
 	cfg.KubeAPIQPS = float32(opt.kubeApiQps)
 	cfg.KubeAPIBurst = opt.kubeApiBurst
+	cfg.MetricsAddress = opt.metricsAddress
+	cfg.SpaceReclaimInterval = opt.spaceReclaimInterval
+	cfg.PathMonitorInterval = opt.pathMonitorInterval
 }
 
 // ValidateFlags validate the service flags
@@ -236,6 +274,12 @@ func (opt *serviceOptions) ValidateFlags() []error {
 		errs = append(errs, fmt.Errorf("kube-api-burst (%d) must be > kube-api-qps (%.2f)",
 			burst, qps))
 	}
+	if opt.spaceReclaimInterval < 0 {
+		errs = append(errs, fmt.Errorf("space-reclaim-interval must be >= 0, got %s", opt.spaceReclaimInterval))
+	}
+	if opt.pathMonitorInterval < 0 {
+		errs = append(errs, fmt.Errorf("path-monitor-interval must be >= 0, got %s", opt.pathMonitorInterval))
+	}
 
 	return errs
 }
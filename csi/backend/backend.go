@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2020-2025. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2020-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -67,8 +67,20 @@ var (
 		{"applicationType", filterByApplicationType},
 		{"storageQuota", filterByStorageQuota},
 		{"sourceVolumeName", filterBySupportClone},
-		{"sourceSnapshotName", filterBySupportClone},
+		{"sourceSnapshotName", filterBySupportCloneFromSnapshot},
 		{"nfsProtocol", filterByNFSProtocol},
+		{constants.SmartDedupeKey, filterBySmartDedupe},
+		{constants.SmartCompressionKey, filterBySmartCompression},
+		{constants.SmartTierInitialPolicyKey, filterBySmartTier},
+		{constants.SmartTierRelocationPolicyKey, filterBySmartTier},
+	}
+
+	// CapacityFilterFuncs filters' function map used to select the pools whose capacity should be reported
+	CapacityFilterFuncs = [][]interface{}{
+		{"backend", filterByBackendName},
+		{"pool", filterByStoragePool},
+		{"volumeType", filterByVolumeType},
+		{"allocType", filterByAllocType},
 	}
 
 	// SecondaryFilterFuncs secondary filters' function map
@@ -78,6 +90,10 @@ var (
 		{"qos", filterByQos},
 		{"replication", filterByReplication},
 		{"applicationType", filterByApplicationType},
+		{constants.SmartDedupeKey, filterBySmartDedupe},
+		{constants.SmartCompressionKey, filterBySmartCompression},
+		{constants.SmartTierInitialPolicyKey, filterBySmartTier},
+		{constants.SmartTierRelocationPolicyKey, filterBySmartTier},
 	}
 )
 
@@ -419,15 +435,19 @@ func SelectRemotePool(ctx context.Context, requestSize int64, parameters map[str
 	return remotePool, err
 }
 
-// WeightSinglePools select the optimal storage pool based on the free capacity.
+// WeightSinglePools select the optimal storage pool by the strategy chosen by the poolWeightStrategy parameter,
+// the pool with the most free capacity is selected by default.
 func WeightSinglePools(
 	ctx context.Context,
 	requestSize int64,
 	parameters map[string]interface{},
 	filterPools []*model.StoragePool) (*model.StoragePool, error) {
-	// weight the storage pool by free capacity
-	var selectPool *model.StoragePool
-	selectPool = weightByFreeCapacity(filterPools)
+	strategy, err := GetPoolWeightStrategy(parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	selectPool := strategy.Select(requestSize, filterPools)
 	if selectPool == nil {
 		return nil, fmt.Errorf("cannot select a storage pool for volume (%d, %v)", requestSize, parameters)
 	}
@@ -437,7 +457,7 @@ func WeightSinglePools(
 	return selectPool, nil
 }
 
-// WeightPools select the optimal local and remote storage pool based on the free capacity.
+// WeightPools select the optimal local and remote storage pool by the pool weight strategy.
 func WeightPools(ctx context.Context, requestSize int64, parameters map[string]interface{},
 	localPools []*model.StoragePool, poolPairs []model.SelectPoolPair) (*model.StoragePool, *model.StoragePool, error) {
 	localPool, err := WeightSinglePools(ctx, requestSize, parameters, localPools)
@@ -872,6 +892,60 @@ func filterBySupportClone(ctx context.Context, cloneSource string, candidatePool
 	return filterPools, nil
 }
 
+func filterBySupportCloneFromSnapshot(ctx context.Context, sourceSnapshot string,
+	candidatePools []*model.StoragePool) ([]*model.StoragePool, error) {
+	if sourceSnapshot == "" {
+		return candidatePools, nil
+	}
+	var filterPools []*model.StoragePool
+	for _, pool := range candidatePools {
+		if pool.Capabilities[string(constants.SupportClone)] ||
+			pool.Capabilities[string(constants.SupportCloneFromSnapshot)] {
+			filterPools = append(filterPools, pool)
+		}
+	}
+	return filterPools, nil
+}
+
+func filterBySmartDedupe(ctx context.Context, smartDedupe string, candidatePools []*model.StoragePool) (
+	[]*model.StoragePool, error) {
+	if len(smartDedupe) == 0 || !utils.StrToBool(ctx, smartDedupe) {
+		return candidatePools, nil
+	}
+
+	return filterBySupportedCapability(constants.SupportSmartDedupe, candidatePools), nil
+}
+
+func filterBySmartCompression(ctx context.Context, smartCompression string, candidatePools []*model.StoragePool) (
+	[]*model.StoragePool, error) {
+	if len(smartCompression) == 0 || !utils.StrToBool(ctx, smartCompression) {
+		return candidatePools, nil
+	}
+
+	return filterBySupportedCapability(constants.SupportSmartCompression, candidatePools), nil
+}
+
+func filterBySmartTier(ctx context.Context, smartTierPolicy string, candidatePools []*model.StoragePool) (
+	[]*model.StoragePool, error) {
+	if smartTierPolicy == "" {
+		return candidatePools, nil
+	}
+
+	return filterBySupportedCapability(constants.SupportSmartTier, candidatePools), nil
+}
+
+// filterBySupportedCapability returns the pools which support the capability
+func filterBySupportedCapability(capability constants.BackendCapability,
+	candidatePools []*model.StoragePool) []*model.StoragePool {
+	var filterPools []*model.StoragePool
+	for _, pool := range candidatePools {
+		if pool.Capabilities[string(capability)] {
+			filterPools = append(filterPools, pool)
+		}
+	}
+	return filterPools
+}
+
 // FilterByCapacity filter backend by capacity
 func FilterByCapacity(requestSize int64, allocType string, candidatePools []*model.StoragePool) []*model.StoragePool {
 	var filterPools []*model.StoragePool
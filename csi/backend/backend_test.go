@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2020-2023. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2020-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -30,6 +30,7 @@ import (
 	cfg "github.com/Huawei/eSDK_K8S_Plugin/v4/csi/app/config"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend/cache"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend/model"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
 )
 
@@ -338,6 +339,41 @@ func TestFilterByAllocType(t *testing.T) {
 	}
 }
 
+func TestFilterBySmartXCapabilities(t *testing.T) {
+	// arrange
+	dedupePool := &model.StoragePool{Name: "pool1", Capabilities: map[string]bool{"SupportSmartDedupe": true,
+		"SupportSmartCompression": true}}
+	tierPool := &model.StoragePool{Name: "pool2", Capabilities: map[string]bool{"SupportSmartTier": true}}
+	pools := []*model.StoragePool{dedupePool, tierPool}
+	tests := []struct {
+		name   string
+		filter func(context.Context, string, []*model.StoragePool) ([]*model.StoragePool, error)
+		value  string
+		expect []*model.StoragePool
+	}{
+		{name: "dedupe not set", filter: filterBySmartDedupe, value: "", expect: pools},
+		{name: "dedupe disabled", filter: filterBySmartDedupe, value: "false", expect: pools},
+		{name: "dedupe enabled", filter: filterBySmartDedupe, value: "true",
+			expect: []*model.StoragePool{dedupePool}},
+		{name: "compression enabled", filter: filterBySmartCompression, value: "true",
+			expect: []*model.StoragePool{dedupePool}},
+		{name: "tier not set", filter: filterBySmartTier, value: "", expect: pools},
+		{name: "tier policy set", filter: filterBySmartTier, value: constants.SmartTierPolicyHighest,
+			expect: []*model.StoragePool{tierPool}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// action
+			got, err := tt.filter(ctx, tt.value, pools)
+
+			// assert
+			require.NoError(t, err)
+			require.Equal(t, tt.expect, got)
+		})
+	}
+}
+
 func TestFilterByMetroNormal(t *testing.T) {
 	load := gomonkey.ApplyMethod(reflect.TypeOf(&cache.BackendCache{}), "Load",
 		func(_ *cache.BackendCache, backendName string) (model.Backend, bool) {
@@ -623,6 +659,45 @@ func TestFilterBySupportClone(t *testing.T) {
 	}
 }
 
+func TestFilterBySupportCloneFromSnapshot(t *testing.T) {
+	tests := []struct {
+		name           string
+		sourceSnapshot string
+		candidatePools []*model.StoragePool
+		expect         int64
+	}{
+		{"SupportClone",
+			"snapshot",
+			[]*model.StoragePool{{Capabilities: map[string]bool{"SupportClone": true}}},
+			1},
+		{"SupportCloneFromSnapshot",
+			"snapshot",
+			[]*model.StoragePool{
+				{Capabilities: map[string]bool{"SupportClone": false, "SupportCloneFromSnapshot": true}},
+				{Capabilities: map[string]bool{"SupportClone": false}}},
+			1},
+		{"AllNotSupportClone",
+			"snapshot",
+			[]*model.StoragePool{
+				{Capabilities: map[string]bool{"SupportClone": false, "SupportCloneFromSnapshot": false}},
+				{Capabilities: map[string]bool{"SupportClone": false}}},
+			0},
+		{"sourceSnapshotEmpty",
+			"",
+			nil,
+			0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _ := filterBySupportCloneFromSnapshot(ctx, tt.sourceSnapshot, tt.candidatePools)
+			if int64(len(got)) != tt.expect {
+				t.Errorf("test filterBySupportCloneFromSnapshot faild. got: %v expect: %v", len(got), tt.expect)
+			}
+		})
+	}
+}
+
 func TestFilterByCapacity(t *testing.T) {
 	tests := []struct {
 		name           string
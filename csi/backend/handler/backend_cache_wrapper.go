@@ -19,6 +19,7 @@ package handler
 
 import (
 	"context"
+	"time"
 
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/client/apis/xuanwu/v1"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend"
@@ -80,6 +81,7 @@ func (b *CacheWrapper) updateCacheBackend(ctx context.Context, bk model.Backend,
 
 	bk.UpdatePools(ctx, &sbct)
 	bk.SetAvailable(ctx, true)
+	bk.LastSyncTime = time.Now()
 	b.Store(ctx, bk.Name, bk)
 
 	b.UpdateCacheBackendMetro(ctx)
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend/model"
@@ -33,6 +34,8 @@ type BackendSelectInterface interface {
 	SelectPoolPair(context.Context, int64, map[string]interface{}) (*model.SelectPoolPair, error)
 	SelectLocalPool(context.Context, int64, map[string]interface{}) ([]*model.StoragePool, error)
 	SelectRemotePool(context.Context, int64, string, map[string]interface{}) (*model.StoragePool, error)
+	ListAvailableBackends(context.Context) []*model.Backend
+	SelectCapacityPools(context.Context, map[string]interface{}) ([]*model.StoragePool, error)
 }
 
 // BackendSelector backend selector
@@ -54,6 +57,37 @@ func (b *BackendSelector) SelectBackend(ctx context.Context, name string) (*mode
 	return b.register.LoadOrRegisterOneBackend(ctx, name)
 }
 
+// ListAvailableBackends list all available backends in cache, sorted by backend name
+func (b *BackendSelector) ListAvailableBackends(ctx context.Context) []*model.Backend {
+	var backends []*model.Backend
+	for _, bk := range b.cacheHandler.List(ctx) {
+		if bk.Available && bk.Plugin != nil {
+			backends = append(backends, &bk)
+		}
+	}
+
+	sort.Slice(backends, func(i, j int) bool {
+		return backends[i].Name < backends[j].Name
+	})
+	return backends
+}
+
+// SelectCapacityPools select the available pools which match the parameters and topology
+func (b *BackendSelector) SelectCapacityPools(ctx context.Context,
+	parameters map[string]interface{}) ([]*model.StoragePool, error) {
+	candidatePools := b.cacheHandler.LoadCacheStoragePools(ctx)
+	if len(candidatePools) == 0 {
+		return nil, nil
+	}
+
+	candidatePools, err := backend.FilterByCapability(ctx, parameters, candidatePools, backend.CapacityFilterFuncs)
+	if err != nil {
+		return nil, err
+	}
+
+	return backend.FilterByTopology(parameters, candidatePools)
+}
+
 // SelectPoolPair select local pool and remote pool
 func (b *BackendSelector) SelectPoolPair(ctx context.Context, requestSize int64,
 	params map[string]interface{}) (*model.SelectPoolPair, error) {
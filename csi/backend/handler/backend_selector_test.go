@@ -26,6 +26,7 @@ import (
 
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend/model"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
 )
 
 func TestBackendSelector_SelectBackend(t *testing.T) {
@@ -139,3 +140,51 @@ func TestBackendSelector_SelectPoolPair_Success(t *testing.T) {
 		t.Error("SelectPoolPair want result, but got nil")
 	}
 }
+
+func TestBackendSelector_SelectCapacityPools_FilterByAllocType(t *testing.T) {
+	// arrange
+	instance := NewBackendSelector()
+	params := map[string]interface{}{"allocType": "thick"}
+	thinPool := &model.StoragePool{Name: "pool-1", Storage: constants.OceanStorSan,
+		Capabilities: map[string]bool{"SupportThin": true}}
+	thickPool := &model.StoragePool{Name: "pool-2", Storage: constants.OceanStorSan,
+		Capabilities: map[string]bool{"SupportThick": true}}
+
+	// mock
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(instance.cacheHandler), "LoadCacheStoragePools",
+		func(*CacheWrapper, context.Context) []*model.StoragePool {
+			return []*model.StoragePool{thinPool, thickPool}
+		})
+	defer patches.Reset()
+
+	// action
+	pools, err := instance.SelectCapacityPools(context.Background(), params)
+
+	// assert
+	if err != nil {
+		t.Errorf("SelectCapacityPools want err is nil, but got error is %v", err)
+	}
+	if !reflect.DeepEqual(pools, []*model.StoragePool{thickPool}) {
+		t.Errorf("SelectCapacityPools want pools %v, but got %v", []*model.StoragePool{thickPool}, pools)
+	}
+}
+
+func TestBackendSelector_SelectCapacityPools_EmptyCache(t *testing.T) {
+	// arrange
+	instance := NewBackendSelector()
+
+	// mock
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(instance.cacheHandler), "LoadCacheStoragePools",
+		func(*CacheWrapper, context.Context) []*model.StoragePool {
+			return nil
+		})
+	defer patches.Reset()
+
+	// action
+	pools, err := instance.SelectCapacityPools(context.Background(), map[string]interface{}{})
+
+	// assert
+	if err != nil || len(pools) != 0 {
+		t.Errorf("SelectCapacityPools want empty pools, but got pools %v, error %v", pools, err)
+	}
+}
@@ -0,0 +1,102 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package job
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	xuanwuV1 "github.com/Huawei/eSDK_K8S_Plugin/v4/client/apis/xuanwu/v1"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend/cache"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend/model"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/metrics"
+)
+
+const backendSubsystem = "backend"
+
+var (
+	poolFreeCapacityDesc = metrics.NewDesc(backendSubsystem, "pool_free_capacity_bytes",
+		"Free capacity of the storage pool.", []string{"backend", "pool"})
+	poolTotalCapacityDesc = metrics.NewDesc(backendSubsystem, "pool_total_capacity_bytes",
+		"Total capacity of the storage pool.", []string{"backend", "pool"})
+	backendOnlineDesc = metrics.NewDesc(backendSubsystem, "online",
+		"Whether the backend is online, 1 for online and 0 for offline.", []string{"backend", "storage"})
+	backendSyncAgeDesc = metrics.NewDesc(backendSubsystem, "seconds_since_last_sync",
+		"Seconds since the last successful sync of the backend.", []string{"backend"})
+)
+
+// backendCollector collects the status of the cached backends when the metrics are scraped,
+// so the values are always consistent with the backends synced by the background task
+type backendCollector struct {
+	cache cache.BackendCacheInterface
+	now   func() time.Time
+}
+
+func newBackendCollector(backendCache cache.BackendCacheInterface) *backendCollector {
+	return &backendCollector{cache: backendCache, now: time.Now}
+}
+
+// Describe implements prometheus.Collector
+func (c *backendCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- poolFreeCapacityDesc
+	ch <- poolTotalCapacityDesc
+	ch <- backendOnlineDesc
+	ch <- backendSyncAgeDesc
+}
+
+// Collect implements prometheus.Collector
+func (c *backendCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, bk := range c.cache.List(context.Background()) {
+		online := 0.0
+		if bk.Available {
+			online = 1
+		}
+		ch <- prometheus.MustNewConstMetric(backendOnlineDesc, prometheus.GaugeValue, online, bk.Name, bk.Storage)
+
+		if !bk.LastSyncTime.IsZero() {
+			ch <- prometheus.MustNewConstMetric(backendSyncAgeDesc, prometheus.GaugeValue,
+				c.now().Sub(bk.LastSyncTime).Seconds(), bk.Name)
+		}
+
+		for _, pool := range bk.Pools {
+			collectPoolCapacity(ch, poolFreeCapacityDesc, bk, pool, xuanwuV1.FreeCapacity)
+			collectPoolCapacity(ch, poolTotalCapacityDesc, bk, pool, xuanwuV1.TotalCapacity)
+		}
+	}
+}
+
+func collectPoolCapacity(ch chan<- prometheus.Metric, desc *prometheus.Desc,
+	bk model.Backend, pool *model.StoragePool, capacityType xuanwuV1.CapacityType) {
+	if pool == nil {
+		return
+	}
+
+	value, exist := pool.GetCapacities()[string(capacityType)]
+	if !exist {
+		return
+	}
+
+	capacity, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return
+	}
+
+	ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, capacity, bk.Name, pool.GetName())
+}
@@ -0,0 +1,83 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package job
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend/cache"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend/model"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
+)
+
+const logName = "backend.job.log"
+
+func TestMain(m *testing.M) {
+	log.MockInitLogging(logName)
+	defer log.MockStopLogging(logName)
+
+	m.Run()
+}
+
+func TestBackendCollector_Collect(t *testing.T) {
+	// arrange
+	now := time.Now()
+	backendCache := cache.NewBackendCache()
+	backendCache.Store(context.Background(), "backend1", model.Backend{
+		Name:         "backend1",
+		Storage:      "oceanstor-san",
+		Available:    true,
+		LastSyncTime: now.Add(-30 * time.Second),
+		Pools: []*model.StoragePool{
+			{Name: "pool1", Capacities: map[string]string{"FreeCapacity": "1024", "TotalCapacity": "4096"}},
+			{Name: "pool2", Capacities: map[string]string{"FreeCapacity": "invalid"}},
+		},
+	})
+	backendCache.Store(context.Background(), "backend2", model.Backend{
+		Name:    "backend2",
+		Storage: "fusionstorage-san",
+	})
+	collector := newBackendCollector(backendCache)
+	collector.now = func() time.Time { return now }
+	expected := `
+# HELP huawei_csi_backend_online Whether the backend is online, 1 for online and 0 for offline.
+# TYPE huawei_csi_backend_online gauge
+huawei_csi_backend_online{backend="backend1",storage="oceanstor-san"} 1
+huawei_csi_backend_online{backend="backend2",storage="fusionstorage-san"} 0
+# HELP huawei_csi_backend_pool_free_capacity_bytes Free capacity of the storage pool.
+# TYPE huawei_csi_backend_pool_free_capacity_bytes gauge
+huawei_csi_backend_pool_free_capacity_bytes{backend="backend1",pool="pool1"} 1024
+# HELP huawei_csi_backend_pool_total_capacity_bytes Total capacity of the storage pool.
+# TYPE huawei_csi_backend_pool_total_capacity_bytes gauge
+huawei_csi_backend_pool_total_capacity_bytes{backend="backend1",pool="pool1"} 4096
+# HELP huawei_csi_backend_seconds_since_last_sync Seconds since the last successful sync of the backend.
+# TYPE huawei_csi_backend_seconds_since_last_sync gauge
+huawei_csi_backend_seconds_since_last_sync{backend="backend1"} 30
+`
+
+	// action
+	err := testutil.CollectAndCompare(collector, strings.NewReader(expected))
+
+	// assert
+	require.NoError(t, err)
+}
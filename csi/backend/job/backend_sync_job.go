@@ -18,10 +18,12 @@
 package job
 
 import (
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend/cache"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend/handler"
 	pkgUtils "github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/utils"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/metrics"
 )
 
 var backendSyncInterface = handler.BackendRegisterInterface(nil)
@@ -32,6 +34,10 @@ func RunSyncBackendTaskInBackground() {
 	log.AddContext(ctx).Infof("start backend status subscribe")
 	pkgUtils.Subscribe(pkgUtils.BackendStatus, handler.NewCacheWrapper().UpdateCacheBackendStatus)
 
+	if err := metrics.Register(newBackendCollector(cache.BackendCacheProvider)); err != nil {
+		log.AddContext(ctx).Warningf("register backend metrics collector failed, error: %v", err)
+	}
+
 	log.AddContext(ctx).Infoln("Start to sync Backend")
 	backendSyncInterface = handler.NewBackendRegister()
 	backendSyncInterface.FetchAndRegisterAllBackend(ctx)
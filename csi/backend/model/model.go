@@ -19,6 +19,7 @@ package model
 
 import (
 	"context"
+	"time"
 
 	xuanwuV1 "github.com/Huawei/eSDK_K8S_Plugin/v4/client/apis/xuanwu/v1"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend/plugin"
@@ -50,6 +51,8 @@ type Backend struct {
 
 	ReplicaBackendName string
 	ReplicaBackend     *Backend
+
+	LastSyncTime time.Time
 }
 
 // SetAvailable set Backend available
@@ -167,6 +167,24 @@ func (p *DMEASeriesPlugin) QueryVolume(ctx context.Context, name string,
 	return volume.NewQuerier(ctx, p.cli, model).Query()
 }
 
+// ListVolumes lists all filesystems of the storage whose name starts with the prefix
+func (p *DMEASeriesPlugin) ListVolumes(ctx context.Context, prefix string) ([]utils.Volume, error) {
+	filesystems, err := p.cli.GetFileSystemsByNamePrefix(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	volumes := make([]utils.Volume, 0, len(filesystems))
+	for _, fs := range filesystems {
+		vol := utils.NewVolume(fs.Name)
+		vol.SetID(fs.ID)
+		vol.SetSize(fs.TotalCapacityInByte)
+		volumes = append(volumes, vol)
+	}
+
+	return volumes, nil
+}
+
 // DeleteVolume used to delete volume
 func (p *DMEASeriesPlugin) DeleteVolume(ctx context.Context, name string, _ map[string]interface{}) error {
 	model := &volume.DeleteVolumeModel{
@@ -209,7 +227,7 @@ func (p *DMEASeriesPlugin) getBackendCapabilities() (map[string]interface{}, err
 		"SupportThick":              false,
 		"SupportMetro":              false,
 		"SupportReplication":        false,
-		"SupportClone":              false,
+		"SupportClone":              true,
 		"SupportMetroNAS":           false,
 		"SupportConsistentSnapshot": false,
 	}
@@ -252,14 +270,22 @@ func (p *DMEASeriesPlugin) UpdatePoolCapabilities(ctx context.Context,
 }
 
 // CreateSnapshot used to create snapshot
-func (p *DMEASeriesPlugin) CreateSnapshot(context.Context, string, string, map[string]interface{}) (
-	map[string]interface{}, error) {
-	return nil, fmt.Errorf("%s storage does not support snapshot feature", constants.OceanStorASeriesNasDme)
+func (p *DMEASeriesPlugin) CreateSnapshot(ctx context.Context, fsName, snapshotName string,
+	_ map[string]interface{}) (map[string]interface{}, error) {
+	model := &storage.SnapshotModel{
+		FsName:       fsName,
+		SnapshotName: utils.GetFSSnapshotName(snapshotName),
+	}
+	return volume.NewSnapshotter(ctx, p.cli, model).Create()
 }
 
 // DeleteSnapshot used to delete snapshot
-func (p *DMEASeriesPlugin) DeleteSnapshot(context.Context, string, string) error {
-	return fmt.Errorf("%s storage does not support snapshot feature", constants.OceanStorASeriesNasDme)
+func (p *DMEASeriesPlugin) DeleteSnapshot(ctx context.Context, snapshotParentId, snapshotName string) error {
+	model := &storage.SnapshotModel{
+		ParentId:     snapshotParentId,
+		SnapshotName: utils.GetFSSnapshotName(snapshotName),
+	}
+	return volume.NewSnapshotter(ctx, p.cli, model).Delete()
 }
 
 // SupportQoSParameters checks requested QoS parameters support by dme plugin
@@ -40,6 +40,9 @@ type CreateDmeVolumeParameter struct {
 	AllocType                   string `json:"allocType"`
 	Description                 string `json:"description"`
 	Size                        int64  `json:"size"`
+	SourceVolumeName            string `json:"sourceVolumeName"`
+	SourceSnapshotName          string `json:"sourceSnapshotName"`
+	SnapshotParentId            string `json:"snapshotParentId"`
 }
 
 func (p *CreateDmeVolumeParameter) genCreateVolumeModel(name, protocol string,
@@ -58,6 +61,12 @@ func (p *CreateDmeVolumeParameter) genCreateVolumeModel(name, protocol string,
 		AllSquash:          constants.NoAllSquashValue,
 		RootSquash:         constants.NoRootSquashValue,
 		AllocationType:     p.AllocType,
+		SourceVolumeName:   p.SourceVolumeName,
+		SnapshotParentId:   p.SnapshotParentId,
+	}
+
+	if p.SourceSnapshotName != "" {
+		model.SourceSnapshotName = utils.GetFSSnapshotName(p.SourceSnapshotName)
 	}
 
 	if p.AuthClient != "" {
@@ -42,6 +42,20 @@ func TestCreateDmeVolumeParameter_genCreateVolumeModel_Success(t *testing.T) {
 	assert.Equal(t, constants.NoRootSquashValue, model.RootSquash)
 }
 
+func TestCreateDmeVolumeParameter_genCreateVolumeModel_FromSnapshot(t *testing.T) {
+	// arrange
+	param := &CreateDmeVolumeParameter{AuthClient: "test1", SourceSnapshotName: "snapshot-test",
+		SnapshotParentId: "fs-id"}
+
+	// act
+	model, err := param.genCreateVolumeModel("test", constants.ProtocolNfs, SectorSize)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, "snapshot_test", model.SourceSnapshotName)
+	assert.Equal(t, "fs-id", model.SnapshotParentId)
+}
+
 func TestCreateDmeVolumeParameter_genCreateVolumeModel_Error(t *testing.T) {
 
 	// arrange
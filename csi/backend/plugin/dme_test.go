@@ -25,11 +25,14 @@ import (
 
 	"github.com/agiledragon/gomonkey/v2"
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
 
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
 	pkgVolume "github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/volume"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/dme/aseries/client"
 	dmeVol "github.com/Huawei/eSDK_K8S_Plugin/v4/storage/dme/aseries/volume"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/test/mocks/mock_client"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
 )
 
@@ -257,6 +260,7 @@ func TestDmeASeriesPlugin_UpdateBackendCapabilities(t *testing.T) {
 	assert.NotNil(t, gotCapabilities)
 	assert.NotNil(t, gotSpecifications)
 	assert.True(t, gotCapabilities["SupportThin"].(bool))
+	assert.True(t, gotCapabilities["SupportClone"].(bool))
 }
 
 func TestDmeASeriesPlugin_UpdatePoolCapabilities_Success(t *testing.T) {
@@ -321,14 +325,23 @@ func TestDmeASeriesPlugin_CreateSnapshot(t *testing.T) {
 	p := &DMEASeriesPlugin{}
 	ctx := context.Background()
 	fsName := "fs"
-	snapshotName := "test"
+	snapshotName := "snapshot-test"
+	want := map[string]interface{}{"ParentID": "fsId", "SizeBytes": int64(1024), "CreationTime": int64(1)}
+
+	// mock
+	patch := gomonkey.NewPatches()
+	defer patch.Reset()
+	patch.ApplyMethod((*storage.FSSnapshotter)(nil), "Create",
+		func(s *storage.FSSnapshotter) (map[string]interface{}, error) {
+			return want, nil
+		})
 
 	// act
 	ret, err := p.CreateSnapshot(ctx, fsName, snapshotName, nil)
 
 	// assert
-	assert.Error(t, err)
-	assert.Nil(t, ret)
+	assert.NoError(t, err)
+	assert.Equal(t, want, ret)
 }
 
 func TestDmeASeriesPlugin_DeleteSnapshot(t *testing.T) {
@@ -336,13 +349,19 @@ func TestDmeASeriesPlugin_DeleteSnapshot(t *testing.T) {
 	p := &DMEASeriesPlugin{}
 	ctx := context.Background()
 	snapshotParentId := "fsId"
-	snapshotName := "test"
+	snapshotName := "snapshot-test"
+	wantErr := errors.New("delete snapshot err")
+
+	// mock
+	patch := gomonkey.NewPatches()
+	defer patch.Reset()
+	patch.ApplyMethodReturn((*storage.FSSnapshotter)(nil), "Delete", wantErr)
 
 	// act
 	err := p.DeleteSnapshot(ctx, snapshotParentId, snapshotName)
 
 	// assert
-	assert.Error(t, err)
+	assert.ErrorIs(t, err, wantErr)
 }
 
 func TestDmeASeriesPlugin_SupportQoSParameters(t *testing.T) {
@@ -440,3 +459,26 @@ func TestDmeASeriesPlugin_GetSectorSize(t *testing.T) {
 	// assert
 	assert.Equal(t, SectorSize, size)
 }
+
+func TestDmeASeriesPlugin_ListVolumes(t *testing.T) {
+	// arrange
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockDMEASeriesClientInterface(mockCtrl)
+	p := &DMEASeriesPlugin{cli: cli}
+	ctx := context.Background()
+	filesystems := []*client.FileSystemInfo{{ID: "1", Name: "pvc-1", TotalCapacityInByte: 1024}}
+
+	// mock
+	cli.EXPECT().GetFileSystemsByNamePrefix(ctx, "pvc").Return(filesystems, nil)
+
+	// act
+	got, err := p.ListVolumes(ctx, "pvc")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Len(t, got, 1)
+	assert.Equal(t, "pvc-1", got[0].GetVolumeName())
+	assert.Equal(t, "1", got[0].GetID())
+	assert.Equal(t, int64(1024), got[0].GetSize())
+}
@@ -92,6 +92,8 @@ func (p *FusionStoragePlugin) getParams(name string,
 	paramKeys := []string{
 		"storagepool",
 		"cloneFrom",
+		"sourceSnapshotName",
+		"snapshotParentId",
 		"authClient",
 		"storageQuota",
 		"allSquash",
@@ -228,6 +230,7 @@ func (p *FusionStoragePlugin) getNewClientConfig(ctx context.Context,
 
 	newClientConfig.UseCert, _ = config["useCert"].(bool)
 	newClientConfig.CertSecretMeta, _ = config["certSecret"].(string)
+	newClientConfig.Name, _ = config["name"].(string)
 
 	return newClientConfig, nil
 }
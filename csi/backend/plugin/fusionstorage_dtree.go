@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
 	pkgVolume "github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/volume"
@@ -156,6 +157,32 @@ func (p *FusionStorageDTreePlugin) QueryVolume(ctx context.Context, name string,
 	return dtree.NewQuerier(ctx, p.cli, name, parentName).Query()
 }
 
+// ListVolumes lists all dTrees of the backend parent filesystem whose name starts with the prefix
+func (p *FusionStorageDTreePlugin) ListVolumes(ctx context.Context, prefix string) ([]utils.Volume, error) {
+	if p.parentname == "" {
+		return nil, ErrListVolumesNotSupported
+	}
+
+	dtrees, err := p.cli.GetDTreesByParentName(ctx, p.parentname)
+	if err != nil {
+		return nil, err
+	}
+
+	volumes := make([]utils.Volume, 0, len(dtrees))
+	for _, dtree := range dtrees {
+		if dtree == nil || !strings.HasPrefix(dtree.Name, prefix) {
+			continue
+		}
+
+		vol := utils.NewVolume(dtree.Name)
+		vol.SetDTreeParentName(p.parentname)
+		vol.SetID(dtree.Id)
+		volumes = append(volumes, vol)
+	}
+
+	return volumes, nil
+}
+
 // DeleteVolume used to delete volume
 func (p *FusionStorageDTreePlugin) DeleteVolume(ctx context.Context, s string, _ map[string]interface{}) error {
 	return errors.New("fusionstorage-dtree not support DeleteVolume feature")
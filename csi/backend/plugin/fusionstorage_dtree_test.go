@@ -140,3 +140,36 @@ func TestFusionStorageDTreePlugin_Validate(t *testing.T) {
 	// assert
 	require.NoError(t, err)
 }
+
+func TestFusionStorageDTreePlugin_ListVolumes(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	cli := mock_client.NewMockIRestClient(mockCtrl)
+	p := &FusionStorageDTreePlugin{parentname: "parent"}
+	p.cli = cli
+	dtrees := []*client.DTreeResponse{{Id: "1", Name: "pvc-1"}, {Id: "2", Name: "other-dtree"}}
+
+	// mock
+	cli.EXPECT().GetDTreesByParentName(ctx, "parent").Return(dtrees, nil)
+
+	// action
+	got, err := p.ListVolumes(ctx, "pvc")
+
+	// assert
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	require.Equal(t, "pvc-1", got[0].GetVolumeName())
+	require.Equal(t, "parent", got[0].GetDTreeParentName())
+}
+
+func TestFusionStorageDTreePlugin_ListVolumes_WithoutParentName(t *testing.T) {
+	// arrange
+	p := &FusionStorageDTreePlugin{}
+
+	// action
+	_, err := p.ListVolumes(context.Background(), "pvc")
+
+	// assert
+	require.ErrorIs(t, err, ErrListVolumesNotSupported)
+}
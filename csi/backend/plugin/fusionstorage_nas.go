@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/fusionstorage/client"
@@ -116,6 +117,26 @@ func (p *FusionStorageNasPlugin) QueryVolume(ctx context.Context, name string, p
 	return nas.Query(ctx, name)
 }
 
+// ListVolumes lists all filesystems of the account whose name starts with the prefix
+func (p *FusionStorageNasPlugin) ListVolumes(ctx context.Context, prefix string) ([]utils.Volume, error) {
+	filesystems, err := p.cli.GetFileSystemsByNamePrefix(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	volumes := make([]utils.Volume, 0, len(filesystems))
+	for _, fs := range filesystems {
+		name, _ := fs["name"].(string)
+		vol := utils.NewVolume(name)
+		if id, ok := fs["id"].(float64); ok {
+			vol.SetID(strconv.FormatInt(int64(id), constants.DefaultIntBase))
+		}
+		volumes = append(volumes, vol)
+	}
+
+	return volumes, nil
+}
+
 // DeleteVolume used to delete volume
 func (p *FusionStorageNasPlugin) DeleteVolume(ctx context.Context, name string, params map[string]interface{}) error {
 	nas := volume.NewNAS(p.cli)
@@ -126,11 +147,12 @@ func (p *FusionStorageNasPlugin) DeleteVolume(ctx context.Context, name string,
 func (p *FusionStorageNasPlugin) UpdateBackendCapabilities(ctx context.Context) (map[string]interface{},
 	map[string]interface{}, error) {
 	capabilities := map[string]interface{}{
-		"SupportThin":  true,
-		"SupportThick": false,
-		"SupportQoS":   true,
-		"SupportQuota": true,
-		"SupportClone": false,
+		"SupportThin":              true,
+		"SupportThick":             false,
+		"SupportQoS":               true,
+		"SupportQuota":             true,
+		"SupportClone":             false,
+		"SupportCloneFromSnapshot": true,
 	}
 
 	err := p.updateNFS4Capability(ctx, capabilities)
@@ -141,15 +163,26 @@ func (p *FusionStorageNasPlugin) UpdateBackendCapabilities(ctx context.Context)
 }
 
 // CreateSnapshot used to create snapshot
-func (p *FusionStorageNasPlugin) CreateSnapshot(ctx context.Context, lunName string, snapshotName string,
+func (p *FusionStorageNasPlugin) CreateSnapshot(ctx context.Context, fsName string, snapshotName string,
 	parameters map[string]interface{}) (map[string]interface{}, error) {
-	return nil, fmt.Errorf("fusionstorage-nas not support snapshot feature")
+	nas := volume.NewNAS(p.cli)
+
+	snapshotName = utils.GetFSSnapshotName(snapshotName)
+	snapshot, err := nas.CreateSnapshot(ctx, fsName, snapshotName)
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
 }
 
 // DeleteSnapshot used to delete snapshot
 func (p *FusionStorageNasPlugin) DeleteSnapshot(ctx context.Context,
 	snapshotParentID, snapshotName string) error {
-	return fmt.Errorf("unimplemented")
+	nas := volume.NewNAS(p.cli)
+
+	snapshotName = utils.GetFSSnapshotName(snapshotName)
+	return nas.DeleteSnapshot(ctx, snapshotParentID, snapshotName)
 }
 
 // ExpandVolume used to expand volume
@@ -160,6 +193,26 @@ func (p *FusionStorageNasPlugin) ExpandVolume(ctx context.Context,
 	return false, nas.Expand(ctx, name, utils.TransK8SCapacity(size, p.GetSectorSize()))
 }
 
+// ModifyVolumeAttributes used to change the export options of the filesystem in place,
+// such as auth clients, squash and snapshot directory visibility
+func (p *FusionStorageNasPlugin) ModifyVolumeAttributes(ctx context.Context, name string,
+	params map[string]string) error {
+	nas := volume.NewNAS(p.cli)
+	return nas.ModifyAttributes(ctx, name, params)
+}
+
+// CheckModifyVolumeAttributes used to check the mutable attributes can be modified on the filesystem
+func (p *FusionStorageNasPlugin) CheckModifyVolumeAttributes(_ context.Context, params map[string]string) error {
+	return volume.CheckNASModifyParameters(params)
+}
+
+// GetVolumeExportOptions used to get the export options of the filesystem
+func (p *FusionStorageNasPlugin) GetVolumeExportOptions(ctx context.Context, name string,
+	_ map[string]string) (map[string]string, error) {
+	nas := volume.NewNAS(p.cli)
+	return nas.GetExportOptions(ctx, name)
+}
+
 // UpdatePoolCapabilities used to update pool capabilities
 func (p *FusionStorageNasPlugin) UpdatePoolCapabilities(ctx context.Context,
 	poolNames []string) (map[string]interface{}, error) {
@@ -0,0 +1,227 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package plugin
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/fusionstorage/client"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/test/mocks/mock_client"
+)
+
+const (
+	fusionNasFsName       = "pvc_fs"
+	fusionNasSnapshotName = "snapshot_1"
+	fusionNasQuotaSize    = 10 * 1024 * 1024
+)
+
+func newFusionStorageNasPluginWithMock(t *testing.T) (*FusionStorageNasPlugin, *mock_client.MockIRestClient) {
+	mockCtrl := gomock.NewController(t)
+	cli := mock_client.NewMockIRestClient(mockCtrl)
+	p, ok := GetPlugin(constants.FusionNas).(*FusionStorageNasPlugin)
+	require.True(t, ok)
+	p.cli = cli
+	p.protocol = constants.ProtocolNfs
+	return p, cli
+}
+
+func TestFusionStorageNasPlugin_CreateSnapshot_Success(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	p, cli := newFusionStorageNasPluginWithMock(t)
+	quota := &client.QueryQuotaResponse{Id: "1", SpaceHardQuota: fusionNasQuotaSize, SpaceUnitType: 1}
+	snapshot := &client.FSSnapshotResponse{Id: "1", Name: fusionNasSnapshotName, CreateTime: 1700000000}
+	want := map[string]interface{}{
+		"CreationTime": int64(1700000000),
+		"SizeBytes":    int64(fusionNasQuotaSize * 1024),
+		"ParentID":     fusionNasFsName,
+	}
+
+	// mock
+	cli.EXPECT().GetQuotaByFileSystemName(ctx, fusionNasFsName).Return(quota, nil)
+	gomock.InOrder(
+		cli.EXPECT().GetFSSnapshotByName(ctx, fusionNasFsName, fusionNasSnapshotName).Return(nil, nil),
+		cli.EXPECT().CreateFSSnapshot(ctx, fusionNasFsName, fusionNasSnapshotName).Return(snapshot, nil),
+		cli.EXPECT().GetFSSnapshotByName(ctx, fusionNasFsName, fusionNasSnapshotName).Return(snapshot, nil),
+	)
+
+	// action
+	got, err := p.CreateSnapshot(ctx, fusionNasFsName, "snapshot-1", nil)
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
+
+func TestFusionStorageNasPlugin_CreateSnapshot_AlreadyExist(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	p, cli := newFusionStorageNasPluginWithMock(t)
+	quota := &client.QueryQuotaResponse{Id: "1", SpaceHardQuota: fusionNasQuotaSize, SpaceUnitType: 1}
+	snapshot := &client.FSSnapshotResponse{Id: "1", Name: fusionNasSnapshotName, CreateTime: 1700000000}
+
+	// mock
+	cli.EXPECT().GetQuotaByFileSystemName(ctx, fusionNasFsName).Return(quota, nil)
+	cli.EXPECT().GetFSSnapshotByName(ctx, fusionNasFsName, fusionNasSnapshotName).Return(snapshot, nil)
+
+	// action
+	got, err := p.CreateSnapshot(ctx, fusionNasFsName, fusionNasSnapshotName, nil)
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, int64(1700000000), got["CreationTime"])
+}
+
+func TestFusionStorageNasPlugin_CreateSnapshot_CreateFailed(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	p, cli := newFusionStorageNasPluginWithMock(t)
+	quota := &client.QueryQuotaResponse{Id: "1", SpaceHardQuota: fusionNasQuotaSize, SpaceUnitType: 1}
+
+	// mock
+	cli.EXPECT().GetQuotaByFileSystemName(ctx, fusionNasFsName).Return(quota, nil)
+	cli.EXPECT().GetFSSnapshotByName(ctx, fusionNasFsName, fusionNasSnapshotName).Return(nil, nil)
+	cli.EXPECT().CreateFSSnapshot(ctx, fusionNasFsName, fusionNasSnapshotName).
+		Return(nil, errors.New("create error"))
+
+	// action
+	_, err := p.CreateSnapshot(ctx, fusionNasFsName, fusionNasSnapshotName, nil)
+
+	// assert
+	require.ErrorContains(t, err, "create error")
+}
+
+func TestFusionStorageNasPlugin_DeleteSnapshot(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	p, cli := newFusionStorageNasPluginWithMock(t)
+	snapshot := &client.FSSnapshotResponse{Id: "1", Name: fusionNasSnapshotName}
+
+	// mock
+	cli.EXPECT().GetFSSnapshotByName(ctx, fusionNasFsName, fusionNasSnapshotName).Return(snapshot, nil)
+	cli.EXPECT().DeleteFSSnapshot(ctx, fusionNasFsName, fusionNasSnapshotName).Return(nil)
+
+	// action
+	err := p.DeleteSnapshot(ctx, fusionNasFsName, "snapshot-1")
+
+	// assert
+	require.NoError(t, err)
+}
+
+func TestFusionStorageNasPlugin_DeleteSnapshot_NotExist(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	p, cli := newFusionStorageNasPluginWithMock(t)
+
+	// mock
+	cli.EXPECT().GetFSSnapshotByName(ctx, fusionNasFsName, fusionNasSnapshotName).Return(nil, nil)
+
+	// action
+	err := p.DeleteSnapshot(ctx, fusionNasFsName, fusionNasSnapshotName)
+
+	// assert
+	require.NoError(t, err)
+}
+
+func TestFusionStorageNasPlugin_CreateVolume_FromSnapshot(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	p, cli := newFusionStorageNasPluginWithMock(t)
+	cloneName := "pvc_clone"
+	parameters := map[string]interface{}{
+		"description":        "test",
+		"size":               int64(fusionNasQuotaSize * 1024),
+		"authClient":         "*",
+		"sourceSnapshotName": "snapshot-1",
+		"snapshotParentId":   fusionNasFsName,
+	}
+	quota := &client.QueryQuotaResponse{Id: "1", SpaceHardQuota: fusionNasQuotaSize, SpaceUnitType: 1}
+	snapshot := &client.FSSnapshotResponse{Id: "1", Name: fusionNasSnapshotName}
+	cloneFS := map[string]interface{}{"id": float64(2), "running_status": float64(0)}
+	wantReq := &client.CloneFSFromSnapshotRequest{Name: cloneName, ParentFSName: fusionNasFsName,
+		ParentSnapshotName: fusionNasSnapshotName}
+
+	// mock
+	gomock.InOrder(
+		cli.EXPECT().GetFileSystemByName(ctx, cloneName).Return(nil, nil),
+		cli.EXPECT().GetFSSnapshotByName(ctx, fusionNasFsName, fusionNasSnapshotName).Return(snapshot, nil),
+		cli.EXPECT().GetQuotaByFileSystemName(ctx, fusionNasFsName).Return(quota, nil),
+		cli.EXPECT().CloneFSFromSnapshot(ctx, wantReq).Return(nil),
+		cli.EXPECT().GetFileSystemByName(ctx, cloneName).Return(cloneFS, nil).Times(2),
+	)
+	cli.EXPECT().GetQuotaByFileSystemById(ctx, "2").Return(map[string]interface{}{"id": "1"}, nil)
+	cli.EXPECT().GetNfsShareByPath(ctx, "/pvc_clone/").Return(map[string]interface{}{"id": "3"}, nil)
+	cli.EXPECT().AllowNfsShareAccess(ctx, gomock.Any()).Return(nil)
+
+	// action
+	vol, err := p.CreateVolume(ctx, "pvc-clone", parameters)
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, cloneName, vol.GetVolumeName())
+}
+
+func TestFusionStorageNasPlugin_CreateVolume_FromSnapshotCapacityTooSmall(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	p, cli := newFusionStorageNasPluginWithMock(t)
+	parameters := map[string]interface{}{
+		"description":        "test",
+		"size":               int64(1024 * 1024),
+		"authClient":         "*",
+		"sourceSnapshotName": fusionNasSnapshotName,
+		"snapshotParentId":   fusionNasFsName,
+	}
+	quota := &client.QueryQuotaResponse{Id: "1", SpaceHardQuota: fusionNasQuotaSize, SpaceUnitType: 1}
+	snapshot := &client.FSSnapshotResponse{Id: "1", Name: fusionNasSnapshotName}
+
+	// mock
+	cli.EXPECT().GetFileSystemByName(ctx, "pvc_clone").Return(nil, nil)
+	cli.EXPECT().GetFSSnapshotByName(ctx, fusionNasFsName, fusionNasSnapshotName).Return(snapshot, nil)
+	cli.EXPECT().GetQuotaByFileSystemName(ctx, fusionNasFsName).Return(quota, nil)
+
+	// action
+	_, err := p.CreateVolume(ctx, "pvc-clone", parameters)
+
+	// assert
+	require.ErrorContains(t, err, "capacity must be >= src snapshot")
+}
+
+func TestFusionStorageNasPlugin_ListVolumes(t *testing.T) {
+	// arrange
+	p, cli := newFusionStorageNasPluginWithMock(t)
+	ctx := context.Background()
+	filesystems := []map[string]interface{}{{"name": fusionNasFsName, "id": float64(10)}}
+
+	// mock
+	cli.EXPECT().GetFileSystemsByNamePrefix(ctx, "pvc").Return(filesystems, nil)
+
+	// action
+	got, err := p.ListVolumes(ctx, "pvc")
+
+	// assert
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	require.Equal(t, fusionNasFsName, got[0].GetVolumeName())
+	require.Equal(t, "10", got[0].GetID())
+}
@@ -205,6 +205,30 @@ func (p *FusionStorageSanPlugin) QueryVolume(ctx context.Context, name string, p
 	return san.Query(ctx, name)
 }
 
+// ListVolumes lists the volumes of all pools whose name starts with the prefix
+func (p *FusionStorageSanPlugin) ListVolumes(ctx context.Context, prefix string) ([]utils.Volume, error) {
+	objs, err := p.listVolumesByNamePrefix(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	volumes := make([]utils.Volume, 0, len(objs))
+	for _, obj := range objs {
+		name, _ := obj["volName"].(string)
+		vol := utils.NewVolume(name)
+		if wwn, ok := obj["wwn"].(string); ok {
+			vol.SetLunWWN(wwn)
+		}
+		// the size queried from storage is in MiB
+		if capacity, ok := obj["volSize"].(float64); ok {
+			vol.SetSize(utils.TransK8SCapacity(int64(capacity), constants.FusionAllocUnitBytes))
+		}
+		volumes = append(volumes, vol)
+	}
+
+	return volumes, nil
+}
+
 // DeleteVolume used to delete volume
 func (p *FusionStorageSanPlugin) DeleteVolume(ctx context.Context, name string, params map[string]interface{}) error {
 	san := volume.NewSAN(p.cli)
@@ -322,6 +346,113 @@ func (p *FusionStorageSanPlugin) DeleteSnapshot(ctx context.Context,
 	return nil
 }
 
+// ListSnapshots lists the snapshots which match the query
+func (p *FusionStorageSanPlugin) ListSnapshots(ctx context.Context,
+	query utils.SnapshotQuery) ([]map[string]interface{}, error) {
+	if query.Name != "" {
+		return p.listSnapshotsByName(ctx, query)
+	}
+
+	var volumes []map[string]interface{}
+	if query.VolumeName != "" {
+		lun, err := p.cli.GetVolumeByName(ctx, query.VolumeName)
+		if err != nil || lun == nil {
+			return nil, err
+		}
+		lun["volName"] = query.VolumeName
+		volumes = append(volumes, lun)
+	} else {
+		var err error
+		volumes, err = p.listVolumesByNamePrefix(ctx, query.VolumePrefix)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var snapshots []map[string]interface{}
+	for _, volume := range volumes {
+		volName, _ := volume["volName"].(string)
+		objs, err := p.cli.GetSnapshotsByVolumeName(ctx, volName)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, obj := range objs {
+			snapshots = append(snapshots, newFusionStorageSnapshot(obj, volume))
+		}
+	}
+
+	return snapshots, nil
+}
+
+func (p *FusionStorageSanPlugin) listSnapshotsByName(ctx context.Context,
+	query utils.SnapshotQuery) ([]map[string]interface{}, error) {
+	snapshot, err := p.cli.GetSnapshotByName(ctx, utils.GetFusionStorageSnapshotName(query.Name))
+	if err != nil || snapshot == nil {
+		return nil, err
+	}
+
+	volName, _ := snapshot["fatherName"].(string)
+	lun, err := p.cli.GetVolumeByName(ctx, volName)
+	if err != nil || lun == nil {
+		return nil, err
+	}
+
+	lun["volName"] = volName
+	entry := newFusionStorageSnapshot(snapshot, lun)
+	if entry["ParentID"] != query.ParentID {
+		return nil, nil
+	}
+	return []map[string]interface{}{entry}, nil
+}
+
+// listVolumesByNamePrefix lists the volumes of all pools whose name starts with the prefix
+func (p *FusionStorageSanPlugin) listVolumesByNamePrefix(ctx context.Context,
+	prefix string) ([]map[string]interface{}, error) {
+	pools, err := p.cli.GetAllPools(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var volumes []map[string]interface{}
+	for _, pool := range pools {
+		poolInfo, ok := pool.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		poolID, _ := poolInfo["poolId"].(float64)
+		poolVolumes, err := p.cli.GetVolumesByPoolID(ctx, int64(poolID))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, volume := range poolVolumes {
+			if volName, _ := volume["volName"].(string); strings.HasPrefix(volName, prefix) {
+				volumes = append(volumes, volume)
+			}
+		}
+	}
+
+	return volumes, nil
+}
+
+// newFusionStorageSnapshot converts the snapshot of the volume queried from storage to snapshot info
+func newFusionStorageSnapshot(obj, volume map[string]interface{}) map[string]interface{} {
+	name, _ := obj["snapshotName"].(string)
+	createTime, _ := obj["createTime"].(string)
+	sizeMB, _ := obj["snapshotSize"].(float64)
+	volName, _ := volume["volName"].(string)
+	volID, _ := volume["volId"].(float64)
+	return map[string]interface{}{
+		"Name":         name,
+		"ParentID":     strconv.FormatInt(int64(volID), constants.DefaultIntBase),
+		"ParentName":   volName,
+		"SizeBytes":    int64(sizeMB) * constants.FusionAllocUnitBytes,
+		"CreationTime": utils.ParseIntWithDefault(createTime, constants.DefaultIntBase, constants.DefaultIntBitSize, 0),
+	}
+}
+
 // UpdatePoolCapabilities used to update pool capabilities
 func (p *FusionStorageSanPlugin) UpdatePoolCapabilities(ctx context.Context,
 	poolNames []string) (map[string]interface{}, error) {
@@ -25,9 +25,12 @@ import (
 
 	"github.com/agiledragon/gomonkey/v2"
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
 
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/proto"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/test/mocks/mock_client"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
 )
 
 func TestFusionStorageSanPlugin_Init_SuccessWithPortals(t *testing.T) {
@@ -359,3 +362,66 @@ func TestFusionStorageSanPlugin_verifyFusionStorageSanParam_ValidIscsiWithLinks(
 	// assert
 	assert.Nil(t, gotErr)
 }
+
+func TestFusionStorageSanPlugin_ListSnapshots_ByVolumePrefix(t *testing.T) {
+	// arrange
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockIRestClient(mockCtrl)
+	p := &FusionStorageSanPlugin{}
+	p.cli = cli
+	ctx := context.Background()
+	pools := map[string]interface{}{"pool1": map[string]interface{}{"poolId": float64(1), "poolName": "pool1"}}
+	volumes := []map[string]interface{}{
+		{"volName": "pvc-1", "volId": float64(10)},
+		{"volName": "other-volume", "volId": float64(11)},
+	}
+	snapshots := []map[string]interface{}{
+		{"snapshotName": "snapshot-1", "createTime": "1700000000", "snapshotSize": float64(1024)},
+	}
+
+	// mock
+	cli.EXPECT().GetAllPools(ctx).Return(pools, nil)
+	cli.EXPECT().GetVolumesByPoolID(ctx, int64(1)).Return(volumes, nil)
+	cli.EXPECT().GetSnapshotsByVolumeName(ctx, "pvc-1").Return(snapshots, nil)
+
+	// action
+	got, err := p.ListSnapshots(ctx, utils.SnapshotQuery{VolumePrefix: "pvc"})
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, []map[string]interface{}{{
+		"Name": "snapshot-1", "ParentID": "10", "ParentName": "pvc-1",
+		"SizeBytes": int64(1024) * constants.FusionAllocUnitBytes, "CreationTime": int64(1700000000),
+	}}, got)
+}
+
+func TestFusionStorageSanPlugin_ListVolumes(t *testing.T) {
+	// arrange
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockIRestClient(mockCtrl)
+	p := &FusionStorageSanPlugin{}
+	p.cli = cli
+	ctx := context.Background()
+	pools := map[string]interface{}{"pool1": map[string]interface{}{"poolId": float64(1), "poolName": "pool1"}}
+	volumes := []map[string]interface{}{
+		{"volName": "pvc-1", "volId": float64(10), "wwn": "wwn-1", "volSize": float64(1024)},
+		{"volName": "other-volume", "volId": float64(11)},
+	}
+
+	// mock
+	cli.EXPECT().GetAllPools(ctx).Return(pools, nil)
+	cli.EXPECT().GetVolumesByPoolID(ctx, int64(1)).Return(volumes, nil)
+
+	// action
+	got, err := p.ListVolumes(ctx, "pvc")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Len(t, got, 1)
+	assert.Equal(t, "pvc-1", got[0].GetVolumeName())
+	wwn, _ := got[0].GetLunWWN()
+	assert.Equal(t, "wwn-1", wwn)
+	assert.Equal(t, int64(1024)*constants.FusionAllocUnitBytes, got[0].GetSize())
+}
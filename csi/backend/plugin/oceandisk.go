@@ -71,7 +71,7 @@ func (p *OceandiskPlugin) getBackendCapabilities() map[string]interface{} {
 		"SupportMetro":           false,
 		"SupportReplication":     false,
 		"SupportApplicationType": true,
-		"SupportClone":           false,
+		"SupportClone":           true,
 		"SupportMetroNAS":        false,
 	}
 
@@ -127,6 +127,16 @@ func (p *OceandiskSanPlugin) QueryVolume(ctx context.Context, name string, param
 	return san.Query(ctx, name, params)
 }
 
+// ListVolumes lists all namespaces whose name starts with the prefix
+func (p *OceandiskSanPlugin) ListVolumes(ctx context.Context, prefix string) ([]utils.Volume, error) {
+	namespaces, err := p.cli.GetNamespacesByNamePrefix(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	return newVolumesFromStorageObjs(ctx, namespaces), nil
+}
+
 // DeleteVolume used to delete volume
 func (p *OceandiskSanPlugin) DeleteVolume(ctx context.Context, name string, params map[string]interface{}) error {
 	san := p.getSanObj()
@@ -179,13 +189,19 @@ func (p *OceandiskSanPlugin) UpdatePoolCapabilities(ctx context.Context,
 // CreateSnapshot used to create snapshot
 func (p *OceandiskSanPlugin) CreateSnapshot(ctx context.Context, namespaceName string,
 	snapshotName string, parameters map[string]interface{}) (map[string]interface{}, error) {
-	return nil, errors.New("oceandisk does not support snapshot feature")
+	san := p.getSanObj()
+
+	snapshotName = utils.GetSnapshotName(snapshotName)
+	return san.CreateSnapshot(ctx, namespaceName, snapshotName)
 }
 
 // DeleteSnapshot used to delete snapshot
 func (p *OceandiskSanPlugin) DeleteSnapshot(ctx context.Context,
 	snapshotParentID, snapshotName string) error {
-	return errors.New("oceandisk does not support snapshot feature")
+	san := p.getSanObj()
+
+	snapshotName = utils.GetSnapshotName(snapshotName)
+	return san.DeleteSnapshot(ctx, snapshotName)
 }
 
 // UpdateBackendCapabilities to update the block storage capabilities
@@ -22,10 +22,12 @@ import (
 
 	"github.com/agiledragon/gomonkey/v2"
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
 
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/base"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/oceandisk/client"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/test/mocks/mock_client"
 )
 
 func TestOceandiskSanPlugin_InitSuccess(t *testing.T) {
@@ -135,3 +137,31 @@ func TestOceandiskSanPlugin_PortalsNotExist(t *testing.T) {
 	// assert
 	assert.ErrorContains(t, gotErr, "portals are required to configure")
 }
+
+func TestOceandiskSanPlugin_DeleteSnapshot_TruncateName(t *testing.T) {
+	// arrange
+	mockCtrl := gomock.NewController(t)
+	cli := mock_client.NewMockOceandiskClientInterface(mockCtrl)
+	p := &OceandiskSanPlugin{OceandiskPlugin: OceandiskPlugin{cli: cli}}
+	snapshotName := "snapshot-0123456789-0123456789-0123456789"
+
+	// mock
+	cli.EXPECT().GetNamespaceSnapshotByName(ctx, snapshotName[:31]).Return(map[string]interface{}{}, nil)
+
+	// act
+	gotErr := p.DeleteSnapshot(ctx, "1", snapshotName)
+
+	// assert
+	assert.NoError(t, gotErr)
+}
+
+func TestOceandiskSanPlugin_SupportClone(t *testing.T) {
+	// arrange
+	p := &OceandiskSanPlugin{}
+
+	// act
+	capabilities := p.getBackendCapabilities()
+
+	// assert
+	assert.Equal(t, true, capabilities["SupportClone"])
+}
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2020-2025. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2020-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -126,18 +126,25 @@ func (p *OceanstorPlugin) updateBackendCapabilities(ctx context.Context) (map[st
 	supportReplication := utils.IsSupportFeature(features, "HyperReplication")
 	supportClone := utils.IsSupportFeature(features, "HyperClone") || utils.IsSupportFeature(features, "HyperCopy")
 	supportApplicationType := p.product.IsDoradoV6OrV7()
+	// the deduplication and compression of Dorado V6 and later are built in and not controlled by the license
+	supportSmartDedupe := utils.IsSupportFeature(features, "SmartDedupe") || p.product.IsDoradoV6OrV7()
+	supportSmartCompression := utils.IsSupportFeature(features, "SmartCompression") || p.product.IsDoradoV6OrV7()
+	supportSmartTier := utils.IsSupportFeature(features, "SmartTier")
 
 	log.AddContext(ctx).Debugf("storageVersion: %v", p.cli.GetStorageVersion())
 
 	capabilities := map[string]interface{}{
-		"SupportThin":            supportThin,
-		"SupportThick":           supportThick,
-		"SupportQoS":             supportQoS,
-		"SupportMetro":           supportMetro,
-		"SupportReplication":     supportReplication,
-		"SupportApplicationType": supportApplicationType,
-		"SupportClone":           supportClone,
-		"SupportMetroNAS":        supportMetroNAS,
+		"SupportThin":             supportThin,
+		"SupportThick":            supportThick,
+		"SupportQoS":              supportQoS,
+		"SupportMetro":            supportMetro,
+		"SupportReplication":      supportReplication,
+		"SupportApplicationType":  supportApplicationType,
+		"SupportClone":            supportClone,
+		"SupportMetroNAS":         supportMetroNAS,
+		"SupportSmartDedupe":      supportSmartDedupe,
+		"SupportSmartCompression": supportSmartCompression,
+		"SupportSmartTier":        supportSmartTier,
 	}
 
 	return capabilities, nil
@@ -172,6 +179,7 @@ func (p *OceanstorPlugin) updateBackendSpecifications(ctx context.Context) (map[
 		"RemoteDevicesSN": devicesSN,
 		"VStoreID":        p.cli.GetvStoreID(),
 		"VStoreName":      p.cli.GetvStoreName(),
+		"Product":         string(p.product),
 	}
 	return specifications, nil
 }
@@ -284,6 +292,8 @@ func processBoolParams(ctx context.Context, source, target map[string]interface{
 		"replication",
 		"hyperMetro",
 		"waitForSplit",
+		constants.SmartDedupeKey,
+		constants.SmartCompressionKey,
 	} {
 		if v, exist := source[i].(string); exist && v != "" {
 			target[strings.ToLower(i)] = utils.StrToBool(ctx, v)
@@ -324,6 +334,8 @@ func toLowerParams(source, target map[string]interface{}) {
 		"accesskrb5p",
 		"fileSystemMode",
 		"metroPairSyncSpeed",
+		constants.SmartTierInitialPolicyKey,
+		constants.SmartTierRelocationPolicyKey,
 	} {
 		if v, exist := source[key]; exist && v != "" {
 			target[strings.ToLower(key)] = v
@@ -26,6 +26,7 @@ import (
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
 	pkgUtils "github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/utils"
 	pkgVolume "github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/volume"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/aseries/client"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/aseries/smartx"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/aseries/volume"
@@ -156,6 +157,16 @@ func (p *OceanstorASeriesPlugin) QueryVolume(ctx context.Context, name string, p
 	return volume.NewQuerier(ctx, p.cli, model).Query()
 }
 
+// ListVolumes lists all filesystems of the vStore whose name starts with the prefix
+func (p *OceanstorASeriesPlugin) ListVolumes(ctx context.Context, prefix string) ([]utils.Volume, error) {
+	fsList, err := p.cli.GetFileSystemsByNamePrefix(ctx, prefix, p.cli.GetvStoreID())
+	if err != nil {
+		return nil, err
+	}
+
+	return newVolumesFromStorageObjs(ctx, fsList), nil
+}
+
 // DeleteVolume used to delete volume
 func (p *OceanstorASeriesPlugin) DeleteVolume(ctx context.Context, name string, params map[string]interface{}) error {
 	kvCacheStoreId, _ := utils.GetValue[string](params, constants.KvCacheStoreId)
@@ -267,7 +278,7 @@ func (p *OceanstorASeriesPlugin) getBackendCapabilities(ctx context.Context) (ma
 		"SupportThick":              false,
 		"SupportMetro":              false,
 		"SupportReplication":        false,
-		"SupportClone":              false,
+		"SupportClone":              true,
 		"SupportMetroNAS":           false,
 		"SupportConsistentSnapshot": false,
 	}
@@ -347,12 +358,20 @@ func (p *OceanstorASeriesPlugin) GetSectorSize() int64 {
 // CreateSnapshot used to create snapshot
 func (p *OceanstorASeriesPlugin) CreateSnapshot(ctx context.Context, fsName string, snapshotName string,
 	parameters map[string]interface{}) (map[string]interface{}, error) {
-	return nil, fmt.Errorf("%s storage does not support snapshot feature", constants.OceanStorASeriesNas)
+	model := &storage.SnapshotModel{
+		FsName:       fsName,
+		SnapshotName: utils.GetFSSnapshotName(snapshotName),
+	}
+	return volume.NewSnapshotter(ctx, p.cli, model).Create()
 }
 
 // DeleteSnapshot used to delete snapshot
 func (p *OceanstorASeriesPlugin) DeleteSnapshot(ctx context.Context, snapshotParentId, snapshotName string) error {
-	return fmt.Errorf("%s storage does not support snapshot feature", constants.OceanStorASeriesNas)
+	model := &storage.SnapshotModel{
+		ParentId:     snapshotParentId,
+		SnapshotName: utils.GetFSSnapshotName(snapshotName),
+	}
+	return volume.NewSnapshotter(ctx, p.cli, model).Delete()
 }
 
 // DeleteDTreeVolume used to delete DTree volume
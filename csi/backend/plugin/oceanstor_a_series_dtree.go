@@ -21,6 +21,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
 	pkgVolume "github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/volume"
@@ -84,6 +85,8 @@ func (p *OceanstorASeriesDtreePlugin) UpdateBackendCapabilities(ctx context.Cont
 	capabilities[string(constants.SupportQoS)] = false
 	capabilities[string(constants.SupportThick)] = false
 	capabilities[string(constants.SupportQuota)] = true
+	// The snapshots of A-series are taken at filesystem level, so a DTree volume can not be cloned or restored.
+	capabilities[string(constants.SupportClone)] = false
 
 	return capabilities, specifications, nil
 }
@@ -146,6 +149,35 @@ func (p *OceanstorASeriesDtreePlugin) QueryVolume(ctx context.Context, name stri
 	return dtree.NewQuerier(ctx, p.cli, name, parentName).Query()
 }
 
+// ListVolumes lists all DTrees of the backend parent filesystem whose name starts with the prefix
+func (p *OceanstorASeriesDtreePlugin) ListVolumes(ctx context.Context, prefix string) ([]utils.Volume, error) {
+	if p.parentName == "" {
+		return nil, ErrListVolumesNotSupported
+	}
+
+	dtrees, err := p.cli.GetDTreesByParentName(ctx, p.parentName, p.cli.GetvStoreID())
+	if err != nil {
+		return nil, err
+	}
+
+	volumes := make([]utils.Volume, 0, len(dtrees))
+	for _, dtree := range dtrees {
+		name, ok := dtree["NAME"].(string)
+		if !ok || !strings.HasPrefix(name, prefix) {
+			continue
+		}
+
+		vol := utils.NewVolume(name)
+		vol.SetDTreeParentName(p.parentName)
+		if id, ok := dtree["ID"].(string); ok {
+			vol.SetID(id)
+		}
+		volumes = append(volumes, vol)
+	}
+
+	return volumes, nil
+}
+
 // DeleteVolume deletes a DTree volume (not implemented, use DeleteDTreeVolume instead)
 func (p *OceanstorASeriesDtreePlugin) DeleteVolume(ctx context.Context, name string,
 	params map[string]interface{}) error {
@@ -187,15 +219,19 @@ func (p *OceanstorASeriesDtreePlugin) AttachVolume(_ context.Context, _ string,
 	return attachDTreeVolume(parameters)
 }
 
-// CreateSnapshot creates snapshot (not supported for DTree)
+// CreateSnapshot creates snapshot (not supported for DTree, snapshots are only taken at filesystem level)
 func (p *OceanstorASeriesDtreePlugin) CreateSnapshot(ctx context.Context,
 	fsName, snapshotName string, parameters map[string]interface{}) (map[string]interface{}, error) {
-	return nil, fmt.Errorf("%s storage does not support snapshot feature", constants.OceanStorASeriesDtree)
+	return nil, fmt.Errorf("%s storage does not support snapshot feature, "+
+		"snapshots are only supported at filesystem level by %s", constants.OceanStorASeriesDtree,
+		constants.OceanStorASeriesNas)
 }
 
-// DeleteSnapshot deletes snapshot (not supported for DTree)
+// DeleteSnapshot deletes snapshot (not supported for DTree, snapshots are only taken at filesystem level)
 func (p *OceanstorASeriesDtreePlugin) DeleteSnapshot(ctx context.Context, snapshotParentId, snapshotName string) error {
-	return fmt.Errorf("%s storage does not support snapshot feature", constants.OceanStorASeriesDtree)
+	return fmt.Errorf("%s storage does not support snapshot feature, "+
+		"snapshots are only supported at filesystem level by %s", constants.OceanStorASeriesDtree,
+		constants.OceanStorASeriesNas)
 }
 
 // ModifyVolume modifies volume (not supported for DTree)
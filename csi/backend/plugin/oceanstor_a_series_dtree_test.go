@@ -24,11 +24,13 @@ import (
 
 	"github.com/agiledragon/gomonkey/v2"
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
 
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/aseries/client"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/aseries/volume/dtree"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/base"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/test/mocks/mock_client"
 )
 
 // TestOceanstorASeriesDtreePlugin_Init_Success tests successful plugin initialization
@@ -625,3 +627,40 @@ func TestOceanstorASeriesDtreePlugin_GetSectorSize(t *testing.T) {
 	assert.Equal(t, constants.ASeriesDTreeCapacityUnit, sectorSize)
 	assert.Equal(t, int64(1), sectorSize)
 }
+
+// TestOceanstorASeriesDtreePlugin_ListVolumes tests listing the dtrees of the parent filesystem
+func TestOceanstorASeriesDtreePlugin_ListVolumes(t *testing.T) {
+	// arrange
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockOceanASeriesClientInterface(mockCtrl)
+	p := &OceanstorASeriesDtreePlugin{parentName: "parent"}
+	p.cli = cli
+	ctx := context.Background()
+	dtrees := []map[string]interface{}{{"NAME": "pvc-1", "ID": "1"}, {"NAME": "other-dtree", "ID": "2"}}
+
+	// mock
+	cli.EXPECT().GetvStoreID().Return("0")
+	cli.EXPECT().GetDTreesByParentName(ctx, "parent", "0").Return(dtrees, nil)
+
+	// act
+	got, gotErr := p.ListVolumes(ctx, "pvc")
+
+	// assert
+	assert.NoError(t, gotErr)
+	assert.Len(t, got, 1)
+	assert.Equal(t, "pvc-1", got[0].GetVolumeName())
+	assert.Equal(t, "parent", got[0].GetDTreeParentName())
+}
+
+// TestOceanstorASeriesDtreePlugin_ListVolumes_WithoutParentName tests listing volumes without parent filesystem
+func TestOceanstorASeriesDtreePlugin_ListVolumes_WithoutParentName(t *testing.T) {
+	// arrange
+	p := &OceanstorASeriesDtreePlugin{}
+
+	// act
+	_, gotErr := p.ListVolumes(context.Background(), "pvc")
+
+	// assert
+	assert.ErrorIs(t, gotErr, ErrListVolumesNotSupported)
+}
@@ -20,6 +20,7 @@ package plugin
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
@@ -44,6 +45,11 @@ type CreateASeriesVolumeParameter struct {
 	Description       string `json:"description"`
 	Size              int64  `json:"size"`
 	AdvancedOptions   string `json:"advancedOptions"`
+
+	SourceVolumeName   string `json:"sourceVolumeName"`
+	SourceSnapshotName string `json:"sourceSnapshotName"`
+	SnapshotParentId   string `json:"snapshotParentId"`
+	CloneSpeed         string `json:"cloneSpeed"`
 }
 
 func (p *CreateASeriesVolumeParameter) genCreateVolumeModel(name,
@@ -68,6 +74,16 @@ func (p *CreateASeriesVolumeParameter) genCreateVolumeModel(name,
 		Qos:               p.Qos,
 		AuthClients:       strings.Split(p.AuthClient, ";"),
 		AuthUsers:         strings.Split(p.AuthUser, ";"),
+		SourceFsName:      p.SourceVolumeName,
+		SnapshotParentId:  p.SnapshotParentId,
+	}
+
+	if p.SourceSnapshotName != "" {
+		model.SourceSnapshotName = utils.GetFSSnapshotName(p.SourceSnapshotName)
+	}
+
+	if p.CloneSpeed != "" {
+		model.CloneSpeed, _ = strconv.Atoi(p.CloneSpeed)
 	}
 
 	if p.AllSquash == constants.AllSquash {
@@ -100,6 +116,14 @@ func (p *CreateASeriesVolumeParameter) validate(protocol string) error {
 			constants.ProtocolDtfs)
 	}
 
+	if p.CloneSpeed != "" {
+		speed, err := strconv.Atoi(p.CloneSpeed)
+		if err != nil || speed < constants.CloneSpeedLevel1 || speed > constants.CloneSpeedLevel4 {
+			return fmt.Errorf("cloneSpeed field in StorageClass must be an integer in range [%d, %d]",
+				constants.CloneSpeedLevel1, constants.CloneSpeedLevel4)
+		}
+	}
+
 	if p.AllSquash != "" &&
 		p.AllSquash != constants.AllSquash &&
 		p.AllSquash != constants.NoAllSquash {
@@ -134,3 +134,37 @@ func TestCreateASeriesVolumeParameter_genCreateVolumeModel_Success(t *testing.T)
 	assert.Nil(t, gotErr)
 	assert.Equal(t, wantModel, gotModel)
 }
+
+func TestCreateASeriesVolumeParameter_genCreateVolumeModel_FromSnapshot(t *testing.T) {
+	// arrange
+	param := &CreateASeriesVolumeParameter{
+		AuthClient:         "client-test",
+		SourceSnapshotName: "snapshot-test",
+		SnapshotParentId:   "1",
+		CloneSpeed:         "4",
+	}
+
+	// act
+	model, gotErr := param.genCreateVolumeModel("vol1", constants.ProtocolNfs)
+
+	// assert
+	assert.NoError(t, gotErr)
+	assert.Equal(t, "snapshot_test", model.SourceSnapshotName)
+	assert.Equal(t, "1", model.SnapshotParentId)
+	assert.Equal(t, constants.CloneSpeedLevel4, model.CloneSpeed)
+}
+
+func TestCreateASeriesVolumeParameter_genCreateVolumeModel_InvalidCloneSpeed(t *testing.T) {
+	// arrange
+	param := &CreateASeriesVolumeParameter{
+		AuthClient:       "client-test",
+		SourceVolumeName: "source-fs",
+		CloneSpeed:       "5",
+	}
+
+	// act
+	_, gotErr := param.genCreateVolumeModel("vol1", constants.ProtocolNfs)
+
+	// assert
+	assert.ErrorContains(t, gotErr, "cloneSpeed field in StorageClass")
+}
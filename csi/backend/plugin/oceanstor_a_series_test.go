@@ -28,6 +28,7 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/aseries/client"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/aseries/volume"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/base"
@@ -337,7 +338,7 @@ func TestOceanstorASeriesPlugin_UpdateBackendCapabilities(t *testing.T) {
 		"SupportThick":              false,
 		"SupportMetro":              false,
 		"SupportReplication":        false,
-		"SupportClone":              false,
+		"SupportClone":              true,
 		"SupportMetroNAS":           false,
 		"SupportConsistentSnapshot": false,
 		"SupportNFS3":               false,
@@ -368,3 +369,67 @@ func TestOceanstorASeriesPlugin_UpdateBackendCapabilities(t *testing.T) {
 	assert.Equal(t, wantCapabilities, gotCapabilities)
 	assert.Equal(t, wantSpecifications, gotSpecifications)
 }
+
+func TestOceanstorASeriesPlugin_CreateSnapshot_Success(t *testing.T) {
+	// arrange
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockOceanASeriesClientInterface(mockCtrl)
+	p := &OceanstorASeriesPlugin{protocol: constants.ProtocolNfs, cli: cli}
+	want := map[string]interface{}{"ParentID": "1", "SizeBytes": int64(1024), "CreationTime": int64(0)}
+
+	// mock
+	mock := gomonkey.NewPatches()
+	defer mock.Reset()
+	cli.EXPECT().GetvStoreID().Return("0")
+	mock.ApplyMethodReturn(&storage.FSSnapshotter{}, "Create", want, nil)
+
+	// act
+	got, gotErr := p.CreateSnapshot(ctx, "fs-test", "snapshot-test", nil)
+
+	// assert
+	assert.NoError(t, gotErr)
+	assert.Equal(t, want, got)
+}
+
+func TestOceanstorASeriesPlugin_DeleteSnapshot_Success(t *testing.T) {
+	// arrange
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockOceanASeriesClientInterface(mockCtrl)
+	p := &OceanstorASeriesPlugin{protocol: constants.ProtocolNfs, cli: cli}
+
+	// mock
+	cli.EXPECT().GetvStoreID().Return("0")
+	cli.EXPECT().GetFSSnapshotByName(ctx, "1", "snapshot_test", "0").
+		Return(map[string]interface{}{"ID": "10"}, nil)
+	cli.EXPECT().DeleteFSSnapshot(ctx, "10", "0").Return(nil)
+
+	// act
+	gotErr := p.DeleteSnapshot(ctx, "1", "snapshot-test")
+
+	// assert
+	assert.NoError(t, gotErr)
+}
+
+func TestOceanstorASeriesPlugin_ListVolumes(t *testing.T) {
+	// arrange
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockOceanASeriesClientInterface(mockCtrl)
+	p := &OceanstorASeriesPlugin{protocol: constants.ProtocolNfs, cli: cli}
+	filesystems := []map[string]interface{}{{"NAME": "pvc-1", "CAPACITY": "2097152"}}
+
+	// mock
+	cli.EXPECT().GetvStoreID().Return("0")
+	cli.EXPECT().GetFileSystemsByNamePrefix(ctx, "pvc", "0").Return(filesystems, nil)
+
+	// act
+	got, gotErr := p.ListVolumes(ctx, "pvc")
+
+	// assert
+	assert.NoError(t, gotErr)
+	assert.Len(t, got, 1)
+	assert.Equal(t, "pvc-1", got[0].GetVolumeName())
+	assert.Equal(t, int64(2097152)*constants.AllocationUnitBytes, got[0].GetSize())
+}
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2020-2025. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2020-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -21,6 +21,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"strings"
 
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
 	pkgVolume "github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/volume"
@@ -133,6 +135,35 @@ func (p *OceanstorDTreePlugin) QueryVolume(ctx context.Context, name string,
 	return p.getDTreeObj().Query(ctx, name, parentName, p.vStoreId)
 }
 
+// ListVolumes lists all dTrees of the backend parent filesystem whose name starts with the prefix
+func (p *OceanstorDTreePlugin) ListVolumes(ctx context.Context, prefix string) ([]utils.Volume, error) {
+	if p.parentName == "" {
+		return nil, ErrListVolumesNotSupported
+	}
+
+	dtrees, err := p.cli.GetDTreesByParentName(ctx, p.parentName, p.vStoreId)
+	if err != nil {
+		return nil, err
+	}
+
+	volumes := make([]utils.Volume, 0, len(dtrees))
+	for _, dtree := range dtrees {
+		name, ok := dtree["NAME"].(string)
+		if !ok || !strings.HasPrefix(name, prefix) {
+			continue
+		}
+
+		vol := utils.NewVolume(name)
+		vol.SetDTreeParentName(p.parentName)
+		if id, ok := dtree["ID"].(string); ok {
+			vol.SetID(id)
+		}
+		volumes = append(volumes, vol)
+	}
+
+	return volumes, nil
+}
+
 // DeleteDTreeVolume used to delete DTree volume
 func (p *OceanstorDTreePlugin) DeleteDTreeVolume(ctx context.Context, dTreeName, parentName string) error {
 	if p == nil {
@@ -294,6 +325,9 @@ func (p *OceanstorDTreePlugin) UpdateBackendCapabilities(ctx context.Context) (m
 	capabilities[string(constants.SupportClone)] = false
 	capabilities[string(constants.SupportApplicationType)] = false
 	capabilities[string(constants.SupportQoS)] = false
+	capabilities[string(constants.SupportSmartDedupe)] = false
+	capabilities[string(constants.SupportSmartCompression)] = false
+	capabilities[string(constants.SupportSmartTier)] = false
 
 	err = p.updateSmartThin(capabilities)
 	if err != nil {
@@ -346,6 +380,50 @@ func (p *OceanstorDTreePlugin) ModifyVolume(ctx context.Context, volumeName stri
 	return errors.New("not implement")
 }
 
+// ModifyVolumeAttributes used to change the export options of the dtree nfs share in place,
+// the parent name is taken from the dTreeParentName parameter, or from the backend if it is not specified.
+func (p *OceanstorDTreePlugin) ModifyVolumeAttributes(ctx context.Context, name string,
+	params map[string]string) error {
+	attributes := make(map[string]string, len(params))
+	for key, value := range params {
+		attributes[key] = value
+	}
+
+	parentName, ok := attributes[constants.DTreeParentKey]
+	if !ok || parentName == "" {
+		parentName = p.parentName
+	}
+	delete(attributes, constants.DTreeParentKey)
+	if parentName == "" {
+		return fmt.Errorf("failed to get parent name of dtree %s", name)
+	}
+
+	return p.getDTreeObj().ModifyAttributes(ctx, name, parentName, attributes)
+}
+
+// CheckModifyVolumeAttributes used to check the mutable attributes can be modified on the dtree nfs share,
+// the dTreeParentName parameter is not an attribute of the dtree, so it is not checked
+func (p *OceanstorDTreePlugin) CheckModifyVolumeAttributes(_ context.Context, params map[string]string) error {
+	attributes := maps.Clone(params)
+	delete(attributes, constants.DTreeParentKey)
+	return volume.CheckDTreeModifyParameters(attributes)
+}
+
+// GetVolumeExportOptions used to get the export options of the dtree nfs share,
+// the parent name is taken the same way as ModifyVolumeAttributes
+func (p *OceanstorDTreePlugin) GetVolumeExportOptions(ctx context.Context, name string,
+	params map[string]string) (map[string]string, error) {
+	parentName := params[constants.DTreeParentKey]
+	if parentName == "" {
+		parentName = p.parentName
+	}
+	if parentName == "" {
+		return nil, fmt.Errorf("failed to get parent name of dtree %s", name)
+	}
+
+	return p.getDTreeObj().GetExportOptions(ctx, name, parentName)
+}
+
 // SetParentName sets the parentName of Oceanstor DTree plugin
 func (p *OceanstorDTreePlugin) SetParentName(parentName string) {
 	p.parentName = parentName
@@ -295,3 +295,26 @@ func Test_OceanstorDTreePlugin_DetachVolume_CheckAllClientsStatusError(t *testin
 	// assert
 	assert.ErrorIs(t, gotErr, wantErr)
 }
+
+func Test_OceanstorDTreePlugin_ModifyVolumeAttributes_EmptyParentName(t *testing.T) {
+	// arrange
+	p := &OceanstorDTreePlugin{parentName: ""}
+	params := map[string]string{"allSquash": "all_squash"}
+
+	// action
+	gotErr := p.ModifyVolumeAttributes(context.Background(), "test-volume", params)
+
+	// assert
+	assert.ErrorContains(t, gotErr, "failed to get parent name")
+}
+
+func Test_OceanstorDTreePlugin_GetVolumeExportOptions_EmptyParentName(t *testing.T) {
+	// arrange
+	p := &OceanstorDTreePlugin{parentName: ""}
+
+	// action
+	_, gotErr := p.GetVolumeExportOptions(context.Background(), "test-volume", map[string]string{})
+
+	// assert
+	assert.ErrorContains(t, gotErr, "failed to get parent name")
+}
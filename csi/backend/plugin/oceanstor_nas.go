@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2020-2025. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2020-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"net"
 	"strconv"
+	"strings"
 
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/cli/helper"
 	xuanwuV1 "github.com/Huawei/eSDK_K8S_Plugin/v4/client/apis/xuanwu/v1"
@@ -136,13 +137,18 @@ func (p *OceanstorNasPlugin) checkNfsPlusPortalsFormat(portals []string) bool {
 }
 
 func (p *OceanstorNasPlugin) getNasObj() *volume.NAS {
-	var metroRemoteCli client.OceanstorClientInterface
+	var metroRemoteCli, replicaRemoteCli client.OceanstorClientInterface
 
 	if p.metroRemotePlugin != nil {
 		metroRemoteCli = p.metroRemotePlugin.cli
 	}
 
-	return volume.NewNAS(p.cli, metroRemoteCli, p.product, p.nasHyperMetro, p.isLogicPortRunningOnOwnSite())
+	if p.replicaRemotePlugin != nil {
+		replicaRemoteCli = p.replicaRemotePlugin.cli
+	}
+
+	return volume.NewNAS(p.cli, metroRemoteCli, replicaRemoteCli, p.product, p.nasHyperMetro,
+		p.isLogicPortRunningOnOwnSite())
 }
 
 // CreateVolume used to create volume
@@ -191,6 +197,66 @@ func (p *OceanstorNasPlugin) QueryVolume(ctx context.Context, name string, param
 	return nas.Query(ctx, name, params)
 }
 
+// ListVolumes lists all filesystems whose name starts with the prefix
+func (p *OceanstorNasPlugin) ListVolumes(ctx context.Context, prefix string) ([]utils.Volume, error) {
+	fsList, err := p.cli.GetFileSystemsByNamePrefix(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	return newVolumesFromStorageObjs(ctx, fsList), nil
+}
+
+// ListSnapshots lists the filesystem snapshots which match the query
+func (p *OceanstorNasPlugin) ListSnapshots(ctx context.Context,
+	query utils.SnapshotQuery) ([]map[string]interface{}, error) {
+	if query.Name != "" {
+		return p.listFSSnapshotsByName(ctx, query)
+	}
+
+	fsList, err := listSnapshotParents(ctx, query, p.cli.GetFileSystemByName, p.cli.GetFileSystemsByNamePrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshots []map[string]interface{}
+	for _, fs := range fsList {
+		fsID, _ := fs["ID"].(string)
+		objs, err := p.cli.GetFSSnapshotsByParentID(ctx, fsID)
+		if err != nil {
+			return nil, err
+		}
+
+		// the size of filesystem snapshot is the capacity of its parent filesystem
+		capacity, _ := fs["CAPACITY"].(string)
+		for _, obj := range objs {
+			snapshots = append(snapshots, newSnapshotFromStorageObj(obj, capacity, utils.GetFSSnapshotName))
+		}
+	}
+
+	return snapshots, nil
+}
+
+func (p *OceanstorNasPlugin) listFSSnapshotsByName(ctx context.Context,
+	query utils.SnapshotQuery) ([]map[string]interface{}, error) {
+	snapshot, err := p.cli.GetFSSnapshotByName(ctx, query.ParentID, utils.GetFSSnapshotName(query.Name))
+	if err != nil || snapshot == nil {
+		return nil, err
+	}
+
+	parentName, _ := snapshot["PARENTNAME"].(string)
+	fs, err := p.cli.GetFileSystemByName(ctx, parentName)
+	if err != nil {
+		return nil, err
+	}
+
+	var capacity string
+	if fs != nil {
+		capacity, _ = fs["CAPACITY"].(string)
+	}
+	return []map[string]interface{}{newSnapshotFromStorageObj(snapshot, capacity, utils.GetFSSnapshotName)}, nil
+}
+
 // DeleteVolume used to delete volume
 func (p *OceanstorNasPlugin) DeleteVolume(ctx context.Context, name string, params map[string]interface{}) error {
 	if p.metroRemotePlugin == nil {
@@ -286,15 +352,38 @@ func (p *OceanstorNasPlugin) CreateSnapshot(ctx context.Context, fsName string,
 	}
 	nas := p.getNasObj()
 
-	snapshotName = utils.GetFSSnapshotName(snapshotName)
-	snapshot, err := nas.CreateSnapshot(ctx, fsName, snapshotName)
+	storageSnapshotName := utils.GetFSSnapshotName(snapshotName)
+	snapshot, err := nas.CreateSnapshot(ctx, fsName, storageSnapshotName)
 	if err != nil {
 		return nil, err
 	}
 
+	if storageSnapshotName != snapshotName {
+		parentID, _ := utils.GetValue[string](snapshot, "ParentID")
+		if err := p.recordFSSnapshotOriginName(ctx, parentID, storageSnapshotName, snapshotName); err != nil {
+			return nil, err
+		}
+	}
+
 	return snapshot, nil
 }
 
+// recordFSSnapshotOriginName keeps the original name of the converted snapshot in its description,
+// so that the snapshot can be listed with the same id as it is created.
+func (p *OceanstorNasPlugin) recordFSSnapshotOriginName(ctx context.Context,
+	parentID, storageSnapshotName, snapshotName string) error {
+	snapshot, err := p.cli.GetFSSnapshotByName(ctx, parentID, storageSnapshotName)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil || snapshot["DESCRIPTION"] == snapshotName {
+		return nil
+	}
+
+	snapshotID, _ := utils.GetValue[string](snapshot, "ID")
+	return p.cli.UpdateFSSnapshot(ctx, snapshotID, map[string]interface{}{"DESCRIPTION": snapshotName})
+}
+
 // DeleteSnapshot used to delete snapshot
 func (p *OceanstorNasPlugin) DeleteSnapshot(ctx context.Context, snapshotParentId, snapshotName string) error {
 	if p.metroRemotePlugin == nil {
@@ -341,6 +430,11 @@ func (p *OceanstorNasPlugin) UpdateBackendCapabilities(ctx context.Context) (map
 		return nil, nil, err
 	}
 
+	// the SmartTier policies are only supported by lun
+	capabilities[string(constants.SupportSmartTier)] = false
+	// the consistent snapshots of SupportConsistentSnapshot are not created by group snapshot
+	capabilities[string(constants.SupportGroupSnapshot)] = false
+
 	p.updateVStorePair(ctx, specifications)
 
 	// update the SupportConsistentSnapshot capability and specification
@@ -606,6 +700,61 @@ func (p *OceanstorNasPlugin) ModifyVolume(ctx context.Context, VolumeId string,
 	return err
 }
 
+// FailoverVolume used to promote the filesystem which is the secondary end of a replication or hypermetro pair,
+// the auth clients configured in the StorageClass are granted on the share of the promoted filesystem.
+func (p *OceanstorNasPlugin) FailoverVolume(ctx context.Context, name string,
+	params map[string]string) (map[string]string, error) {
+	var authClients []string
+	if authClient := params["authClient"]; authClient != "" {
+		authClients = strings.Split(authClient, ";")
+	}
+
+	if err := p.getNasObj().Failover(ctx, name, authClients); err != nil {
+		return nil, err
+	}
+
+	return map[string]string{}, nil
+}
+
+// FailbackVolume used to resync the filesystem from the remote end and make it the primary end again
+func (p *OceanstorNasPlugin) FailbackVolume(ctx context.Context, name string) (map[string]string, bool, error) {
+	finished, err := p.getNasObj().Failback(ctx, name)
+	if err != nil || !finished {
+		return nil, false, err
+	}
+
+	return map[string]string{}, true, nil
+}
+
+// RollbackSnapshot used to roll back the filesystem to its snapshot in place
+func (p *OceanstorNasPlugin) RollbackSnapshot(ctx context.Context, name, snapshotParentID, snapshotName string,
+	started bool) (int, bool, error) {
+	return p.getNasObj().RollbackSnapshot(ctx, name, snapshotParentID, utils.GetFSSnapshotName(snapshotName), started)
+}
+
+// ModifyVolumeAttributes used to change the mutable attributes of the filesystem in place,
+// such as qos, description, allocation type, snapshot reserve and the export options of the nfs share
+func (p *OceanstorNasPlugin) ModifyVolumeAttributes(ctx context.Context, name string,
+	params map[string]string) error {
+	return p.getNasObj().ModifyAttributes(ctx, name, params)
+}
+
+// CheckModifyVolumeAttributes used to check the mutable attributes can be modified on the filesystem
+func (p *OceanstorNasPlugin) CheckModifyVolumeAttributes(_ context.Context, params map[string]string) error {
+	return volume.CheckNASModifyParameters(params)
+}
+
+// GetVolumeQoS used to get the qos config of the filesystem
+func (p *OceanstorNasPlugin) GetVolumeQoS(ctx context.Context, name string) (string, error) {
+	return p.getNasObj().GetQoS(ctx, name)
+}
+
+// GetVolumeExportOptions used to get the export options of the filesystem
+func (p *OceanstorNasPlugin) GetVolumeExportOptions(ctx context.Context, name string,
+	_ map[string]string) (map[string]string, error) {
+	return p.getNasObj().GetExportOptions(ctx, name)
+}
+
 func (p *OceanstorNasPlugin) canModify() error {
 	if p.metroRemotePlugin == nil || p.metroRemotePlugin.cli == nil {
 		return fmt.Errorf("metro plugin not exist")
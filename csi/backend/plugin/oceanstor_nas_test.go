@@ -30,6 +30,7 @@ import (
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/oceanstor/client"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/oceanstor/volume"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
 )
 
 func TestInit(t *testing.T) {
@@ -518,3 +519,75 @@ func Test_OceanstorNasPlugin_DetachVolume_CheckAllClientsStatusError(t *testing.
 	// assert
 	assert.ErrorIs(t, gotErr, wantErr)
 }
+
+func TestOceanstorNasPlugin_ListSnapshots_ByVolumeName(t *testing.T) {
+	// arrange
+	cli := &client.OceanstorClient{}
+	plugin := &OceanstorNasPlugin{}
+	plugin.cli = cli
+	fs := map[string]interface{}{"ID": "20", "NAME": "pvc_1", "CAPACITY": "4194304"}
+	snapshots := []map[string]interface{}{{
+		"NAME": "snapshot_1", "PARENTID": "20", "PARENTNAME": "pvc_1", "TIMESTAMP": "1700000000",
+	}}
+
+	// mock
+	mock := gomonkey.NewPatches()
+	defer mock.Reset()
+	mock.ApplyMethodReturn(cli, "GetFileSystemByName", fs, nil).
+		ApplyMethodReturn(cli, "GetFSSnapshotsByParentID", snapshots, nil)
+
+	// action
+	got, err := plugin.ListSnapshots(context.Background(), utils.SnapshotQuery{VolumeName: "pvc_1"})
+
+	// assert
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	require.Equal(t, "snapshot_1", got[0]["Name"])
+	require.Equal(t, int64(2147483648), got[0]["SizeBytes"])
+}
+
+func TestOceanstorNasPlugin_ListSnapshots_VolumeNotExist(t *testing.T) {
+	// arrange
+	cli := &client.OceanstorClient{}
+	plugin := &OceanstorNasPlugin{}
+	plugin.cli = cli
+
+	// mock
+	mock := gomonkey.NewPatches()
+	defer mock.Reset()
+	mock.ApplyMethodReturn(cli, "GetFileSystemByName", nil, nil)
+
+	// action
+	got, err := plugin.ListSnapshots(context.Background(), utils.SnapshotQuery{VolumeName: "pvc_1"})
+
+	// assert
+	require.NoError(t, err)
+	require.Empty(t, got)
+}
+
+func TestOceanstorNasPlugin_ListSnapshots_RecoverConvertedName(t *testing.T) {
+	// arrange
+	cli := &client.OceanstorClient{}
+	plugin := &OceanstorNasPlugin{}
+	plugin.cli = cli
+	fs := map[string]interface{}{"ID": "20", "NAME": "pvc_1", "CAPACITY": "4194304"}
+	snapshots := []map[string]interface{}{
+		{"NAME": "snapshot_1", "DESCRIPTION": "snapshot-1", "PARENTID": "20", "PARENTNAME": "pvc_1"},
+		{"NAME": "snapshot_2", "DESCRIPTION": "snapshot-1", "PARENTID": "20", "PARENTNAME": "pvc_1"},
+	}
+
+	// mock
+	mock := gomonkey.NewPatches()
+	defer mock.Reset()
+	mock.ApplyMethodReturn(cli, "GetFileSystemByName", fs, nil).
+		ApplyMethodReturn(cli, "GetFSSnapshotsByParentID", snapshots, nil)
+
+	// action
+	got, err := plugin.ListSnapshots(context.Background(), utils.SnapshotQuery{VolumeName: "pvc_1"})
+
+	// assert
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	require.Equal(t, "snapshot-1", got[0]["Name"])
+	require.Equal(t, "snapshot_2", got[1]["Name"])
+}
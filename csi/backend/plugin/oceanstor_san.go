@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2020-2025. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2020-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -523,15 +523,37 @@ func (p *OceanstorSanPlugin) CreateSnapshot(ctx context.Context, lunName, snapsh
 	parameters map[string]interface{}) (map[string]interface{}, error) {
 	san := p.getSanObj()
 
-	snapshotName = utils.GetSnapshotName(snapshotName)
-	snapshot, err := san.CreateSnapshot(ctx, lunName, snapshotName, parameters)
+	storageSnapshotName := utils.GetSnapshotName(snapshotName)
+	snapshot, err := san.CreateSnapshot(ctx, lunName, storageSnapshotName, parameters)
 	if err != nil {
 		return nil, err
 	}
 
+	if storageSnapshotName != snapshotName {
+		if err := p.recordLunSnapshotOriginName(ctx, storageSnapshotName, snapshotName); err != nil {
+			return nil, err
+		}
+	}
+
 	return snapshot, nil
 }
 
+// recordLunSnapshotOriginName keeps the original name of the truncated snapshot in its description,
+// so that the snapshot can be listed with the same id as it is created.
+func (p *OceanstorSanPlugin) recordLunSnapshotOriginName(ctx context.Context,
+	storageSnapshotName, snapshotName string) error {
+	snapshot, err := p.cli.GetLunSnapshotByName(ctx, storageSnapshotName)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil || snapshot["DESCRIPTION"] == snapshotName {
+		return nil
+	}
+
+	snapshotID, _ := utils.GetValue[string](snapshot, "ID")
+	return p.cli.UpdateLunSnapshot(ctx, snapshotID, map[string]interface{}{"DESCRIPTION": snapshotName})
+}
+
 // DeleteSnapshot used to delete snapshot
 func (p *OceanstorSanPlugin) DeleteSnapshot(ctx context.Context,
 	snapshotParentID, snapshotName string) error {
@@ -546,6 +568,24 @@ func (p *OceanstorSanPlugin) DeleteSnapshot(ctx context.Context,
 	return nil
 }
 
+// CreateGroupSnapshot used to create the snapshots of the luns, which are consistent with each other
+func (p *OceanstorSanPlugin) CreateGroupSnapshot(ctx context.Context,
+	members []utils.GroupSnapshotMember) ([]map[string]interface{}, error) {
+	if !p.storageOnline {
+		return nil, errors.New("local storage is offline")
+	}
+
+	groupMembers := make([]utils.GroupSnapshotMember, 0, len(members))
+	for _, member := range members {
+		groupMembers = append(groupMembers, utils.GroupSnapshotMember{
+			VolumeName:   member.VolumeName,
+			SnapshotName: utils.GetSnapshotName(member.SnapshotName),
+		})
+	}
+
+	return p.getSanObj().CreateGroupSnapshot(ctx, groupMembers)
+}
+
 func (p *OceanstorSanPlugin) mutexGetClient(ctx context.Context) (client.OceanstorClientInterface, error) {
 	p.clientMutex.Lock()
 	defer p.clientMutex.Unlock()
@@ -608,6 +648,7 @@ func (p *OceanstorSanPlugin) UpdateBackendCapabilities(ctx context.Context) (map
 	p.storageOnline = true
 	p.updateHyperMetroCapability(ctx, capabilities)
 	p.updateReplicaCapability(capabilities)
+	capabilities[string(constants.SupportGroupSnapshot)] = true
 	return capabilities, specifications, nil
 }
 
@@ -776,6 +817,120 @@ func (p *OceanstorSanPlugin) ModifyVolume(ctx context.Context, volumeId string,
 	return nil
 }
 
+// FailoverVolume used to promote the lun which is the secondary end of a replication or hypermetro pair
+func (p *OceanstorSanPlugin) FailoverVolume(ctx context.Context, name string,
+	_ map[string]string) (map[string]string, error) {
+	if !p.storageOnline {
+		return nil, errors.New("local storage is offline")
+	}
+
+	return p.getSanObj().Failover(ctx, name)
+}
+
+// FailbackVolume used to resync the lun from the remote end and make it the primary end again
+func (p *OceanstorSanPlugin) FailbackVolume(ctx context.Context, name string) (map[string]string, bool, error) {
+	if !p.storageOnline {
+		return nil, false, errors.New("local storage is offline")
+	}
+
+	return p.getSanObj().Failback(ctx, name)
+}
+
+// RollbackSnapshot used to roll back the lun to its snapshot in place
+func (p *OceanstorSanPlugin) RollbackSnapshot(ctx context.Context, name, _, snapshotName string,
+	started bool) (int, bool, error) {
+	if !p.storageOnline {
+		return 0, false, errors.New("local storage is offline")
+	}
+
+	return p.getSanObj().RollbackSnapshot(ctx, name, utils.GetSnapshotName(snapshotName), started)
+}
+
+// MigrateVolume used to migrate the lun to the pool of the target plugin. The lun is migrated online by
+// SmartMigration if the target plugin is this plugin, otherwise it is copied offline to the target plugin, by LUN
+// copy if the target plugin is a backend of the same storage, or by remote replication if it is on another storage.
+func (p *OceanstorSanPlugin) MigrateVolume(ctx context.Context, name string, target StoragePlugin,
+	targetPool string, started bool) (int, bool, error) {
+	targetPlugin, err := p.getMigrationTarget(target)
+	if err != nil {
+		return 0, false, err
+	}
+	if targetPlugin == p {
+		return p.getSanObj().MigrateToPool(ctx, name, targetPool, started)
+	}
+	if p.isSameDevice(targetPlugin) {
+		return p.getSanObj().MigrateToBackend(ctx, name, targetPlugin.getSanObj(), targetPool, started)
+	}
+
+	return p.getSanObj().MigrateToDevice(ctx, name, targetPlugin.getSanObj(), targetPool, started)
+}
+
+// RollbackMigration used to cancel the migration of the lun to the pool of the target plugin, the SmartMigration,
+// the LUN copy or the replication pair is deleted along with the target lun created for it.
+func (p *OceanstorSanPlugin) RollbackMigration(ctx context.Context, name string, target StoragePlugin,
+	targetPool string) error {
+	targetPlugin, err := p.getMigrationTarget(target)
+	if err != nil {
+		return err
+	}
+	if targetPlugin == p {
+		return p.getSanObj().RollbackMigrationToPool(ctx, name, targetPool)
+	}
+	if p.isSameDevice(targetPlugin) {
+		return p.getSanObj().RollbackMigrationToBackend(ctx, name, targetPlugin.getSanObj(), targetPool)
+	}
+
+	return p.getSanObj().RollbackMigrationToDevice(ctx, name, targetPlugin.getSanObj(), targetPool)
+}
+
+func (p *OceanstorSanPlugin) getMigrationTarget(target StoragePlugin) (*OceanstorSanPlugin, error) {
+	if !p.storageOnline {
+		return nil, errors.New("local storage is offline")
+	}
+
+	targetPlugin, ok := target.(*OceanstorSanPlugin)
+	if !ok {
+		return nil, errors.New("the target backend of the migration is not an oceanstor-san backend")
+	}
+	if targetPlugin == p {
+		return targetPlugin, nil
+	}
+
+	if !targetPlugin.storageOnline {
+		return nil, errors.New("target storage is offline")
+	}
+
+	return targetPlugin, nil
+}
+
+func (p *OceanstorSanPlugin) isSameDevice(target *OceanstorSanPlugin) bool {
+	return p.cli.GetDeviceSN() == target.cli.GetDeviceSN()
+}
+
+// ModifyVolumeAttributes used to change the mutable attributes of the lun in place, such as qos and description
+func (p *OceanstorSanPlugin) ModifyVolumeAttributes(ctx context.Context, name string,
+	params map[string]string) error {
+	if !p.storageOnline {
+		return errors.New("local storage is offline")
+	}
+
+	return p.getSanObj().ModifyAttributes(ctx, name, params)
+}
+
+// CheckModifyVolumeAttributes used to check the mutable attributes can be modified on the lun
+func (p *OceanstorSanPlugin) CheckModifyVolumeAttributes(_ context.Context, params map[string]string) error {
+	return volume.CheckSANModifyParameters(params)
+}
+
+// GetVolumeQoS used to get the qos config of the lun
+func (p *OceanstorSanPlugin) GetVolumeQoS(ctx context.Context, name string) (string, error) {
+	if !p.storageOnline {
+		return "", errors.New("local storage is offline")
+	}
+
+	return p.getSanObj().GetQoS(ctx, name)
+}
+
 // canModifyVolume checks if modify volume is allowed
 func (p *OceanstorSanPlugin) canModifyVolume() error {
 	if !p.storageOnline {
@@ -840,6 +995,54 @@ func (p *OceanstorSanPlugin) SetStorageOnline(online bool) {
 	p.storageOnline = online
 }
 
+// ListVolumes lists all luns whose name starts with the prefix
+func (p *OceanstorSanPlugin) ListVolumes(ctx context.Context, prefix string) ([]utils.Volume, error) {
+	luns, err := p.cli.GetLunsByNamePrefix(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	return newVolumesFromStorageObjs(ctx, luns), nil
+}
+
+// ListSnapshots lists the lun snapshots which match the query
+func (p *OceanstorSanPlugin) ListSnapshots(ctx context.Context,
+	query utils.SnapshotQuery) ([]map[string]interface{}, error) {
+	if query.Name != "" {
+		snapshot, err := p.cli.GetLunSnapshotByName(ctx, utils.GetSnapshotName(query.Name))
+		if err != nil {
+			return nil, err
+		}
+		if snapshot == nil || snapshot["PARENTID"] != query.ParentID {
+			return nil, nil
+		}
+
+		userCapacity, _ := snapshot["USERCAPACITY"].(string)
+		return []map[string]interface{}{newSnapshotFromStorageObj(snapshot, userCapacity, utils.GetSnapshotName)}, nil
+	}
+
+	luns, err := listSnapshotParents(ctx, query, p.cli.GetLunByName, p.cli.GetLunsByNamePrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshots []map[string]interface{}
+	for _, lun := range luns {
+		lunID, _ := lun["ID"].(string)
+		objs, err := p.cli.GetLunSnapshotsByParentID(ctx, lunID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, obj := range objs {
+			userCapacity, _ := obj["USERCAPACITY"].(string)
+			snapshots = append(snapshots, newSnapshotFromStorageObj(obj, userCapacity, utils.GetSnapshotName))
+		}
+	}
+
+	return snapshots, nil
+}
+
 // GetVolumeStatus get volume health status
 func (p *OceanstorSanPlugin) GetVolumeStatus(ctx context.Context,
 	query utils.VolumeQuery) utils.VolumeStatus {
@@ -199,6 +199,21 @@ func TestUpdateBackendCapabilities_Succeed(t *testing.T) {
 	if _, ok := capabilities["SupportMetroNAS"]; ok {
 		t.Fatalf("TestUpdateBackendCapabilities failed, capabilities: %v", capabilities)
 	}
+	if capabilities[string(constants.SupportGroupSnapshot)] != true {
+		t.Fatalf("TestUpdateBackendCapabilities failed, capabilities: %v", capabilities)
+	}
+}
+
+func TestOceanstorSanPlugin_CreateGroupSnapshot_StorageOffline(t *testing.T) {
+	// arrange
+	oceanstorSanPlugin := &OceanstorSanPlugin{storageOnline: false}
+
+	// action
+	_, err := oceanstorSanPlugin.CreateGroupSnapshot(ctx,
+		[]utils.GroupSnapshotMember{{VolumeName: "pvc-1", SnapshotName: "gs-1"}})
+
+	// assert
+	assert.ErrorContains(t, err, "local storage is offline")
 }
 
 func TestUpdateBackendCapabilities_WithErrorStatus(t *testing.T) {
@@ -693,3 +708,106 @@ func TestModifyVolume_NoMapping(t *testing.T) {
 	var noMappingErr NoMappingError
 	assert.ErrorAs(t, err, &noMappingErr)
 }
+
+func TestOceanstorSanPlugin_ListSnapshots_ByVolumePrefix(t *testing.T) {
+	// arrange
+	cli := &client.OceanstorClient{}
+	plugin := &OceanstorSanPlugin{}
+	plugin.cli = cli
+	luns := []map[string]interface{}{{"ID": "10", "NAME": "pvc-1"}}
+	snapshots := []map[string]interface{}{{
+		"NAME": "snapshot-1", "PARENTID": "10", "PARENTNAME": "pvc-1",
+		"USERCAPACITY": "2097152", "TIMESTAMP": "1700000000",
+	}}
+
+	// mock
+	mock := gomonkey.NewPatches()
+	defer mock.Reset()
+	mock.ApplyMethodReturn(cli, "GetLunsByNamePrefix", luns, nil).
+		ApplyMethodReturn(cli, "GetLunSnapshotsByParentID", snapshots, nil)
+
+	// action
+	got, err := plugin.ListSnapshots(context.Background(), utils.SnapshotQuery{VolumePrefix: "pvc"})
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, []map[string]interface{}{{
+		"Name": "snapshot-1", "ParentID": "10", "ParentName": "pvc-1",
+		"SizeBytes": int64(1073741824), "CreationTime": int64(1700000000),
+	}}, got)
+}
+
+func TestOceanstorSanPlugin_ListSnapshots_ByNameWithOtherParent(t *testing.T) {
+	// arrange
+	cli := &client.OceanstorClient{}
+	plugin := &OceanstorSanPlugin{}
+	plugin.cli = cli
+	snapshot := map[string]interface{}{"NAME": "snapshot-1", "PARENTID": "11", "PARENTNAME": "pvc-2"}
+
+	// mock
+	mock := gomonkey.NewPatches()
+	defer mock.Reset()
+	mock.ApplyMethodReturn(cli, "GetLunSnapshotByName", snapshot, nil)
+
+	// action
+	got, err := plugin.ListSnapshots(context.Background(), utils.SnapshotQuery{Name: "snapshot-1", ParentID: "10"})
+
+	// assert
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+}
+
+func TestOceanstorSanPlugin_ListSnapshots_RecoverTruncatedName(t *testing.T) {
+	// arrange
+	cli := &client.OceanstorClient{}
+	plugin := &OceanstorSanPlugin{}
+	plugin.cli = cli
+	originName := "snapshot-1234567890-1234567890-1234567890"
+	luns := []map[string]interface{}{{"ID": "10", "NAME": "pvc-1"}}
+	snapshots := []map[string]interface{}{
+		{"NAME": utils.GetSnapshotName(originName), "DESCRIPTION": originName, "PARENTID": "10"},
+		{"NAME": "manual-snapshot", "DESCRIPTION": "Created from huawei-csi for Kubernetes", "PARENTID": "10"},
+	}
+
+	// mock
+	mock := gomonkey.NewPatches()
+	defer mock.Reset()
+	mock.ApplyMethodReturn(cli, "GetLunsByNamePrefix", luns, nil).
+		ApplyMethodReturn(cli, "GetLunSnapshotsByParentID", snapshots, nil)
+
+	// action
+	got, err := plugin.ListSnapshots(context.Background(), utils.SnapshotQuery{VolumePrefix: "pvc"})
+
+	// assert
+	assert.NoError(t, err)
+	assert.Len(t, got, 2)
+	assert.Equal(t, originName, got[0]["Name"])
+	assert.Equal(t, "manual-snapshot", got[1]["Name"])
+}
+
+func TestOceanstorSanPlugin_CreateSnapshot_RecordTruncatedName(t *testing.T) {
+	// arrange
+	cli := &client.OceanstorClient{}
+	plugin := &OceanstorSanPlugin{}
+	plugin.cli = cli
+	originName := "snapshot-1234567890-1234567890-1234567890"
+	var gotParams map[string]interface{}
+
+	// mock
+	mock := gomonkey.NewPatches()
+	defer mock.Reset()
+	mock.ApplyMethodReturn(&volume.SAN{}, "CreateSnapshot", map[string]interface{}{"ParentID": "10"}, nil).
+		ApplyMethodReturn(cli, "GetLunSnapshotByName", map[string]interface{}{"ID": "100"}, nil).
+		ApplyMethodFunc(cli, "UpdateLunSnapshot",
+			func(_ context.Context, snapshotID string, params map[string]interface{}) error {
+				gotParams = params
+				return nil
+			})
+
+	// action
+	_, err := plugin.CreateSnapshot(context.Background(), "pvc-1", originName, nil)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"DESCRIPTION": originName}, gotParams)
+}
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2025-2025. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2025-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -20,9 +20,11 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/app"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/test/mocks/mock_client"
 )
 
 func Test_validateVolumeName(t *testing.T) {
@@ -95,3 +97,63 @@ func Test_newExtraCreateMetadataFromParameters(t *testing.T) {
 		})
 	}
 }
+
+func Test_getParams_SmartParameters(t *testing.T) {
+	// arrange
+	parameters := map[string]any{
+		"description":                          "test",
+		"size":                                 int64(1024 * 1024 * 1024),
+		constants.SmartDedupeKey:               "true",
+		constants.SmartCompressionKey:          "false",
+		constants.SmartTierInitialPolicyKey:    constants.SmartTierPolicyHighest,
+		constants.SmartTierRelocationPolicyKey: constants.SmartTierPolicyAutomatic,
+	}
+
+	// action
+	params := getParams(ctx, "test-volume", parameters)
+
+	// assert
+	require.Equal(t, true, params["smartdedupe"])
+	require.Equal(t, false, params["smartcompression"])
+	require.Equal(t, constants.SmartTierPolicyHighest, params["smarttierinitialpolicy"])
+	require.Equal(t, constants.SmartTierPolicyAutomatic, params["smarttierrelocationpolicy"])
+}
+
+func TestOceanstorPlugin_updateBackendCapabilities_SmartCapabilities(t *testing.T) {
+	// arrange
+	tests := []struct {
+		name     string
+		product  constants.OceanstorVersion
+		features map[string]int
+		want     map[string]bool
+	}{
+		{name: "licensed on V5", product: constants.OceanStorV5,
+			features: map[string]int{"SmartDedupe": 1, "SmartCompression": 0, "SmartTier": 2},
+			want: map[string]bool{"SupportSmartDedupe": true, "SupportSmartCompression": false,
+				"SupportSmartTier": true}},
+		{name: "built in on Dorado V6", product: constants.OceanStorDoradoV6, features: map[string]int{},
+			want: map[string]bool{"SupportSmartDedupe": true, "SupportSmartCompression": true,
+				"SupportSmartTier": false}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// mock
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+			cli.EXPECT().GetLicenseFeature(ctx).Return(tt.features, nil)
+			cli.EXPECT().GetStorageVersion().Return("").AnyTimes()
+			p := &OceanstorPlugin{cli: cli, product: tt.product}
+
+			// action
+			capabilities, err := p.updateBackendCapabilities(ctx)
+
+			// assert
+			require.NoError(t, err)
+			for key, want := range tt.want {
+				require.Equal(t, want, capabilities[key], key)
+			}
+		})
+	}
+}
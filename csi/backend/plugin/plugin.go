@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2020-2025. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2020-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -18,6 +18,7 @@ package plugin
 
 import (
 	"context"
+	"errors"
 	// init the nfs connector
 	_ "github.com/Huawei/eSDK_K8S_Plugin/v4/connector/nfs"
 	pkgVolume "github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/volume"
@@ -30,17 +31,51 @@ type StoragePlugin interface {
 	Init(context.Context, map[string]interface{}, map[string]interface{}, bool) error
 	CreateVolume(context.Context, string, map[string]interface{}) (utils.Volume, error)
 	QueryVolume(context.Context, string, map[string]interface{}) (utils.Volume, error)
+	// ListVolumes used to list all volumes whose name starts with the given prefix
+	ListVolumes(context.Context, string) ([]utils.Volume, error)
 	DeleteVolume(context.Context, string, map[string]interface{}) error
 	ExpandVolume(context.Context, string, int64) (bool, error)
 	AttachVolume(context.Context, string, map[string]interface{}) (map[string]interface{}, error)
 	DetachVolume(context.Context, string, map[string]interface{}) error
 	ModifyVolume(context.Context, string, pkgVolume.ModifyVolumeType, map[string]string) error
+	// FailoverVolume used to promote the volume which is the secondary end of a replication or hypermetro pair,
+	// it returns the attributes of the promoted volume
+	FailoverVolume(context.Context, string, map[string]string) (map[string]string, error)
+	// FailbackVolume used to resync the volume from the remote end and make it the primary end again,
+	// it returns the attributes of the volume and whether the failback is finished
+	FailbackVolume(context.Context, string) (map[string]string, bool, error)
+	// ModifyVolumeAttributes used to change the mutable attributes of the volume in place, such as qos and description
+	ModifyVolumeAttributes(context.Context, string, map[string]string) error
+	// CheckModifyVolumeAttributes used to check the mutable attributes can be modified on the volumes of the plugin,
+	// it fails with ErrModifyAttributesNotSupported if the plugin can not modify any attribute
+	CheckModifyVolumeAttributes(context.Context, map[string]string) error
+	// GetVolumeQoS used to get the qos config of the volume, it is empty if the volume has no qos
+	GetVolumeQoS(context.Context, string) (string, error)
+	// GetVolumeExportOptions used to get the export options of the volume in the format of the mutable parameters,
+	// such as auth clients, all squash, root squash and snapshot directory visibility
+	GetVolumeExportOptions(context.Context, string, map[string]string) (map[string]string, error)
 
 	UpdateBackendCapabilities(context.Context) (map[string]interface{}, map[string]interface{}, error)
 	UpdatePoolCapabilities(context.Context, []string) (map[string]interface{}, error)
 	UpdateMetroRemotePlugin(context.Context, StoragePlugin)
 	CreateSnapshot(context.Context, string, string, map[string]interface{}) (map[string]interface{}, error)
 	DeleteSnapshot(context.Context, string, string) error
+	// ListSnapshots used to list the snapshots which match the query
+	ListSnapshots(context.Context, utils.SnapshotQuery) ([]map[string]interface{}, error)
+	// CreateGroupSnapshot used to create the snapshots of the volumes at the same point in time,
+	// it returns the snapshots in the order of the members
+	CreateGroupSnapshot(context.Context, []utils.GroupSnapshotMember) ([]map[string]interface{}, error)
+	// RollbackSnapshot used to roll back the volume to its snapshot in place, the rollback is started if it is not,
+	// it returns the progress in percent and whether the rollback is finished
+	RollbackSnapshot(ctx context.Context, name, snapshotParentID, snapshotName string, started bool) (int, bool, error)
+	// MigrateVolume used to migrate the volume to the pool of the target plugin, the migration is started if it is
+	// not, it returns the progress in percent and whether the migration is finished. The started means the caller
+	// has recorded a successful start, the source volume must not be deleted by the migration before that.
+	MigrateVolume(ctx context.Context, name string, target StoragePlugin, targetPool string,
+		started bool) (int, bool, error)
+	// RollbackMigration used to delete the objects created on storage by the migration which is not finished, the
+	// volume is kept in place. It fails with constants.ErrMigrationCutOver if the volume has been cut over.
+	RollbackMigration(ctx context.Context, name string, target StoragePlugin, targetPool string) error
 	SmartXQoSQuery
 	Logout(context.Context)
 	ReLogin(ctx context.Context) error
@@ -69,6 +104,27 @@ type SmartXQoSQuery interface {
 
 var (
 	plugins = map[string]StoragePlugin{}
+
+	// ErrListVolumesNotSupported means the plugin can not list volumes of the storage
+	ErrListVolumesNotSupported = errors.New("list volumes is not supported")
+
+	// ErrListSnapshotsNotSupported means the plugin can not list snapshots of the storage
+	ErrListSnapshotsNotSupported = errors.New("list snapshots is not supported")
+
+	// ErrFailoverNotSupported means the plugin can not fail over or fail back volumes of the storage
+	ErrFailoverNotSupported = errors.New("volume failover is not supported")
+
+	// ErrModifyAttributesNotSupported means the plugin can not modify the attributes of the volumes in place
+	ErrModifyAttributesNotSupported = errors.New("modify volume attributes is not supported")
+
+	// ErrGroupSnapshotNotSupported means the plugin can not create consistent snapshots of a group of volumes
+	ErrGroupSnapshotNotSupported = errors.New("group snapshot is not supported")
+
+	// ErrRollbackNotSupported means the plugin can not roll back volumes to their snapshots in place
+	ErrRollbackNotSupported = errors.New("snapshot rollback is not supported")
+
+	// ErrMigrationNotSupported means the plugin can not migrate volumes to another pool or backend
+	ErrMigrationNotSupported = errors.New("volume migration is not supported")
 )
 
 const (
@@ -121,7 +177,68 @@ func (p *basePlugin) GetDTreeParentName() string {
 	return ""
 }
 
+// ListVolumes list volumes, the storage does not support it by default
+func (p *basePlugin) ListVolumes(context.Context, string) ([]utils.Volume, error) {
+	return nil, ErrListVolumesNotSupported
+}
+
+// ListSnapshots list snapshots, the storage does not support it by default
+func (p *basePlugin) ListSnapshots(context.Context, utils.SnapshotQuery) ([]map[string]interface{}, error) {
+	return nil, ErrListSnapshotsNotSupported
+}
+
 // GetVolumeStatus get volume status
 func (p *basePlugin) GetVolumeStatus(context.Context, utils.VolumeQuery) utils.VolumeStatus {
 	return utils.VolumeStatus{Abnormal: false}
 }
+
+// FailoverVolume fails over volume, the storage does not support it by default
+func (p *basePlugin) FailoverVolume(context.Context, string, map[string]string) (map[string]string, error) {
+	return nil, ErrFailoverNotSupported
+}
+
+// FailbackVolume fails back volume, the storage does not support it by default
+func (p *basePlugin) FailbackVolume(context.Context, string) (map[string]string, bool, error) {
+	return nil, false, ErrFailoverNotSupported
+}
+
+// ModifyVolumeAttributes modifies volume attributes, the storage does not support it by default
+func (p *basePlugin) ModifyVolumeAttributes(context.Context, string, map[string]string) error {
+	return ErrModifyAttributesNotSupported
+}
+
+// CheckModifyVolumeAttributes checks the volume attributes to modify, the storage does not support it by default
+func (p *basePlugin) CheckModifyVolumeAttributes(context.Context, map[string]string) error {
+	return ErrModifyAttributesNotSupported
+}
+
+// GetVolumeQoS gets the qos config of the volume, the storage does not support it by default
+func (p *basePlugin) GetVolumeQoS(context.Context, string) (string, error) {
+	return "", ErrModifyAttributesNotSupported
+}
+
+// GetVolumeExportOptions gets the export options of the volume, the storage does not support it by default
+func (p *basePlugin) GetVolumeExportOptions(context.Context, string, map[string]string) (map[string]string, error) {
+	return nil, ErrModifyAttributesNotSupported
+}
+
+// CreateGroupSnapshot creates group snapshot, the storage does not support it by default
+func (p *basePlugin) CreateGroupSnapshot(context.Context,
+	[]utils.GroupSnapshotMember) ([]map[string]interface{}, error) {
+	return nil, ErrGroupSnapshotNotSupported
+}
+
+// RollbackSnapshot rolls back volume to snapshot, the storage does not support it by default
+func (p *basePlugin) RollbackSnapshot(context.Context, string, string, string, bool) (int, bool, error) {
+	return 0, false, ErrRollbackNotSupported
+}
+
+// MigrateVolume migrates volume to another pool, the storage does not support it by default
+func (p *basePlugin) MigrateVolume(context.Context, string, StoragePlugin, string, bool) (int, bool, error) {
+	return 0, false, ErrMigrationNotSupported
+}
+
+// RollbackMigration rolls back the migration of volume, the storage does not support it by default
+func (p *basePlugin) RollbackMigration(context.Context, string, StoragePlugin, string) error {
+	return ErrMigrationNotSupported
+}
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2022-2025. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2022-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -236,6 +236,13 @@ func analyzePoolsCapacity(ctx context.Context, pools []map[string]interface{},
 			string(xuanwuV1.TotalCapacity): totalCapacity * constants.AllocationUnitBytes,
 			string(xuanwuV1.UsedCapacity):  (totalCapacity - freeCapacity) * constants.AllocationUnitBytes,
 		}
+		if subscribedStr, ok := pool["SUBSCRIBEDCAPACITY"].(string); ok {
+			subscribedCapacity, err := strconv.ParseInt(subscribedStr, constants.DefaultIntBase,
+				constants.DefaultIntBitSize)
+			if err == nil {
+				poolCapacityMap[string(xuanwuV1.SubscribedCapacity)] = subscribedCapacity * constants.AllocationUnitBytes
+			}
+		}
 		if len(vStoreQuotaMap) == 0 {
 			capacities[name] = poolCapacityMap
 			continue
@@ -444,3 +451,66 @@ func getZeroPoolsCapacities(ctx context.Context, poolNames []string) (map[string
 	}
 	return capabilities, nil
 }
+
+// newVolumesFromStorageObjs converts luns, namespaces or filesystems queried from storage to volumes
+func newVolumesFromStorageObjs(ctx context.Context, objs []map[string]interface{}) []utils.Volume {
+	volumes := make([]utils.Volume, 0, len(objs))
+	for _, obj := range objs {
+		name, ok := obj["NAME"].(string)
+		if !ok {
+			log.AddContext(ctx).Warningf("convert name: %v to string failed", obj["NAME"])
+			continue
+		}
+
+		vol := utils.NewVolume(name)
+		if wwn, ok := obj["WWN"].(string); ok {
+			vol.SetLunWWN(wwn)
+		}
+		// the capacity queried from storage is in sectors
+		if capacityStr, ok := obj["CAPACITY"].(string); ok {
+			capacity, err := strconv.ParseInt(capacityStr, constants.DefaultIntBase, constants.DefaultIntBitSize)
+			if err == nil {
+				vol.SetSize(utils.TransK8SCapacity(capacity, constants.AllocationUnitBytes))
+			}
+		}
+		volumes = append(volumes, vol)
+	}
+
+	return volumes
+}
+
+// listSnapshotParents lists the luns or filesystems whose snapshots are selected by the query
+func listSnapshotParents(ctx context.Context, query utils.SnapshotQuery,
+	getByName func(context.Context, string) (map[string]interface{}, error),
+	getByNamePrefix func(context.Context, string) ([]map[string]interface{}, error)) (
+	[]map[string]interface{}, error) {
+	if query.VolumeName == "" {
+		return getByNamePrefix(ctx, query.VolumePrefix)
+	}
+
+	parent, err := getByName(ctx, query.VolumeName)
+	if err != nil || parent == nil {
+		return nil, err
+	}
+	return []map[string]interface{}{parent}, nil
+}
+
+// newSnapshotFromStorageObj converts lun or filesystem snapshot queried from storage to snapshot info,
+// the capacity of snapshot is in sectors, and nameOnStorage converts the original name to the name on storage
+func newSnapshotFromStorageObj(obj map[string]interface{}, capacity string,
+	nameOnStorage func(string) string) map[string]interface{} {
+	storageName, _ := obj["NAME"].(string)
+	description, _ := obj["DESCRIPTION"].(string)
+	name := utils.GetSnapshotOriginName(storageName, description, nameOnStorage)
+	parentID, _ := obj["PARENTID"].(string)
+	parentName, _ := obj["PARENTNAME"].(string)
+	timestamp, _ := obj["TIMESTAMP"].(string)
+	sectors := utils.ParseIntWithDefault(capacity, constants.DefaultIntBase, constants.DefaultIntBitSize, 0)
+	return map[string]interface{}{
+		"Name":         name,
+		"ParentID":     parentID,
+		"ParentName":   parentName,
+		"SizeBytes":    sectors * constants.AllocationUnitBytes,
+		"CreationTime": utils.ParseIntWithDefault(timestamp, constants.DefaultIntBase, constants.DefaultIntBitSize, 0),
+	}
+}
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2025-2025. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2025-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -410,3 +410,19 @@ func Test_getFilteredIPs_filter_ips_failure(t *testing.T) {
 	assert.Error(t, gotErr)
 	assert.Nil(t, gotIPs)
 }
+
+func Test_analyzePoolsCapacity_WithSubscribedCapacity(t *testing.T) {
+	// arrange
+	pools := []map[string]interface{}{
+		{"NAME": "pool-1", "USERFREECAPACITY": "100", "USERTOTALCAPACITY": "400", "SUBSCRIBEDCAPACITY": "800"},
+		{"NAME": "pool-2", "USERFREECAPACITY": "100", "USERTOTALCAPACITY": "400"},
+	}
+
+	// action
+	capacities := analyzePoolsCapacity(context.Background(), pools, nil)
+
+	// assert
+	assert.Equal(t, int64(800*constants.AllocationUnitBytes),
+		capacities["pool-1"].(map[string]interface{})["SubscribedCapacity"])
+	assert.NotContains(t, capacities["pool-2"], "SubscribedCapacity")
+}
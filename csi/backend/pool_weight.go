@@ -0,0 +1,207 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package backend
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	v1 "github.com/Huawei/eSDK_K8S_Plugin/v4/client/apis/xuanwu/v1"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend/model"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
+)
+
+const (
+	// PoolWeightStrategyKey is the StorageClass parameter to choose the strategy weighting the candidate pools
+	PoolWeightStrategyKey = "poolWeightStrategy"
+
+	// MostFreeStrategy selects the pool with the most free capacity, it is the default strategy
+	MostFreeStrategy = "mostFree"
+	// LeastUsedPercentageStrategy selects the pool with the lowest percentage of the used capacity
+	LeastUsedPercentageStrategy = "leastUsedPercentage"
+	// RoundRobinStrategy selects the candidate pools in turn
+	RoundRobinStrategy = "roundRobin"
+	// WeightedRandomStrategy selects a pool randomly, weighted by the free capacity
+	WeightedRandomStrategy = "weightedRandom"
+	// ThinRatioAwareStrategy selects the pool with the lowest subscription ratio after provisioning the volume
+	ThinRatioAwareStrategy = "thinRatioAware"
+)
+
+// PoolWeightStrategy used to select the optimal pool from the candidate pools which meet the volume requirements
+type PoolWeightStrategy interface {
+	Select(requestSize int64, candidatePools []*model.StoragePool) *model.StoragePool
+}
+
+// PoolWeightFunc used to adapt a function to the PoolWeightStrategy
+type PoolWeightFunc func(requestSize int64, candidatePools []*model.StoragePool) *model.StoragePool
+
+// Select calls f(requestSize, candidatePools)
+func (f PoolWeightFunc) Select(requestSize int64, candidatePools []*model.StoragePool) *model.StoragePool {
+	return f(requestSize, candidatePools)
+}
+
+var (
+	poolWeightStrategies = map[string]PoolWeightStrategy{
+		MostFreeStrategy: PoolWeightFunc(func(_ int64, pools []*model.StoragePool) *model.StoragePool {
+			return weightByFreeCapacity(pools)
+		}),
+		LeastUsedPercentageStrategy: PoolWeightFunc(weightByUsedPercentage),
+		RoundRobinStrategy:          &roundRobinStrategy{},
+		WeightedRandomStrategy:      PoolWeightFunc(weightByRandomFreeCapacity),
+		ThinRatioAwareStrategy:      PoolWeightFunc(weightBySubscriptionRatio),
+	}
+	poolWeightStrategiesMutex sync.RWMutex
+
+	// randInt63n is the random source of the weighted random strategy
+	randInt63n = rand.Int63n
+)
+
+// RegisterPoolWeightStrategy used to register a strategy which can be chosen by the poolWeightStrategy parameter
+func RegisterPoolWeightStrategy(name string, strategy PoolWeightStrategy) {
+	poolWeightStrategiesMutex.Lock()
+	defer poolWeightStrategiesMutex.Unlock()
+	poolWeightStrategies[name] = strategy
+}
+
+// GetPoolWeightStrategy used to get the strategy chosen by the poolWeightStrategy parameter,
+// the most free strategy is returned if the parameter is not set
+func GetPoolWeightStrategy(parameters map[string]interface{}) (PoolWeightStrategy, error) {
+	name, _ := parameters[PoolWeightStrategyKey].(string)
+	if name == "" {
+		name = MostFreeStrategy
+	}
+
+	poolWeightStrategiesMutex.RLock()
+	defer poolWeightStrategiesMutex.RUnlock()
+	strategy, exist := poolWeightStrategies[name]
+	if !exist {
+		names := make([]string, 0, len(poolWeightStrategies))
+		for key := range poolWeightStrategies {
+			names = append(names, key)
+		}
+		slices.Sort(names)
+		return nil, fmt.Errorf("%s [%s] is not supported, it must be one of %v", PoolWeightStrategyKey, name, names)
+	}
+
+	return strategy, nil
+}
+
+// roundRobinStrategy selects the candidate pools in turn, the pools are sorted by the backend and pool name
+// so that the order is stable between the requests
+type roundRobinStrategy struct {
+	counter atomic.Uint64
+}
+
+// Select selects the next pool of the candidate pools
+func (s *roundRobinStrategy) Select(_ int64, candidatePools []*model.StoragePool) *model.StoragePool {
+	if len(candidatePools) == 0 {
+		return nil
+	}
+
+	pools := slices.Clone(candidatePools)
+	slices.SortFunc(pools, func(a, b *model.StoragePool) int {
+		if c := strings.Compare(a.Parent, b.Parent); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+	index := (s.counter.Add(1) - 1) % uint64(len(pools))
+	return pools[index]
+}
+
+func weightByUsedPercentage(_ int64, candidatePools []*model.StoragePool) *model.StoragePool {
+	return selectPoolByMinRatio(candidatePools, func(pool *model.StoragePool) float64 {
+		return capacityRatio(getUsedCapacity(pool), getPoolCapacity(pool, v1.TotalCapacity))
+	})
+}
+
+// weightBySubscriptionRatio uses the subscribed capacity if the pool reports it, otherwise the used capacity,
+// so that the thin pools which are oversubscribed are avoided
+func weightBySubscriptionRatio(requestSize int64, candidatePools []*model.StoragePool) *model.StoragePool {
+	return selectPoolByMinRatio(candidatePools, func(pool *model.StoragePool) float64 {
+		subscribed := getUsedCapacity(pool)
+		if _, exist := pool.GetCapacities()[string(v1.SubscribedCapacity)]; exist {
+			subscribed = getPoolCapacity(pool, v1.SubscribedCapacity)
+		}
+		return capacityRatio(subscribed+requestSize, getPoolCapacity(pool, v1.TotalCapacity))
+	})
+}
+
+func weightByRandomFreeCapacity(_ int64, candidatePools []*model.StoragePool) *model.StoragePool {
+	if len(candidatePools) == 0 {
+		return nil
+	}
+
+	var totalWeight int64
+	for _, pool := range candidatePools {
+		totalWeight += max(getPoolCapacity(pool, v1.FreeCapacity), 0)
+	}
+	if totalWeight == 0 {
+		return candidatePools[randInt63n(int64(len(candidatePools)))]
+	}
+
+	point := randInt63n(totalWeight)
+	for _, pool := range candidatePools {
+		point -= max(getPoolCapacity(pool, v1.FreeCapacity), 0)
+		if point < 0 {
+			return pool
+		}
+	}
+
+	return candidatePools[len(candidatePools)-1]
+}
+
+func selectPoolByMinRatio(candidatePools []*model.StoragePool,
+	ratioFunc func(pool *model.StoragePool) float64) *model.StoragePool {
+	var selectPool *model.StoragePool
+	var selectRatio float64
+	for _, pool := range candidatePools {
+		ratio := ratioFunc(pool)
+		if selectPool == nil || ratio < selectRatio {
+			selectPool, selectRatio = pool, ratio
+		}
+	}
+
+	return selectPool
+}
+
+func getPoolCapacity(pool *model.StoragePool, capacityType v1.CapacityType) int64 {
+	return utils.ParseIntWithDefault(pool.GetCapacities()[string(capacityType)], 10, 64, 0)
+}
+
+func getUsedCapacity(pool *model.StoragePool) int64 {
+	if _, exist := pool.GetCapacities()[string(v1.UsedCapacity)]; exist {
+		return getPoolCapacity(pool, v1.UsedCapacity)
+	}
+
+	return getPoolCapacity(pool, v1.TotalCapacity) - getPoolCapacity(pool, v1.FreeCapacity)
+}
+
+// capacityRatio returns the ratio of the capacity to the total capacity, the pool without total capacity is
+// the last choice
+func capacityRatio(capacity, totalCapacity int64) float64 {
+	if totalCapacity <= 0 {
+		return math.MaxFloat64
+	}
+
+	return float64(capacity) / float64(totalCapacity)
+}
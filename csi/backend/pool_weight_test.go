@@ -0,0 +1,169 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package backend
+
+import (
+	"testing"
+
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend/model"
+)
+
+func newWeightPool(parent, name, total, free string) *model.StoragePool {
+	return &model.StoragePool{Parent: parent, Name: name,
+		Capacities: map[string]string{"TotalCapacity": total, "FreeCapacity": free}}
+}
+
+func TestWeightSinglePools_Strategies(t *testing.T) {
+	// arrange
+	// pool1 has the most free capacity, while pool2 has the lowest used percentage
+	pool1 := newWeightPool("backend1", "pool1", "1000", "400")
+	pool2 := newWeightPool("backend2", "pool2", "200", "150")
+	pools := []*model.StoragePool{pool1, pool2}
+	tests := []struct {
+		name     string
+		strategy string
+		want     *model.StoragePool
+	}{
+		{name: "default", strategy: "", want: pool1},
+		{name: "mostFree", strategy: MostFreeStrategy, want: pool1},
+		{name: "leastUsedPercentage", strategy: LeastUsedPercentageStrategy, want: pool2},
+		{name: "thinRatioAware", strategy: ThinRatioAwareStrategy, want: pool2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// action
+			got, err := WeightSinglePools(ctx, 10, map[string]interface{}{PoolWeightStrategyKey: tt.strategy}, pools)
+
+			// assert
+			require.NoError(t, err)
+			assert.Same(t, tt.want, got)
+		})
+	}
+}
+
+func TestWeightSinglePools_UnknownStrategy(t *testing.T) {
+	// arrange
+	pools := []*model.StoragePool{newWeightPool("backend1", "pool1", "1000", "400")}
+
+	// action
+	_, err := WeightSinglePools(ctx, 10, map[string]interface{}{PoolWeightStrategyKey: "leastFree"}, pools)
+
+	// assert
+	assert.ErrorContains(t, err, "poolWeightStrategy [leastFree] is not supported")
+}
+
+func TestWeightSinglePools_NoCandidatePools(t *testing.T) {
+	// action
+	_, err := WeightSinglePools(ctx, 10, map[string]interface{}{PoolWeightStrategyKey: RoundRobinStrategy}, nil)
+
+	// assert
+	assert.ErrorContains(t, err, "cannot select a storage pool")
+}
+
+func TestRoundRobinStrategy_Select(t *testing.T) {
+	// arrange
+	strategy := &roundRobinStrategy{}
+	poolA := newWeightPool("backend1", "poolA", "100", "10")
+	poolB := newWeightPool("backend1", "poolB", "100", "90")
+	poolC := newWeightPool("backend2", "poolA", "100", "50")
+
+	// action
+	var selected []*model.StoragePool
+	for i := 0; i < 4; i++ {
+		selected = append(selected, strategy.Select(10, []*model.StoragePool{poolC, poolB, poolA}))
+	}
+
+	// assert
+	assert.Equal(t, []*model.StoragePool{poolA, poolB, poolC, poolA}, selected)
+}
+
+func TestWeightByRandomFreeCapacity(t *testing.T) {
+	// arrange
+	pool1 := newWeightPool("backend1", "pool1", "1000", "100")
+	pool2 := newWeightPool("backend1", "pool2", "1000", "300")
+	pools := []*model.StoragePool{pool1, pool2}
+	tests := []struct {
+		name  string
+		point int64
+		want  *model.StoragePool
+	}{
+		{name: "first interval", point: 99, want: pool1},
+		{name: "second interval", point: 100, want: pool2},
+		{name: "last point", point: 399, want: pool2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// mock
+			var gotWeight int64
+			stubs := gostub.Stub(&randInt63n, func(n int64) int64 {
+				gotWeight = n
+				return tt.point
+			})
+			defer stubs.Reset()
+
+			// action
+			got := weightByRandomFreeCapacity(10, pools)
+
+			// assert
+			assert.Equal(t, int64(400), gotWeight)
+			assert.Same(t, tt.want, got)
+		})
+	}
+}
+
+func TestWeightBySubscriptionRatio_PreferLowerSubscription(t *testing.T) {
+	// arrange
+	// pool1 has more free capacity but is oversubscribed by the thin volumes
+	pool1 := newWeightPool("backend1", "pool1", "1000", "900")
+	pool1.Capacities["SubscribedCapacity"] = "3000"
+	pool2 := newWeightPool("backend1", "pool2", "1000", "500")
+	pool2.Capacities["SubscribedCapacity"] = "1200"
+
+	// action
+	got := weightBySubscriptionRatio(100, []*model.StoragePool{pool1, pool2})
+
+	// assert
+	assert.Same(t, pool2, got)
+}
+
+func TestRegisterPoolWeightStrategy(t *testing.T) {
+	// arrange
+	pool1 := newWeightPool("backend1", "pool1", "1000", "900")
+	pool2 := newWeightPool("backend1", "pool2", "1000", "100")
+	RegisterPoolWeightStrategy("last", PoolWeightFunc(func(_ int64, pools []*model.StoragePool) *model.StoragePool {
+		return pools[len(pools)-1]
+	}))
+	defer func() {
+		poolWeightStrategiesMutex.Lock()
+		delete(poolWeightStrategies, "last")
+		poolWeightStrategiesMutex.Unlock()
+	}()
+
+	// action
+	got, err := WeightSinglePools(ctx, 10, map[string]interface{}{PoolWeightStrategyKey: "last"},
+		[]*model.StoragePool{pool1, pool2})
+
+	// assert
+	require.NoError(t, err)
+	assert.Same(t, pool2, got)
+}
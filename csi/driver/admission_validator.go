@@ -0,0 +1,83 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package driver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/container-storage-interface/spec/lib/go/csi"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/app"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
+)
+
+// CheckStorageClassParameters used to check the parameters of the storage class before it is created,
+// the checks are the same as the CreateVolume runs, so that the incorrect parameters are found in advance
+func CheckStorageClassParameters(ctx context.Context, parameters map[string]string) error {
+	params := utils.CopyMap(parameters)
+	if err := checkStorageClassParameters(ctx, params); err != nil {
+		return err
+	}
+
+	// the volume mode is unknown before the pvc is created, so only the fsType is checked here
+	if msg := checkModeAndType("", "", params); msg != "" {
+		return errors.New(msg)
+	}
+
+	if err := processDescription(ctx, params); err != nil {
+		return err
+	}
+
+	return processParentName(ctx, params)
+}
+
+// CheckClaimVolumeMode used to check the volume mode and access mode of the pvc against the storage class
+func CheckClaimVolumeMode(parameters map[string]string, block, multiWriter bool) error {
+	volumeMode, accessMode := FileSystem, ""
+	if block {
+		volumeMode = Block
+	}
+	if multiWriter {
+		accessMode = RWX
+	}
+
+	if msg := checkModeAndType(volumeMode, accessMode, utils.CopyMap(parameters)); msg != "" {
+		return errors.New(msg)
+	}
+
+	return nil
+}
+
+// CheckClaimAnnotations used to check the annotations of the pvc which are handled by CreateVolume,
+// the backend name of the volume to manage is returned if the pvc manages an existing volume
+func CheckClaimAnnotations(annotations map[string]string) (string, error) {
+	req := &csi.CreateVolumeRequest{Parameters: map[string]string{}}
+	if err := processAnnotations(annotations, req); err != nil {
+		return "", err
+	}
+
+	volumeName, volumeOk := annotations[app.GetGlobalConfig().DriverName+annManageVolumeName]
+	backendName, backendOk := annotations[app.GetGlobalConfig().DriverName+annManageBackendName]
+	if volumeOk != backendOk {
+		return "", fmt.Errorf("both VolumeName [%s] and BackendName [%s] should be configured "+
+			"in the annotations to manage volume", volumeName, backendName)
+	}
+
+	return backendName, nil
+}
@@ -0,0 +1,113 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package driver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/app"
+)
+
+func TestCheckStorageClassParameters(t *testing.T) {
+	// arrange
+	cases := []struct {
+		name       string
+		parameters map[string]string
+		wantErr    string
+	}{
+		{name: "valid", parameters: map[string]string{"volumeType": "fs", "allocType": "thin",
+			"authClient": "*;192.168.1.1", "qos": `{"MAXIOPS": 1000}`, "hyperMetro": "true",
+			"poolWeightStrategy": "roundRobin"}},
+		{name: "invalid allocType", parameters: map[string]string{"allocType": "thine"},
+			wantErr: "allocType [thine]"},
+		{name: "invalid hyperMetro", parameters: map[string]string{"hyperMetro": "yes"},
+			wantErr: "hyperMetro [yes]"},
+		{name: "hyperMetro with replication", parameters: map[string]string{"hyperMetro": "true",
+			"replication": "true"}, wantErr: "can not be enabled at the same time"},
+		{name: "invalid qos", parameters: map[string]string{"qos": `{"MAXIOPS": 1000`},
+			wantErr: "must be a json object"},
+		{name: "invalid authClient", parameters: map[string]string{"authClient": "*; 192.168.1.1"},
+			wantErr: "authClient [*; 192.168.1.1]"},
+		{name: "invalid fsPermission", parameters: map[string]string{"fsPermission": "888"},
+			wantErr: "fsPermission"},
+		{name: "invalid fsType", parameters: map[string]string{"fsType": "ntfs"},
+			wantErr: "fsType ntfs is not correct"},
+		{name: "invalid poolWeightStrategy", parameters: map[string]string{"poolWeightStrategy": "leastFree"},
+			wantErr: "poolWeightStrategy [leastFree] is not supported"},
+		{name: "valid smartx", parameters: map[string]string{"volumeType": "lun", "smartDedupe": "true",
+			"smartCompression": "false", "smartTierInitialPolicy": "highest", "smartTierRelocationPolicy": "none"}},
+		{name: "invalid smartDedupe", parameters: map[string]string{"smartDedupe": "on"},
+			wantErr: "smartDedupe [on]"},
+		{name: "invalid smartTierInitialPolicy", parameters: map[string]string{"smartTierInitialPolicy": "none"},
+			wantErr: "smartTierInitialPolicy [none] in storageClass.yaml must be one of"},
+		{name: "smartTierRelocationPolicy on fs", parameters: map[string]string{"volumeType": "fs",
+			"smartTierRelocationPolicy": "automatic"}, wantErr: "only supported by volumeType lun"},
+		{name: "parentname without backend", parameters: map[string]string{"parentname": "parent"},
+			wantErr: "backend must be configured together"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			// action
+			err := CheckStorageClassParameters(context.Background(), c.parameters)
+
+			// assert
+			if c.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, c.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckClaimVolumeMode(t *testing.T) {
+	// arrange
+	fsParameters := map[string]string{"volumeType": "fs"}
+	lunParameters := map[string]string{"volumeType": "lun"}
+
+	// action & assert
+	assert.Error(t, CheckClaimVolumeMode(fsParameters, true, false))
+	assert.Error(t, CheckClaimVolumeMode(lunParameters, false, true))
+	assert.NoError(t, CheckClaimVolumeMode(lunParameters, true, true))
+	assert.NoError(t, CheckClaimVolumeMode(fsParameters, false, true))
+}
+
+func TestCheckClaimAnnotations(t *testing.T) {
+	// arrange
+	driverName := app.GetGlobalConfig().DriverName
+	manageAnnotations := map[string]string{
+		driverName + annManageVolumeName:  "volume",
+		driverName + annManageBackendName: "backend",
+	}
+	partialAnnotations := map[string]string{driverName + annManageVolumeName: "volume"}
+	modeAnnotations := map[string]string{driverName + annFileSystemMode: "remote"}
+
+	// action
+	backendName, manageErr := CheckClaimAnnotations(manageAnnotations)
+	_, partialErr := CheckClaimAnnotations(partialAnnotations)
+	_, modeErr := CheckClaimAnnotations(modeAnnotations)
+
+	// assert
+	assert.NoError(t, manageErr)
+	assert.Equal(t, "backend", backendName)
+	assert.ErrorContains(t, partialErr, "should be configured")
+	assert.ErrorContains(t, modeErr, "filesystemMode")
+}
@@ -20,14 +20,18 @@ package driver
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"maps"
 
 	"github.com/container-storage-interface/spec/lib/go/csi"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/app"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend/plugin"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
@@ -164,6 +168,64 @@ func (d *CsiDriver) ControllerExpandVolume(ctx context.Context, req *csi.Control
 		NodeExpansionRequired: nodeExpansionRequired}, nil
 }
 
+// ControllerModifyVolume used to modify the mutable attributes of the volume in place, such as qos and description
+func (d *CsiDriver) ControllerModifyVolume(ctx context.Context, req *csi.ControllerModifyVolumeRequest) (
+	*csi.ControllerModifyVolumeResponse, error) {
+	defer utils.RecoverPanic(ctx)
+
+	volumeId := req.GetVolumeId()
+	if volumeId == "" {
+		return nil, status.Error(codes.InvalidArgument, "no volume ID provided")
+	}
+
+	parameters := req.GetMutableParameters()
+	log.AddContext(ctx).Infof("Start to modify volume %s, mutable parameters: %v", volumeId, parameters)
+	if len(parameters) == 0 {
+		return &csi.ControllerModifyVolumeResponse{}, nil
+	}
+
+	backendName, volName := utils.SplitVolumeId(volumeId)
+	backend, err := d.backendSelector.SelectBackend(ctx, backendName)
+	if backend == nil || err != nil {
+		msg := fmt.Sprintf("Backend %s doesn't exist", backendName)
+		log.AddContext(ctx).Errorf("%s, error: %v", msg, err)
+		return nil, status.Error(codes.Internal, msg)
+	}
+
+	err = verifyModifyArguments(ctx, parameters, backend)
+	if err != nil {
+		msg := fmt.Sprintf("Verify modify arguments error: %v", err)
+		log.AddContext(ctx).Errorln(msg)
+		return nil, status.Error(codes.InvalidArgument, msg)
+	}
+
+	if constants.IsDtreeStorage(backend.Storage) {
+		var parentName string
+		parentName, err = app.GetGlobalConfig().K8sUtils.GetDTreeParentNameByVolumeId(volumeId)
+		if err != nil {
+			log.AddContext(ctx).Errorf("Failed to get DTree parent name by volume id %s: %v", volumeId, err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		parameters = maps.Clone(parameters)
+		parameters[constants.DTreeParentKey] = parentName
+	}
+
+	err = backend.Plugin.ModifyVolumeAttributes(ctx, volName, parameters)
+	if errors.Is(err, plugin.ErrModifyAttributesNotSupported) {
+		msg := fmt.Sprintf("Modify volume %s error: backend %s of storage %s does not support it",
+			volumeId, backendName, backend.Storage)
+		log.AddContext(ctx).Errorln(msg)
+		return nil, status.Error(codes.InvalidArgument, msg)
+	}
+	if err != nil {
+		log.AddContext(ctx).Errorf("Modify volume %s error: %v", volumeId, err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	log.AddContext(ctx).Infof("Volume %s is modified with mutable parameters %v", volumeId, parameters)
+	return &csi.ControllerModifyVolumeResponse{}, nil
+}
+
 // ControllerPublishVolume used to controller publish volume
 func (d *CsiDriver) ControllerPublishVolume(ctx context.Context, req *csi.ControllerPublishVolumeRequest) (
 	*csi.ControllerPublishVolumeResponse, error) {
@@ -173,6 +235,10 @@ func (d *CsiDriver) ControllerPublishVolume(ctx context.Context, req *csi.Contro
 	volumeId := req.GetVolumeId()
 	log.AddContext(ctx).Infof("Run controller publish volume %s to node %s", volumeId, nodeId)
 
+	if err := checkVolumeNotFenced(ctx, volumeId); err != nil {
+		return nil, err
+	}
+
 	backendName, volName := utils.SplitVolumeId(volumeId)
 	backend, err := d.backendSelector.SelectBackend(ctx, backendName)
 	if err != nil || backend == nil {
@@ -259,18 +325,112 @@ func (d *CsiDriver) ControllerUnpublishVolume(ctx context.Context, req *csi.Cont
 // ValidateVolumeCapabilities used to validate volume capabilities
 func (d *CsiDriver) ValidateVolumeCapabilities(ctx context.Context, req *csi.ValidateVolumeCapabilitiesRequest) (
 	*csi.ValidateVolumeCapabilitiesResponse, error) {
+	defer utils.RecoverPanic(ctx)
+
+	volumeId := req.GetVolumeId()
+	if volumeId == "" {
+		return nil, status.Error(codes.InvalidArgument, "Volume ID missing in request")
+	}
+
+	if len(req.GetVolumeCapabilities()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Volume Capabilities missing in request")
+	}
+	log.AddContext(ctx).Infof("Start to validate capabilities of volume %s", volumeId)
+
+	backendName, volName := utils.SplitVolumeId(volumeId)
+	bk, err := d.backendSelector.SelectBackend(ctx, backendName)
+	if err != nil || bk == nil {
+		// the volume can not be queried without its backend, which does not mean the volume does not exist
+		msg := fmt.Sprintf("Backend %s doesn't exist", backendName)
+		log.AddContext(ctx).Errorf("%s, error: %v", msg, err)
+		return nil, status.Error(codes.Internal, msg)
+	}
+
+	// only the existence of the volume is checked, so the request parameters, which are validated against the
+	// volume when managing it, are not passed to the query
+	params := map[string]interface{}{"description": "Query from Huawei Storage", "size": int64(0)}
+	if constants.IsDtreeStorage(bk.Storage) {
+		parentName, exist := req.GetVolumeContext()[constants.DTreeParentKey]
+		if !exist {
+			parentName, err = app.GetGlobalConfig().K8sUtils.GetDTreeParentNameByVolumeId(volumeId)
+			if err != nil {
+				log.AddContext(ctx).Errorf("Failed to get DTree parent name by volume id: %v", err)
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+		}
+		params["parentname"] = parentName
+	}
+
+	if _, err = bk.Plugin.QueryVolume(ctx, volName, params); err != nil {
+		log.AddContext(ctx).Errorf("Query volume %s error: %v", volumeId, err)
+		if errors.Is(err, constants.ErrVolumeNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		// the storage may be offline or unreachable, the volume is not known to be nonexistent
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
-	return nil, status.Error(codes.Unimplemented, "Not implemented")
+	if msg := validateCapabilitiesOfBackend(bk, req.GetVolumeCapabilities()); msg != "" {
+		log.AddContext(ctx).Warningf("Capabilities of volume %s are not supported: %s", volumeId, msg)
+		return &csi.ValidateVolumeCapabilitiesResponse{Message: msg}, nil
+	}
+
+	log.AddContext(ctx).Infof("Finish to validate capabilities of volume %s", volumeId)
+	return &csi.ValidateVolumeCapabilitiesResponse{
+		Confirmed: &csi.ValidateVolumeCapabilitiesResponse_Confirmed{
+			VolumeContext:      req.GetVolumeContext(),
+			VolumeCapabilities: req.GetVolumeCapabilities(),
+			Parameters:         req.GetParameters(),
+		},
+	}, nil
 }
 
 // ListVolumes used to list volumes
 func (d *CsiDriver) ListVolumes(ctx context.Context, req *csi.ListVolumesRequest) (*csi.ListVolumesResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "Not implemented")
+	defer utils.RecoverPanic(ctx)
+	log.AddContext(ctx).Infof("Start to list volumes, starting token: %q, max entries: %d",
+		req.GetStartingToken(), req.GetMaxEntries())
+
+	if req.GetMaxEntries() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "max entries can not be negative")
+	}
+
+	entries, err := d.listVolumeEntries(ctx)
+	if err != nil {
+		log.AddContext(ctx).Errorf("List volumes failed, error: %v", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	entries, nextToken, err := paginate(entries, req.GetStartingToken(), req.GetMaxEntries())
+	if err != nil {
+		log.AddContext(ctx).Errorln(err)
+		return nil, status.Error(codes.Aborted, err.Error())
+	}
+
+	log.AddContext(ctx).Infof("Finish to list volumes, count: %d, next token: %q", len(entries), nextToken)
+	return &csi.ListVolumesResponse{Entries: entries, NextToken: nextToken}, nil
 }
 
-// GetCapacity used to get volume capacity
+// GetCapacity used to get the available capacity of pools which match the parameters and topology
 func (d *CsiDriver) GetCapacity(ctx context.Context, req *csi.GetCapacityRequest) (*csi.GetCapacityResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "Not implemented")
+	ctx = log.SetSkipRestLogFlag(ctx)
+	defer utils.RecoverPanic(ctx)
+
+	parameters := processGetCapacityParameters(req)
+	pools, err := d.backendSelector.SelectCapacityPools(ctx, parameters)
+	if err != nil {
+		log.AddContext(ctx).Warningf("No pool matches parameters %v, report zero capacity, reason: %v",
+			parameters, err)
+		return &csi.GetCapacityResponse{AvailableCapacity: 0}, nil
+	}
+
+	available, maximum := sumPoolsFreeCapacity(pools)
+	log.AddContext(ctx).Debugf("Get capacity of %d pools, available: %d, maximum volume size: %d",
+		len(pools), available, maximum)
+	return &csi.GetCapacityResponse{
+		AvailableCapacity: available,
+		MaximumVolumeSize: wrapperspb.Int64(maximum),
+	}, nil
 }
 
 // ControllerGetCapabilities used to controller get capabilities
@@ -314,6 +474,44 @@ func (d *CsiDriver) ControllerGetCapabilities(ctx context.Context, req *csi.Cont
 				},
 			},
 		},
+		{
+			Type: &csi.ControllerServiceCapability_Rpc{
+				Rpc: &csi.ControllerServiceCapability_RPC{
+					Type: csi.ControllerServiceCapability_RPC_LIST_VOLUMES,
+				},
+			},
+		},
+		{
+			Type: &csi.ControllerServiceCapability_Rpc{
+				Rpc: &csi.ControllerServiceCapability_RPC{
+					Type: csi.ControllerServiceCapability_RPC_LIST_VOLUMES_PUBLISHED_NODES,
+				},
+			},
+		},
+		{
+			Type: &csi.ControllerServiceCapability_Rpc{
+				Rpc: &csi.ControllerServiceCapability_RPC{
+					Type: csi.ControllerServiceCapability_RPC_LIST_SNAPSHOTS,
+				},
+			},
+		},
+		{
+			Type: &csi.ControllerServiceCapability_Rpc{
+				Rpc: &csi.ControllerServiceCapability_RPC{
+					Type: csi.ControllerServiceCapability_RPC_MODIFY_VOLUME,
+				},
+			},
+		},
+	}
+
+	if app.GetGlobalConfig().EnableStorageCapacity {
+		capabilities = append(capabilities, &csi.ControllerServiceCapability{
+			Type: &csi.ControllerServiceCapability_Rpc{
+				Rpc: &csi.ControllerServiceCapability_RPC{
+					Type: csi.ControllerServiceCapability_RPC_GET_CAPACITY,
+				},
+			},
+		})
 	}
 
 	if app.GetGlobalConfig().HealthMonitorEnabled {
@@ -415,7 +613,29 @@ func (d *CsiDriver) DeleteSnapshot(ctx context.Context, req *csi.DeleteSnapshotR
 // ListSnapshots used to list snapshots
 func (d *CsiDriver) ListSnapshots(ctx context.Context,
 	req *csi.ListSnapshotsRequest) (*csi.ListSnapshotsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "")
+	defer utils.RecoverPanic(ctx)
+	log.AddContext(ctx).Infof("Start to list snapshots, snapshot id: %q, source volume id: %q, "+
+		"starting token: %q, max entries: %d", req.GetSnapshotId(), req.GetSourceVolumeId(),
+		req.GetStartingToken(), req.GetMaxEntries())
+
+	if req.GetMaxEntries() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "max entries can not be negative")
+	}
+
+	entries, err := d.listSnapshotEntries(ctx, req)
+	if err != nil {
+		log.AddContext(ctx).Errorf("List snapshots failed, error: %v", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	entries, nextToken, err := paginate(entries, req.GetStartingToken(), req.GetMaxEntries())
+	if err != nil {
+		log.AddContext(ctx).Errorln(err)
+		return nil, status.Error(codes.Aborted, err.Error())
+	}
+
+	log.AddContext(ctx).Infof("Finish to list snapshots, count: %d, next token: %q", len(entries), nextToken)
+	return &csi.ListSnapshotsResponse{Entries: entries, NextToken: nextToken}, nil
 }
 
 // ControllerGetVolume is to get volume info, but unimplemented
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2020-2025. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2020-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -18,6 +18,7 @@ package driver
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"regexp"
@@ -28,8 +29,10 @@ import (
 	"github.com/container-storage-interface/spec/lib/go/csi"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/cli/helper"
+	xuanwuV1 "github.com/Huawei/eSDK_K8S_Plugin/v4/client/apis/xuanwu/v1"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/app"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend/handler"
@@ -54,6 +57,9 @@ const (
 	volumeTypeFileSystem          = "fs"
 	volumeTypeLun                 = "lun"
 	maxReservedSnapshotSpaceRatio = 50
+
+	allocTypeThin  = "thin"
+	allocTypeThick = "thick"
 )
 
 var (
@@ -134,6 +140,33 @@ func verifyExpandArguments(ctx context.Context, req *csi.ControllerExpandVolumeR
 	return nil
 }
 
+func verifyModifyArguments(ctx context.Context, parameters map[string]string, backend *model.Backend) error {
+	err := backend.Plugin.CheckModifyVolumeAttributes(ctx, parameters)
+	if errors.Is(err, plugin.ErrModifyAttributesNotSupported) {
+		return fmt.Errorf("backend %s of storage %s does not support it", backend.Name, backend.Storage)
+	}
+	if err != nil {
+		return err
+	}
+
+	if qos, exist := parameters["qos"]; exist && qos != "" {
+		if err := backend.Plugin.SupportQoSParameters(ctx, qos); err != nil {
+			return err
+		}
+	}
+
+	if description, exist := parameters["description"]; exist && len(description) > maxDescriptionLength {
+		return fmt.Errorf("parameter description [%s] is invalid, the length exceeds %d",
+			description, maxDescriptionLength)
+	}
+
+	if ratio, exist := parameters["reservedSnapshotSpaceRatio"]; exist {
+		return checkReservedSnapshotSpaceRatio(ctx, map[string]interface{}{"reservedSnapshotSpaceRatio": ratio})
+	}
+
+	return nil
+}
+
 func verifySectorSize(ctx context.Context, volumeId string, backend *model.Backend, minSize int64) error {
 	volumeAttrs, err := app.GetGlobalConfig().K8sUtils.GetVolumeAttrsByVolumeId(volumeId)
 	if err != nil {
@@ -204,6 +237,10 @@ func validateModeAndType(req *csi.CreateVolumeRequest, parameters map[string]int
 		}
 	}
 
+	return checkModeAndType(volumeMode, accessMode, parameters)
+}
+
+func checkModeAndType(volumeMode, accessMode string, parameters map[string]interface{}) string {
 	if volumeMode == Block &&
 		(parameters["volumeType"] == volumeTypeFileSystem || parameters["volumeType"] == volumeTypeDTree) {
 		return fmt.Sprintf("VolumeMode is block but volumeType is %s. Please check the storage class",
@@ -225,6 +262,37 @@ func validateModeAndType(req *csi.CreateVolumeRequest, parameters map[string]int
 	return ""
 }
 
+// validateCapabilitiesOfBackend checks the access types and access modes against the backend protocol,
+// returns the reason if any capability is not supported
+func validateCapabilitiesOfBackend(bk *model.Backend, capabilities []*csi.VolumeCapability) string {
+	protocol, _ := bk.Parameters["protocol"].(string)
+	isNas := slices.Contains(constants.NasStorageTypes, bk.Storage)
+	for _, capability := range capabilities {
+		mode := capability.GetAccessMode().GetMode()
+		if mode == csi.VolumeCapability_AccessMode_UNKNOWN {
+			return "Access mode missing in volume capability"
+		}
+
+		if capability.GetBlock() == nil && capability.GetMount() == nil {
+			return "Access type missing in volume capability"
+		}
+
+		if isNas && capability.GetBlock() != nil {
+			return fmt.Sprintf("Access type block is not supported by %s backend %s with protocol %s",
+				bk.Storage, bk.Name, protocol)
+		}
+
+		multiNodeWriter := mode == csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER ||
+			mode == csi.VolumeCapability_AccessMode_MULTI_NODE_SINGLE_WRITER
+		if !isNas && capability.GetMount() != nil && multiNodeWriter {
+			return fmt.Sprintf("Access mode %s with access type mount is not supported by %s backend %s "+
+				"with protocol %s, use access type block instead", mode, bk.Storage, bk.Name, protocol)
+		}
+	}
+
+	return ""
+}
+
 func processAccessibilityRequirements(ctx context.Context, req *csi.CreateVolumeRequest,
 	parameters map[string]interface{}) {
 
@@ -313,6 +381,11 @@ func getAttributes(req *csi.CreateVolumeRequest, vol utils.Volume, backendName s
 		constants.DTreeParentKey:           vol.GetDTreeParentName(),
 		constants.DisableVerifyCapacityKey: req.Parameters[constants.DisableVerifyCapacityKey],
 	}
+	for _, key := range []string{constants.EncryptedKey, constants.SpaceReclaimKey} {
+		if value := req.Parameters[key]; value != "" {
+			attributes[key] = value
+		}
+	}
 	if lunWWN, err := vol.GetLunWWN(); err == nil {
 		attributes["lunWWN"] = lunWWN
 	}
@@ -361,6 +434,183 @@ func checkStorageClassParameters(ctx context.Context, parameters map[string]inte
 		return err
 	}
 
+	// check encrypted and spaceReclaim parameters in sc, both of them only work on the block volumes
+	for _, key := range []string{constants.EncryptedKey, constants.SpaceReclaimKey} {
+		err = checkLunOnlyBoolParameter(ctx, parameters, key)
+		if err != nil {
+			return err
+		}
+	}
+
+	// check allocType, hyperMetro, replication, qos, authClient, poolWeightStrategy and smartx parameters in sc
+	for _, check := range []func(context.Context, map[string]interface{}) error{
+		checkAllocType, checkRemoteParameters, checkQoSFormat, checkAuthClient, checkPoolWeightStrategy,
+		checkSmartXParameters} {
+		err = check(ctx, parameters)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func checkAllocType(ctx context.Context, parameters map[string]interface{}) error {
+	allocType, exist := parameters["allocType"].(string)
+	if !exist || allocType == "" {
+		return nil
+	}
+
+	if allocType != allocTypeThin && allocType != allocTypeThick {
+		errMsg := fmt.Sprintf("allocType [%s] in storageClass.yaml must be %s or %s.",
+			allocType, allocTypeThin, allocTypeThick)
+		log.AddContext(ctx).Errorln(errMsg)
+		return errors.New(errMsg)
+	}
+
+	return nil
+}
+
+func checkRemoteParameters(ctx context.Context, parameters map[string]interface{}) error {
+	var enabledKeys []string
+	for _, key := range []string{"hyperMetro", "replication"} {
+		value, exist := parameters[key].(string)
+		if !exist {
+			continue
+		}
+
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			errMsg := fmt.Sprintf("%s [%s] in storageClass.yaml must be true or false.", key, value)
+			log.AddContext(ctx).Errorln(errMsg)
+			return errors.New(errMsg)
+		}
+		if enabled {
+			enabledKeys = append(enabledKeys, key)
+		}
+	}
+
+	if len(enabledKeys) > 1 {
+		errMsg := "hyperMetro and replication in storageClass.yaml can not be enabled at the same time."
+		log.AddContext(ctx).Errorln(errMsg)
+		return errors.New(errMsg)
+	}
+
+	return nil
+}
+
+func checkQoSFormat(ctx context.Context, parameters map[string]interface{}) error {
+	qos, exist := parameters["qos"].(string)
+	if !exist {
+		return nil
+	}
+
+	var qosConfig map[string]interface{}
+	if err := json.Unmarshal([]byte(qos), &qosConfig); err != nil {
+		errMsg := fmt.Sprintf("qos [%s] in storageClass.yaml must be a json object, error: %v.", qos, err)
+		log.AddContext(ctx).Errorln(errMsg)
+		return errors.New(errMsg)
+	}
+
+	return nil
+}
+
+func checkAuthClient(ctx context.Context, parameters map[string]interface{}) error {
+	authClient, exist := parameters["authClient"].(string)
+	if !exist {
+		return nil
+	}
+
+	for _, client := range strings.Split(authClient, ";") {
+		if client == "" || strings.TrimSpace(client) != client {
+			errMsg := fmt.Sprintf("authClient [%s] in storageClass.yaml must be clients separated by \";\" "+
+				"without empty clients or spaces.", authClient)
+			log.AddContext(ctx).Errorln(errMsg)
+			return errors.New(errMsg)
+		}
+	}
+
+	return nil
+}
+
+func checkPoolWeightStrategy(ctx context.Context, parameters map[string]interface{}) error {
+	if _, err := backend.GetPoolWeightStrategy(parameters); err != nil {
+		errMsg := fmt.Sprintf("%v in storageClass.yaml.", err)
+		log.AddContext(ctx).Errorln(errMsg)
+		return errors.New(errMsg)
+	}
+
+	return nil
+}
+
+// checkSmartXParameters checks the SmartDedupe, SmartCompression and SmartTier parameters,
+// the SmartTier policies only work on the block volumes
+func checkSmartXParameters(ctx context.Context, parameters map[string]interface{}) error {
+	for _, key := range []string{constants.SmartDedupeKey, constants.SmartCompressionKey} {
+		value, exist := parameters[key].(string)
+		if !exist {
+			continue
+		}
+
+		if _, err := strconv.ParseBool(value); err != nil {
+			errMsg := fmt.Sprintf("%s [%s] in storageClass.yaml must be true or false.", key, value)
+			log.AddContext(ctx).Errorln(errMsg)
+			return errors.New(errMsg)
+		}
+	}
+
+	for _, tierPolicy := range []struct {
+		key      string
+		policies []string
+	}{
+		{key: constants.SmartTierInitialPolicyKey, policies: []string{constants.SmartTierPolicyAutomatic,
+			constants.SmartTierPolicyHighest, constants.SmartTierPolicyLowest}},
+		{key: constants.SmartTierRelocationPolicyKey, policies: []string{constants.SmartTierPolicyNone,
+			constants.SmartTierPolicyAutomatic, constants.SmartTierPolicyHighest, constants.SmartTierPolicyLowest}},
+	} {
+		value, exist := parameters[tierPolicy.key].(string)
+		if !exist {
+			continue
+		}
+
+		if !slices.Contains(tierPolicy.policies, value) {
+			errMsg := fmt.Sprintf("%s [%s] in storageClass.yaml must be one of %v.",
+				tierPolicy.key, value, tierPolicy.policies)
+			log.AddContext(ctx).Errorln(errMsg)
+			return errors.New(errMsg)
+		}
+
+		if parameters["volumeType"] == volumeTypeFileSystem || parameters["volumeType"] == volumeTypeDTree {
+			errMsg := fmt.Sprintf("%s is only supported by volumeType lun, but volumeType is %s.",
+				tierPolicy.key, parameters["volumeType"])
+			log.AddContext(ctx).Errorln(errMsg)
+			return errors.New(errMsg)
+		}
+	}
+
+	return nil
+}
+
+func checkLunOnlyBoolParameter(ctx context.Context, parameters map[string]interface{}, key string) error {
+	value, exist := parameters[key].(string)
+	if !exist {
+		return nil
+	}
+
+	enabled, err := strconv.ParseBool(value)
+	if err != nil {
+		errMsg := fmt.Sprintf("%s [%s] in storageClass.yaml must be true or false.", key, value)
+		log.AddContext(ctx).Errorln(errMsg)
+		return errors.New(errMsg)
+	}
+
+	if enabled && (parameters["volumeType"] == volumeTypeFileSystem || parameters["volumeType"] == volumeTypeDTree) {
+		errMsg := fmt.Sprintf("%s is only supported by volumeType lun, but volumeType is %s.",
+			key, parameters["volumeType"])
+		log.AddContext(ctx).Errorln(errMsg)
+		return errors.New(errMsg)
+	}
+
 	return nil
 }
 
@@ -684,3 +934,241 @@ func (d *CsiDriver) getASeriesNasDeleteParams(volNameId string) (map[string]inte
 
 	return params, nil
 }
+
+func (d *CsiDriver) listVolumeEntries(ctx context.Context) ([]*csi.ListVolumesResponse_Entry, error) {
+	publishedNodes, err := d.k8sUtils.GetPublishedNodeIdsByDriver(ctx, d.name)
+	if err != nil {
+		return nil, fmt.Errorf("get published nodes failed, error: %w", err)
+	}
+
+	prefix := app.GetGlobalConfig().VolumeNamePrefix
+	backends := d.backendSelector.ListAvailableBackends(ctx)
+	backendVolumes := make(map[string][]utils.Volume, len(backends))
+	for _, bk := range backends {
+		volumes, err := bk.Plugin.ListVolumes(ctx, prefix)
+		if errors.Is(err, plugin.ErrListVolumesNotSupported) {
+			log.AddContext(ctx).Warningf("backend %s does not support listing volumes, skip it, reason: %v",
+				bk.Name, err)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("list volumes of backend %s failed, error: %w", bk.Name, err)
+		}
+
+		slices.SortFunc(volumes, func(a, b utils.Volume) int {
+			return strings.Compare(a.GetVolumeName(), b.GetVolumeName())
+		})
+		backendVolumes[bk.Name] = volumes
+	}
+
+	var entries []*csi.ListVolumesResponse_Entry
+	for _, bk := range backends {
+		for _, vol := range backendVolumes[bk.Name] {
+			isRemote, err := d.isRemotePairVolume(bk, vol.GetVolumeName(), backendVolumes)
+			if err != nil {
+				return nil, err
+			}
+			if isRemote {
+				continue
+			}
+
+			volumeId := bk.Name + "." + vol.GetVolumeName()
+			entries = append(entries, &csi.ListVolumesResponse_Entry{
+				Volume: &csi.Volume{
+					VolumeId:      volumeId,
+					CapacityBytes: vol.GetSize(),
+				},
+				Status: &csi.ListVolumesResponse_VolumeStatus{
+					PublishedNodeIds: publishedNodes[volumeId],
+				},
+			})
+		}
+	}
+
+	return entries, nil
+}
+
+// isRemotePairVolume returns whether the volume is the HyperMetro or replication member of a volume on the peer
+// backend, such member is not used by any PV. The volume used by a PV is the local one, and if no PV uses the
+// volumes of the pair, the volume on the backend which sorts first is regarded as the local one.
+func (d *CsiDriver) isRemotePairVolume(bk *model.Backend, volName string,
+	backendVolumes map[string][]utils.Volume) (bool, error) {
+	var peerNames []string
+	for _, peer := range []*model.Backend{bk.MetroBackend, bk.ReplicaBackend} {
+		if peer == nil || !slices.ContainsFunc(backendVolumes[peer.Name], func(vol utils.Volume) bool {
+			return vol.GetVolumeName() == volName
+		}) {
+			continue
+		}
+		peerNames = append(peerNames, peer.Name)
+	}
+	if len(peerNames) == 0 {
+		return false, nil
+	}
+
+	inUse, err := d.k8sUtils.ExistPVByVolumeId(bk.Name + "." + volName)
+	if err != nil || inUse {
+		return false, err
+	}
+
+	for _, peerName := range peerNames {
+		peerInUse, err := d.k8sUtils.ExistPVByVolumeId(peerName + "." + volName)
+		if err != nil {
+			return false, err
+		}
+		if peerInUse || peerName < bk.Name {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// listSnapshotEntries lists the snapshots which match the snapshot id and source volume id of the request
+func (d *CsiDriver) listSnapshotEntries(ctx context.Context,
+	req *csi.ListSnapshotsRequest) ([]*csi.ListSnapshotsResponse_Entry, error) {
+	backends, query := d.processListSnapshotsRequest(ctx, req)
+
+	var entries []*csi.ListSnapshotsResponse_Entry
+	for _, bk := range backends {
+		snapshots, err := bk.Plugin.ListSnapshots(ctx, query)
+		if errors.Is(err, plugin.ErrListSnapshotsNotSupported) {
+			log.AddContext(ctx).Warningf("backend %s does not support listing snapshots, skip it", bk.Name)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("list snapshots of backend %s failed, error: %w", bk.Name, err)
+		}
+
+		slices.SortFunc(snapshots, func(a, b map[string]interface{}) int {
+			return strings.Compare(a["Name"].(string), b["Name"].(string))
+		})
+		for _, snapshot := range snapshots {
+			entry := newListSnapshotsEntry(bk.Name, snapshot)
+			if req.GetSnapshotId() != "" {
+				// the snapshot name on storage may be truncated, so keep the id in request
+				entry.Snapshot.SnapshotId = req.GetSnapshotId()
+			}
+			if req.GetSourceVolumeId() != "" && entry.Snapshot.SourceVolumeId != req.GetSourceVolumeId() {
+				continue
+			}
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries, nil
+}
+
+// processListSnapshotsRequest returns the backends to query and the snapshot query of the request,
+// no backend is returned if the backend in snapshot id or source volume id does not exist
+func (d *CsiDriver) processListSnapshotsRequest(ctx context.Context,
+	req *csi.ListSnapshotsRequest) ([]*model.Backend, utils.SnapshotQuery) {
+	var backendName string
+	var query utils.SnapshotQuery
+	if snapshotId := req.GetSnapshotId(); snapshotId != "" {
+		backendName, query.ParentID, query.Name = utils.SplitSnapshotId(snapshotId)
+		if query.ParentID == "" || query.Name == "" {
+			log.AddContext(ctx).Warningf("Snapshot id %s is invalid, no snapshot matches it", snapshotId)
+			return nil, query
+		}
+	} else if volumeId := req.GetSourceVolumeId(); volumeId != "" {
+		backendName, query.VolumeName = utils.SplitVolumeId(volumeId)
+	} else {
+		query.VolumePrefix = app.GetGlobalConfig().VolumeNamePrefix
+		return d.backendSelector.ListAvailableBackends(ctx), query
+	}
+
+	bk, err := d.backendSelector.SelectBackend(ctx, backendName)
+	if err != nil || bk == nil {
+		log.AddContext(ctx).Warningf("Backend %s doesn't exist, no snapshot matches the request", backendName)
+		return nil, query
+	}
+
+	return []*model.Backend{bk}, query
+}
+
+func newListSnapshotsEntry(backendName string, snapshot map[string]interface{}) *csi.ListSnapshotsResponse_Entry {
+	return &csi.ListSnapshotsResponse_Entry{
+		Snapshot: &csi.Snapshot{
+			SizeBytes:      snapshot["SizeBytes"].(int64),
+			SnapshotId:     backendName + "." + snapshot["ParentID"].(string) + "." + snapshot["Name"].(string),
+			SourceVolumeId: backendName + "." + snapshot["ParentName"].(string),
+			CreationTime:   &timestamppb.Timestamp{Seconds: snapshot["CreationTime"].(int64)},
+			ReadyToUse:     true,
+		},
+	}
+}
+
+// paginate returns the page of entries which starts at startingToken, the token is the offset of the entries
+func paginate[T any](entries []T, startingToken string, maxEntries int32) ([]T, string, error) {
+	start := 0
+	if startingToken != "" {
+		var err error
+		start, err = strconv.Atoi(startingToken)
+		if err != nil || start < 0 || start > len(entries) {
+			return nil, "", fmt.Errorf("invalid starting token %q", startingToken)
+		}
+	}
+
+	end := len(entries)
+	if maxEntries > 0 && start+int(maxEntries) < end {
+		end = start + int(maxEntries)
+	}
+
+	var nextToken string
+	if end < len(entries) {
+		nextToken = strconv.Itoa(end)
+	}
+	return entries[start:end], nextToken, nil
+}
+
+func processGetCapacityParameters(req *csi.GetCapacityRequest) map[string]interface{} {
+	parameters := utils.CopyMap(req.GetParameters())
+	if backendName, exist := parameters["backend"].(string); exist {
+		parameters["backend"] = helper.GetBackendName(backendName)
+	}
+
+	if segments := req.GetAccessibleTopology().GetSegments(); len(segments) != 0 {
+		requisite := make(map[string]string, len(segments))
+		for k, v := range segments {
+			requisite[k] = v
+		}
+		parameters[backend.Topology] = backend.AccessibleTopology{
+			RequisiteTopologies: []map[string]string{requisite},
+		}
+	}
+
+	return parameters
+}
+
+// sumPoolsFreeCapacity returns the total free capacity of pools and the max free capacity of a single pool
+func sumPoolsFreeCapacity(pools []*model.StoragePool) (int64, int64) {
+	var available, maximum int64
+	for _, pool := range pools {
+		free := utils.ParseIntWithDefault(pool.GetCapacities()[string(xuanwuV1.FreeCapacity)],
+			constants.DefaultIntBase, constants.DefaultIntBitSize, 0)
+		available += free
+		maximum = max(maximum, free)
+	}
+
+	return available, maximum
+}
+
+// checkVolumeNotFenced rejects publishing the volume which is fenced by the annotation of its PV, such as the volume
+// being copied to another backend by a VolumeMigration, otherwise the data written by the node would be lost.
+func checkVolumeNotFenced(ctx context.Context, volumeId string) error {
+	owner, err := app.GetGlobalConfig().K8sUtils.GetPublishFenceByVolumeId(volumeId)
+	if err != nil {
+		log.AddContext(ctx).Errorf("Check publish fence of volume %s error: %v", volumeId, err)
+		return status.Error(codes.Internal, err.Error())
+	}
+	if owner != "" {
+		msg := fmt.Sprintf("Volume %s is fenced from publishing by %s, it can not be published until %s is "+
+			"completed or failed, or the annotation %s of the pv is removed", volumeId, owner, owner,
+			constants.PublishFenceAnnotationKey)
+		log.AddContext(ctx).Errorln(msg)
+		return status.Error(codes.FailedPrecondition, msg)
+	}
+
+	return nil
+}
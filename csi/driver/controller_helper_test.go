@@ -27,6 +27,8 @@ import (
 	"github.com/prashantv/gostub"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/app"
 	cfg "github.com/Huawei/eSDK_K8S_Plugin/v4/csi/app/config"
@@ -84,6 +86,28 @@ func TestCheckReservedSnapshotSpaceRatio(t *testing.T) {
 
 }
 
+func TestCheckLunOnlyBoolParameter(t *testing.T) {
+	t.Run("Lun", func(t *testing.T) {
+		param := map[string]interface{}{"encrypted": "true", "volumeType": "lun"}
+		require.NoError(t, checkLunOnlyBoolParameter(context.TODO(), param, "encrypted"))
+	})
+
+	t.Run("Not bool", func(t *testing.T) {
+		param := map[string]interface{}{"encrypted": "luks2"}
+		require.Error(t, checkLunOnlyBoolParameter(context.TODO(), param, "encrypted"))
+	})
+
+	t.Run("Filesystem", func(t *testing.T) {
+		param := map[string]interface{}{"encrypted": "true", "volumeType": "fs"}
+		require.Error(t, checkLunOnlyBoolParameter(context.TODO(), param, "encrypted"))
+	})
+
+	t.Run("Space reclaim disabled on filesystem", func(t *testing.T) {
+		param := map[string]interface{}{"spaceReclaim": "false", "volumeType": "fs"}
+		require.NoError(t, checkLunOnlyBoolParameter(context.TODO(), param, "spaceReclaim"))
+	})
+}
+
 func mockCreateRequest() *csi.CreateVolumeRequest {
 	capacity := &csi.CapacityRange{
 		RequiredBytes: 1024 * 1024 * 1024,
@@ -320,3 +344,43 @@ func Test_isSupportExpandVolume_NasSuccess(t *testing.T) {
 		t.Errorf("Test_isSupportExpandVolume_NasSuccess failed, wantRes = %v, gotRes = %v", true, res)
 	}
 }
+
+func Test_checkVolumeNotFenced(t *testing.T) {
+	// arrange
+	app.GetGlobalConfig().K8sUtils = &k8sutils.KubeClient{}
+
+	// mock
+	patches := gomonkey.ApplyMethodFunc(app.GetGlobalConfig().K8sUtils, "GetPublishFenceByVolumeId",
+		func(volumeId string) (string, error) {
+			if volumeId == "backend-a.pvc-1" {
+				return "VolumeMigration/migration-1", nil
+			}
+			return "", nil
+		})
+	defer patches.Reset()
+
+	// action
+	fencedErr := checkVolumeNotFenced(context.Background(), "backend-a.pvc-1")
+	err := checkVolumeNotFenced(context.Background(), "backend-a.pvc-2")
+
+	// assert
+	assert.Equal(t, codes.FailedPrecondition, status.Code(fencedErr))
+	assert.ErrorContains(t, fencedErr, "VolumeMigration/migration-1")
+	assert.NoError(t, err)
+}
+
+func Test_checkVolumeNotFenced_GetFenceFailed(t *testing.T) {
+	// arrange
+	app.GetGlobalConfig().K8sUtils = &k8sutils.KubeClient{}
+
+	// mock
+	patches := gomonkey.ApplyMethodReturn(app.GetGlobalConfig().K8sUtils, "GetPublishFenceByVolumeId",
+		"", errors.New("mock error"))
+	defer patches.Reset()
+
+	// action
+	err := checkVolumeNotFenced(context.Background(), "backend-a.pvc-1")
+
+	// assert
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
@@ -19,13 +19,19 @@ package driver
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/agiledragon/gomonkey/v2"
 	"github.com/container-storage-interface/spec/lib/go/csi"
+	"github.com/prashantv/gostub"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/app"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend/handler"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend/model"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend/plugin"
@@ -139,3 +145,584 @@ func (d *controllerGetVolumeData) backend() *model.Backend {
 		Plugin:      &plugin.FusionStorageSanPlugin{},
 	}
 }
+
+func TestCsiDriver_ListVolumes_Pagination(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	kubeClient := &k8sutils.KubeClient{}
+	csiServer := NewServer(constants.DefaultDriverName, constants.ProviderVersion, kubeClient, "node1")
+	backends := []*model.Backend{
+		{Name: "backend-a", Available: true, Plugin: &plugin.OceanstorSanPlugin{}},
+		{Name: "backend-b", Available: true, Plugin: &plugin.OceanstorNasPlugin{}},
+	}
+	lunVol, fsVol := utils.NewVolume("pvc-lun"), utils.NewVolume("pvc-fs")
+	lunVol.SetSize(1024)
+	published := map[string][]string{"backend-a.pvc-lun": {"node-id-1"}}
+
+	// mock
+	mock := gomonkey.NewPatches()
+	defer mock.Reset()
+	mock.ApplyMethodReturn(kubeClient, "GetPublishedNodeIdsByDriver", published, nil).
+		ApplyMethodReturn(&handler.BackendSelector{}, "ListAvailableBackends", backends).
+		ApplyMethodReturn(&plugin.OceanstorSanPlugin{}, "ListVolumes", []utils.Volume{lunVol}, nil).
+		ApplyMethodReturn(&plugin.OceanstorNasPlugin{}, "ListVolumes", []utils.Volume{fsVol}, nil)
+
+	// action
+	firstPage, firstErr := csiServer.ListVolumes(ctx, &csi.ListVolumesRequest{MaxEntries: 1})
+	secondPage, secondErr := csiServer.ListVolumes(ctx,
+		&csi.ListVolumesRequest{MaxEntries: 1, StartingToken: firstPage.GetNextToken()})
+
+	// assert
+	require.NoError(t, firstErr)
+	require.Len(t, firstPage.Entries, 1)
+	require.Equal(t, "backend-a.pvc-lun", firstPage.Entries[0].Volume.VolumeId)
+	require.Equal(t, int64(1024), firstPage.Entries[0].Volume.CapacityBytes)
+	require.Equal(t, []string{"node-id-1"}, firstPage.Entries[0].Status.PublishedNodeIds)
+	require.Equal(t, "1", firstPage.NextToken)
+	require.NoError(t, secondErr)
+	require.Len(t, secondPage.Entries, 1)
+	require.Equal(t, "backend-b.pvc-fs", secondPage.Entries[0].Volume.VolumeId)
+	require.Empty(t, secondPage.NextToken)
+}
+
+func TestCsiDriver_ListVolumes_SkipUnsupportedBackend(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	kubeClient := &k8sutils.KubeClient{}
+	csiServer := NewServer(constants.DefaultDriverName, constants.ProviderVersion, kubeClient, "node1")
+	backends := []*model.Backend{
+		{Name: "backend-a", Available: true, Plugin: &plugin.OceanstorSanPlugin{}},
+		{Name: "backend-b", Available: true, Plugin: &plugin.OceanstorDTreePlugin{}},
+	}
+
+	// mock
+	mock := gomonkey.NewPatches()
+	defer mock.Reset()
+	mock.ApplyMethodReturn(kubeClient, "GetPublishedNodeIdsByDriver", map[string][]string{}, nil).
+		ApplyMethodReturn(&handler.BackendSelector{}, "ListAvailableBackends", backends).
+		ApplyMethodReturn(&plugin.OceanstorSanPlugin{}, "ListVolumes", []utils.Volume{utils.NewVolume("pvc-lun")}, nil)
+
+	// action
+	resp, err := csiServer.ListVolumes(ctx, &csi.ListVolumesRequest{})
+
+	// assert
+	require.NoError(t, err)
+	require.Len(t, resp.Entries, 1)
+	require.Equal(t, "backend-a.pvc-lun", resp.Entries[0].Volume.VolumeId)
+}
+
+func TestCsiDriver_ListVolumes_SkipRemotePairVolumes(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	kubeClient := &k8sutils.KubeClient{}
+	csiServer := NewServer(constants.DefaultDriverName, constants.ProviderVersion, kubeClient, "node1")
+	local := &model.Backend{Name: "backend-b", Available: true, Plugin: &plugin.OceanstorSanPlugin{}}
+	remote := &model.Backend{Name: "backend-a", Available: true, Plugin: &plugin.OceanstorSanPlugin{}}
+	local.MetroBackend, remote.MetroBackend = remote, local
+	inUse := map[string]bool{"backend-b.pvc-used": true}
+
+	// mock
+	mock := gomonkey.NewPatches()
+	defer mock.Reset()
+	mock.ApplyMethodReturn(kubeClient, "GetPublishedNodeIdsByDriver", map[string][]string{}, nil).
+		ApplyMethodReturn(&handler.BackendSelector{}, "ListAvailableBackends", []*model.Backend{remote, local}).
+		ApplyMethodReturn(&plugin.OceanstorSanPlugin{}, "ListVolumes",
+			[]utils.Volume{utils.NewVolume("pvc-used"), utils.NewVolume("pvc-orphan")}, nil).
+		ApplyMethodFunc(kubeClient, "ExistPVByVolumeId", func(volumeId string) (bool, error) {
+			return inUse[volumeId], nil
+		})
+
+	// action
+	resp, err := csiServer.ListVolumes(ctx, &csi.ListVolumesRequest{})
+
+	// assert
+	require.NoError(t, err)
+	var volumeIds []string
+	for _, entry := range resp.Entries {
+		volumeIds = append(volumeIds, entry.Volume.VolumeId)
+	}
+	require.Equal(t, []string{"backend-a.pvc-orphan", "backend-b.pvc-used"}, volumeIds)
+}
+
+func TestCsiDriver_ListVolumes_InvalidStartingToken(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	kubeClient := &k8sutils.KubeClient{}
+	csiServer := NewServer(constants.DefaultDriverName, constants.ProviderVersion, kubeClient, "node1")
+
+	// mock
+	mock := gomonkey.NewPatches()
+	defer mock.Reset()
+	mock.ApplyMethodReturn(kubeClient, "GetPublishedNodeIdsByDriver", map[string][]string{}, nil).
+		ApplyMethodReturn(&handler.BackendSelector{}, "ListAvailableBackends", []*model.Backend{})
+
+	// action
+	_, err := csiServer.ListVolumes(ctx, &csi.ListVolumesRequest{StartingToken: "invalid"})
+
+	// assert
+	require.Equal(t, codes.Aborted, status.Code(err))
+}
+
+func TestCsiDriver_GetCapacity_SumFreeCapacity(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	csiServer := NewServer(constants.DefaultDriverName, constants.ProviderVersion, &k8sutils.KubeClient{}, "node1")
+	pools := []*model.StoragePool{
+		{Name: "pool-1", Capacities: map[string]string{"FreeCapacity": "1024"}},
+		{Name: "pool-2", Capacities: map[string]string{"FreeCapacity": "4096"}},
+	}
+	req := &csi.GetCapacityRequest{
+		Parameters:         map[string]string{"pool": "pool-1"},
+		AccessibleTopology: &csi.Topology{Segments: map[string]string{"topology.kubernetes.io/zone": "zone-1"}},
+	}
+	var gotParams map[string]interface{}
+
+	// mock
+	mock := gomonkey.NewPatches()
+	defer mock.Reset()
+	mock.ApplyMethodFunc(&handler.BackendSelector{}, "SelectCapacityPools",
+		func(_ context.Context, params map[string]interface{}) ([]*model.StoragePool, error) {
+			gotParams = params
+			return pools, nil
+		})
+
+	// action
+	resp, err := csiServer.GetCapacity(ctx, req)
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, int64(5120), resp.AvailableCapacity)
+	require.Equal(t, int64(4096), resp.MaximumVolumeSize.GetValue())
+	require.Equal(t, backend.AccessibleTopology{
+		RequisiteTopologies: []map[string]string{{"topology.kubernetes.io/zone": "zone-1"}},
+	}, gotParams[backend.Topology])
+}
+
+func TestCsiDriver_ControllerGetCapabilities_StorageCapacity(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	csiServer := NewServer(constants.DefaultDriverName, constants.ProviderVersion, &k8sutils.KubeClient{}, "node1")
+	hasGetCapacity := func(resp *csi.ControllerGetCapabilitiesResponse) bool {
+		for _, capability := range resp.GetCapabilities() {
+			if capability.GetRpc().GetType() == csi.ControllerServiceCapability_RPC_GET_CAPACITY {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, enabled := range []bool{true, false} {
+		config := *app.GetGlobalConfig()
+		config.EnableStorageCapacity = enabled
+
+		// mock
+		stubs := gostub.StubFunc(&app.GetGlobalConfig, &config)
+
+		// action
+		resp, err := csiServer.ControllerGetCapabilities(ctx, &csi.ControllerGetCapabilitiesRequest{})
+		stubs.Reset()
+
+		// assert
+		require.NoError(t, err)
+		require.Equal(t, enabled, hasGetCapacity(resp))
+	}
+}
+
+func TestCsiDriver_GetCapacity_NoMatchedPool(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	csiServer := NewServer(constants.DefaultDriverName, constants.ProviderVersion, &k8sutils.KubeClient{}, "node1")
+
+	// mock
+	mock := gomonkey.NewPatches()
+	defer mock.Reset()
+	mock.ApplyMethodReturn(&handler.BackendSelector{}, "SelectCapacityPools",
+		nil, errors.New("no storage pool meets the requirements"))
+
+	// action
+	resp, err := csiServer.GetCapacity(ctx, &csi.GetCapacityRequest{})
+
+	// assert
+	require.NoError(t, err)
+	require.Zero(t, resp.AvailableCapacity)
+}
+
+func TestCsiDriver_ListSnapshots_Pagination(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	csiServer := NewServer(constants.DefaultDriverName, constants.ProviderVersion, &k8sutils.KubeClient{}, "node1")
+	backends := []*model.Backend{
+		{Name: "backend-a", Available: true, Plugin: &plugin.OceanstorSanPlugin{}},
+		{Name: "backend-b", Available: true, Plugin: &plugin.FusionStorageSanPlugin{}},
+	}
+	snapshots := []map[string]interface{}{
+		fakeSnapshotInfo("snapshot-2", "10", "pvc-lun"),
+		fakeSnapshotInfo("snapshot-1", "10", "pvc-lun"),
+	}
+
+	// mock
+	mock := gomonkey.NewPatches()
+	defer mock.Reset()
+	mock.ApplyMethodReturn(&handler.BackendSelector{}, "ListAvailableBackends", backends).
+		ApplyMethodReturn(&plugin.OceanstorSanPlugin{}, "ListSnapshots", snapshots, nil).
+		ApplyMethodReturn(&plugin.FusionStorageSanPlugin{}, "ListSnapshots", nil, plugin.ErrListSnapshotsNotSupported)
+
+	// action
+	firstPage, firstErr := csiServer.ListSnapshots(ctx, &csi.ListSnapshotsRequest{MaxEntries: 1})
+	secondPage, secondErr := csiServer.ListSnapshots(ctx,
+		&csi.ListSnapshotsRequest{MaxEntries: 1, StartingToken: firstPage.GetNextToken()})
+
+	// assert
+	require.NoError(t, firstErr)
+	require.Len(t, firstPage.Entries, 1)
+	require.Equal(t, "backend-a.10.snapshot-1", firstPage.Entries[0].Snapshot.SnapshotId)
+	require.Equal(t, "backend-a.pvc-lun", firstPage.Entries[0].Snapshot.SourceVolumeId)
+	require.Equal(t, "1", firstPage.NextToken)
+	require.NoError(t, secondErr)
+	require.Len(t, secondPage.Entries, 1)
+	require.Equal(t, "backend-a.10.snapshot-2", secondPage.Entries[0].Snapshot.SnapshotId)
+	require.Empty(t, secondPage.NextToken)
+}
+
+func TestCsiDriver_ListSnapshots_BySnapshotId(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	csiServer := NewServer(constants.DefaultDriverName, constants.ProviderVersion, &k8sutils.KubeClient{}, "node1")
+	bk := &model.Backend{Name: "backend-a", Available: true, Plugin: &plugin.OceanstorSanPlugin{}}
+	snapshotId := "backend-a.10.snapshot-0123456789-0123456789-0123456789"
+	var gotQuery utils.SnapshotQuery
+
+	// mock
+	mock := gomonkey.NewPatches()
+	defer mock.Reset()
+	mock.ApplyMethodReturn(&handler.BackendSelector{}, "SelectBackend", bk, nil).
+		ApplyMethodFunc(&plugin.OceanstorSanPlugin{}, "ListSnapshots",
+			func(_ context.Context, query utils.SnapshotQuery) ([]map[string]interface{}, error) {
+				gotQuery = query
+				truncated := fakeSnapshotInfo("snapshot-0123456789-0123456789-", "10", "pvc-lun")
+				return []map[string]interface{}{truncated}, nil
+			})
+
+	// action
+	resp, err := csiServer.ListSnapshots(ctx, &csi.ListSnapshotsRequest{SnapshotId: snapshotId})
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, utils.SnapshotQuery{Name: "snapshot-0123456789-0123456789-0123456789", ParentID: "10"}, gotQuery)
+	require.Len(t, resp.Entries, 1)
+	require.Equal(t, snapshotId, resp.Entries[0].Snapshot.SnapshotId)
+}
+
+func TestCsiDriver_ListSnapshots_BackendNotExist(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	csiServer := NewServer(constants.DefaultDriverName, constants.ProviderVersion, &k8sutils.KubeClient{}, "node1")
+
+	// mock
+	mock := gomonkey.NewPatches()
+	defer mock.Reset()
+	mock.ApplyMethodReturn(&handler.BackendSelector{}, "SelectBackend", nil, errors.New("backend not found"))
+
+	// action
+	resp, err := csiServer.ListSnapshots(ctx, &csi.ListSnapshotsRequest{SourceVolumeId: "backend-x.pvc-lun"})
+
+	// assert
+	require.NoError(t, err)
+	require.Empty(t, resp.Entries)
+}
+
+func fakeSnapshotInfo(name, parentID, parentName string) map[string]interface{} {
+	return map[string]interface{}{
+		"Name":         name,
+		"ParentID":     parentID,
+		"ParentName":   parentName,
+		"SizeBytes":    int64(1024),
+		"CreationTime": int64(1700000000),
+	}
+}
+
+func TestCsiDriver_ValidateVolumeCapabilities_Confirmed(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	csiServer := NewServer(constants.DefaultDriverName, constants.ProviderVersion, &k8sutils.KubeClient{}, "node1")
+	bk := &model.Backend{Name: "backend-a", Storage: constants.OceanStorSan, Plugin: &plugin.OceanstorSanPlugin{},
+		Parameters: map[string]interface{}{"protocol": "iscsi"}}
+	req := &csi.ValidateVolumeCapabilitiesRequest{
+		VolumeId:           "backend-a.pvc-lun",
+		VolumeCapabilities: []*csi.VolumeCapability{fakeVolumeCapability(true, multiNodeMultiWriter)},
+	}
+
+	// mock
+	mock := gomonkey.NewPatches()
+	defer mock.Reset()
+	mock.ApplyMethodReturn(&handler.BackendSelector{}, "SelectBackend", bk, nil).
+		ApplyMethodReturn(&plugin.OceanstorSanPlugin{}, "QueryVolume", utils.NewVolume("pvc-lun"), nil)
+
+	// action
+	resp, err := csiServer.ValidateVolumeCapabilities(ctx, req)
+
+	// assert
+	require.NoError(t, err)
+	require.NotNil(t, resp.Confirmed)
+	require.Equal(t, req.VolumeCapabilities, resp.Confirmed.VolumeCapabilities)
+}
+
+func TestCsiDriver_ValidateVolumeCapabilities_NotSupported(t *testing.T) {
+	tests := []struct {
+		name       string
+		bk         *model.Backend
+		capability *csi.VolumeCapability
+		wantMsg    string
+	}{
+		{
+			name: "mount multi node writer on san",
+			bk: &model.Backend{Name: "backend-a", Storage: constants.OceanStorSan,
+				Plugin: &plugin.OceanstorSanPlugin{}, Parameters: map[string]interface{}{"protocol": "iscsi"}},
+			capability: fakeVolumeCapability(false, multiNodeMultiWriter),
+			wantMsg: "Access mode MULTI_NODE_MULTI_WRITER with access type mount is not supported by " +
+				"oceanstor-san backend backend-a with protocol iscsi, use access type block instead",
+		},
+		{
+			name: "block on nas",
+			bk: &model.Backend{Name: "backend-a", Storage: constants.OceanStorNas,
+				Plugin: &plugin.OceanstorSanPlugin{}, Parameters: map[string]interface{}{"protocol": "nfs"}},
+			capability: fakeVolumeCapability(true, csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER),
+			wantMsg:    "Access type block is not supported by oceanstor-nas backend backend-a with protocol nfs",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			csiServer := NewServer(constants.DefaultDriverName, constants.ProviderVersion,
+				&k8sutils.KubeClient{}, "node1")
+			req := &csi.ValidateVolumeCapabilitiesRequest{
+				VolumeId:           "backend-a.pvc-1",
+				VolumeCapabilities: []*csi.VolumeCapability{tt.capability},
+			}
+
+			// mock
+			mock := gomonkey.NewPatches()
+			defer mock.Reset()
+			mock.ApplyMethodReturn(&handler.BackendSelector{}, "SelectBackend", tt.bk, nil).
+				ApplyMethodReturn(&plugin.OceanstorSanPlugin{}, "QueryVolume", utils.NewVolume("pvc-1"), nil)
+
+			// action
+			resp, err := csiServer.ValidateVolumeCapabilities(context.Background(), req)
+
+			// assert
+			require.NoError(t, err)
+			require.Nil(t, resp.Confirmed)
+			require.Equal(t, tt.wantMsg, resp.Message)
+		})
+	}
+}
+
+func TestCsiDriver_ValidateVolumeCapabilities_VolumeNotExist(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	csiServer := NewServer(constants.DefaultDriverName, constants.ProviderVersion, &k8sutils.KubeClient{}, "node1")
+	bk := &model.Backend{Name: "backend-a", Storage: constants.OceanStorSan, Plugin: &plugin.OceanstorSanPlugin{}}
+	req := &csi.ValidateVolumeCapabilitiesRequest{
+		VolumeId:           "backend-a.pvc-lun",
+		VolumeCapabilities: []*csi.VolumeCapability{fakeVolumeCapability(true, multiNodeMultiWriter)},
+	}
+
+	// mock
+	mock := gomonkey.NewPatches()
+	defer mock.Reset()
+	mock.ApplyMethodReturn(&handler.BackendSelector{}, "SelectBackend", bk, nil).
+		ApplyMethodReturn(&plugin.OceanstorSanPlugin{}, "QueryVolume", nil,
+			fmt.Errorf("%w: lun does not exist", constants.ErrVolumeNotFound))
+
+	// action
+	_, err := csiServer.ValidateVolumeCapabilities(ctx, req)
+
+	// assert
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestCsiDriver_ValidateVolumeCapabilities_QueryFailed(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	csiServer := NewServer(constants.DefaultDriverName, constants.ProviderVersion, &k8sutils.KubeClient{}, "node1")
+	bk := &model.Backend{Name: "backend-a", Storage: constants.OceanStorSan, Plugin: &plugin.OceanstorSanPlugin{}}
+	req := &csi.ValidateVolumeCapabilitiesRequest{
+		VolumeId:           "backend-a.pvc-lun",
+		VolumeCapabilities: []*csi.VolumeCapability{fakeVolumeCapability(true, multiNodeMultiWriter)},
+	}
+	tests := []struct {
+		name     string
+		backend  *model.Backend
+		queryErr error
+	}{
+		{name: "storage unreachable", backend: bk, queryErr: errors.New("connection refused")},
+		{name: "backend not exist", backend: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// mock
+			mock := gomonkey.NewPatches()
+			defer mock.Reset()
+			mock.ApplyMethodReturn(&handler.BackendSelector{}, "SelectBackend", tt.backend, nil).
+				ApplyMethodReturn(&plugin.OceanstorSanPlugin{}, "QueryVolume", nil, tt.queryErr)
+
+			// action
+			_, err := csiServer.ValidateVolumeCapabilities(ctx, req)
+
+			// assert
+			require.Equal(t, codes.Internal, status.Code(err))
+		})
+	}
+}
+
+const multiNodeMultiWriter = csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER
+
+func fakeVolumeCapability(block bool, mode csi.VolumeCapability_AccessMode_Mode) *csi.VolumeCapability {
+	capability := &csi.VolumeCapability{AccessMode: &csi.VolumeCapability_AccessMode{Mode: mode}}
+	if block {
+		capability.AccessType = &csi.VolumeCapability_Block{Block: &csi.VolumeCapability_BlockVolume{}}
+	} else {
+		capability.AccessType = &csi.VolumeCapability_Mount{Mount: &csi.VolumeCapability_MountVolume{}}
+	}
+	return capability
+}
+
+func TestCsiDriver_ControllerModifyVolume_Success(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	csiServer := NewServer(constants.DefaultDriverName, constants.ProviderVersion, &k8sutils.KubeClient{}, "node1")
+	bk := &model.Backend{Name: "backend-1", Storage: constants.OceanStorSan, Plugin: &plugin.OceanstorSanPlugin{}}
+	params := map[string]string{"qos": `{"MAXIOPS": 1000}`, "description": "gold"}
+	var gotName string
+	var gotParams map[string]string
+
+	// mock
+	mock := gomonkey.NewPatches()
+	defer mock.Reset()
+	mock.ApplyMethodReturn(&handler.BackendSelector{}, "SelectBackend", bk, nil).
+		ApplyMethodReturn(&plugin.OceanstorSanPlugin{}, "SupportQoSParameters", nil).
+		ApplyMethod(&plugin.OceanstorSanPlugin{}, "ModifyVolumeAttributes",
+			func(_ *plugin.OceanstorSanPlugin, _ context.Context, name string, params map[string]string) error {
+				gotName, gotParams = name, params
+				return nil
+			})
+
+	// action
+	resp, err := csiServer.ControllerModifyVolume(ctx, &csi.ControllerModifyVolumeRequest{
+		VolumeId:          "backend-1.pvc-lun",
+		MutableParameters: params,
+	})
+
+	// assert
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, "pvc-lun", gotName)
+	require.Equal(t, params, gotParams)
+}
+
+func TestCsiDriver_ControllerModifyVolume_InvalidArgument(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	csiServer := NewServer(constants.DefaultDriverName, constants.ProviderVersion, &k8sutils.KubeClient{}, "node1")
+	bk := &model.Backend{Name: "backend-1", Storage: constants.OceanStorNas, Plugin: &plugin.OceanstorNasPlugin{}}
+	tests := []struct {
+		name      string
+		params    map[string]string
+		modifyErr error
+	}{
+		{name: "invalid snapshot reserve", params: map[string]string{"reservedSnapshotSpaceRatio": "60"}},
+		{name: "unsupported by backend", params: map[string]string{"description": "gold"},
+			modifyErr: plugin.ErrModifyAttributesNotSupported},
+		{name: "unsupported key", params: map[string]string{"smartTierInitialPolicy": "automatic"},
+			modifyErr: errors.New("modify error")},
+		{name: "invalid value", params: map[string]string{"allocType": "unknown"},
+			modifyErr: errors.New("modify error")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// mock
+			mock := gomonkey.NewPatches()
+			defer mock.Reset()
+			mock.ApplyMethodReturn(&handler.BackendSelector{}, "SelectBackend", bk, nil).
+				ApplyMethodReturn(&plugin.OceanstorNasPlugin{}, "ModifyVolumeAttributes", tt.modifyErr)
+
+			// action
+			_, err := csiServer.ControllerModifyVolume(ctx, &csi.ControllerModifyVolumeRequest{
+				VolumeId:          "backend-1.pvc-fs",
+				MutableParameters: tt.params,
+			})
+
+			// assert
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
+
+func TestCsiDriver_ControllerModifyVolume_DTreeParentFromPV(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	csiServer := NewServer(constants.DefaultDriverName, constants.ProviderVersion, &k8sutils.KubeClient{}, "node1")
+	bk := &model.Backend{Name: "backend-a", Storage: constants.OceanStorDtree, Plugin: &plugin.OceanstorDTreePlugin{}}
+	params := map[string]string{"allSquash": "all_squash"}
+	app.GetGlobalConfig().K8sUtils = &k8sutils.KubeClient{}
+	var gotParams map[string]string
+
+	// mock
+	mock := gomonkey.NewPatches()
+	defer mock.Reset()
+	mock.ApplyMethodReturn(&handler.BackendSelector{}, "SelectBackend", bk, nil).
+		ApplyMethodReturn(app.GetGlobalConfig().K8sUtils, "GetDTreeParentNameByVolumeId", "parent-fs", nil).
+		ApplyMethod(&plugin.OceanstorDTreePlugin{}, "ModifyVolumeAttributes",
+			func(_ *plugin.OceanstorDTreePlugin, _ context.Context, _ string, params map[string]string) error {
+				gotParams = params
+				return nil
+			})
+
+	// action
+	_, err := csiServer.ControllerModifyVolume(ctx, &csi.ControllerModifyVolumeRequest{
+		VolumeId:          "backend-a.pvc-dtree",
+		MutableParameters: params,
+	})
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"allSquash": "all_squash", constants.DTreeParentKey: "parent-fs"}, gotParams)
+	require.NotContains(t, params, constants.DTreeParentKey)
+}
+
+func TestCsiDriver_ValidateVolumeCapabilities_DTreeParentFromVolumeContext(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	csiServer := NewServer(constants.DefaultDriverName, constants.ProviderVersion, &k8sutils.KubeClient{}, "node1")
+	bk := &model.Backend{Name: "backend-a", Storage: constants.OceanStorDtree, Plugin: &plugin.OceanstorDTreePlugin{},
+		Parameters: map[string]interface{}{"protocol": "nfs"}}
+	req := &csi.ValidateVolumeCapabilitiesRequest{
+		VolumeId:           "backend-a.pvc-dtree",
+		VolumeContext:      map[string]string{constants.DTreeParentKey: "parent-fs"},
+		VolumeCapabilities: []*csi.VolumeCapability{fakeVolumeCapability(false, multiNodeMultiWriter)},
+		Parameters:         map[string]string{"applicationType": "Oracle_OLAP"},
+	}
+	var gotParams map[string]interface{}
+
+	// mock
+	mock := gomonkey.NewPatches()
+	defer mock.Reset()
+	mock.ApplyMethodReturn(&handler.BackendSelector{}, "SelectBackend", bk, nil).
+		ApplyMethodFunc(&plugin.OceanstorDTreePlugin{}, "QueryVolume",
+			func(_ context.Context, _ string, params map[string]interface{}) (utils.Volume, error) {
+				gotParams = params
+				return utils.NewVolume("pvc-dtree"), nil
+			})
+
+	// action
+	resp, err := csiServer.ValidateVolumeCapabilities(ctx, req)
+
+	// assert
+	require.NoError(t, err)
+	require.NotNil(t, resp.Confirmed)
+	require.Equal(t, "parent-fs", gotParams["parentname"])
+	require.NotContains(t, gotParams, "applicationType")
+}
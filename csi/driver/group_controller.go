@@ -0,0 +1,281 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package driver provides csi driver with controller, node, identity services
+package driver
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/container-storage-interface/spec/lib/go/csi"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend/model"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend/plugin"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
+)
+
+const (
+	// groupMemberSnapshotPrefix is the prefix of the snapshot names of the group members
+	groupMemberSnapshotPrefix = "gs-"
+
+	// groupMemberHashBytes keeps the snapshot names of the group members within 31 characters
+	groupMemberHashBytes = 14
+)
+
+// GroupControllerGetCapabilities used to get group controller capabilities
+func (d *CsiDriver) GroupControllerGetCapabilities(ctx context.Context,
+	req *csi.GroupControllerGetCapabilitiesRequest) (*csi.GroupControllerGetCapabilitiesResponse, error) {
+	defer utils.RecoverPanic(ctx)
+
+	return &csi.GroupControllerGetCapabilitiesResponse{
+		Capabilities: []*csi.GroupControllerServiceCapability{
+			{
+				Type: &csi.GroupControllerServiceCapability_Rpc{
+					Rpc: &csi.GroupControllerServiceCapability_RPC{
+						Type: csi.GroupControllerServiceCapability_RPC_CREATE_DELETE_GET_VOLUME_GROUP_SNAPSHOT,
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+// CreateVolumeGroupSnapshot used to create the snapshots of a group of volumes at the same point in time,
+// the backend of the volumes must report the SupportGroupSnapshot capability
+func (d *CsiDriver) CreateVolumeGroupSnapshot(ctx context.Context, req *csi.CreateVolumeGroupSnapshotRequest) (
+	*csi.CreateVolumeGroupSnapshotResponse, error) {
+	defer utils.RecoverPanic(ctx)
+
+	groupName := req.GetName()
+	if groupName == "" {
+		return nil, status.Error(codes.InvalidArgument, "Group snapshot name missing in request")
+	}
+	if len(req.GetSourceVolumeIds()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Source volume IDs missing in request")
+	}
+	log.AddContext(ctx).Infof("Start to create group snapshot %s for volumes %v", groupName, req.GetSourceVolumeIds())
+
+	backendName, members, err := getGroupSnapshotMembers(groupName, req.GetSourceVolumeIds())
+	if err != nil {
+		log.AddContext(ctx).Errorln(err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	backend, err := d.backendSelector.SelectBackend(ctx, backendName)
+	if err != nil || backend == nil {
+		msg := fmt.Sprintf("Backend %s doesn't exist", backendName)
+		log.AddContext(ctx).Errorln(msg)
+		return nil, status.Error(codes.Internal, msg)
+	}
+
+	if !supportGroupSnapshot(backend) {
+		msg := fmt.Sprintf("Backend %s does not support group snapshot", backendName)
+		log.AddContext(ctx).Errorln(msg)
+		return nil, status.Error(codes.InvalidArgument, msg)
+	}
+
+	snapshots, err := backend.Plugin.CreateGroupSnapshot(ctx, members)
+	if errors.Is(err, plugin.ErrGroupSnapshotNotSupported) {
+		msg := fmt.Sprintf("Backend %s does not support group snapshot", backendName)
+		log.AddContext(ctx).Errorln(msg)
+		return nil, status.Error(codes.InvalidArgument, msg)
+	}
+	if err != nil {
+		log.AddContext(ctx).Errorf("Create group snapshot %s error: %v", groupName, err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if len(snapshots) != len(members) {
+		msg := fmt.Sprintf("Create group snapshot %s got %d snapshots, but %d volumes are requested",
+			groupName, len(snapshots), len(members))
+		log.AddContext(ctx).Errorln(msg)
+		return nil, status.Error(codes.Internal, msg)
+	}
+
+	groupSnapshot := &csi.VolumeGroupSnapshot{
+		GroupSnapshotId: backendName + "." + groupName,
+		ReadyToUse:      true,
+	}
+	for i, snapshot := range snapshots {
+		csiSnapshot, err := newGroupMemberSnapshot(snapshot, backendName, members[i].SnapshotName)
+		if err != nil {
+			log.AddContext(ctx).Errorf("Create group snapshot %s error: %v", groupName, err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		csiSnapshot.SourceVolumeId = req.GetSourceVolumeIds()[i]
+		csiSnapshot.GroupSnapshotId = groupSnapshot.GroupSnapshotId
+		groupSnapshot.Snapshots = append(groupSnapshot.Snapshots, csiSnapshot)
+	}
+	groupSnapshot.CreationTime = groupSnapshot.Snapshots[0].CreationTime
+
+	log.AddContext(ctx).Infof("Finish to create group snapshot %s", groupSnapshot.GroupSnapshotId)
+	return &csi.CreateVolumeGroupSnapshotResponse{GroupSnapshot: groupSnapshot}, nil
+}
+
+// supportGroupSnapshot checks whether the pools of the backend report the SupportGroupSnapshot capability
+func supportGroupSnapshot(backend *model.Backend) bool {
+	for _, pool := range backend.Pools {
+		if pool.GetCapabilities()[string(constants.SupportGroupSnapshot)] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// newGroupMemberSnapshot converts the snapshot created by the plugin to the csi snapshot of the group member
+func newGroupMemberSnapshot(snapshot map[string]interface{}, backendName, snapshotName string) (*csi.Snapshot, error) {
+	sizeBytes, ok := utils.GetValue[int64](snapshot, "SizeBytes")
+	if !ok {
+		return nil, fmt.Errorf("get size of snapshot %s failed, data: %v", snapshotName, snapshot)
+	}
+	parentID, ok := utils.GetValue[string](snapshot, "ParentID")
+	if !ok {
+		return nil, fmt.Errorf("get parent id of snapshot %s failed, data: %v", snapshotName, snapshot)
+	}
+	creationTime, ok := utils.GetValue[int64](snapshot, "CreationTime")
+	if !ok {
+		return nil, fmt.Errorf("get creation time of snapshot %s failed, data: %v", snapshotName, snapshot)
+	}
+
+	return &csi.Snapshot{
+		SizeBytes:    sizeBytes,
+		SnapshotId:   backendName + "." + parentID + "." + snapshotName,
+		CreationTime: &timestamppb.Timestamp{Seconds: creationTime},
+		ReadyToUse:   true,
+	}, nil
+}
+
+// DeleteVolumeGroupSnapshot used to delete the snapshots of the group
+func (d *CsiDriver) DeleteVolumeGroupSnapshot(ctx context.Context, req *csi.DeleteVolumeGroupSnapshotRequest) (
+	*csi.DeleteVolumeGroupSnapshotResponse, error) {
+	defer utils.RecoverPanic(ctx)
+
+	groupSnapshotId := req.GetGroupSnapshotId()
+	if groupSnapshotId == "" {
+		return nil, status.Error(codes.InvalidArgument, "Group snapshot ID missing in request")
+	}
+	log.AddContext(ctx).Infof("Start to delete group snapshot %s, snapshots: %v",
+		groupSnapshotId, req.GetSnapshotIds())
+
+	backendName, _ := utils.SplitVolumeId(groupSnapshotId)
+	backend, err := d.backendSelector.SelectBackend(ctx, backendName)
+	if err != nil || backend == nil {
+		log.AddContext(ctx).Warningf("Backend %s doesn't exist. Ignore this request and return success. "+
+			"CAUTION: group snapshot need to manually delete from array.", backendName)
+		return &csi.DeleteVolumeGroupSnapshotResponse{}, nil
+	}
+
+	for _, snapshotId := range req.GetSnapshotIds() {
+		snapshotBackend, snapshotParentId, snapshotName := utils.SplitSnapshotId(snapshotId)
+		if snapshotBackend != backendName {
+			msg := fmt.Sprintf("Snapshot %s does not belong to group snapshot %s", snapshotId, groupSnapshotId)
+			log.AddContext(ctx).Errorln(msg)
+			return nil, status.Error(codes.InvalidArgument, msg)
+		}
+
+		if err = backend.Plugin.DeleteSnapshot(ctx, snapshotParentId, snapshotName); err != nil {
+			log.AddContext(ctx).Errorf("Delete snapshot %s of group %s error: %v", snapshotId, groupSnapshotId, err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	log.AddContext(ctx).Infof("Finish to delete group snapshot %s", groupSnapshotId)
+	return &csi.DeleteVolumeGroupSnapshotResponse{}, nil
+}
+
+// GetVolumeGroupSnapshot used to get the snapshots of the group
+func (d *CsiDriver) GetVolumeGroupSnapshot(ctx context.Context, req *csi.GetVolumeGroupSnapshotRequest) (
+	*csi.GetVolumeGroupSnapshotResponse, error) {
+	defer utils.RecoverPanic(ctx)
+
+	groupSnapshotId := req.GetGroupSnapshotId()
+	if groupSnapshotId == "" {
+		return nil, status.Error(codes.InvalidArgument, "Group snapshot ID missing in request")
+	}
+
+	backendName, _ := utils.SplitVolumeId(groupSnapshotId)
+	backend, err := d.backendSelector.SelectBackend(ctx, backendName)
+	if err != nil || backend == nil {
+		msg := fmt.Sprintf("Backend %s doesn't exist", backendName)
+		log.AddContext(ctx).Errorln(msg)
+		return nil, status.Error(codes.NotFound, msg)
+	}
+
+	groupSnapshot := &csi.VolumeGroupSnapshot{GroupSnapshotId: groupSnapshotId, ReadyToUse: true}
+	for _, snapshotId := range req.GetSnapshotIds() {
+		_, snapshotParentId, snapshotName := utils.SplitSnapshotId(snapshotId)
+		snapshots, err := backend.Plugin.ListSnapshots(ctx,
+			utils.SnapshotQuery{Name: snapshotName, ParentID: snapshotParentId})
+		if err != nil {
+			log.AddContext(ctx).Errorf("Get snapshot %s of group %s error: %v", snapshotId, groupSnapshotId, err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if len(snapshots) == 0 {
+			msg := fmt.Sprintf("Snapshot %s of group %s does not exist", snapshotId, groupSnapshotId)
+			log.AddContext(ctx).Errorln(msg)
+			return nil, status.Error(codes.NotFound, msg)
+		}
+
+		snapshot := newListSnapshotsEntry(backendName, snapshots[0]).Snapshot
+		snapshot.SnapshotId = snapshotId
+		snapshot.GroupSnapshotId = groupSnapshotId
+		groupSnapshot.Snapshots = append(groupSnapshot.Snapshots, snapshot)
+	}
+	if len(groupSnapshot.Snapshots) != 0 {
+		groupSnapshot.CreationTime = groupSnapshot.Snapshots[0].CreationTime
+	}
+
+	return &csi.GetVolumeGroupSnapshotResponse{GroupSnapshot: groupSnapshot}, nil
+}
+
+// getGroupSnapshotMembers returns the backend of the volumes and the snapshot of each volume,
+// all the volumes of a group snapshot must be on the same backend
+func getGroupSnapshotMembers(groupName string, volumeIds []string) (string, []utils.GroupSnapshotMember, error) {
+	var backendName string
+	members := make([]utils.GroupSnapshotMember, 0, len(volumeIds))
+	for _, volumeId := range volumeIds {
+		volumeBackend, volumeName := utils.SplitVolumeId(volumeId)
+		if backendName == "" {
+			backendName = volumeBackend
+		}
+		if volumeBackend != backendName {
+			return "", nil, fmt.Errorf("volumes of group snapshot %s must be on the same backend, "+
+				"but got %s and %s", groupName, backendName, volumeBackend)
+		}
+
+		members = append(members, utils.GroupSnapshotMember{
+			VolumeName:   volumeName,
+			SnapshotName: getGroupMemberSnapshotName(groupName, volumeId),
+		})
+	}
+
+	return backendName, members, nil
+}
+
+// getGroupMemberSnapshotName returns a stable snapshot name of the volume in the group,
+// the name is short enough to be used on the storage without truncation
+func getGroupMemberSnapshotName(groupName, volumeId string) string {
+	hash := sha256.Sum256([]byte(groupName + "/" + volumeId))
+	return fmt.Sprintf("%s%x", groupMemberSnapshotPrefix, hash[:groupMemberHashBytes])
+}
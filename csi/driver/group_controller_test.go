@@ -0,0 +1,197 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package driver provides csi driver with controller, node, identity services
+package driver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/container-storage-interface/spec/lib/go/csi"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend/handler"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend/model"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend/plugin"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/k8sutils"
+)
+
+func newGroupSnapshotBackend(storagePlugin plugin.StoragePlugin, supported bool) *model.Backend {
+	return &model.Backend{Name: "backend-a", Available: true, Plugin: storagePlugin,
+		Pools: []*model.StoragePool{{Name: "pool-a", Parent: "backend-a", Plugin: storagePlugin,
+			Capabilities: map[string]bool{string(constants.SupportGroupSnapshot): supported}}}}
+}
+
+func TestCsiDriver_CreateVolumeGroupSnapshot_Success(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	csiServer := NewServer(constants.DefaultDriverName, constants.ProviderVersion, &k8sutils.KubeClient{}, "node1")
+	bk := newGroupSnapshotBackend(&plugin.OceanstorSanPlugin{}, true)
+	req := &csi.CreateVolumeGroupSnapshotRequest{
+		Name:            "groupsnapshot-1",
+		SourceVolumeIds: []string{"backend-a.pvc-data", "backend-a.pvc-wal"},
+	}
+	var gotMembers []utils.GroupSnapshotMember
+
+	// mock
+	mock := gomonkey.NewPatches()
+	defer mock.Reset()
+	mock.ApplyMethodReturn(&handler.BackendSelector{}, "SelectBackend", bk, nil).
+		ApplyMethodFunc(&plugin.OceanstorSanPlugin{}, "CreateGroupSnapshot",
+			func(_ context.Context, members []utils.GroupSnapshotMember) ([]map[string]interface{}, error) {
+				gotMembers = members
+				return []map[string]interface{}{
+					fakeSnapshotInfo(members[0].SnapshotName, "10", "pvc-data"),
+					fakeSnapshotInfo(members[1].SnapshotName, "20", "pvc-wal"),
+				}, nil
+			})
+
+	// action
+	resp, err := csiServer.CreateVolumeGroupSnapshot(ctx, req)
+
+	// assert
+	require.NoError(t, err)
+	require.Len(t, gotMembers, 2)
+	require.Equal(t, "pvc-wal", gotMembers[1].VolumeName)
+	require.LessOrEqual(t, len(gotMembers[0].SnapshotName), 31)
+	require.NotEqual(t, gotMembers[0].SnapshotName, gotMembers[1].SnapshotName)
+	require.Equal(t, "backend-a.groupsnapshot-1", resp.GroupSnapshot.GroupSnapshotId)
+	require.Len(t, resp.GroupSnapshot.Snapshots, 2)
+	require.Equal(t, "backend-a.20."+gotMembers[1].SnapshotName, resp.GroupSnapshot.Snapshots[1].SnapshotId)
+	require.Equal(t, "backend-a.pvc-wal", resp.GroupSnapshot.Snapshots[1].SourceVolumeId)
+}
+
+func TestCsiDriver_CreateVolumeGroupSnapshot_DifferentBackends(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	csiServer := NewServer(constants.DefaultDriverName, constants.ProviderVersion, &k8sutils.KubeClient{}, "node1")
+	req := &csi.CreateVolumeGroupSnapshotRequest{
+		Name:            "groupsnapshot-1",
+		SourceVolumeIds: []string{"backend-a.pvc-data", "backend-b.pvc-wal"},
+	}
+
+	// action
+	_, err := csiServer.CreateVolumeGroupSnapshot(ctx, req)
+
+	// assert
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestCsiDriver_CreateVolumeGroupSnapshot_NasNotSupported(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	csiServer := NewServer(constants.DefaultDriverName, constants.ProviderVersion, &k8sutils.KubeClient{}, "node1")
+	bk := newGroupSnapshotBackend(&plugin.OceanstorNasPlugin{}, false)
+	req := &csi.CreateVolumeGroupSnapshotRequest{
+		Name:            "groupsnapshot-1",
+		SourceVolumeIds: []string{"backend-a.pvc-data"},
+	}
+
+	// mock
+	mock := gomonkey.NewPatches()
+	defer mock.Reset()
+	mock.ApplyMethodReturn(&handler.BackendSelector{}, "SelectBackend", bk, nil)
+
+	// action
+	_, err := csiServer.CreateVolumeGroupSnapshot(ctx, req)
+
+	// assert
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.ErrorContains(t, err, "does not support group snapshot")
+}
+
+func TestCsiDriver_CreateVolumeGroupSnapshot_InvalidSnapshotInfo(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	csiServer := NewServer(constants.DefaultDriverName, constants.ProviderVersion, &k8sutils.KubeClient{}, "node1")
+	bk := newGroupSnapshotBackend(&plugin.OceanstorSanPlugin{}, true)
+	req := &csi.CreateVolumeGroupSnapshotRequest{
+		Name:            "groupsnapshot-1",
+		SourceVolumeIds: []string{"backend-a.pvc-data"},
+	}
+
+	// mock
+	mock := gomonkey.NewPatches()
+	defer mock.Reset()
+	mock.ApplyMethodReturn(&handler.BackendSelector{}, "SelectBackend", bk, nil).
+		ApplyMethodReturn(&plugin.OceanstorSanPlugin{}, "CreateGroupSnapshot",
+			[]map[string]interface{}{{"SizeBytes": "1024", "ParentID": "10", "CreationTime": int64(1)}}, nil)
+
+	// action
+	_, err := csiServer.CreateVolumeGroupSnapshot(ctx, req)
+
+	// assert
+	require.Equal(t, codes.Internal, status.Code(err))
+	require.ErrorContains(t, err, "get size of snapshot")
+}
+
+func TestCsiDriver_DeleteVolumeGroupSnapshot_Success(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	csiServer := NewServer(constants.DefaultDriverName, constants.ProviderVersion, &k8sutils.KubeClient{}, "node1")
+	bk := &model.Backend{Name: "backend-a", Available: true, Plugin: &plugin.OceanstorSanPlugin{}}
+	req := &csi.DeleteVolumeGroupSnapshotRequest{
+		GroupSnapshotId: "backend-a.groupsnapshot-1",
+		SnapshotIds:     []string{"backend-a.10.gs-1", "backend-a.20.gs-2"},
+	}
+	var deleted []string
+
+	// mock
+	mock := gomonkey.NewPatches()
+	defer mock.Reset()
+	mock.ApplyMethodReturn(&handler.BackendSelector{}, "SelectBackend", bk, nil).
+		ApplyMethodFunc(&plugin.OceanstorSanPlugin{}, "DeleteSnapshot",
+			func(_ context.Context, parentID, name string) error {
+				deleted = append(deleted, parentID+"/"+name)
+				return nil
+			})
+
+	// action
+	_, err := csiServer.DeleteVolumeGroupSnapshot(ctx, req)
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, []string{"10/gs-1", "20/gs-2"}, deleted)
+}
+
+func TestCsiDriver_GetVolumeGroupSnapshot_SnapshotNotExist(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	csiServer := NewServer(constants.DefaultDriverName, constants.ProviderVersion, &k8sutils.KubeClient{}, "node1")
+	bk := &model.Backend{Name: "backend-a", Available: true, Plugin: &plugin.OceanstorSanPlugin{}}
+	req := &csi.GetVolumeGroupSnapshotRequest{
+		GroupSnapshotId: "backend-a.groupsnapshot-1",
+		SnapshotIds:     []string{"backend-a.10.gs-1"},
+	}
+
+	// mock
+	mock := gomonkey.NewPatches()
+	defer mock.Reset()
+	mock.ApplyMethodReturn(&handler.BackendSelector{}, "SelectBackend", bk, nil).
+		ApplyMethodReturn(&plugin.OceanstorSanPlugin{}, "ListSnapshots", nil, nil)
+
+	// action
+	_, err := csiServer.GetVolumeGroupSnapshot(ctx, req)
+
+	// assert
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,77 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package driver
+
+import (
+	"context"
+	"path"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/metrics"
+)
+
+type volumeIDGetter interface {
+	GetVolumeId() string
+}
+
+type sourceVolumeIDGetter interface {
+	GetSourceVolumeId() string
+}
+
+type snapshotIDGetter interface {
+	GetSnapshotId() string
+}
+
+type parametersGetter interface {
+	GetParameters() map[string]string
+}
+
+// MetricsInterceptor used to record the latency and the errors of the grpc requests
+func MetricsInterceptor(ctx context.Context, req interface{},
+	info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	metrics.ObserveRPC(path.Base(info.FullMethod), getRequestBackend(req), status.Code(err).String(), start)
+	return resp, err
+}
+
+func getRequestBackend(req interface{}) string {
+	if getter, ok := req.(volumeIDGetter); ok && getter.GetVolumeId() != "" {
+		backendName, _ := utils.SplitVolumeId(getter.GetVolumeId())
+		return backendName
+	}
+
+	if getter, ok := req.(sourceVolumeIDGetter); ok && getter.GetSourceVolumeId() != "" {
+		backendName, _ := utils.SplitVolumeId(getter.GetSourceVolumeId())
+		return backendName
+	}
+
+	if getter, ok := req.(snapshotIDGetter); ok && getter.GetSnapshotId() != "" {
+		backendName, _, _ := utils.SplitSnapshotId(getter.GetSnapshotId())
+		return backendName
+	}
+
+	if getter, ok := req.(parametersGetter); ok {
+		return getter.GetParameters()["backend"]
+	}
+
+	return ""
+}
@@ -0,0 +1,85 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package driver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/container-storage-interface/spec/lib/go/csi"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/metrics"
+)
+
+func TestGetRequestBackend(t *testing.T) {
+	// arrange
+	cases := []struct {
+		name string
+		req  interface{}
+		want string
+	}{
+		{name: "volume id", req: &csi.DeleteVolumeRequest{VolumeId: "backend1.pvc-1"}, want: "backend1"},
+		{name: "snapshot id", req: &csi.DeleteSnapshotRequest{SnapshotId: "backend2.pvc-1.snap-1"}, want: "backend2"},
+		{name: "source volume id", req: &csi.CreateSnapshotRequest{SourceVolumeId: "backend3.pvc-1"},
+			want: "backend3"},
+		{name: "parameters", req: &csi.CreateVolumeRequest{Parameters: map[string]string{"backend": "backend4"}},
+			want: "backend4"},
+		{name: "no backend", req: &csi.GetPluginInfoRequest{}, want: ""},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			// action
+			got := getRequestBackend(c.req)
+
+			// assert
+			assert.Equal(t, c.want, got)
+		})
+	}
+}
+
+func TestMetricsInterceptor_ObserveError(t *testing.T) {
+	// arrange
+	var gotMethod, gotBackend, gotCode string
+	req := &csi.DeleteVolumeRequest{VolumeId: "backend1.pvc-1"}
+	info := &grpc.UnaryServerInfo{FullMethod: "/csi.v1.Controller/DeleteVolume"}
+	wantErr := status.Error(codes.Internal, "delete failed")
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, wantErr
+	}
+
+	// mock
+	p := gomonkey.ApplyFunc(metrics.ObserveRPC, func(method, backend, code string, start time.Time) {
+		gotMethod, gotBackend, gotCode = method, backend, code
+	})
+	defer p.Reset()
+
+	// action
+	_, err := MetricsInterceptor(context.Background(), req, info, handler)
+
+	// assert
+	assert.ErrorIs(t, err, wantErr)
+	assert.Equal(t, "DeleteVolume", gotMethod)
+	assert.Equal(t, "backend1", gotBackend)
+	assert.Equal(t, codes.Internal.String(), gotCode)
+}
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2020-2025. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2020-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -19,8 +19,11 @@ package driver
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"os"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/container-storage-interface/spec/lib/go/csi"
@@ -203,7 +206,7 @@ func (d *CsiDriver) NodeGetInfo(ctx context.Context, req *csi.NodeGetInfoRequest
 func (d *CsiDriver) NodeGetCapabilities(ctx context.Context,
 	req *csi.NodeGetCapabilitiesRequest) (*csi.NodeGetCapabilitiesResponse, error) {
 	defer utils.RecoverPanic(ctx)
-	return &csi.NodeGetCapabilitiesResponse{
+	resp := &csi.NodeGetCapabilitiesResponse{
 		Capabilities: []*csi.NodeServiceCapability{
 			{
 				Type: &csi.NodeServiceCapability_Rpc{
@@ -227,7 +230,19 @@ func (d *CsiDriver) NodeGetCapabilities(ctx context.Context,
 				},
 			},
 		},
-	}, nil
+	}
+
+	if app.GetGlobalConfig().HealthMonitorEnabled {
+		resp.Capabilities = append(resp.Capabilities, &csi.NodeServiceCapability{
+			Type: &csi.NodeServiceCapability_Rpc{
+				Rpc: &csi.NodeServiceCapability_RPC{
+					Type: csi.NodeServiceCapability_RPC_VOLUME_CONDITION,
+				},
+			},
+		})
+	}
+
+	return resp, nil
 }
 
 // NodeGetVolumeStats used to get node volume status
@@ -248,15 +263,49 @@ func (d *CsiDriver) NodeGetVolumeStats(ctx context.Context,
 	}
 
 	isBlock, err := utils.IsBlockDevice(volumePath)
-	if err != nil {
+	// the stale nfs handle is checked with the filesystem volume stats
+	if err != nil && !errors.Is(err, syscall.ESTALE) {
 		log.AddContext(ctx).Errorf("check block device for volume %s failed: %v", volumeID, err)
 		return nil, status.Errorf(codes.Internal, "check block device for volume %s failed: %v", volumeID, err)
 	}
 
+	var resp *csi.NodeGetVolumeStatsResponse
 	if isBlock {
-		return d.getBlockVolumeStats(ctx, volumeID, volumePath)
+		resp, err = d.getBlockVolumeStats(ctx, volumeID, volumePath)
+	} else {
+		resp, err = d.getFilesystemVolumeStats(ctx, volumePath)
+	}
+
+	if err != nil || resp.VolumeCondition != nil || !app.GetGlobalConfig().HealthMonitorEnabled {
+		return resp, err
 	}
-	return d.getFilesystemVolumeStats(ctx, volumePath)
+
+	d.addVolumeCondition(ctx, volumeID, volumePath, resp)
+	return resp, nil
+}
+
+// addVolumeCondition sets the volume condition by the path health of the device, so that the kubelet and
+// the external health monitor can raise events on the pods of the abnormal volumes
+func (d *CsiDriver) addVolumeCondition(ctx context.Context, volumeID, volumePath string,
+	resp *csi.NodeGetVolumeStatsResponse) {
+	deviceName, err := utils.GetBlockDeviceName(volumePath)
+	if err != nil {
+		// the volume is not on a block device, such as the nfs volumes
+		log.AddContext(ctx).Debugf("Skip checking paths of volume %s, error: %v", volumeID, err)
+		resp.VolumeCondition = &csi.VolumeCondition{Message: "volume is healthy"}
+		return
+	}
+
+	health, err := connector.GetDevicePathHealth(ctx, deviceName)
+	if err != nil {
+		log.AddContext(ctx).Warningf("Get path health of volume %s failed, error: %v", volumeID, err)
+		return
+	}
+
+	if health.Degraded() {
+		log.AddContext(ctx).Warningf("Volume %s is degraded, %s", volumeID, health.Message())
+	}
+	resp.VolumeCondition = &csi.VolumeCondition{Abnormal: health.Degraded(), Message: health.Message()}
 }
 
 func (d *CsiDriver) getBlockVolumeStats(ctx context.Context, volumeID, volumePath string) (
@@ -275,6 +324,14 @@ func (d *CsiDriver) getBlockVolumeStats(ctx context.Context, volumeID, volumePat
 func (d *CsiDriver) getFilesystemVolumeStats(ctx context.Context, volumePath string) (
 	*csi.NodeGetVolumeStatsResponse, error) {
 	volumeMetrics, err := utils.GetVolumeMetrics(volumePath)
+	if err != nil && errors.Is(err, syscall.ESTALE) && app.GetGlobalConfig().HealthMonitorEnabled {
+		// the usage is unknown, but the stale nfs handle is reported as the abnormal volume condition
+		log.AddContext(ctx).Warningf("Volume path %s has a stale file handle", volumePath)
+		return &csi.NodeGetVolumeStatsResponse{VolumeCondition: &csi.VolumeCondition{
+			Abnormal: true,
+			Message:  fmt.Sprintf("stale file handle of volume path %s", volumePath),
+		}}, nil
+	}
 	if err != nil {
 		log.AddContext(ctx).Errorf("get volume metrics failed: %v", err)
 		return nil, status.Errorf(codes.Internal, "get volume metrics failed: %v", err)
@@ -20,15 +20,20 @@ package driver
 import (
 	"context"
 	"errors"
+	"fmt"
+	"syscall"
 	"testing"
 
 	"github.com/agiledragon/gomonkey/v2"
 	"github.com/container-storage-interface/spec/lib/go/csi"
+	"github.com/prashantv/gostub"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"k8s.io/apimachinery/pkg/api/resource"
 
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/connector"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/app"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/k8sutils"
@@ -211,3 +216,82 @@ func TestCsiDriver_NodeGetVolumeStats_FilesystemVolume_GetMetricsError(t *testin
 	require.True(t, ok)
 	require.Equal(t, codes.Internal, st.Code())
 }
+
+func TestCsiDriver_NodeGetVolumeStats_BlockVolumeDegraded(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	driver := NewServer(constants.DefaultDriverName, constants.ProviderVersion, &k8sutils.KubeClient{}, "node1")
+	req := &csi.NodeGetVolumeStatsRequest{
+		VolumeId:   "backend.vol-name",
+		VolumePath: "/dev/dm-0",
+	}
+	health := &connector.PathHealth{Device: "dm-0", TotalPaths: 2, FaultyPaths: []string{"sdc"}}
+	config := *app.GetGlobalConfig()
+	config.HealthMonitorEnabled = true
+
+	// mock
+	stubs := gostub.StubFunc(&app.GetGlobalConfig, &config)
+	defer stubs.Reset()
+	patches := gomonkey.ApplyFuncReturn(utils.IsBlockDevice, true, nil).
+		ApplyFuncReturn(utils.GetBlockDeviceSize, int64(1024), nil).
+		ApplyFuncReturn(utils.GetBlockDeviceName, "dm-0", nil).
+		ApplyFuncReturn(connector.GetDevicePathHealth, health, nil)
+	defer patches.Reset()
+
+	// action
+	resp, err := driver.NodeGetVolumeStats(ctx, req)
+
+	// assert
+	require.NoError(t, err)
+	require.NotNil(t, resp.VolumeCondition)
+	require.True(t, resp.VolumeCondition.Abnormal)
+	require.Equal(t, "1 of 2 paths of device dm-0 are faulty: sdc", resp.VolumeCondition.Message)
+}
+
+func TestCsiDriver_NodeGetVolumeStats_StaleFileHandle(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	driver := NewServer(constants.DefaultDriverName, constants.ProviderVersion, &k8sutils.KubeClient{}, "node1")
+	req := &csi.NodeGetVolumeStatsRequest{
+		VolumeId:   "backend.vol-name",
+		VolumePath: "/var/lib/kubelet/pods/pod-uid/volumes/kubernetes.io~csi/pvc-name/mount",
+	}
+	config := *app.GetGlobalConfig()
+	config.HealthMonitorEnabled = true
+
+	// mock
+	stubs := gostub.StubFunc(&app.GetGlobalConfig, &config)
+	defer stubs.Reset()
+	patches := gomonkey.ApplyFuncReturn(utils.IsBlockDevice, false, syscall.ESTALE).
+		ApplyFuncReturn(utils.GetVolumeMetrics, nil, fmt.Errorf("failed to get FsInfo, error %w", syscall.ESTALE))
+	defer patches.Reset()
+
+	// action
+	resp, err := driver.NodeGetVolumeStats(ctx, req)
+
+	// assert
+	require.NoError(t, err)
+	require.Empty(t, resp.Usage)
+	require.True(t, resp.VolumeCondition.Abnormal)
+	require.Contains(t, resp.VolumeCondition.Message, "stale file handle")
+}
+
+func TestCsiDriver_NodeGetCapabilities_VolumeCondition(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	driver := NewServer(constants.DefaultDriverName, constants.ProviderVersion, &k8sutils.KubeClient{}, "node1")
+	config := *app.GetGlobalConfig()
+	config.HealthMonitorEnabled = true
+
+	// mock
+	stubs := gostub.StubFunc(&app.GetGlobalConfig, &config)
+	defer stubs.Reset()
+
+	// action
+	resp, err := driver.NodeGetCapabilities(ctx, &csi.NodeGetCapabilitiesRequest{})
+
+	// assert
+	require.NoError(t, err)
+	require.Len(t, resp.Capabilities, 4)
+	require.Equal(t, csi.NodeServiceCapability_RPC_VOLUME_CONDITION, resp.Capabilities[3].GetRpc().GetType())
+}
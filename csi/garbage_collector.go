@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2020-2024. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2020-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -51,6 +51,7 @@ const (
 type PVFileData struct {
 	VolumeHandle string `json:"volumeHandle"` // volume handle
 	DriverName   string `json:"driverName"`   // driver name
+	SpecVolID    string `json:"specVolID"`    // pv name
 }
 
 // NodePVData represents volume related info
@@ -49,6 +49,7 @@ import (
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/cert"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/iputils"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/metrics"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/notify"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/version"
 )
@@ -131,6 +132,14 @@ func runCSINode(ctx context.Context, csiDriver *driver.CsiDriver) {
 
 	triggerGarbageCollector()
 
+	if app.GetGlobalConfig().SpaceReclaimInterval > 0 {
+		go runSpaceReclaimer(ctx)
+	}
+
+	if app.GetGlobalConfig().PathMonitorInterval > 0 {
+		go runPathMonitor(ctx)
+	}
+
 	// Save host info to secret, such as: hostname, initiator
 	go func() {
 		if err := host.SaveNodeHostInfoToSecret(context.Background()); err != nil {
@@ -210,6 +219,8 @@ func main() {
 		logrus.Fatalf("Init log error: %v", err)
 	}
 
+	metrics.StartServer(context.Background(), app.GetGlobalConfig().MetricsAddress)
+
 	csiDriver := driver.NewServer(app.GetGlobalConfig().DriverName,
 		csiVersion,
 		app.GetGlobalConfig().K8sUtils,
@@ -229,7 +240,7 @@ func registerDRCSIServer() {
 	p := provider.NewProvider(app.GetGlobalConfig().DriverName, csiVersion)
 	drListener := listenEndpoint(app.GetGlobalConfig().DrEndpoint)
 	opts := []grpc.ServerOption{
-		grpc.UnaryInterceptor(log.EnsureGRPCContext),
+		grpc.ChainUnaryInterceptor(log.EnsureGRPCContext, driver.MetricsInterceptor),
 	}
 	grpcServer := grpc.NewServer(opts...)
 	drcsi.RegisterIdentityServer(grpcServer, p)
@@ -265,13 +276,14 @@ func registerServerOnService(ctx context.Context, listener net.Listener, d *driv
 		notify.Stop("start Huawei CSI driver on service error: %v", err)
 	}
 	opts := []grpc.ServerOption{
-		grpc.UnaryInterceptor(log.EnsureGRPCContext),
+		grpc.ChainUnaryInterceptor(log.EnsureGRPCContext, driver.MetricsInterceptor),
 		grpc.Creds(cred),
 	}
 	server := grpc.NewServer(opts...)
 
 	csi.RegisterIdentityServer(server, d)
 	csi.RegisterControllerServer(server, d)
+	csi.RegisterGroupControllerServer(server, d)
 	csi.RegisterNodeServer(server, d)
 
 	log.Infof("starting Huawei CSI driver on service, listening on %s", listener.Addr().String())
@@ -312,12 +324,13 @@ func listenEndpoint(endpoint string) net.Listener {
 
 func registerServer(listener net.Listener, d *driver.CsiDriver) {
 	opts := []grpc.ServerOption{
-		grpc.UnaryInterceptor(log.EnsureGRPCContext),
+		grpc.ChainUnaryInterceptor(log.EnsureGRPCContext, driver.MetricsInterceptor),
 	}
 	server := grpc.NewServer(opts...)
 
 	csi.RegisterIdentityServer(server, d)
 	csi.RegisterControllerServer(server, d)
+	csi.RegisterGroupControllerServer(server, d)
 	csi.RegisterNodeServer(server, d)
 
 	log.Infof("Starting Huawei CSI driver, listening on %s", app.GetGlobalConfig().Endpoint)
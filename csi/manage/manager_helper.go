@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2020-2025. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2020-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"reflect"
 	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/container-storage-interface/spec/lib/go/csi"
@@ -227,6 +228,27 @@ func WithVolumeCapability(ctx context.Context, req *csi.NodeStageVolumeRequest)
 	}
 }
 
+// WithEncryption build the encryption parameters of the encrypted volume, the passphrase is from the node stage
+// secret referenced by the StorageClass
+func WithEncryption(req *csi.NodeStageVolumeRequest) BuildParameterOption {
+	return func(parameters map[string]interface{}) error {
+		encrypted, err := strconv.ParseBool(req.GetVolumeContext()[constants.EncryptedKey])
+		if err != nil || !encrypted {
+			return nil
+		}
+
+		passphrase := req.GetSecrets()[constants.EncryptionPassphraseKey]
+		if passphrase == "" {
+			return fmt.Errorf("volume %s is encrypted, but %s is not found in the node stage secret",
+				req.GetVolumeId(), constants.EncryptionPassphraseKey)
+		}
+
+		parameters["encrypted"] = true
+		parameters["encryptionPassphrase"] = passphrase
+		return nil
+	}
+}
+
 // CheckParam check node stage volume request parameters
 func CheckParam(ctx context.Context, req *csi.NodeStageVolumeRequest) error {
 	switch req.VolumeCapability.GetAccessType().(type) {
@@ -951,3 +951,46 @@ func Test_GetBackendConfig_SuccessDtfsProtocol(t *testing.T) {
 	assert.Equal(t, wantCfg.protocol, gotCfg.protocol)
 	assert.Equal(t, wantCfg.deviceWWN, gotCfg.deviceWWN)
 }
+
+func Test_WithEncryption_Encrypted(t *testing.T) {
+	// arrange
+	req := &csi.NodeStageVolumeRequest{
+		VolumeId:      "backend.pvc-1",
+		VolumeContext: map[string]string{constants.EncryptedKey: "true"},
+		Secrets:       map[string]string{constants.EncryptionPassphraseKey: "passphrase"},
+	}
+
+	// act
+	parameters, err := BuildParameters(WithEncryption(req))
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, true, parameters["encrypted"])
+	assert.Equal(t, "passphrase", parameters["encryptionPassphrase"])
+}
+
+func Test_WithEncryption_MissingPassphrase(t *testing.T) {
+	// arrange
+	req := &csi.NodeStageVolumeRequest{
+		VolumeId:      "backend.pvc-1",
+		VolumeContext: map[string]string{constants.EncryptedKey: "true"},
+	}
+
+	// act
+	_, err := BuildParameters(WithEncryption(req))
+
+	// assert
+	assert.ErrorContains(t, err, constants.EncryptionPassphraseKey)
+}
+
+func Test_WithEncryption_NotEncrypted(t *testing.T) {
+	// arrange
+	req := &csi.NodeStageVolumeRequest{VolumeId: "backend.pvc-1"}
+
+	// act
+	parameters, err := BuildParameters(WithEncryption(req))
+
+	// assert
+	assert.NoError(t, err)
+	assert.NotContains(t, parameters, "encrypted")
+}
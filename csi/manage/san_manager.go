@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2020-2023. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2020-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -71,6 +71,7 @@ func (m *SanManager) StageVolume(ctx context.Context, req *csi.NodeStageVolumeRe
 		WithVolumeCapability(ctx, req),
 		WithControllerPublishInfo(ctx, req),
 		WithMultiPathType(m.protocol),
+		WithEncryption(req),
 	)
 	if err != nil {
 		log.AddContext(ctx).Errorf("build san parameters filed, error: %v", err)
@@ -80,7 +81,8 @@ func (m *SanManager) StageVolume(ctx context.Context, req *csi.NodeStageVolumeRe
 	tasks := flow.NewTaskFlow(ctx, "StageVolume").
 		AddTaskWithOutRevert(clearResidualPathWithWwn).
 		AddTaskWithOutRevert(clearResidualPathWithLunId).
-		AddTaskWithOutRevert(connectVolume)
+		AddTaskWithOutRevert(connectVolume).
+		AddTaskWithOutRevert(openEncryptedDevice)
 
 	if volMode, exist := parameters["volumeMode"].(string); exist && volMode == "Block" {
 		tasks = tasks.AddTaskWithOutRevert(stageForBlock)
@@ -146,6 +148,16 @@ func (m *SanManager) ExpandVolume(ctx context.Context, req *csi.NodeExpandVolume
 		return err
 	}
 
+	// the encrypted device is resized after the underlying device, and before the filesystem on it
+	mapperName := connUtils.LuksMapperName(req.GetVolumeId())
+	if connUtils.IsLuksDeviceOpened(ctx, mapperName) {
+		passphrase := req.GetSecrets()[constants.EncryptionPassphraseKey]
+		if err = connUtils.ResizeLuksDevice(ctx, mapperName, passphrase); err != nil {
+			log.AddContext(ctx).Errorf("Encrypted volume %s resize error: %v", req.GetVolumePath(), err)
+			return err
+		}
+	}
+
 	if req.GetVolumeCapability().GetMount() != nil {
 		err = connector.ResizeMountPath(ctx, req.GetVolumePath())
 		if err != nil {
@@ -159,7 +171,15 @@ func (m *SanManager) ExpandVolume(ctx context.Context, req *csi.NodeExpandVolume
 
 // UnStageWithWwn unstage volume by wwn
 func (m *SanManager) UnStageWithWwn(ctx context.Context, wwn, volumeId string) error {
-	err := m.Conn.DisConnectVolume(ctx, wwn)
+	// the encrypted device holds the underlying device, so it must be closed before disconnecting
+	err := connUtils.CloseLuksDevice(ctx, connUtils.LuksMapperName(volumeId))
+	if err != nil {
+		log.AddContext(ctx).Errorf("close encrypted device failed while unstage volume,"+
+			" volumeId: %s, error: %v", volumeId, err)
+		return err
+	}
+
+	err = m.Conn.DisConnectVolume(ctx, wwn)
 	if err != nil {
 		log.AddContext(ctx).Errorf("disconnect volume failed while unstage volume,"+
 			" wwn: %s, error: %v", wwn, err)
@@ -258,6 +278,34 @@ func connectVolume(ctx context.Context, parameters map[string]interface{}) error
 	return nil
 }
 
+// openEncryptedDevice opens the connected device with LUKS2 when the volume is encrypted,
+// and replaces the device path with the decrypted device, so that it is formatted and mounted instead
+func openEncryptedDevice(ctx context.Context, parameters map[string]interface{}) error {
+	if encrypted, _ := parameters["encrypted"].(bool); !encrypted {
+		return nil
+	}
+
+	devPath, exist := parameters["devPath"].(string)
+	if !exist {
+		return errors.New("device path doesn't exist while open encrypted device")
+	}
+
+	volumeId, exist := parameters["volumeId"].(string)
+	if !exist {
+		return errors.New("volumeId doesn't exist while open encrypted device")
+	}
+
+	passphrase, _ := parameters["encryptionPassphrase"].(string)
+	mapperPath, err := connUtils.OpenLuksDevice(ctx, devPath, connUtils.LuksMapperName(volumeId), passphrase)
+	if err != nil {
+		log.AddContext(ctx).Errorf("open encrypted device %s failed, error: %v", devPath, err)
+		return err
+	}
+
+	parameters["devPath"] = mapperPath
+	return nil
+}
+
 // stageForMount when AccessType is csi.VolumeCapability_Mount, this function will be called to mount share path
 func stageForMount(ctx context.Context, parameters map[string]interface{}) error {
 	log.AddContext(ctx).Infoln("the request to stage filesystem device")
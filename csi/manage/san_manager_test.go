@@ -344,3 +344,75 @@ func TestStageForBlock_BindMountRawBlockDeviceError(t *testing.T) {
 		t.Errorf("TestStageForBlock_BindMountRawBlockDeviceError want error, got nil")
 	}
 }
+
+func TestOpenEncryptedDevice_ReplaceDevPath(t *testing.T) {
+	patches := gomonkey.NewPatches()
+	defer patches.Reset()
+
+	var gotMapperName, gotPassphrase string
+	patches.ApplyFunc(connUtils.OpenLuksDevice,
+		func(_ context.Context, devPath, mapperName, passphrase string) (string, error) {
+			gotMapperName, gotPassphrase = mapperName, passphrase
+			return "/dev/mapper/" + mapperName, nil
+		})
+
+	parameters := map[string]interface{}{
+		"volumeId":             "backend.pvc-1",
+		"devPath":              "/dev/dm-1",
+		"encrypted":            true,
+		"encryptionPassphrase": "passphrase",
+	}
+
+	err := openEncryptedDevice(context.Background(), parameters)
+	if err != nil {
+		t.Errorf("TestOpenEncryptedDevice_ReplaceDevPath want error = nil, got error = %v", err)
+	}
+	if gotMapperName != "luks-backend.pvc-1" || gotPassphrase != "passphrase" {
+		t.Errorf("TestOpenEncryptedDevice_ReplaceDevPath got mapper name %s", gotMapperName)
+	}
+	if parameters["devPath"] != "/dev/mapper/luks-backend.pvc-1" {
+		t.Errorf("TestOpenEncryptedDevice_ReplaceDevPath got devPath %v", parameters["devPath"])
+	}
+}
+
+func TestOpenEncryptedDevice_NotEncrypted(t *testing.T) {
+	patches := gomonkey.NewPatches()
+	defer patches.Reset()
+
+	patches.ApplyFunc(connUtils.OpenLuksDevice,
+		func(_ context.Context, devPath, mapperName, passphrase string) (string, error) {
+			t.Errorf("TestOpenEncryptedDevice_NotEncrypted should not open the device")
+			return "", nil
+		})
+
+	parameters := map[string]interface{}{"volumeId": "backend.pvc-1", "devPath": "/dev/dm-1"}
+
+	err := openEncryptedDevice(context.Background(), parameters)
+	if err != nil {
+		t.Errorf("TestOpenEncryptedDevice_NotEncrypted want error = nil, got error = %v", err)
+	}
+	if parameters["devPath"] != "/dev/dm-1" {
+		t.Errorf("TestOpenEncryptedDevice_NotEncrypted got devPath %v", parameters["devPath"])
+	}
+}
+
+func TestUnStageWithWwn_CloseEncryptedDeviceError(t *testing.T) {
+	manager := &SanManager{
+		protocol: "iscsi",
+		Conn:     connector.GetConnector(context.Background(), connector.ISCSIDriver),
+	}
+	patches := gomonkey.NewPatches()
+	defer patches.Reset()
+
+	patches.ApplyFuncReturn(connUtils.CloseLuksDevice, errors.New("device is busy")).
+		ApplyMethodFunc(reflect.TypeOf(manager.Conn), "DisConnectVolume",
+			func(_ context.Context, _ string) error {
+				t.Errorf("TestUnStageWithWwn_CloseEncryptedDeviceError should not disconnect the volume")
+				return nil
+			})
+
+	err := manager.UnStageWithWwn(context.Background(), "mock_tgt_lun_wwn_1", "backend.pvc-1")
+	if err == nil {
+		t.Errorf("TestUnStageWithWwn_CloseEncryptedDeviceError want error, got nil")
+	}
+}
@@ -0,0 +1,298 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/connector"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/connector/nvme"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/app"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/manage"
+	pkgutils "github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/utils"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/scanner"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/k8sutils"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/metrics"
+)
+
+const (
+	// relativeBlockStagingPath is the staging directory of the raw block volumes inside kubelet root directory,
+	// the device is bind mounted to the sub path named by the volume handle, such as staging/pvc-123/backend.pvc-123
+	relativeBlockStagingPath = "/kubelet/plugins/kubernetes.io/csi/volumeDevices/staging"
+
+	pathMonitorComponent     = "huawei-csi-path-monitor"
+	pathDegradedReason       = "VolumePathDegraded"
+	pathRecoveredReason      = "VolumePathRecovered"
+	pathRecoveryFailedReason = "VolumePathRecoveryFailed"
+)
+
+// pathMonitor used to inspect the physical paths of the volumes staged on the node periodically,
+// the degraded paths are recovered by relogin and rescan, and reported by events and metrics
+type pathMonitor struct {
+	k8sUtils       k8sutils.Interface
+	recorder       record.EventRecorder
+	kubeletRootDir string
+	driverName     string
+	nodeName       string
+
+	// degradedVolumes records whether the volumes inspected in the last round are degraded, keyed by the pv name
+	degradedVolumes map[string]bool
+	// failedEventTimes records the time of the last VolumePathRecoveryFailed event of the volumes
+	failedEventTimes map[string]time.Time
+}
+
+func runPathMonitor(ctx context.Context) {
+	k8sClient, _, err := pkgutils.GetK8SAndCrdClient(ctx)
+	if err != nil {
+		log.AddContext(ctx).Errorf("Start path monitor failed, GetK8SAndCrdClient error: %v", err)
+		return
+	}
+
+	monitor := &pathMonitor{
+		k8sUtils:         app.GetGlobalConfig().K8sUtils,
+		recorder:         pkgutils.InitRecorder(k8sClient, pathMonitorComponent),
+		kubeletRootDir:   app.GetGlobalConfig().KubeletRootDir,
+		driverName:       app.GetGlobalConfig().DriverName,
+		nodeName:         app.GetGlobalConfig().NodeName,
+		degradedVolumes:  map[string]bool{},
+		failedEventTimes: map[string]time.Time{},
+	}
+	monitor.run(ctx, app.GetGlobalConfig().PathMonitorInterval)
+}
+
+func (m *pathMonitor) run(ctx context.Context, interval time.Duration) {
+	log.AddContext(ctx).Infof("Path monitor started, interval: %s", interval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.AddContext(ctx).Infoln("Path monitor stopped")
+			return
+		case <-ticker.C:
+			m.checkAll(ctx)
+		}
+	}
+}
+
+// checkAll inspects the staged volumes one by one, the metrics of the volumes which are unstaged are removed
+func (m *pathMonitor) checkAll(ctx context.Context) {
+	volumes, err := getStagedDeviceVolumes(ctx, m.kubeletRootDir, m.driverName)
+	if err != nil {
+		log.AddContext(ctx).Errorf("Get staged volumes for path monitor failed, error: %v", err)
+		return
+	}
+
+	degradedVolumes := make(map[string]bool, len(volumes))
+	var degradedCount int
+	for _, volume := range volumes {
+		degraded, err := m.checkVolume(ctx, volume)
+		if err != nil {
+			// the volume is still staged, its state is kept so that its metrics are not removed
+			log.AddContext(ctx).Warningf("Check paths of volume %s failed, error: %v", volume.volumeHandle, err)
+			degradedVolumes[volume.pvName] = m.degradedVolumes[volume.pvName]
+			continue
+		}
+
+		degradedVolumes[volume.pvName] = degraded
+		if degraded {
+			degradedCount++
+		}
+	}
+
+	for pvName := range m.degradedVolumes {
+		if _, exist := degradedVolumes[pvName]; !exist {
+			metrics.DeleteVolumePaths(pvName)
+			delete(m.failedEventTimes, pvName)
+		}
+	}
+	m.degradedVolumes = degradedVolumes
+
+	log.AddContext(ctx).Infof("Path monitor finished, volume count: %d, degraded volume count: %d",
+		len(volumes), degradedCount)
+}
+
+// checkVolume returns whether the volume is degraded, the recovery is attempted if so
+func (m *pathMonitor) checkVolume(ctx context.Context, volume stagedVolume) (bool, error) {
+	device, err := utils.GetBlockDeviceName(volume.mountPath)
+	if err != nil {
+		return false, fmt.Errorf("get device of staging path %s error: %w", volume.mountPath, err)
+	}
+
+	health, err := connector.GetDevicePathHealth(ctx, device)
+	if err != nil {
+		return false, err
+	}
+
+	publishInfo := m.getPublishInfo(ctx, volume.volumeHandle)
+	missingPaths := getMissingPaths(health, publishInfo)
+	metrics.SetVolumePaths(volume.pvName, health.TotalPaths+missingPaths, len(health.FaultyPaths)+missingPaths)
+
+	pv, err := m.k8sUtils.GetPVByName(ctx, volume.pvName)
+	if err != nil {
+		return false, fmt.Errorf("get pv %s error: %w", volume.pvName, err)
+	}
+
+	wasDegraded := m.degradedVolumes[volume.pvName]
+	if !health.Degraded() && missingPaths == 0 {
+		delete(m.failedEventTimes, volume.pvName)
+		if wasDegraded {
+			log.AddContext(ctx).Infof("Paths of volume %s are recovered", volume.volumeHandle)
+			m.recorder.Eventf(pv, corev1.EventTypeNormal, pathRecoveredReason,
+				"All %d paths are healthy on node %s", health.TotalPaths, m.nodeName)
+		}
+		return false, nil
+	}
+
+	message := health.Message()
+	if missingPaths > 0 {
+		message = fmt.Sprintf("%s, %d paths are missing", message, missingPaths)
+	}
+	log.AddContext(ctx).Warningf("Volume %s is degraded: %s", volume.volumeHandle, message)
+	if !wasDegraded {
+		m.recorder.Eventf(pv, corev1.EventTypeWarning, pathDegradedReason, "Volume is degraded on node %s: %s",
+			m.nodeName, message)
+	}
+
+	// the paths come back asynchronously after relogin and rescan, the result is checked in the next round
+	err = recoverPaths(ctx, health, publishInfo)
+	metrics.ObservePathRecovery(volume.pvName, err)
+	if err != nil {
+		log.AddContext(ctx).Warningf("Recover paths of volume %s failed, error: %v", volume.volumeHandle, err)
+		m.recordFailedEvent(pv, err)
+	}
+
+	return true, nil
+}
+
+// recordFailedEvent records the VolumePathRecoveryFailed event at most once in failedEventInterval for each volume
+func (m *pathMonitor) recordFailedEvent(pv *corev1.PersistentVolume, err error) {
+	if lastTime, exist := m.failedEventTimes[pv.Name]; exist && time.Since(lastTime) < failedEventInterval {
+		return
+	}
+
+	m.failedEventTimes[pv.Name] = time.Now()
+	m.recorder.Eventf(pv, corev1.EventTypeWarning, pathRecoveryFailedReason,
+		"Failed to recover paths on node %s: %v", m.nodeName, err)
+}
+
+// getPublishInfo returns the publish info recorded in the VolumeAttachment of the volume on this node,
+// it is nil if the volume is attached without VolumeAttachment
+func (m *pathMonitor) getPublishInfo(ctx context.Context, volumeHandle string) *manage.ControllerPublishInfo {
+	vaName := getAttachmentName(volumeHandle, m.driverName, m.nodeName)
+	va, err := m.k8sUtils.GetVA(ctx, vaName)
+	if err != nil {
+		log.AddContext(ctx).Debugf("Get VA %s of volume %s failed, error: %v", vaName, volumeHandle, err)
+		return nil
+	}
+
+	publishInfoStr, exist := va.Status.AttachmentMetadata["publishInfo"]
+	if !exist {
+		return nil
+	}
+
+	publishInfo := &manage.ControllerPublishInfo{}
+	if err = json.Unmarshal([]byte(publishInfoStr), publishInfo); err != nil {
+		log.AddContext(ctx).Warningf("Unmarshal publishInfo of VA %s failed, error: %v", vaName, err)
+		return nil
+	}
+
+	return publishInfo
+}
+
+// getAttachmentName returns the VolumeAttachment name the same as the kubelet does
+func getAttachmentName(volumeHandle, driverName, nodeName string) string {
+	result := sha256.Sum256([]byte(fmt.Sprintf("%s%s%s", volumeHandle, driverName, nodeName)))
+	return fmt.Sprintf("csi-%x", result)
+}
+
+// getMissingPaths returns the number of the paths which are lost, for iSCSI and NVMe over Fabrics each portal
+// provides one path, while the number of the FC paths depends on the zoning and is not checked
+func getMissingPaths(health *connector.PathHealth, publishInfo *manage.ControllerPublishInfo) int {
+	if publishInfo == nil || !publishInfo.VolumeUseMultiPath || len(publishInfo.TgtWWNs) > 0 {
+		return 0
+	}
+
+	return max(len(publishInfo.TgtPortals)-health.TotalPaths, 0)
+}
+
+// recoverPaths relogins and rescans the scsi paths with the scanner, and reconnects the lost nvme portals
+func recoverPaths(ctx context.Context, health *connector.PathHealth, publishInfo *manage.ControllerPublishInfo) error {
+	if publishInfo == nil {
+		return errors.New("publishInfo of the volume is not found in VolumeAttachment")
+	}
+
+	if len(publishInfo.TgtIQNs) > 0 || len(publishInfo.TgtWWNs) > 0 {
+		return scanner.GetFactory().Scan(ctx, publishInfo)
+	}
+
+	if health.Transport == "" {
+		return fmt.Errorf("the transport of the paths of device %s is unknown", health.Device)
+	}
+
+	return nvme.ReconnectPortals(ctx, health.Transport, publishInfo.TgtPortals)
+}
+
+// getStagedDeviceVolumes returns the volumes of the driver which are staged with a filesystem or a raw block device
+func getStagedDeviceVolumes(ctx context.Context, kubeletRootDir, driverName string) ([]stagedVolume, error) {
+	volumes, err := getStagedFilesystemVolumes(ctx, kubeletRootDir, driverName)
+	if err != nil {
+		return nil, err
+	}
+
+	mountMap, err := connector.ReadMountPoints(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pathInfos, err := getPvPathInfo(path.Join(kubeletRootDir, relativeDevicePath), deviceLastIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pathInfo := range pathInfos {
+		pvFileData, err := loadPVFileData(ctx, pathInfo.pvFilePath)
+		if err != nil || pvFileData == nil || pvFileData.DriverName != driverName {
+			continue
+		}
+
+		mountPath := path.Join(kubeletRootDir, relativeBlockStagingPath, pathInfo.VolumeName, pvFileData.VolumeHandle)
+		if _, mounted := mountMap[mountPath]; !mounted {
+			continue
+		}
+
+		volumes = append(volumes, stagedVolume{
+			volumeHandle: pvFileData.VolumeHandle,
+			pvName:       pathInfo.VolumeName,
+			mountPath:    mountPath,
+		})
+	}
+
+	return volumes, nil
+}
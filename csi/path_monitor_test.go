@@ -0,0 +1,183 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/connector"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/manage"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/scanner"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/k8sutils"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/metrics"
+)
+
+func TestGetMissingPaths(t *testing.T) {
+	// arrange
+	health := &connector.PathHealth{Device: "dm-0", TotalPaths: 2}
+	iscsiInfo := &manage.ControllerPublishInfo{TgtIQNs: []string{"iqn-1", "iqn-2", "iqn-3"},
+		TgtPortals: []string{"127.0.0.1", "127.0.0.2", "127.0.0.3"}, VolumeUseMultiPath: true}
+	fcInfo := &manage.ControllerPublishInfo{TgtWWNs: []string{"wwn-1", "wwn-2", "wwn-3"}, VolumeUseMultiPath: true}
+	singlePathInfo := &manage.ControllerPublishInfo{TgtPortals: []string{"127.0.0.1", "127.0.0.2", "127.0.0.3"}}
+
+	// action & assert
+	assert.Equal(t, 1, getMissingPaths(health, iscsiInfo))
+	assert.Equal(t, 0, getMissingPaths(health, fcInfo))
+	assert.Equal(t, 0, getMissingPaths(health, singlePathInfo))
+	assert.Equal(t, 0, getMissingPaths(health, nil))
+}
+
+func TestPathMonitor_CheckAll_DegradedThenRecovered(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	rootDir := t.TempDir()
+	driverName := "csi.huawei.com"
+	volumeHandle := "backend.pvc-block"
+	writeVolData(t, filepath.Join(rootDir, "kubelet/plugins/kubernetes.io/csi/volumeDevices/pvc-block/data"),
+		`{"volumeHandle":"backend.pvc-block","driverName":"csi.huawei.com"}`)
+	stagingPath := filepath.Join(rootDir, relativeBlockStagingPath, "pvc-block", volumeHandle)
+
+	va := &storagev1.VolumeAttachment{
+		ObjectMeta: metav1.ObjectMeta{Name: getAttachmentName(volumeHandle, driverName, "node1")},
+		Status: storagev1.VolumeAttachmentStatus{AttachmentMetadata: map[string]string{
+			"publishInfo": `{"tgtLunWWN":"wwn","tgtPortals":["127.0.0.1","127.0.0.2"],` +
+				`"tgtIQNs":["iqn-1","iqn-2"],"tgtHostLUNs":["1","1"],"volumeUseMultiPath":true}`,
+		}},
+	}
+	k8sClient := &k8sutils.KubeClient{}
+	k8sClient.SetClient(fake.NewClientset(newReclaimPV("pvc-block", nil, ""), va))
+	recorder := record.NewFakeRecorder(10)
+	monitor := &pathMonitor{
+		k8sUtils:         k8sClient,
+		recorder:         recorder,
+		kubeletRootDir:   rootDir,
+		driverName:       driverName,
+		nodeName:         "node1",
+		degradedVolumes:  map[string]bool{},
+		failedEventTimes: map[string]time.Time{},
+	}
+	degraded := &connector.PathHealth{Device: "dm-0", TotalPaths: 2, FaultyPaths: []string{"sdc"}}
+	healthy := &connector.PathHealth{Device: "dm-0", TotalPaths: 2}
+	var scannedInfo *manage.ControllerPublishInfo
+
+	// mock
+	patches := gomonkey.ApplyFuncReturn(connector.ReadMountPoints,
+		map[string]string{stagingPath: "devtmpfs"}, nil).
+		ApplyFuncReturn(utils.GetBlockDeviceName, "dm-0", nil).
+		ApplyFuncSeq(connector.GetDevicePathHealth, []gomonkey.OutputCell{
+			{Values: gomonkey.Params{degraded, nil}},
+			{Values: gomonkey.Params{healthy, nil}},
+		}).
+		ApplyMethodFunc(scanner.GetFactory(), "Scan",
+			func(_ context.Context, publishInfo *manage.ControllerPublishInfo) error {
+				scannedInfo = publishInfo
+				return nil
+			})
+	defer patches.Reset()
+
+	// action
+	monitor.checkAll(ctx)
+
+	// assert
+	assert.True(t, monitor.degradedVolumes["pvc-block"])
+	assert.Equal(t, []string{"iqn-1", "iqn-2"}, scannedInfo.TgtIQNs)
+	assert.Contains(t, <-recorder.Events, pathDegradedReason)
+
+	// action
+	monitor.checkAll(ctx)
+
+	// assert
+	assert.False(t, monitor.degradedVolumes["pvc-block"])
+	assert.Contains(t, <-recorder.Events, pathRecoveredReason)
+}
+
+func TestRecoverPaths_NVMeWithoutTransport(t *testing.T) {
+	// arrange
+	health := &connector.PathHealth{Device: "nvme0n1", TotalPaths: 1}
+	publishInfo := &manage.ControllerPublishInfo{TgtPortals: []string{"127.0.0.1", "127.0.0.2"}}
+
+	// action
+	err := recoverPaths(context.Background(), health, publishInfo)
+
+	// assert
+	assert.ErrorContains(t, err, "transport of the paths of device nvme0n1 is unknown")
+}
+
+func TestPathMonitor_CheckAll_KeepFailedVolumes(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	rootDir := t.TempDir()
+	volumeHandle := "backend.pvc-failed"
+	writeVolData(t, filepath.Join(rootDir, "kubelet/plugins/kubernetes.io/csi/volumeDevices/pvc-failed/data"),
+		`{"volumeHandle":"backend.pvc-failed","driverName":"csi.huawei.com"}`)
+	stagingPath := filepath.Join(rootDir, relativeBlockStagingPath, "pvc-failed", volumeHandle)
+
+	monitor := &pathMonitor{
+		k8sUtils:         &k8sutils.KubeClient{},
+		recorder:         record.NewFakeRecorder(10),
+		kubeletRootDir:   rootDir,
+		driverName:       "csi.huawei.com",
+		nodeName:         "node1",
+		degradedVolumes:  map[string]bool{"pvc-failed": true, "pvc-unstaged": true},
+		failedEventTimes: map[string]time.Time{"pvc-unstaged": time.Now()},
+	}
+	var deleted []string
+
+	// mock
+	patches := gomonkey.ApplyFuncReturn(connector.ReadMountPoints,
+		map[string]string{stagingPath: "devtmpfs"}, nil).
+		ApplyFuncReturn(utils.GetBlockDeviceName, "dm-0", nil).
+		ApplyFuncReturn(connector.GetDevicePathHealth, nil, errors.New("read device dm-0 failed")).
+		ApplyFunc(metrics.DeleteVolumePaths, func(volume string) {
+			deleted = append(deleted, volume)
+		})
+	defer patches.Reset()
+
+	// action
+	monitor.checkAll(ctx)
+
+	// assert
+	assert.Equal(t, map[string]bool{"pvc-failed": true}, monitor.degradedVolumes)
+	assert.Equal(t, []string{"pvc-unstaged"}, deleted)
+	assert.Empty(t, monitor.failedEventTimes)
+}
+
+func TestPathMonitor_RecordFailedEvent_RateLimited(t *testing.T) {
+	// arrange
+	recorder := record.NewFakeRecorder(10)
+	monitor := &pathMonitor{recorder: recorder, nodeName: "node1", failedEventTimes: map[string]time.Time{}}
+	pv := newReclaimPV("pvc-block", nil, "")
+
+	// action
+	monitor.recordFailedEvent(pv, errors.New("scan failed"))
+	monitor.recordFailedEvent(pv, errors.New("scan failed"))
+
+	// assert
+	assert.Len(t, recorder.Events, 1)
+}
@@ -0,0 +1,115 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package provider is related with volume
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/cli/helper"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend/model"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend/plugin"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/lib/drcsi"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/volume"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
+)
+
+// modifyFailover switches the volume to the target backend which is the replication or hypermetro peer of
+// the backend it belongs to. All storage operations are executed on the target backend, because the source
+// backend may be unavailable during a disaster. The errors which can not be recovered by retrying are returned
+// with codes.Aborted.
+func (p *StorageProvider) modifyFailover(ctx context.Context, req *drcsi.ModifyVolumeRequest) (
+	*drcsi.ModifyVolumeResponse, error) {
+	action := volume.ModifyVolumeType(req.MutableParameters[volume.FailoverActionKey])
+	targetName := req.MutableParameters[volume.FailoverTargetBackendKey]
+	if targetName == "" {
+		return nil, errors.New("the target backend of failover is not specified")
+	}
+
+	backendName, volumeName := utils.SplitVolumeId(req.VolumeId)
+	target, err := p.backendSelector.SelectBackend(ctx, helper.GetBackendName(targetName))
+	if err != nil {
+		return nil, fmt.Errorf("select target backend %s failed, error: %w", targetName, err)
+	}
+	if target == nil || target.Plugin == nil {
+		return nil, fmt.Errorf("target backend %s does not exist", targetName)
+	}
+
+	if !p.isPeerBackend(ctx, target, backendName) {
+		return nil, status.Errorf(codes.Aborted,
+			"backend %s is neither the replication nor the hypermetro peer of backend %s", target.Name, backendName)
+	}
+
+	var attributes map[string]string
+	finished := true
+	switch action {
+	case volume.Failover:
+		attributes, err = target.Plugin.FailoverVolume(ctx, volumeName, req.StorageClassParameters)
+	case volume.Failback:
+		attributes, finished, err = target.Plugin.FailbackVolume(ctx, volumeName)
+	default:
+		return nil, status.Errorf(codes.Aborted, "failover action must be %q or %q, %q is invalid",
+			volume.Failover, volume.Failback, action)
+	}
+	if err != nil {
+		log.AddContext(ctx).Errorf("%s volume %s to backend %s failed, error: %v",
+			action, req.VolumeId, target.Name, err)
+		return nil, abortOnTerminalError(err, constants.ErrFailoverFailed, plugin.ErrFailoverNotSupported)
+	}
+
+	resp := map[string]string{volume.FailoverFinishedKey: strconv.FormatBool(finished)}
+	if !finished {
+		log.AddContext(ctx).Infof("%s volume %s to backend %s is in progress", action, req.VolumeId, target.Name)
+		return &drcsi.ModifyVolumeResponse{VolumeAttributes: resp}, nil
+	}
+
+	for key, value := range attributes {
+		resp[key] = value
+	}
+	resp["backend"] = target.Name
+	resp[volume.FailoverVolumeIdKey] = target.Name + "." + volumeName
+	log.AddContext(ctx).Infof("%s volume %s to backend %s finished", action, req.VolumeId, target.Name)
+	return &drcsi.ModifyVolumeResponse{VolumeAttributes: resp}, nil
+}
+
+// isPeerBackend checks whether the target backend is paired with the source backend,
+// the source backend is only loaded when the target does not declare the pairing.
+func (p *StorageProvider) isPeerBackend(ctx context.Context, target *model.Backend, sourceName string) bool {
+	if target.Name == sourceName {
+		return false
+	}
+
+	if target.ReplicaBackendName == sourceName || target.MetroBackendName == sourceName {
+		return true
+	}
+
+	source, err := p.backendSelector.SelectBackend(ctx, sourceName)
+	if err != nil || source == nil {
+		log.AddContext(ctx).Warningf("select source backend %s failed, error: %v", sourceName, err)
+		return false
+	}
+
+	return source.ReplicaBackendName == target.Name || source.MetroBackendName == target.Name
+}
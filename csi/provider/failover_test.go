@@ -0,0 +1,184 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package provider used to test failover module
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend/handler"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend/model"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend/plugin"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/lib/drcsi"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/volume"
+)
+
+func newFailoverRequest(action volume.ModifyVolumeType) *drcsi.ModifyVolumeRequest {
+	return &drcsi.ModifyVolumeRequest{
+		VolumeId: "backend-a.pvc-1",
+		MutableParameters: map[string]string{
+			volume.FailoverActionKey:        string(action),
+			volume.FailoverTargetBackendKey: "backend-b",
+		},
+	}
+}
+
+func TestModifyVolume_FailoverSuccess(t *testing.T) {
+	// arrange
+	p := NewProvider("providerForTest", "TestVersion")
+	sanPlugin := &plugin.OceanstorSanPlugin{}
+	req := newFailoverRequest(volume.Failover)
+
+	// mock
+	m := gomonkey.ApplyMethod(reflect.TypeOf(p.backendSelector), "SelectBackend",
+		func(_ *handler.BackendSelector, _ context.Context, name string) (*model.Backend, error) {
+			return &model.Backend{Name: "backend-b", ReplicaBackendName: "backend-a", Plugin: sanPlugin}, nil
+		})
+	m.ApplyMethod(reflect.TypeOf(sanPlugin), "FailoverVolume",
+		func(_ *plugin.OceanstorSanPlugin, _ context.Context, name string,
+			_ map[string]string) (map[string]string, error) {
+			return map[string]string{"lunWWN": "wwn-b"}, nil
+		})
+	defer m.Reset()
+
+	// action
+	resp, err := p.ModifyVolume(context.TODO(), req)
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		volume.FailoverFinishedKey: "true",
+		volume.FailoverVolumeIdKey: "backend-b.pvc-1",
+		"backend":                  "backend-b",
+		"lunWWN":                   "wwn-b",
+	}, resp.VolumeAttributes)
+}
+
+func TestModifyVolume_FailbackInProgress(t *testing.T) {
+	// arrange
+	p := NewProvider("providerForTest", "TestVersion")
+	sanPlugin := &plugin.OceanstorSanPlugin{}
+	req := newFailoverRequest(volume.Failback)
+
+	// mock
+	m := gomonkey.ApplyMethod(reflect.TypeOf(p.backendSelector), "SelectBackend",
+		func(_ *handler.BackendSelector, _ context.Context, name string) (*model.Backend, error) {
+			return &model.Backend{Name: "backend-b", MetroBackendName: "backend-a", Plugin: sanPlugin}, nil
+		})
+	m.ApplyMethod(reflect.TypeOf(sanPlugin), "FailbackVolume",
+		func(_ *plugin.OceanstorSanPlugin, _ context.Context, name string) (map[string]string, bool, error) {
+			return nil, false, nil
+		})
+	defer m.Reset()
+
+	// action
+	resp, err := p.ModifyVolume(context.TODO(), req)
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{volume.FailoverFinishedKey: "false"}, resp.VolumeAttributes)
+}
+
+func TestModifyVolume_FailoverToNotPeerBackend(t *testing.T) {
+	// arrange
+	p := NewProvider("providerForTest", "TestVersion")
+	req := newFailoverRequest(volume.Failover)
+
+	// mock
+	m := gomonkey.ApplyMethod(reflect.TypeOf(p.backendSelector), "SelectBackend",
+		func(_ *handler.BackendSelector, _ context.Context, name string) (*model.Backend, error) {
+			return &model.Backend{Name: name, ReplicaBackendName: "backend-c", Plugin: &plugin.OceanstorSanPlugin{}}, nil
+		})
+	defer m.Reset()
+
+	// action
+	_, err := p.ModifyVolume(context.TODO(), req)
+
+	// assert
+	require.ErrorContains(t, err, "neither the replication nor the hypermetro peer")
+	require.Equal(t, codes.Aborted, status.Code(err))
+}
+
+func TestModifyVolume_FailoverWithInvalidAction(t *testing.T) {
+	// arrange
+	p := NewProvider("providerForTest", "TestVersion")
+	req := newFailoverRequest("Switch")
+
+	// mock
+	m := gomonkey.ApplyMethod(reflect.TypeOf(p.backendSelector), "SelectBackend",
+		func(_ *handler.BackendSelector, _ context.Context, name string) (*model.Backend, error) {
+			return &model.Backend{Name: "backend-b", ReplicaBackendName: "backend-a",
+				Plugin: &plugin.OceanstorSanPlugin{}}, nil
+		})
+	defer m.Reset()
+
+	// action
+	_, err := p.ModifyVolume(context.TODO(), req)
+
+	// assert
+	require.ErrorContains(t, err, "is invalid")
+}
+
+func TestModifyVolume_FailoverFailedOnStorage(t *testing.T) {
+	// arrange
+	p := NewProvider("providerForTest", "TestVersion")
+	sanPlugin := &plugin.OceanstorSanPlugin{}
+	req := newFailoverRequest(volume.Failover)
+	cases := []struct {
+		name     string
+		err      error
+		wantCode codes.Code
+	}{
+		{name: "not protected", err: fmt.Errorf("%w: lun pvc-1 is not protected", constants.ErrFailoverFailed),
+			wantCode: codes.Aborted},
+		{name: "not supported", err: plugin.ErrFailoverNotSupported, wantCode: codes.Aborted},
+		{name: "unreachable", err: errors.New("connect to storage timeout"), wantCode: codes.Unknown},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			// mock
+			m := gomonkey.ApplyMethod(reflect.TypeOf(p.backendSelector), "SelectBackend",
+				func(_ *handler.BackendSelector, _ context.Context, name string) (*model.Backend, error) {
+					return &model.Backend{Name: "backend-b", ReplicaBackendName: "backend-a", Plugin: sanPlugin}, nil
+				})
+			m.ApplyMethod(reflect.TypeOf(sanPlugin), "FailoverVolume",
+				func(_ *plugin.OceanstorSanPlugin, _ context.Context, name string,
+					_ map[string]string) (map[string]string, error) {
+					return nil, c.err
+				})
+			defer m.Reset()
+
+			// action
+			_, err := p.ModifyVolume(context.TODO(), req)
+
+			// assert
+			require.ErrorContains(t, err, c.err.Error())
+			require.Equal(t, c.wantCode, status.Code(err))
+		})
+	}
+}
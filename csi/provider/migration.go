@@ -0,0 +1,122 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package provider is related with volume
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend/model"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/lib/drcsi"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/volume"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
+)
+
+// modifyMigration migrates the volume to the pool of the target backend. The migration runs in background on
+// storage, so the request is called repeatedly, the first one starts the migration and the others query the progress.
+// The volume id after the migration is returned once it is finished, it changes when the volume is migrated to
+// another backend. The migration which is not finished is rolled back instead if it is requested.
+func (p *StorageProvider) modifyMigration(ctx context.Context, req *drcsi.ModifyVolumeRequest) (
+	*drcsi.ModifyVolumeResponse, error) {
+	targetPool := req.MutableParameters[volume.MigrationTargetPoolKey]
+	if targetPool == "" {
+		return nil, errors.New("the pool to migrate to is not specified")
+	}
+
+	started, err := strconv.ParseBool(req.MutableParameters[volume.MigrationStartedKey])
+	if err != nil {
+		started = false
+	}
+
+	backendName, volumeName := utils.SplitVolumeId(req.VolumeId)
+	targetBackendName := req.MutableParameters[volume.MigrationTargetBackendKey]
+	if targetBackendName == "" {
+		targetBackendName = backendName
+	}
+
+	bk, err := p.selectMigrationBackend(ctx, backendName)
+	if err != nil {
+		return nil, err
+	}
+	targetBk, err := p.selectMigrationBackend(ctx, targetBackendName)
+	if err != nil {
+		return nil, err
+	}
+
+	if rollback, _ := strconv.ParseBool(req.MutableParameters[volume.MigrationRollbackKey]); rollback {
+		return rollbackMigration(ctx, req.VolumeId, volumeName, bk, targetBk, targetPool)
+	}
+
+	progress, finished, err := bk.Plugin.MigrateVolume(ctx, volumeName, targetBk.Plugin, targetPool, started)
+	if err != nil {
+		log.AddContext(ctx).Errorf("migrate volume %s to pool %s of backend %s failed, error: %v",
+			req.VolumeId, targetPool, targetBackendName, err)
+		return nil, abortOnTerminalError(err, constants.ErrMigrationFailed)
+	}
+
+	log.AddContext(ctx).Infof("migrate volume %s to pool %s of backend %s, progress: %d%%, finished: %t",
+		req.VolumeId, targetPool, targetBackendName, progress, finished)
+	resp := map[string]string{
+		volume.MigrationStartedKey:  strconv.FormatBool(true),
+		volume.MigrationProgressKey: strconv.Itoa(progress),
+		volume.MigrationFinishedKey: strconv.FormatBool(finished),
+	}
+	if finished {
+		resp["backend"] = targetBk.Name
+		resp[volume.MigrationVolumeIdKey] = targetBk.Name + "." + volumeName
+	}
+	return &drcsi.ModifyVolumeResponse{VolumeAttributes: resp}, nil
+}
+
+// rollbackMigration deletes the objects created on storage by the migration, the caller completes the migration
+// instead on codes.FailedPrecondition, because the volume has been cut over to the target backend.
+func rollbackMigration(ctx context.Context, volumeId, volumeName string, bk, targetBk *model.Backend,
+	targetPool string) (*drcsi.ModifyVolumeResponse, error) {
+	err := bk.Plugin.RollbackMigration(ctx, volumeName, targetBk.Plugin, targetPool)
+	if err != nil {
+		log.AddContext(ctx).Errorf("roll back migration of volume %s to pool %s of backend %s failed, error: %v",
+			volumeId, targetPool, targetBk.Name, err)
+		if errors.Is(err, constants.ErrMigrationCutOver) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, err
+	}
+
+	log.AddContext(ctx).Infof("roll back migration of volume %s to pool %s of backend %s",
+		volumeId, targetPool, targetBk.Name)
+	return &drcsi.ModifyVolumeResponse{}, nil
+}
+
+func (p *StorageProvider) selectMigrationBackend(ctx context.Context, backendName string) (*model.Backend, error) {
+	bk, err := p.backendSelector.SelectBackend(ctx, backendName)
+	if err != nil {
+		return nil, fmt.Errorf("select backend %s failed, error: %w", backendName, err)
+	}
+	if bk == nil || bk.Plugin == nil {
+		return nil, fmt.Errorf("backend %s does not exist", backendName)
+	}
+
+	return bk, nil
+}
@@ -0,0 +1,184 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package provider used to test migration module
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend/handler"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend/model"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend/plugin"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/lib/drcsi"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/volume"
+)
+
+func TestModifyVolume_MigrationToBackend(t *testing.T) {
+	// arrange
+	p := NewProvider("providerForTest", "TestVersion")
+	sourcePlugin := &plugin.OceanstorSanPlugin{}
+	targetPlugin := &plugin.OceanstorSanPlugin{}
+	plugins := map[string]plugin.StoragePlugin{"backend-a": sourcePlugin, "backend-b": targetPlugin}
+	req := &drcsi.ModifyVolumeRequest{
+		VolumeId: "backend-a.pvc-1",
+		MutableParameters: map[string]string{
+			volume.MigrationTargetBackendKey: "backend-b",
+			volume.MigrationTargetPoolKey:    "pool2",
+		},
+	}
+	var gotTarget plugin.StoragePlugin
+
+	// mock
+	m := gomonkey.ApplyMethod(reflect.TypeOf(p.backendSelector), "SelectBackend",
+		func(_ *handler.BackendSelector, _ context.Context, name string) (*model.Backend, error) {
+			return &model.Backend{Name: name, Plugin: plugins[name]}, nil
+		})
+	m.ApplyMethod(reflect.TypeOf(sourcePlugin), "MigrateVolume",
+		func(_ *plugin.OceanstorSanPlugin, _ context.Context, name string, target plugin.StoragePlugin,
+			targetPool string, started bool) (int, bool, error) {
+			gotTarget = target
+			return 100, true, nil
+		})
+	defer m.Reset()
+
+	// action
+	resp, err := p.ModifyVolume(context.TODO(), req)
+
+	// assert
+	require.NoError(t, err)
+	require.Same(t, targetPlugin, gotTarget)
+	require.Equal(t, map[string]string{
+		volume.MigrationStartedKey:  "true",
+		volume.MigrationProgressKey: "100",
+		volume.MigrationFinishedKey: "true",
+		volume.MigrationVolumeIdKey: "backend-b.pvc-1",
+		"backend":                   "backend-b",
+	}, resp.VolumeAttributes)
+}
+
+func TestModifyVolume_MigrationTargetBackendNotExist(t *testing.T) {
+	// arrange
+	p := NewProvider("providerForTest", "TestVersion")
+	req := &drcsi.ModifyVolumeRequest{
+		VolumeId: "backend-a.pvc-1",
+		MutableParameters: map[string]string{
+			volume.MigrationTargetBackendKey: "backend-b",
+			volume.MigrationTargetPoolKey:    "pool2",
+		},
+	}
+
+	// mock
+	m := gomonkey.ApplyMethod(reflect.TypeOf(p.backendSelector), "SelectBackend",
+		func(_ *handler.BackendSelector, _ context.Context, name string) (*model.Backend, error) {
+			if name == "backend-b" {
+				return nil, nil
+			}
+			return &model.Backend{Name: name, Plugin: &plugin.OceanstorSanPlugin{}}, nil
+		})
+	defer m.Reset()
+
+	// action
+	_, err := p.ModifyVolume(context.TODO(), req)
+
+	// assert
+	require.ErrorContains(t, err, "backend backend-b does not exist")
+}
+
+func TestModifyVolume_MigrationFailedOnStorage(t *testing.T) {
+	// arrange
+	p := NewProvider("providerForTest", "TestVersion")
+	sourcePlugin := &plugin.OceanstorSanPlugin{}
+	req := &drcsi.ModifyVolumeRequest{
+		VolumeId:          "backend-a.pvc-1",
+		MutableParameters: map[string]string{volume.MigrationTargetPoolKey: "pool2", volume.MigrationStartedKey: "true"},
+	}
+
+	// mock
+	m := gomonkey.ApplyMethodReturn(p.backendSelector, "SelectBackend",
+		&model.Backend{Name: "backend-a", Plugin: sourcePlugin}, nil)
+	m.ApplyMethodReturn(sourcePlugin, "MigrateVolume", 0, false,
+		fmt.Errorf("%w: migration of lun pvc-1 is at fault status", constants.ErrMigrationFailed))
+	defer m.Reset()
+
+	// action
+	_, err := p.ModifyVolume(context.TODO(), req)
+
+	// assert
+	require.Equal(t, codes.Aborted, status.Code(err))
+	require.ErrorContains(t, err, "is at fault status")
+}
+
+func TestModifyVolume_RollbackMigration(t *testing.T) {
+	// arrange
+	p := NewProvider("providerForTest", "TestVersion")
+	sourcePlugin := &plugin.OceanstorSanPlugin{}
+	req := &drcsi.ModifyVolumeRequest{
+		VolumeId:          "backend-a.pvc-1",
+		MutableParameters: map[string]string{volume.MigrationTargetPoolKey: "pool2", volume.MigrationRollbackKey: "true"},
+	}
+
+	// mock
+	m := gomonkey.ApplyMethodReturn(p.backendSelector, "SelectBackend",
+		&model.Backend{Name: "backend-a", Plugin: sourcePlugin}, nil)
+	m.ApplyMethodReturn(sourcePlugin, "RollbackMigration", nil)
+	m.ApplyMethod(reflect.TypeOf(sourcePlugin), "MigrateVolume",
+		func(_ *plugin.OceanstorSanPlugin, _ context.Context, _ string, _ plugin.StoragePlugin,
+			_ string, _ bool) (int, bool, error) {
+			t.Fatal("the migration must not be continued when it is rolled back")
+			return 0, false, nil
+		})
+	defer m.Reset()
+
+	// action
+	resp, err := p.ModifyVolume(context.TODO(), req)
+
+	// assert
+	require.NoError(t, err)
+	require.Empty(t, resp.VolumeAttributes)
+}
+
+func TestModifyVolume_RollbackMigrationCutOver(t *testing.T) {
+	// arrange
+	p := NewProvider("providerForTest", "TestVersion")
+	sourcePlugin := &plugin.OceanstorSanPlugin{}
+	req := &drcsi.ModifyVolumeRequest{
+		VolumeId:          "backend-a.pvc-1",
+		MutableParameters: map[string]string{volume.MigrationTargetPoolKey: "pool2", volume.MigrationRollbackKey: "true"},
+	}
+
+	// mock
+	m := gomonkey.ApplyMethodReturn(p.backendSelector, "SelectBackend",
+		&model.Backend{Name: "backend-a", Plugin: sourcePlugin}, nil)
+	m.ApplyMethodReturn(sourcePlugin, "RollbackMigration",
+		fmt.Errorf("%w: migration of lun pvc-1 is completed", constants.ErrMigrationCutOver))
+	defer m.Reset()
+
+	// action
+	_, err := p.ModifyVolume(context.TODO(), req)
+
+	// assert
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
@@ -17,7 +17,14 @@
 // Package provider is related with storage provider
 package provider
 
-import "github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend/handler"
+import (
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend/handler"
+)
 
 // StorageProvider is for storage provider
 type StorageProvider struct {
@@ -42,3 +49,16 @@ func NewProvider(name, version string) *StorageProvider {
 		backendSelector: handler.NewBackendSelector(),
 	}
 }
+
+// abortOnTerminalError returns the error with codes.Aborted if it wraps any of the terminal errors, which means the
+// request can never be finished on storage. The callers, such as the migration, rollback and failover controllers,
+// stop retrying the request on this code and set it to failed, the other errors are returned as they are.
+func abortOnTerminalError(err error, terminalErrs ...error) error {
+	for _, terminalErr := range terminalErrs {
+		if errors.Is(err, terminalErr) {
+			return status.Error(codes.Aborted, err.Error())
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,74 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package provider is related with volume
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/lib/drcsi"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/volume"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
+)
+
+// modifyRollback rolls back the volume to the snapshot in place. The rollback runs in background on storage,
+// so the request is called repeatedly, the first one starts the rollback and the others query the progress.
+func (p *StorageProvider) modifyRollback(ctx context.Context, req *drcsi.ModifyVolumeRequest) (
+	*drcsi.ModifyVolumeResponse, error) {
+	snapshotId := req.MutableParameters[volume.RollbackSnapshotIdKey]
+	if snapshotId == "" {
+		return nil, errors.New("the snapshot to roll back to is not specified")
+	}
+
+	started, err := strconv.ParseBool(req.MutableParameters[volume.RollbackStartedKey])
+	if err != nil {
+		started = false
+	}
+
+	backendName, volumeName := utils.SplitVolumeId(req.VolumeId)
+	snapshotBackend, snapshotParentId, snapshotName := utils.SplitSnapshotId(snapshotId)
+	if snapshotBackend != backendName {
+		return nil, fmt.Errorf("snapshot %s and volume %s are on different backends", snapshotId, req.VolumeId)
+	}
+
+	bk, err := p.backendSelector.SelectBackend(ctx, backendName)
+	if err != nil {
+		return nil, fmt.Errorf("select backend %s failed, error: %w", backendName, err)
+	}
+	if bk == nil || bk.Plugin == nil {
+		return nil, fmt.Errorf("backend %s does not exist", backendName)
+	}
+
+	progress, finished, err := bk.Plugin.RollbackSnapshot(ctx, volumeName, snapshotParentId, snapshotName, started)
+	if err != nil {
+		log.AddContext(ctx).Errorf("rollback volume %s to snapshot %s failed, error: %v",
+			req.VolumeId, snapshotId, err)
+		return nil, abortOnTerminalError(err, constants.ErrRollbackFailed)
+	}
+
+	log.AddContext(ctx).Infof("rollback volume %s to snapshot %s, progress: %d%%, finished: %t",
+		req.VolumeId, snapshotId, progress, finished)
+	return &drcsi.ModifyVolumeResponse{VolumeAttributes: map[string]string{
+		volume.RollbackProgressKey: strconv.Itoa(progress),
+		volume.RollbackFinishedKey: strconv.FormatBool(finished),
+	}}, nil
+}
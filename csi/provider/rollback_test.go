@@ -0,0 +1,86 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package provider used to test rollback module
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend/handler"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend/model"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend/plugin"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/lib/drcsi"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/volume"
+)
+
+func TestModifyVolume_RollbackInProgress(t *testing.T) {
+	// arrange
+	p := NewProvider("providerForTest", "TestVersion")
+	sanPlugin := &plugin.OceanstorSanPlugin{}
+	req := &drcsi.ModifyVolumeRequest{
+		VolumeId: "backend-a.pvc-1",
+		MutableParameters: map[string]string{
+			volume.RollbackSnapshotIdKey: "backend-a.10.snapshot-1",
+			volume.RollbackStartedKey:    "true",
+		},
+	}
+	var gotStarted bool
+
+	// mock
+	m := gomonkey.ApplyMethod(reflect.TypeOf(p.backendSelector), "SelectBackend",
+		func(_ *handler.BackendSelector, _ context.Context, name string) (*model.Backend, error) {
+			return &model.Backend{Name: "backend-a", Plugin: sanPlugin}, nil
+		})
+	m.ApplyMethod(reflect.TypeOf(sanPlugin), "RollbackSnapshot",
+		func(_ *plugin.OceanstorSanPlugin, _ context.Context, name, parentID, snapshotName string,
+			started bool) (int, bool, error) {
+			gotStarted = started
+			return 45, false, nil
+		})
+	defer m.Reset()
+
+	// action
+	resp, err := p.ModifyVolume(context.TODO(), req)
+
+	// assert
+	require.NoError(t, err)
+	require.True(t, gotStarted)
+	require.Equal(t, map[string]string{
+		volume.RollbackProgressKey: "45",
+		volume.RollbackFinishedKey: "false",
+	}, resp.VolumeAttributes)
+}
+
+func TestModifyVolume_RollbackDifferentBackend(t *testing.T) {
+	// arrange
+	p := NewProvider("providerForTest", "TestVersion")
+	req := &drcsi.ModifyVolumeRequest{
+		VolumeId:          "backend-a.pvc-1",
+		MutableParameters: map[string]string{volume.RollbackSnapshotIdKey: "backend-b.10.snapshot-1"},
+	}
+
+	// action
+	_, err := p.ModifyVolume(context.TODO(), req)
+
+	// assert
+	require.ErrorContains(t, err, "different backends")
+}
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2024-2024. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2024-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -22,8 +22,10 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/app"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend/plugin"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/lib/drcsi"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
 	pkgUtils "github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/utils"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/volume"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
@@ -32,8 +34,21 @@ import (
 
 const (
 	thinVolumeRequestSize = 0
+
+	qosParameterKey                         = "qos"
+	authClientParameterKey                  = "authClient"
+	allSquashParameterKey                   = "allSquash"
+	rootSquashParameterKey                  = "rootSquash"
+	snapshotDirectoryVisibilityParameterKey = "snapshotDirectoryVisibility"
 )
 
+// exportOptionKeys are the parameters of the export options of the nfs share
+var exportOptionKeys = []string{authClientParameterKey, allSquashParameterKey, rootSquashParameterKey,
+	snapshotDirectoryVisibilityParameterKey}
+
+// mutableAttributeKeys are the parameters which are modified in place by ModifyVolumeAttributes of the plugin
+var mutableAttributeKeys = append([]string{qosParameterKey}, exportOptionKeys...)
+
 // ModifyVolume is used to modify volume attribute
 func (p *StorageProvider) ModifyVolume(ctx context.Context, req *drcsi.ModifyVolumeRequest) (
 	*drcsi.ModifyVolumeResponse, error) {
@@ -41,15 +56,158 @@ func (p *StorageProvider) ModifyVolume(ctx context.Context, req *drcsi.ModifyVol
 	defer utils.RecoverPanic(ctx)
 	log.AddContext(ctx).Infof("Modify volume: %s, MutableParameters: %v", req.VolumeId, req.MutableParameters)
 
+	if _, exist := req.MutableParameters[volume.FailoverActionKey]; exist {
+		return p.modifyFailover(ctx, req)
+	}
+
+	if _, exist := req.MutableParameters[volume.RollbackSnapshotIdKey]; exist {
+		return p.modifyRollback(ctx, req)
+	}
+
+	if _, exist := req.MutableParameters[volume.MigrationTargetPoolKey]; exist {
+		return p.modifyMigration(ctx, req)
+	}
+
 	// Other modification operations are extended in a similar way.
 	ret, err := p.modifyHyperMetro(ctx, req)
 	if err != nil {
 		return nil, err
 	}
 
+	// The attributes are modified after the hyperMetro, so that the qos is applied to the newly created remote volume.
+	attributes, err := p.modifyAttributes(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(attributes) != 0 && ret.VolumeAttributes == nil {
+		ret.VolumeAttributes = make(map[string]string, len(attributes))
+	}
+	for key, value := range attributes {
+		ret.VolumeAttributes[key] = value
+	}
+
 	return ret, nil
 }
 
+// modifyAttributes changes the mutable attributes of the volume in place, such as the qos and the export options
+// of the nfs share, and returns the modified attributes. An empty qos deletes the qos.
+func (p *StorageProvider) modifyAttributes(ctx context.Context, req *drcsi.ModifyVolumeRequest) (
+	map[string]string, error) {
+	attributes := make(map[string]string)
+	for _, key := range mutableAttributeKeys {
+		if value, exist := req.MutableParameters[key]; exist {
+			attributes[key] = value
+		}
+	}
+	if len(attributes) == 0 {
+		return nil, nil
+	}
+
+	backendName, volumeName := utils.SplitVolumeId(req.VolumeId)
+	bk, err := p.backendSelector.SelectBackend(ctx, backendName)
+	if err != nil || bk == nil {
+		errMsg := fmt.Sprintf("select backend failed, backend name: %s, error: %v", backendName, err)
+		log.AddContext(ctx).Errorln(errMsg)
+		return nil, errors.New(errMsg)
+	}
+
+	if qos := attributes[qosParameterKey]; qos != "" {
+		if err = bk.Plugin.SupportQoSParameters(ctx, qos); err != nil {
+			log.AddContext(ctx).Errorf("check qos %s of volume %s failed, error: %v", qos, volumeName, err)
+			return nil, err
+		}
+	}
+
+	params := make(map[string]string, len(attributes))
+	for key, value := range attributes {
+		params[key] = value
+	}
+	if constants.IsDtreeStorage(bk.Storage) {
+		parentName, err := app.GetGlobalConfig().K8sUtils.GetDTreeParentNameByVolumeId(req.VolumeId)
+		if err != nil {
+			return nil, fmt.Errorf("get parent name of dtree %s failed, error: %w", volumeName, err)
+		}
+		params[constants.DTreeParentKey] = parentName
+	}
+
+	previous := getPreviousAttributes(ctx, bk.Plugin, volumeName, params, attributes)
+	err = bk.Plugin.ModifyVolumeAttributes(ctx, volumeName, params)
+	if err != nil {
+		errMsg := fmt.Sprintf("modify attributes of volume failed, volume name: %s, attributes: %v, error: %v",
+			volumeName, attributes, err)
+		log.AddContext(ctx).Errorln(errMsg)
+		return nil, errors.New(errMsg)
+	}
+
+	log.AddContext(ctx).Infof("modify attributes of volume %s to %v success", volumeName, attributes)
+	for key, value := range previous {
+		attributes[constants.PreviousAttributeKeyPrefix+key] = value
+	}
+	return attributes, nil
+}
+
+// getPreviousAttributes returns the values of the modified attributes before the modification,
+// so that the modification can be rolled back to them
+func getPreviousAttributes(ctx context.Context, storagePlugin plugin.StoragePlugin, volumeName string,
+	params, attributes map[string]string) map[string]string {
+	previous := make(map[string]string)
+	if qos, exist := getPreviousQoS(ctx, storagePlugin, volumeName, attributes); exist {
+		previous[qosParameterKey] = qos
+	}
+
+	for key, value := range getPreviousExportOptions(ctx, storagePlugin, volumeName, params, attributes) {
+		previous[key] = value
+	}
+	return previous
+}
+
+// getPreviousQoS returns the qos of the volume before the modification if the qos is modified,
+// so that the modification can be rolled back to it
+func getPreviousQoS(ctx context.Context, storagePlugin plugin.StoragePlugin, volumeName string,
+	attributes map[string]string) (string, bool) {
+	if _, exist := attributes[qosParameterKey]; !exist {
+		return "", false
+	}
+
+	qos, err := storagePlugin.GetVolumeQoS(ctx, volumeName)
+	if err != nil {
+		log.AddContext(ctx).Warningf("get qos of volume %s failed, error: %v", volumeName, err)
+		return "", false
+	}
+
+	return qos, true
+}
+
+// getPreviousExportOptions returns the export options of the volume before the modification
+// if any of them is modified, only the modified ones are returned
+func getPreviousExportOptions(ctx context.Context, storagePlugin plugin.StoragePlugin, volumeName string,
+	params, attributes map[string]string) map[string]string {
+	var modifiedKeys []string
+	for _, key := range exportOptionKeys {
+		if _, exist := attributes[key]; exist {
+			modifiedKeys = append(modifiedKeys, key)
+		}
+	}
+	if len(modifiedKeys) == 0 {
+		return nil
+	}
+
+	options, err := storagePlugin.GetVolumeExportOptions(ctx, volumeName, params)
+	if err != nil {
+		log.AddContext(ctx).Warningf("get export options of volume %s failed, error: %v", volumeName, err)
+		return nil
+	}
+
+	previous := make(map[string]string, len(modifiedKeys))
+	for _, key := range modifiedKeys {
+		if value, exist := options[key]; exist {
+			previous[key] = value
+		}
+	}
+	return previous
+}
+
 func (p *StorageProvider) modifyHyperMetro(ctx context.Context, req *drcsi.ModifyVolumeRequest) (
 	*drcsi.ModifyVolumeResponse, error) {
 
@@ -24,12 +24,16 @@ import (
 	"testing"
 
 	"github.com/agiledragon/gomonkey/v2"
+	"github.com/prashantv/gostub"
 	"github.com/stretchr/testify/require"
 
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/app"
+	cfg "github.com/Huawei/eSDK_K8S_Plugin/v4/csi/app/config"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend/handler"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend/model"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend/plugin"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/lib/drcsi"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/oceanstor/volume"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
@@ -71,7 +75,7 @@ func TestModifyVolume_HyperMetroTrue(t *testing.T) {
 		StorageClassParameters: nil,
 		MutableParameters:      map[string]string{"hyperMetro": "true"},
 	}
-	nas := volume.NewNAS(nil, nil, "test", volume.NASHyperMetro{}, true)
+	nas := volume.NewNAS(nil, nil, nil, "test", volume.NASHyperMetro{}, true)
 
 	// mock
 	m := gomonkey.ApplyMethod(reflect.TypeOf(p.backendSelector), "SelectBackend",
@@ -212,3 +216,118 @@ func TestModifyVolume_HyperMetroModifyVolumeFailed(t *testing.T) {
 	// assert
 	require.Error(t, err)
 }
+
+func TestModifyVolume_QoSSuccess(t *testing.T) {
+	// arrange
+	p := NewProvider("providerForTest", "TestVersion")
+	sanPlugin := &plugin.OceanstorSanPlugin{}
+	req := &drcsi.ModifyVolumeRequest{
+		VolumeId:          "Backend1.PVC1",
+		MutableParameters: map[string]string{"qos": `{"MAXIOPS": 1000}`},
+	}
+	var gotName string
+	var gotParams map[string]string
+
+	// mock
+	m := gomonkey.ApplyMethodReturn(p.backendSelector, "SelectBackend", &model.Backend{Plugin: sanPlugin}, nil)
+	m.ApplyMethodReturn(sanPlugin, "SupportQoSParameters", nil)
+	m.ApplyMethodReturn(sanPlugin, "GetVolumeQoS", `{"MAXIOPS":500}`, nil)
+	m.ApplyMethod(reflect.TypeOf(sanPlugin), "ModifyVolumeAttributes",
+		func(_ *plugin.OceanstorSanPlugin, _ context.Context, name string, params map[string]string) error {
+			gotName, gotParams = name, params
+			return nil
+		})
+	defer m.Reset()
+
+	// act
+	resp, err := p.ModifyVolume(context.TODO(), req)
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, "PVC1", gotName)
+	require.Equal(t, map[string]string{"qos": `{"MAXIOPS": 1000}`}, gotParams)
+	require.Equal(t, `{"MAXIOPS":500}`, resp.VolumeAttributes["previous.qos"])
+}
+
+func TestModifyVolume_RecordPreviousExportOptions(t *testing.T) {
+	// arrange
+	p := NewProvider("providerForTest", "TestVersion")
+	nasPlugin := &plugin.OceanstorNasPlugin{}
+	req := &drcsi.ModifyVolumeRequest{
+		VolumeId:          "Backend1.PVC1",
+		MutableParameters: map[string]string{"authClient": "192.168.1.1"},
+	}
+	previous := map[string]string{"authClient": "*", "allSquash": "no_all_squash", "rootSquash": "root_squash",
+		"snapshotDirectoryVisibility": "visible"}
+
+	// mock
+	m := gomonkey.ApplyMethodReturn(p.backendSelector, "SelectBackend", &model.Backend{Plugin: nasPlugin}, nil)
+	m.ApplyMethodReturn(nasPlugin, "GetVolumeExportOptions", previous, nil)
+	m.ApplyMethodReturn(nasPlugin, "ModifyVolumeAttributes", nil)
+	defer m.Reset()
+
+	// act
+	resp, err := p.ModifyVolume(context.TODO(), req)
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"authClient": "192.168.1.1", "previous.authClient": "*"},
+		resp.VolumeAttributes)
+}
+
+func TestModifyVolume_QoSNotSupported(t *testing.T) {
+	// arrange
+	p := NewProvider("providerForTest", "TestVersion")
+	sanPlugin := &plugin.OceanstorSanPlugin{}
+	req := &drcsi.ModifyVolumeRequest{
+		VolumeId:          "Backend1.PVC1",
+		MutableParameters: map[string]string{"qos": `{"MINIOPS": 1000}`},
+	}
+
+	// mock
+	m := gomonkey.ApplyMethodReturn(p.backendSelector, "SelectBackend", &model.Backend{Plugin: sanPlugin}, nil)
+	m.ApplyMethodReturn(sanPlugin, "SupportQoSParameters", errors.New("MINIOPS is not supported"))
+	defer m.Reset()
+
+	// act
+	_, err := p.ModifyVolume(context.TODO(), req)
+
+	// assert
+	require.ErrorContains(t, err, "MINIOPS is not supported")
+}
+
+func TestModifyVolume_DTreeShareOptions(t *testing.T) {
+	// arrange
+	p := NewProvider("providerForTest", "TestVersion")
+	dtreePlugin := &plugin.OceanstorDTreePlugin{}
+	getGlobalConfig := gostub.StubFunc(&app.GetGlobalConfig, cfg.MockCompletedConfig())
+	defer getGlobalConfig.Reset()
+	req := &drcsi.ModifyVolumeRequest{
+		VolumeId:          "Backend1.dtree1",
+		MutableParameters: map[string]string{"authClient": "192.168.1.1", "rootSquash": "root_squash"},
+	}
+	var gotParams map[string]string
+
+	// mock
+	m := gomonkey.ApplyMethodReturn(p.backendSelector, "SelectBackend",
+		&model.Backend{Plugin: dtreePlugin, Storage: constants.OceanStorDtree}, nil)
+	m.ApplyMethodReturn(app.GetGlobalConfig().K8sUtils, "GetDTreeParentNameByVolumeId", "parent", nil)
+	m.ApplyMethodReturn(dtreePlugin, "GetVolumeExportOptions",
+		map[string]string{"authClient": "*", "allSquash": "no_all_squash", "rootSquash": "no_root_squash"}, nil)
+	m.ApplyMethod(reflect.TypeOf(dtreePlugin), "ModifyVolumeAttributes",
+		func(_ *plugin.OceanstorDTreePlugin, _ context.Context, _ string, params map[string]string) error {
+			gotParams = params
+			return nil
+		})
+	defer m.Reset()
+
+	// act
+	resp, err := p.ModifyVolume(context.TODO(), req)
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"authClient": "192.168.1.1", "rootSquash": "root_squash",
+		constants.DTreeParentKey: "parent"}, gotParams)
+	require.Equal(t, map[string]string{"authClient": "192.168.1.1", "rootSquash": "root_squash",
+		"previous.authClient": "*", "previous.rootSquash": "no_root_squash"}, resp.VolumeAttributes)
+}
@@ -0,0 +1,372 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sInformers "k8s.io/client-go/informers"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/connector"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/app"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
+	pkgutils "github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/utils"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/metrics"
+)
+
+const (
+	// relativeStagingPath is a relative path inside kubelet root directory, since kubernetes 1.24 the volumes
+	// are staged under the directory of the driver, which is named by the sha256 of the volume handle
+	relativeStagingPath = "/kubelet/plugins/kubernetes.io/csi/%s/*/vol_data.json"
+	// stagingMountDir is the staging mount point next to the vol_data.json
+	stagingMountDir = "globalmount"
+	// devicePrefix is the prefix of the block devices, the nfs mounts are skipped by it
+	devicePrefix = "/dev/"
+
+	// requestCheckInterval is the interval to check the on-demand space reclamation requests of the volumes
+	requestCheckInterval = time.Minute
+	// failedEventInterval is the minimum interval of the failure events of a volume, so that a volume which
+	// keeps failing does not flood the events
+	failedEventInterval = time.Hour
+
+	spaceReclaimerComponent  = "huawei-csi-space-reclaimer"
+	spaceReclaimedReason     = "SpaceReclaimed"
+	spaceReclaimFailedReason = "SpaceReclaimFailed"
+)
+
+// fstrimOutputPattern matches the output of "fstrim -v", such as
+// "/mnt: 1 GiB (1073741824 bytes) trimmed" or "/mnt: 1073741824 bytes were trimmed" of the old versions
+var fstrimOutputPattern = regexp.MustCompile(`\((\d+) bytes\) trimmed|(\d+) bytes were trimmed`)
+
+// stagedVolume is the volume staged on the node with a filesystem on its block device,
+// the mountPath is the staging mount point of the filesystem, which is the only kind fstrim can reclaim
+type stagedVolume struct {
+	volumeHandle string
+	pvName       string
+	mountPath    string
+}
+
+// spaceReclaimer used to run fstrim on the staged filesystems of the volumes which enable spaceReclaim,
+// so that the space of the deleted files is given back to the thin luns.
+// The pvs are read from the informer cache, so the periodical checks do not query the api server
+type spaceReclaimer struct {
+	pvLister       corev1listers.PersistentVolumeLister
+	recorder       record.EventRecorder
+	kubeletRootDir string
+	driverName     string
+
+	// reclaimedVolumes records the volumes whose metrics are observed, keyed by the pv name
+	reclaimedVolumes map[string]bool
+	// handledRequests records the last handled on-demand request of the volumes, keyed by the pv name
+	handledRequests map[string]string
+	// failedEventTimes records the time of the last SpaceReclaimFailed event of the volumes, keyed by the pv name
+	failedEventTimes map[string]time.Time
+}
+
+func runSpaceReclaimer(ctx context.Context) {
+	k8sClient, _, err := pkgutils.GetK8SAndCrdClient(ctx)
+	if err != nil {
+		log.AddContext(ctx).Errorf("Start space reclaimer failed, GetK8SAndCrdClient error: %v", err)
+		return
+	}
+
+	driverName := app.GetGlobalConfig().DriverName
+	k8sFactory := k8sInformers.NewSharedInformerFactory(k8sClient, 0)
+	pvInformer := k8sFactory.Core().V1().PersistentVolumes()
+	err = pvInformer.Informer().SetTransform(func(obj interface{}) (interface{}, error) {
+		return trimReclaimPV(obj, driverName), nil
+	})
+	if err != nil {
+		log.AddContext(ctx).Errorf("Start space reclaimer failed, set pv transform error: %v", err)
+		return
+	}
+
+	k8sFactory.Start(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), pvInformer.Informer().HasSynced) {
+		log.AddContext(ctx).Errorln("Start space reclaimer failed, wait for pv cache sync failed")
+		return
+	}
+
+	reclaimer := newSpaceReclaimer(pvInformer.Lister(),
+		pkgutils.InitRecorder(k8sClient, spaceReclaimerComponent),
+		app.GetGlobalConfig().KubeletRootDir, driverName)
+	reclaimer.run(ctx, app.GetGlobalConfig().SpaceReclaimInterval)
+}
+
+func newSpaceReclaimer(pvLister corev1listers.PersistentVolumeLister, recorder record.EventRecorder,
+	kubeletRootDir, driverName string) *spaceReclaimer {
+	return &spaceReclaimer{
+		pvLister:         pvLister,
+		recorder:         recorder,
+		kubeletRootDir:   kubeletRootDir,
+		driverName:       driverName,
+		reclaimedVolumes: map[string]bool{},
+		handledRequests:  map[string]string{},
+		failedEventTimes: map[string]time.Time{},
+	}
+}
+
+func (r *spaceReclaimer) run(ctx context.Context, interval time.Duration) {
+	log.AddContext(ctx).Infof("Space reclaimer started, interval: %s", interval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	requestTicker := time.NewTicker(requestCheckInterval)
+	defer requestTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.AddContext(ctx).Infoln("Space reclaimer stopped")
+			return
+		case <-ticker.C:
+			r.reclaimAll(ctx, isSpaceReclaimEnabled)
+		case <-requestTicker.C:
+			r.reclaimAll(ctx, r.isReclaimRequested)
+		}
+	}
+}
+
+// reclaimAll runs fstrim on the selected staged volumes one by one to limit the io load on the storage,
+// the states and the metrics of the volumes which are unstaged are removed
+func (r *spaceReclaimer) reclaimAll(ctx context.Context, selected func(pv *corev1.PersistentVolume) bool) {
+	volumes, err := getStagedFilesystemVolumes(ctx, r.kubeletRootDir, r.driverName)
+	if err != nil {
+		log.AddContext(ctx).Errorf("Get staged volumes for space reclamation failed, error: %v", err)
+		return
+	}
+
+	stagedVolumes := make(map[string]bool, len(volumes))
+	var selectedCount, reclaimedCount int
+	for _, volume := range volumes {
+		stagedVolumes[volume.pvName] = true
+		pv, err := r.pvLister.Get(volume.pvName)
+		if err != nil {
+			log.AddContext(ctx).Warningf("Get pv %s from cache failed, skip space reclamation, error: %v",
+				volume.pvName, err)
+			continue
+		}
+		if !selected(pv) {
+			continue
+		}
+
+		selectedCount++
+		if r.reclaim(ctx, volume, pv) {
+			reclaimedCount++
+		}
+	}
+	r.removeUnstagedVolumes(stagedVolumes)
+
+	if selectedCount > 0 {
+		log.AddContext(ctx).Infof("Space reclamation finished, reclaimed volume count: %d/%d",
+			reclaimedCount, selectedCount)
+	}
+}
+
+// reclaim runs fstrim on the volume and returns whether it succeeds, the on-demand request of the volume is
+// marked as handled even if it fails, so that the failed request is not retried until it is changed
+func (r *spaceReclaimer) reclaim(ctx context.Context, volume stagedVolume, pv *corev1.PersistentVolume) bool {
+	if request, exist := pv.Annotations[constants.SpaceReclaimRequestAnnotationKey]; exist {
+		r.handledRequests[volume.pvName] = request
+	}
+
+	reclaimedBytes, err := reclaimVolume(ctx, volume.mountPath)
+	metrics.ObserveSpaceReclaim(volume.pvName, reclaimedBytes, err)
+	r.reclaimedVolumes[volume.pvName] = true
+	if err != nil {
+		log.AddContext(ctx).Warningf("Reclaim space of volume %s failed, error: %v", volume.volumeHandle, err)
+		r.recordFailedEvent(pv, err)
+		return false
+	}
+
+	delete(r.failedEventTimes, volume.pvName)
+	log.AddContext(ctx).Infof("Reclaimed %d bytes of volume %s", reclaimedBytes, volume.volumeHandle)
+	if reclaimedBytes > 0 {
+		r.recorder.Eventf(pv, corev1.EventTypeNormal, spaceReclaimedReason,
+			"Reclaimed %d bytes on node %s", reclaimedBytes, app.GetGlobalConfig().NodeName)
+	}
+	return true
+}
+
+// recordFailedEvent records the SpaceReclaimFailed event at most once in failedEventInterval for each volume
+func (r *spaceReclaimer) recordFailedEvent(pv *corev1.PersistentVolume, err error) {
+	if lastTime, exist := r.failedEventTimes[pv.Name]; exist && time.Since(lastTime) < failedEventInterval {
+		return
+	}
+
+	r.failedEventTimes[pv.Name] = time.Now()
+	r.recorder.Eventf(pv, corev1.EventTypeWarning, spaceReclaimFailedReason,
+		"Failed to reclaim space on node %s: %v", app.GetGlobalConfig().NodeName, err)
+}
+
+// removeUnstagedVolumes removes the states and the metrics of the volumes which are no longer staged on the node
+func (r *spaceReclaimer) removeUnstagedVolumes(stagedVolumes map[string]bool) {
+	for pvName := range r.reclaimedVolumes {
+		if !stagedVolumes[pvName] {
+			metrics.DeleteSpaceReclaim(pvName)
+			delete(r.reclaimedVolumes, pvName)
+		}
+	}
+	for pvName := range r.handledRequests {
+		if !stagedVolumes[pvName] {
+			delete(r.handledRequests, pvName)
+		}
+	}
+	for pvName := range r.failedEventTimes {
+		if !stagedVolumes[pvName] {
+			delete(r.failedEventTimes, pvName)
+		}
+	}
+}
+
+// isReclaimRequested checks whether the pv is annotated with an on-demand request which is not handled yet,
+// the request is served regardless of the spaceReclaim parameter of the volume
+func (r *spaceReclaimer) isReclaimRequested(pv *corev1.PersistentVolume) bool {
+	request, exist := pv.Annotations[constants.SpaceReclaimRequestAnnotationKey]
+	if !exist || request == "" {
+		return false
+	}
+
+	handled, exist := r.handledRequests[pv.Name]
+	return !exist || handled != request
+}
+
+// getStagedFilesystemVolumes returns the volumes of the driver which are staged with a filesystem on the node
+func getStagedFilesystemVolumes(ctx context.Context, kubeletRootDir, driverName string) ([]stagedVolume, error) {
+	mountMap, err := connector.ReadMountPoints(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pathInfos, err := getPvPathInfo(path.Join(kubeletRootDir, relativePvPath), pvLastIndex)
+	if err != nil {
+		return nil, err
+	}
+	stagingFilePaths, err := filepath.Glob(path.Join(kubeletRootDir, fmt.Sprintf(relativeStagingPath, driverName)))
+	if err != nil {
+		return nil, err
+	}
+	for _, filePath := range stagingFilePaths {
+		// the pv name is read from the data file, the directory is named by the hash of the volume handle
+		pathInfos = append(pathInfos, pvPathInfo{pvFilePath: filePath})
+	}
+
+	var volumes []stagedVolume
+	for _, pathInfo := range pathInfos {
+		mountPath := filepath.Join(filepath.Dir(pathInfo.pvFilePath), stagingMountDir)
+		if device, mounted := mountMap[mountPath]; !mounted || !strings.HasPrefix(device, devicePrefix) {
+			continue
+		}
+
+		pvFileData, err := loadPVFileData(ctx, pathInfo.pvFilePath)
+		if err != nil || pvFileData == nil || pvFileData.DriverName != driverName {
+			continue
+		}
+
+		pvName := pathInfo.VolumeName
+		if pvName == "" {
+			pvName = pvFileData.SpecVolID
+		}
+		volumes = append(volumes, stagedVolume{
+			volumeHandle: pvFileData.VolumeHandle,
+			pvName:       pvName,
+			mountPath:    mountPath,
+		})
+	}
+
+	return volumes, nil
+}
+
+// trimReclaimPV keeps only the fields of the pv used by the space reclaimer in the informer cache,
+// the pvs of other drivers are kept with the name only, since they are never staged by this driver
+func trimReclaimPV(obj interface{}, driverName string) interface{} {
+	pv, ok := obj.(*corev1.PersistentVolume)
+	if !ok {
+		return obj
+	}
+
+	trimmed := &corev1.PersistentVolume{
+		TypeMeta: pv.TypeMeta,
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            pv.Name,
+			UID:             pv.UID,
+			ResourceVersion: pv.ResourceVersion,
+		},
+	}
+	if pv.Spec.CSI == nil || pv.Spec.CSI.Driver != driverName {
+		return trimmed
+	}
+
+	trimmed.Annotations = pv.Annotations
+	trimmed.Spec.CSI = &corev1.CSIPersistentVolumeSource{
+		Driver:           pv.Spec.CSI.Driver,
+		VolumeHandle:     pv.Spec.CSI.VolumeHandle,
+		VolumeAttributes: pv.Spec.CSI.VolumeAttributes,
+	}
+	return trimmed
+}
+
+// isSpaceReclaimEnabled checks the annotation of the pv first, then the spaceReclaim parameter of the volume
+func isSpaceReclaimEnabled(pv *corev1.PersistentVolume) bool {
+	value, exist := pv.Annotations[constants.SpaceReclaimAnnotationKey]
+	if !exist && pv.Spec.CSI != nil {
+		value = pv.Spec.CSI.VolumeAttributes[constants.SpaceReclaimKey]
+	}
+
+	enabled, err := strconv.ParseBool(value)
+	return err == nil && enabled
+}
+
+func reclaimVolume(ctx context.Context, mountPath string) (int64, error) {
+	output, err := utils.ExecShellCmd(ctx, "fstrim -v %s", mountPath)
+	if err != nil {
+		return 0, fmt.Errorf("fstrim %s error: %w, output: %s", mountPath, err, output)
+	}
+
+	return parseReclaimedBytes(output), nil
+}
+
+func parseReclaimedBytes(output string) int64 {
+	match := fstrimOutputPattern.FindStringSubmatch(output)
+	if match == nil {
+		return 0
+	}
+
+	value := match[1]
+	if value == "" {
+		value = match[2]
+	}
+	reclaimedBytes, err := strconv.ParseInt(value, constants.DefaultIntBase, constants.DefaultIntBitSize)
+	if err != nil {
+		return 0
+	}
+
+	return reclaimedBytes
+}
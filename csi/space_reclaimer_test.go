@@ -0,0 +1,295 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/connector"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/app"
+	cfg "github.com/Huawei/eSDK_K8S_Plugin/v4/csi/app/config"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/metrics"
+)
+
+const logName = "space_reclaimer_test.log"
+
+func TestMain(m *testing.M) {
+	getGlobalConfig := gostub.StubFunc(&app.GetGlobalConfig, cfg.MockCompletedConfig())
+	defer getGlobalConfig.Reset()
+
+	log.MockInitLogging(logName)
+	defer log.MockStopLogging(logName)
+
+	m.Run()
+}
+
+func TestParseReclaimedBytes(t *testing.T) {
+	// arrange
+	cases := []struct {
+		name   string
+		output string
+		want   int64
+	}{
+		{name: "human readable", output: "/mnt: 1 GiB (1073741824 bytes) trimmed", want: 1073741824},
+		{name: "old version", output: "/mnt: 4096 bytes were trimmed", want: 4096},
+		{name: "unknown", output: "fstrim: /mnt: the discard operation is not supported", want: 0},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			// action
+			got := parseReclaimedBytes(c.output)
+
+			// assert
+			assert.Equal(t, c.want, got)
+		})
+	}
+}
+
+func TestIsSpaceReclaimEnabled(t *testing.T) {
+	// arrange
+	enabledByParameter := newReclaimPV("pvc-1", nil, "true")
+	disabledByAnnotation := newReclaimPV("pvc-2", map[string]string{"xuanwu.huawei.io/spaceReclaim": "false"}, "true")
+	enabledByAnnotation := newReclaimPV("pvc-3", map[string]string{"xuanwu.huawei.io/spaceReclaim": "true"}, "")
+
+	// action & assert
+	assert.True(t, isSpaceReclaimEnabled(enabledByParameter))
+	assert.False(t, isSpaceReclaimEnabled(disabledByAnnotation))
+	assert.True(t, isSpaceReclaimEnabled(enabledByAnnotation))
+}
+
+func TestSpaceReclaimer_ReclaimAll(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	rootDir := t.TempDir()
+	legacyDir := filepath.Join(rootDir, "kubelet/plugins/kubernetes.io/csi/pv/pvc-legacy")
+	stagingDir := filepath.Join(rootDir, "kubelet/plugins/kubernetes.io/csi/csi.huawei.com/0a1b2c")
+	disabledDir := filepath.Join(rootDir, "kubelet/plugins/kubernetes.io/csi/pv/pvc-disabled")
+	writeVolData(t, legacyDir, `{"volumeHandle":"backend.pvc-legacy","driverName":"csi.huawei.com"}`)
+	writeVolData(t, stagingDir,
+		`{"volumeHandle":"backend.pvc-new","driverName":"csi.huawei.com","specVolID":"pvc-new"}`)
+	writeVolData(t, disabledDir, `{"volumeHandle":"backend.pvc-disabled","driverName":"csi.huawei.com"}`)
+
+	pvLister := newReclaimPVLister(t, newReclaimPV("pvc-legacy", nil, "true"),
+		newReclaimPV("pvc-new", nil, "true"), newReclaimPV("pvc-disabled", nil, "false"))
+	recorder := record.NewFakeRecorder(10)
+	reclaimer := newSpaceReclaimer(pvLister, recorder, rootDir, "csi.huawei.com")
+	var trimmed []string
+
+	// mock
+	stubs := gostub.Stub(&utils.ExecShellCmd, func(_ context.Context, _ string, args ...interface{}) (string, error) {
+		trimmed = append(trimmed, args[0].(string))
+		return "/mnt: 1 MiB (1048576 bytes) trimmed", nil
+	})
+	defer stubs.Reset()
+	patches := gomonkey.ApplyFuncReturn(connector.ReadMountPoints, map[string]string{
+		filepath.Join(legacyDir, "globalmount"):   "/dev/dm-1",
+		filepath.Join(stagingDir, "globalmount"):  "/dev/dm-2",
+		filepath.Join(disabledDir, "globalmount"): "/dev/dm-3",
+	}, nil)
+	defer patches.Reset()
+
+	// action
+	reclaimer.reclaimAll(ctx, isSpaceReclaimEnabled)
+
+	// assert
+	assert.ElementsMatch(t, []string{filepath.Join(legacyDir, "globalmount"),
+		filepath.Join(stagingDir, "globalmount")}, trimmed)
+	assert.Len(t, recorder.Events, 2)
+}
+
+func TestSpaceReclaimer_ReclaimRequested(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	rootDir := t.TempDir()
+	volumeDir := filepath.Join(rootDir, "kubelet/plugins/kubernetes.io/csi/pv/pvc-request")
+	writeVolData(t, volumeDir, `{"volumeHandle":"backend.pvc-request","driverName":"csi.huawei.com"}`)
+
+	pvLister := newReclaimPVLister(t, newReclaimPV("pvc-request",
+		map[string]string{"xuanwu.huawei.io/spaceReclaimRequest": "2026-10-16T10:00:00Z"}, "false"))
+	reclaimer := newSpaceReclaimer(pvLister, record.NewFakeRecorder(10), rootDir, "csi.huawei.com")
+	var trimmedCount int
+
+	// mock
+	stubs := gostub.Stub(&utils.ExecShellCmd, func(_ context.Context, _ string, _ ...interface{}) (string, error) {
+		trimmedCount++
+		return "/mnt: 0 B (0 bytes) trimmed", nil
+	})
+	defer stubs.Reset()
+	patches := gomonkey.ApplyFuncReturn(connector.ReadMountPoints, map[string]string{
+		filepath.Join(volumeDir, "globalmount"): "/dev/dm-1",
+	}, nil)
+	defer patches.Reset()
+
+	// action
+	reclaimer.reclaimAll(ctx, reclaimer.isReclaimRequested)
+	reclaimer.reclaimAll(ctx, reclaimer.isReclaimRequested)
+
+	// assert
+	assert.Equal(t, 1, trimmedCount)
+	assert.Equal(t, "2026-10-16T10:00:00Z", reclaimer.handledRequests["pvc-request"])
+}
+
+func TestSpaceReclaimer_ReclaimAll_LimitFailedEvents(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	rootDir := t.TempDir()
+	volumeDir := filepath.Join(rootDir, "kubelet/plugins/kubernetes.io/csi/pv/pvc-failed")
+	writeVolData(t, volumeDir, `{"volumeHandle":"backend.pvc-failed","driverName":"csi.huawei.com"}`)
+
+	pvLister := newReclaimPVLister(t, newReclaimPV("pvc-failed", nil, "true"))
+	recorder := record.NewFakeRecorder(10)
+	reclaimer := newSpaceReclaimer(pvLister, recorder, rootDir, "csi.huawei.com")
+
+	// mock
+	stubs := gostub.StubFunc(&utils.ExecShellCmd, "", errors.New("the discard operation is not supported"))
+	defer stubs.Reset()
+	patches := gomonkey.ApplyFuncReturn(connector.ReadMountPoints, map[string]string{
+		filepath.Join(volumeDir, "globalmount"): "/dev/dm-1",
+	}, nil)
+	defer patches.Reset()
+
+	// action
+	reclaimer.reclaimAll(ctx, isSpaceReclaimEnabled)
+	reclaimer.reclaimAll(ctx, isSpaceReclaimEnabled)
+
+	// assert
+	assert.Len(t, recorder.Events, 1)
+}
+
+func TestSpaceReclaimer_ReclaimAll_RemoveUnstagedVolumes(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	reclaimer := newSpaceReclaimer(newReclaimPVLister(t), record.NewFakeRecorder(10), t.TempDir(),
+		"csi.huawei.com")
+	reclaimer.reclaimedVolumes["pvc-unstaged"] = true
+	reclaimer.handledRequests["pvc-unstaged"] = "2026-10-16T10:00:00Z"
+	reclaimer.failedEventTimes["pvc-unstaged"] = time.Now()
+	var deleted []string
+
+	// mock
+	patches := gomonkey.ApplyFuncReturn(connector.ReadMountPoints, map[string]string{}, nil).
+		ApplyFunc(metrics.DeleteSpaceReclaim, func(volume string) {
+			deleted = append(deleted, volume)
+		})
+	defer patches.Reset()
+
+	// action
+	reclaimer.reclaimAll(ctx, isSpaceReclaimEnabled)
+
+	// assert
+	assert.Equal(t, []string{"pvc-unstaged"}, deleted)
+	assert.Empty(t, reclaimer.reclaimedVolumes)
+	assert.Empty(t, reclaimer.handledRequests)
+	assert.Empty(t, reclaimer.failedEventTimes)
+}
+
+func TestGetStagedFilesystemVolumes_SkipNotMounted(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	rootDir := t.TempDir()
+	nfsDir := filepath.Join(rootDir, "kubelet/plugins/kubernetes.io/csi/pv/pvc-nfs")
+	unmountedDir := filepath.Join(rootDir, "kubelet/plugins/kubernetes.io/csi/pv/pvc-unmounted")
+	writeVolData(t, nfsDir, `{"volumeHandle":"backend.pvc-nfs","driverName":"csi.huawei.com"}`)
+	writeVolData(t, unmountedDir, `{"volumeHandle":"backend.pvc-unmounted","driverName":"csi.huawei.com"}`)
+
+	// mock
+	patches := gomonkey.ApplyFuncReturn(connector.ReadMountPoints, map[string]string{
+		filepath.Join(nfsDir, "globalmount"): "127.0.0.1:/pvc-nfs",
+	}, nil)
+	defer patches.Reset()
+
+	// action
+	volumes, err := getStagedFilesystemVolumes(ctx, rootDir, "csi.huawei.com")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Empty(t, volumes)
+}
+
+func TestTrimReclaimPV(t *testing.T) {
+	// arrange
+	pv := newReclaimPV("pvc-trim", map[string]string{"xuanwu.huawei.io/spaceReclaim": "true"}, "true")
+	pv.ManagedFields = []metav1.ManagedFieldsEntry{{Manager: "kube-controller-manager"}}
+	otherPV := newReclaimPV("pvc-other", map[string]string{"xuanwu.huawei.io/spaceReclaim": "true"}, "true")
+	otherPV.Spec.CSI.Driver = "other.csi.com"
+
+	// action
+	trimmed, ok := trimReclaimPV(pv, "csi.huawei.com").(*corev1.PersistentVolume)
+	trimmedOther, otherOk := trimReclaimPV(otherPV, "csi.huawei.com").(*corev1.PersistentVolume)
+
+	// assert
+	assert.True(t, ok)
+	assert.Empty(t, trimmed.ManagedFields)
+	assert.Equal(t, pv.Annotations, trimmed.Annotations)
+	assert.Equal(t, pv.Spec.CSI.VolumeAttributes, trimmed.Spec.CSI.VolumeAttributes)
+	assert.True(t, otherOk)
+	assert.Equal(t, "pvc-other", trimmedOther.Name)
+	assert.Empty(t, trimmedOther.Annotations)
+	assert.Nil(t, trimmedOther.Spec.CSI)
+}
+
+func newReclaimPVLister(t *testing.T, pvs ...*corev1.PersistentVolume) corev1listers.PersistentVolumeLister {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, pv := range pvs {
+		if err := indexer.Add(pv); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return corev1listers.NewPersistentVolumeLister(indexer)
+}
+
+func newReclaimPV(name string, annotations map[string]string, spaceReclaim string) *corev1.PersistentVolume {
+	return &corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Annotations: annotations},
+		Spec: corev1.PersistentVolumeSpec{
+			PersistentVolumeSource: corev1.PersistentVolumeSource{
+				CSI: &corev1.CSIPersistentVolumeSource{
+					Driver:           "csi.huawei.com",
+					VolumeHandle:     "backend." + name,
+					VolumeAttributes: map[string]string{"spaceReclaim": spaceReclaim},
+				},
+			},
+		},
+	}
+}
+
+func writeVolData(t *testing.T, dir, content string) {
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "vol_data.json"), []byte(content), 0640); err != nil {
+		t.Fatal(err)
+	}
+}
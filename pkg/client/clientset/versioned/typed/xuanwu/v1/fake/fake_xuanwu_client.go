@@ -34,6 +34,14 @@ func (c *FakeXuanwuV1) StorageBackendContents() v1.StorageBackendContentInterfac
 	return &FakeStorageBackendContents{c}
 }
 
+func (c *FakeXuanwuV1) VolumeFailovers() v1.VolumeFailoverInterface {
+	return &FakeVolumeFailovers{c}
+}
+
+func (c *FakeXuanwuV1) VolumeMigrations() v1.VolumeMigrationInterface {
+	return &FakeVolumeMigrations{c}
+}
+
 func (c *FakeXuanwuV1) VolumeModifyClaims() v1.VolumeModifyClaimInterface {
 	return &FakeVolumeModifyClaims{c}
 }
@@ -42,6 +50,10 @@ func (c *FakeXuanwuV1) VolumeModifyContents() v1.VolumeModifyContentInterface {
 	return &FakeVolumeModifyContents{c}
 }
 
+func (c *FakeXuanwuV1) VolumeSnapshotRollbacks() v1.VolumeSnapshotRollbackInterface {
+	return &FakeVolumeSnapshotRollbacks{c}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeXuanwuV1) RESTClient() rest.Interface {
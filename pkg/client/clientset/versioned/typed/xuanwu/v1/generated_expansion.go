@@ -19,6 +19,12 @@ type StorageBackendClaimExpansion interface{}
 
 type StorageBackendContentExpansion interface{}
 
+type VolumeFailoverExpansion interface{}
+
+type VolumeMigrationExpansion interface{}
+
 type VolumeModifyClaimExpansion interface{}
 
 type VolumeModifyContentExpansion interface{}
+
+type VolumeSnapshotRollbackExpansion interface{}
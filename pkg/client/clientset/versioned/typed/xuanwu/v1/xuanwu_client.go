@@ -28,8 +28,11 @@ type XuanwuV1Interface interface {
 	RESTClient() rest.Interface
 	StorageBackendClaimsGetter
 	StorageBackendContentsGetter
+	VolumeFailoversGetter
+	VolumeMigrationsGetter
 	VolumeModifyClaimsGetter
 	VolumeModifyContentsGetter
+	VolumeSnapshotRollbacksGetter
 }
 
 // XuanwuV1Client is used to interact with features provided by the xuanwu.huawei.io group.
@@ -45,6 +48,14 @@ func (c *XuanwuV1Client) StorageBackendContents() StorageBackendContentInterface
 	return newStorageBackendContents(c)
 }
 
+func (c *XuanwuV1Client) VolumeFailovers() VolumeFailoverInterface {
+	return newVolumeFailovers(c)
+}
+
+func (c *XuanwuV1Client) VolumeMigrations() VolumeMigrationInterface {
+	return newVolumeMigrations(c)
+}
+
 func (c *XuanwuV1Client) VolumeModifyClaims() VolumeModifyClaimInterface {
 	return newVolumeModifyClaims(c)
 }
@@ -53,6 +64,10 @@ func (c *XuanwuV1Client) VolumeModifyContents() VolumeModifyContentInterface {
 	return newVolumeModifyContents(c)
 }
 
+func (c *XuanwuV1Client) VolumeSnapshotRollbacks() VolumeSnapshotRollbackInterface {
+	return newVolumeSnapshotRollbacks(c)
+}
+
 // NewForConfig creates a new XuanwuV1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
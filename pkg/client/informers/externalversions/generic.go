@@ -55,10 +55,16 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Xuanwu().V1().StorageBackendClaims().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("storagebackendcontents"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Xuanwu().V1().StorageBackendContents().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("volumefailovers"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Xuanwu().V1().VolumeFailovers().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("volumemigrations"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Xuanwu().V1().VolumeMigrations().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("volumemodifyclaims"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Xuanwu().V1().VolumeModifyClaims().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("volumemodifycontents"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Xuanwu().V1().VolumeModifyContents().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("volumesnapshotrollbacks"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Xuanwu().V1().VolumeSnapshotRollbacks().Informer()}, nil
 
 	}
 
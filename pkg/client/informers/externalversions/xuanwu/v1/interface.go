@@ -25,10 +25,16 @@ type Interface interface {
 	StorageBackendClaims() StorageBackendClaimInformer
 	// StorageBackendContents returns a StorageBackendContentInformer.
 	StorageBackendContents() StorageBackendContentInformer
+	// VolumeFailovers returns a VolumeFailoverInformer.
+	VolumeFailovers() VolumeFailoverInformer
+	// VolumeMigrations returns a VolumeMigrationInformer.
+	VolumeMigrations() VolumeMigrationInformer
 	// VolumeModifyClaims returns a VolumeModifyClaimInformer.
 	VolumeModifyClaims() VolumeModifyClaimInformer
 	// VolumeModifyContents returns a VolumeModifyContentInformer.
 	VolumeModifyContents() VolumeModifyContentInformer
+	// VolumeSnapshotRollbacks returns a VolumeSnapshotRollbackInformer.
+	VolumeSnapshotRollbacks() VolumeSnapshotRollbackInformer
 }
 
 type version struct {
@@ -52,6 +58,16 @@ func (v *version) StorageBackendContents() StorageBackendContentInformer {
 	return &storageBackendContentInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// VolumeFailovers returns a VolumeFailoverInformer.
+func (v *version) VolumeFailovers() VolumeFailoverInformer {
+	return &volumeFailoverInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// VolumeMigrations returns a VolumeMigrationInformer.
+func (v *version) VolumeMigrations() VolumeMigrationInformer {
+	return &volumeMigrationInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // VolumeModifyClaims returns a VolumeModifyClaimInformer.
 func (v *version) VolumeModifyClaims() VolumeModifyClaimInformer {
 	return &volumeModifyClaimInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -61,3 +77,8 @@ func (v *version) VolumeModifyClaims() VolumeModifyClaimInformer {
 func (v *version) VolumeModifyContents() VolumeModifyContentInformer {
 	return &volumeModifyContentInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
+
+// VolumeSnapshotRollbacks returns a VolumeSnapshotRollbackInformer.
+func (v *version) VolumeSnapshotRollbacks() VolumeSnapshotRollbackInformer {
+	return &volumeSnapshotRollbackInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
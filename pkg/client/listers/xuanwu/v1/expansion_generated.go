@@ -27,6 +27,14 @@ type StorageBackendClaimNamespaceListerExpansion interface{}
 // StorageBackendContentLister.
 type StorageBackendContentListerExpansion interface{}
 
+// VolumeFailoverListerExpansion allows custom methods to be added to
+// VolumeFailoverLister.
+type VolumeFailoverListerExpansion interface{}
+
+// VolumeMigrationListerExpansion allows custom methods to be added to
+// VolumeMigrationLister.
+type VolumeMigrationListerExpansion interface{}
+
 // VolumeModifyClaimListerExpansion allows custom methods to be added to
 // VolumeModifyClaimLister.
 type VolumeModifyClaimListerExpansion interface{}
@@ -34,3 +42,7 @@ type VolumeModifyClaimListerExpansion interface{}
 // VolumeModifyContentListerExpansion allows custom methods to be added to
 // VolumeModifyContentLister.
 type VolumeModifyContentListerExpansion interface{}
+
+// VolumeSnapshotRollbackListerExpansion allows custom methods to be added to
+// VolumeSnapshotRollbackLister.
+type VolumeSnapshotRollbackListerExpansion interface{}
@@ -1,5 +1,5 @@
 /*
- Copyright (c) Huawei Technologies Co., Ltd. 2022-2025. All rights reserved.
+ Copyright (c) Huawei Technologies Co., Ltd. 2022-2026. All rights reserved.
 
  Licensed under the Apache License, Version 2.0 (the "License");
  you may not use this file except in compliance with the License.
@@ -112,6 +112,33 @@ const (
 	AdvancedOptionsKey = "advancedOptions"
 	// ScVolumeNameKey is the key of volumeName in StorageClass
 	ScVolumeNameKey = "volumeName"
+	// EncryptedKey is the key of encrypted parameter in StorageClass, the volume is encrypted with LUKS2 on the node
+	EncryptedKey = "encrypted"
+	// EncryptionPassphraseKey is the key of the passphrase in the node stage secret of the encrypted volume
+	EncryptionPassphraseKey = "encryptionPassphrase"
+	// SpaceReclaimKey is the key of spaceReclaim parameter in StorageClass, the node runs fstrim on the volume
+	SpaceReclaimKey = "spaceReclaim"
+	// SpaceReclaimAnnotationKey is the annotation of PV which overrides the spaceReclaim parameter of the volume
+	SpaceReclaimAnnotationKey = "xuanwu.huawei.io/spaceReclaim"
+	// SpaceReclaimRequestAnnotationKey is the annotation of PV which requests an on-demand space reclamation,
+	// the nodes run fstrim on the volume once whenever the value is changed, such as set to the current time
+	SpaceReclaimRequestAnnotationKey = "xuanwu.huawei.io/spaceReclaimRequest"
+	// PublishFenceAnnotationKey is the annotation of PV which fences the volume from being published, the value is
+	// the owner of the fence, such as a VolumeMigration which copies the volume offline
+	PublishFenceAnnotationKey = "xuanwu.huawei.io/publishFence"
+	// SmartDedupeKey is the key of smartDedupe parameter in StorageClass, the volume is created with SmartDedupe.
+	// It is supported by OceanStor SAN and NAS, the pools of FusionStorage Pacific backends are never selected for it
+	SmartDedupeKey = "smartDedupe"
+	// SmartCompressionKey is the key of smartCompression parameter in StorageClass, the volume is created with
+	// SmartCompression. It is supported by OceanStor SAN and NAS, the pools of FusionStorage Pacific backends are
+	// never selected for it
+	SmartCompressionKey = "smartCompression"
+	// SmartTierInitialPolicyKey is the key of smartTierInitialPolicy parameter in StorageClass, it decides the tier
+	// where the data of the lun is written at first. It is only supported by OceanStor SAN
+	SmartTierInitialPolicyKey = "smartTierInitialPolicy"
+	// SmartTierRelocationPolicyKey is the key of smartTierRelocationPolicy parameter in StorageClass, it decides the
+	// tier where the data of the lun is relocated to. It is only supported by OceanStor SAN
+	SmartTierRelocationPolicyKey = "smartTierRelocationPolicy"
 
 	// PVCNameKey is the key of PVC name in CreateVolumeRequest parameters
 	PVCNameKey = "csi.storage.k8s.io/pvc/name"
@@ -136,11 +163,26 @@ const (
 	DefaultDescription = "Created from Kubernetes CSI"
 	// RescanLabelKey is the label key of va need to rescan
 	RescanLabelKey = "modify.xuanwu.huawei.io/needScan"
+	// PreviousAttributeKeyPrefix is the prefix of the attribute keys of the modify volume response which return
+	// the values of the modified parameters before the modification, such as "previous.qos"
+	PreviousAttributeKeyPrefix = "previous."
 )
 
 var (
 	// ErrTimeout defines the timeout error
 	ErrTimeout = errors.New("timeout")
+	// ErrMigrationFailed means the migration is at fault on storage and can never be finished, it is not retried
+	ErrMigrationFailed = errors.New("migration failed on storage")
+	// ErrMigrationCutOver means the source volume has been replaced by the target on storage, the migration can not
+	// be rolled back but only be completed
+	ErrMigrationCutOver = errors.New("migration cut over on storage")
+	// ErrVolumeNotFound means the volume queried does not exist on storage
+	ErrVolumeNotFound = errors.New("volume not found")
+	// ErrRollbackFailed means the rollback is at fault on storage or can never be started, it is not retried
+	ErrRollbackFailed = errors.New("rollback failed on storage")
+	// ErrFailoverFailed means the volume can never be switched on storage, such as it is not protected or its pair
+	// does not exist, it is not retried
+	ErrFailoverFailed = errors.New("failover failed on storage")
 )
 
 // DRCSIConfig contains storage normal configuration
@@ -171,3 +213,14 @@ const (
 	// RestoreModeSnapshot defines the volume restore mode of snapshot
 	RestoreModeSnapshot = "snapshot"
 )
+
+const (
+	// SmartTierPolicyNone means the data of the lun is not relocated between the tiers
+	SmartTierPolicyNone = "none"
+	// SmartTierPolicyAutomatic means the tier of the data is decided by the storage
+	SmartTierPolicyAutomatic = "automatic"
+	// SmartTierPolicyHighest means the data is preferentially placed on the high-performance tier
+	SmartTierPolicyHighest = "highest"
+	// SmartTierPolicyLowest means the data is preferentially placed on the low-performance tier
+	SmartTierPolicyLowest = "lowest"
+)
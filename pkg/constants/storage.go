@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2025. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -158,6 +158,15 @@ const (
 	// NoRootSquashValue is the value of no root squash when request
 	NoRootSquashValue = 1
 
+	// ModifyAuthClientKey is the mutable parameter key of the auth clients of the nfs share, separated by ";"
+	ModifyAuthClientKey = "authClient"
+	// ModifyAllSquashKey is the mutable parameter key of the all squash of the nfs share auth clients
+	ModifyAllSquashKey = "allSquash"
+	// ModifyRootSquashKey is the mutable parameter key of the root squash of the nfs share auth clients
+	ModifyRootSquashKey = "rootSquash"
+	// ModifySnapshotDirectoryVisibilityKey is the mutable parameter key of the snapshot directory visibility
+	ModifySnapshotDirectoryVisibilityKey = "snapshotDirectoryVisibility"
+
 	// FusionSanStorageUnHealthStatus is the value of un health status
 	FusionSanStorageUnHealthStatus = 2
 	// DoradoSanStorageUnHealthStatus is the value of un health status
@@ -182,6 +191,10 @@ var SupportQuota BackendCapability = "SupportQuota"
 // SupportClone defines backend capability SupportClone
 var SupportClone BackendCapability = "SupportClone"
 
+// SupportCloneFromSnapshot defines backend capability SupportCloneFromSnapshot,
+// it is used by the backend which can only clone volumes from snapshots
+var SupportCloneFromSnapshot BackendCapability = "SupportCloneFromSnapshot"
+
 // SupportMetro defines backend capability SupportMetro
 var SupportMetro BackendCapability = "SupportMetro"
 
@@ -194,6 +207,18 @@ var SupportApplicationType BackendCapability = "SupportApplicationType"
 // SupportMetroNAS defines backend capability SupportMetroNAS
 var SupportMetroNAS BackendCapability = "SupportMetroNAS"
 
+// SupportSmartDedupe defines backend capability SupportSmartDedupe
+var SupportSmartDedupe BackendCapability = "SupportSmartDedupe"
+
+// SupportSmartCompression defines backend capability SupportSmartCompression
+var SupportSmartCompression BackendCapability = "SupportSmartCompression"
+
+// SupportSmartTier defines backend capability SupportSmartTier
+var SupportSmartTier BackendCapability = "SupportSmartTier"
+
+// SupportGroupSnapshot defines backend capability SupportGroupSnapshot
+var SupportGroupSnapshot BackendCapability = "SupportGroupSnapshot"
+
 // SupportNFS3 defines backend capability SupportNFS3
 const SupportNFS3 = "SupportNFS3"
 
@@ -0,0 +1,216 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package failover contains VolumeFailover controller definitions and synchronization functions
+package failover
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	coreV1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	xuanwuv1 "github.com/Huawei/eSDK_K8S_Plugin/v4/client/apis/xuanwu/v1"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/lib/drcsi"
+	clientset "github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/client/clientset/versioned"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/client/clientset/versioned/scheme"
+	external "github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/client/informers/externalversions"
+	failoverinformers "github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/client/informers/externalversions/xuanwu/v1"
+	failoverlisters "github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/client/listers/xuanwu/v1"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/modify"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
+)
+
+const (
+	// defaultRetryMaxDelay is used when option function RetryMaxDelay is omitted
+	defaultRetryMaxDelay = 5 * time.Minute
+
+	// defaultRetryBaseDelay is used when option function RetryBaseDelay is omitted
+	defaultRetryBaseDelay = 5 * time.Second
+
+	// defaultReconcileDelay is used when option function ReconcileDelay is omitted
+	defaultReconcileDelay = 10 * time.Second
+
+	// defaultProvisioner is used when option function Provisioner is omitted
+	defaultProvisioner = "csi.huawei.com"
+
+	// defaultWorkerThreads is used when option function WorkerThreads is omitted
+	defaultWorkerThreads = 4
+
+	// failoverResource is used uniquely identifies failover work queue
+	failoverResource = "vfo"
+
+	// eventResourceName is used to record event
+	eventResourceName = "volume-failover-mgnt"
+)
+
+// VolumeFailoverController controller of volume failover
+type VolumeFailoverController struct {
+	clientSet          clientset.Interface
+	client             kubernetes.Interface
+	modifyClient       drcsi.ModifyVolumeInterfaceClient
+	eventRecorder      record.EventRecorder
+	failoverQueue      workqueue.RateLimitingInterface
+	failoverInformer   failoverinformers.VolumeFailoverInformer
+	failoverLister     failoverlisters.VolumeFailoverLister
+	failoverListerSync cache.InformerSynced
+	failoverWorker     *modify.ObjectWorker
+	retryMaxDelay      time.Duration
+	retryBaseDelay     time.Duration
+	reconcileDelay     time.Duration
+	workerThreads      int
+	provisioner        string
+}
+
+// NewVolumeFailoverController instance a controller
+func NewVolumeFailoverController(ctx context.Context, client kubernetes.Interface, clientSet clientset.Interface,
+	factory external.SharedInformerFactory,
+	options ...func(controller *VolumeFailoverController)) *VolumeFailoverController {
+	ctr := &VolumeFailoverController{
+		client:         client,
+		clientSet:      clientSet,
+		retryBaseDelay: defaultRetryBaseDelay,
+		retryMaxDelay:  defaultRetryMaxDelay,
+		reconcileDelay: defaultReconcileDelay,
+		workerThreads:  defaultWorkerThreads,
+		provisioner:    defaultProvisioner,
+	}
+
+	// add custom options
+	for _, option := range options {
+		option(ctr)
+	}
+
+	broadcaster := record.NewBroadcaster()
+	broadcaster.StartRecordingToSink(&coreV1.EventSinkImpl{Interface: client.CoreV1().Events(v1.NamespaceAll)})
+	ctr.eventRecorder = broadcaster.NewRecorder(scheme.Scheme, v1.EventSource{Component: eventResourceName})
+	ctr.failoverInformer = factory.Xuanwu().V1().VolumeFailovers()
+	ctr.failoverLister = ctr.failoverInformer.Lister()
+	ctr.failoverListerSync = ctr.failoverInformer.Informer().HasSynced
+	rateLimiter := workqueue.NewItemExponentialFailureRateLimiter(ctr.retryBaseDelay, ctr.retryMaxDelay)
+	queueConfig := workqueue.RateLimitingQueueConfig{Name: failoverResource}
+	ctr.failoverQueue = workqueue.NewRateLimitingQueueWithConfig(rateLimiter, queueConfig)
+
+	// add event handler
+	ctr.AddFailoverHandler(ctx)
+
+	// add workers
+	ctr.failoverWorker = modify.NewObjectWorker(failoverResource, ctr.failoverQueue,
+		modify.SyncFunc(ctr.syncFailoverWork))
+	return ctr
+}
+
+// Run will sync informer caches and starting workers. It will block until stopCh is closed
+func (ctrl *VolumeFailoverController) Run(ctx context.Context, stopCh <-chan struct{}) {
+	defer ctrl.failoverQueue.ShutDown()
+
+	log.AddContext(ctx).Infoln("starting volume failover controller")
+	defer log.AddContext(ctx).Infoln("shutting down volume failover controller")
+
+	if !cache.WaitForCacheSync(stopCh, ctrl.failoverListerSync) {
+		log.AddContext(ctx).Errorln("cannot sync caches")
+		return
+	}
+
+	log.AddContext(ctx).Infoln("starting workers")
+	for i := 0; i < ctrl.workerThreads; i++ {
+		go wait.Until(func() { ctrl.failoverWorker.Run(ctx) }, time.Second, stopCh)
+	}
+
+	log.AddContext(ctx).Infoln("started workers")
+	defer log.AddContext(ctx).Infoln("shutting down workers")
+	if stopCh != nil {
+		sign := <-stopCh
+		log.AddContext(ctx).Infof("volume failover controller exited, reason: [%v]", sign)
+	}
+}
+
+// AddFailoverHandler add failover event handler
+func (ctrl *VolumeFailoverController) AddFailoverHandler(ctx context.Context) *VolumeFailoverController {
+	_, err := ctrl.failoverInformer.Informer().AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    func(obj interface{}) { ctrl.enqueueFailover(obj) },
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.enqueueFailover(newObj) },
+		},
+	)
+	if err != nil {
+		log.AddContext(ctx).Errorf("Add failover event handler failed, error: %v", err)
+	}
+	return ctrl
+}
+
+func (ctrl *VolumeFailoverController) enqueueFailover(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+	if failover, ok := obj.(*xuanwuv1.VolumeFailover); ok {
+		objName, err := cache.DeletionHandlingMetaNamespaceKeyFunc(failover)
+		if err != nil {
+			log.Errorf("failed to get failover key from object [%v] err: [%v]", failover, err)
+			return
+		}
+		log.Infof("enqueued failover [%v] for sync", objName)
+		ctrl.failoverQueue.Add(objName)
+	}
+}
+
+// WorkerThreads used to configure the number of working threads.
+func WorkerThreads(workerThreads int) func(controller *VolumeFailoverController) {
+	return func(ctr *VolumeFailoverController) {
+		ctr.workerThreads = workerThreads
+	}
+}
+
+// RetryMaxDelay used to configure the max interval of retry.
+func RetryMaxDelay(retryMaxDelay time.Duration) func(controller *VolumeFailoverController) {
+	return func(ctr *VolumeFailoverController) {
+		ctr.retryMaxDelay = retryMaxDelay
+	}
+}
+
+// RetryBaseDelay used to configure the start interval of retry.
+func RetryBaseDelay(retryBaseDelay time.Duration) func(controller *VolumeFailoverController) {
+	return func(ctr *VolumeFailoverController) {
+		ctr.retryBaseDelay = retryBaseDelay
+	}
+}
+
+// ReconcileDelay used to configure the interval of checking the volumes which are synchronizing.
+func ReconcileDelay(reconcileDelay time.Duration) func(controller *VolumeFailoverController) {
+	return func(ctr *VolumeFailoverController) {
+		ctr.reconcileDelay = reconcileDelay
+	}
+}
+
+// Provisioner used to configure the driver name.
+func Provisioner(provisioner string) func(controller *VolumeFailoverController) {
+	return func(ctr *VolumeFailoverController) {
+		ctr.provisioner = provisioner
+	}
+}
+
+// ClientOfModify used to configure the modify client.
+func ClientOfModify(modifyClient drcsi.ModifyVolumeInterfaceClient) func(controller *VolumeFailoverController) {
+	return func(ctr *VolumeFailoverController) {
+		ctr.modifyClient = modifyClient
+	}
+}
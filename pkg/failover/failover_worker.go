@@ -0,0 +1,373 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package failover contains VolumeFailover controller definitions and synchronization functions
+package failover
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xuanwuv1 "github.com/Huawei/eSDK_K8S_Plugin/v4/client/apis/xuanwu/v1"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/lib/drcsi"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/modify"
+	pkgutils "github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/utils"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/volume"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
+)
+
+const (
+	// SourcePersistentVolumeClaimKind source kind of PersistentVolumeClaim
+	SourcePersistentVolumeClaimKind = "PersistentVolumeClaim"
+
+	// StartFailoverReason reason of failover is started
+	StartFailoverReason = "FailoverStarted"
+
+	// FailoverFailedReason reason of failover failed
+	FailoverFailedReason = "FailoverFailed"
+
+	// FailoverCompletedReason reason of failover completed
+	FailoverCompletedReason = "FailoverCompleted"
+)
+
+// errFailoverAborted means the failover can never be finished, such as the spec is invalid or the volume can not
+// be switched on storage, so it is not retried
+var errFailoverAborted = errors.New("failover aborted")
+
+func (ctrl *VolumeFailoverController) syncFailoverWork(ctx context.Context, name string) error {
+	log.AddContext(ctx).Debugf("start sync VolumeFailover: %s", name)
+	defer log.AddContext(ctx).Debugf("finish sync VolumeFailover: %s", name)
+	failover, err := ctrl.failoverLister.Get(name)
+	if err != nil {
+		if apiErrors.IsNotFound(err) {
+			log.AddContext(ctx).Infof("failover:%s is no longer exists, end this work", name)
+			return nil
+		}
+		return fmt.Errorf("get failover:%s from the indexer cache error: %w", name, err)
+	}
+
+	if failover.DeletionTimestamp != nil {
+		return nil
+	}
+
+	syncFunctions := []func(context.Context, *xuanwuv1.VolumeFailover) (*xuanwuv1.VolumeFailover, error){
+		ctrl.setFailoverPending,
+		ctrl.collectFailoverVolumes,
+		ctrl.switchFailoverVolumes,
+	}
+	for _, syncFunction := range syncFunctions {
+		if failover, err = syncFunction(ctx, failover); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ctrl *VolumeFailoverController) setFailoverPending(ctx context.Context,
+	failover *xuanwuv1.VolumeFailover) (*xuanwuv1.VolumeFailover, error) {
+	if failover.Status.Phase != "" {
+		return failover, nil
+	}
+
+	defer log.AddContext(ctx).Infof("set failover %s to %s", failover.Name, xuanwuv1.VolumeFailoverPending)
+	failoverClone := failover.DeepCopy()
+	failoverClone.Status.Phase = xuanwuv1.VolumeFailoverPending
+	failoverClone.Status.Ready = generateReadyString(0, 0)
+	failoverClone.Status.StartedAt = &metav1.Time{Time: time.Now().Local()}
+	return ctrl.clientSet.XuanwuV1().VolumeFailovers().UpdateStatus(ctx, failoverClone, metav1.UpdateOptions{})
+}
+
+func (ctrl *VolumeFailoverController) collectFailoverVolumes(ctx context.Context,
+	failover *xuanwuv1.VolumeFailover) (*xuanwuv1.VolumeFailover, error) {
+	if failover.Status.Phase != xuanwuv1.VolumeFailoverPending {
+		return failover, nil
+	}
+
+	volumes, err := ctrl.listSourceVolumes(ctx, failover)
+	if errors.Is(err, errFailoverAborted) {
+		return ctrl.setFailoverFailed(ctx, failover, err)
+	}
+	if err != nil {
+		ctrl.eventRecorder.Event(failover, corev1.EventTypeWarning, FailoverFailedReason, err.Error())
+		return failover, err
+	}
+
+	failoverClone := failover.DeepCopy()
+	failoverClone.Status.Volumes = volumes
+	failoverClone.Status.Ready = generateReadyString(0, len(volumes))
+	failoverClone.Status.Phase = xuanwuv1.VolumeFailoverRunning
+	if len(volumes) == 0 {
+		msg := fmt.Sprintf("%s %s is not associated with any bound volume",
+			failover.Spec.Source.Kind, failover.Spec.Source.Name)
+		ctrl.eventRecorder.Event(failover, corev1.EventTypeNormal, FailoverCompletedReason, msg)
+		failoverClone.Status.Phase = xuanwuv1.VolumeFailoverCompleted
+		failoverClone.Status.CompletedAt = &metav1.Time{Time: time.Now().Local()}
+	} else {
+		ctrl.eventRecorder.Event(failover, corev1.EventTypeNormal, StartFailoverReason,
+			fmt.Sprintf("%s %d volumes to backend %s", failover.Spec.Action, len(volumes),
+				failover.Spec.TargetBackend))
+	}
+
+	log.AddContext(ctx).Infof("set failover %s to %s with %d volumes",
+		failover.Name, failoverClone.Status.Phase, len(volumes))
+	return ctrl.clientSet.XuanwuV1().VolumeFailovers().UpdateStatus(ctx, failoverClone, metav1.UpdateOptions{})
+}
+
+func (ctrl *VolumeFailoverController) listSourceVolumes(ctx context.Context,
+	failover *xuanwuv1.VolumeFailover) ([]xuanwuv1.FailoverVolume, error) {
+	source := failover.Spec.Source
+	if source == nil {
+		return nil, fmt.Errorf("%w: check spec failed: spec.source is empty", errFailoverAborted)
+	}
+
+	var pvs []corev1.PersistentVolume
+	switch source.Kind {
+	case modify.SourceStorageClassKind:
+		list, err := ctrl.client.CoreV1().PersistentVolumes().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("list persistent volumes error: %w", err)
+		}
+		for _, pv := range list.Items {
+			if pv.Spec.StorageClassName == source.Name {
+				pvs = append(pvs, pv)
+			}
+		}
+	case SourcePersistentVolumeClaimKind:
+		pvc, err := ctrl.client.CoreV1().PersistentVolumeClaims(source.Namespace).Get(ctx,
+			source.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("get pvc %s error: %w",
+				pkgutils.MakeMetaWithNamespace(source.Namespace, source.Name), err)
+		}
+		if pvc.Spec.VolumeName == "" {
+			return nil, fmt.Errorf("pvc %s is not bound", pkgutils.MakeMetaWithNamespace(pvc.Namespace, pvc.Name))
+		}
+		pv, err := ctrl.client.CoreV1().PersistentVolumes().Get(ctx, pvc.Spec.VolumeName, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("get pv %s error: %w", pvc.Spec.VolumeName, err)
+		}
+		pvs = append(pvs, *pv)
+	default:
+		return nil, fmt.Errorf("%w: check spec failed: spec.source.kind %q is not supported",
+			errFailoverAborted, source.Kind)
+	}
+
+	var volumes []xuanwuv1.FailoverVolume
+	for _, pv := range pvs {
+		if pv.Status.Phase != corev1.VolumeBound || pv.Spec.CSI == nil || pv.Spec.CSI.Driver != ctrl.provisioner ||
+			pv.Spec.CSI.VolumeHandle == "" || pv.Spec.ClaimRef == nil {
+			log.AddContext(ctx).Infof("volume %s is not a bound volume of %s, skip it", pv.Name, ctrl.provisioner)
+			continue
+		}
+
+		volumes = append(volumes, xuanwuv1.FailoverVolume{
+			PersistentVolumeName: pv.Name,
+			SourceVolume:         pkgutils.MakeMetaWithNamespace(pv.Spec.ClaimRef.Namespace, pv.Spec.ClaimRef.Name),
+			SourceVolumeHandle:   pv.Spec.CSI.VolumeHandle,
+			Status:               xuanwuv1.FailoverVolumePending,
+		})
+	}
+
+	return volumes, nil
+}
+
+func (ctrl *VolumeFailoverController) switchFailoverVolumes(ctx context.Context,
+	failover *xuanwuv1.VolumeFailover) (*xuanwuv1.VolumeFailover, error) {
+	if failover.Status.Phase != xuanwuv1.VolumeFailoverRunning {
+		return failover, nil
+	}
+
+	var completed, failed int
+	var errs []error
+	failoverClone := failover.DeepCopy()
+	for i := range failoverClone.Status.Volumes {
+		vol := &failoverClone.Status.Volumes[i]
+		if vol.Status != xuanwuv1.FailoverVolumeCompleted && vol.Status != xuanwuv1.FailoverVolumeFailed {
+			err := ctrl.switchVolume(ctx, failover, vol)
+			if errors.Is(err, errFailoverAborted) {
+				log.AddContext(ctx).Errorf("%s volume %s failed, error: %v",
+					failover.Spec.Action, vol.SourceVolume, err)
+				vol.Status = xuanwuv1.FailoverVolumeFailed
+				vol.Message = err.Error()
+			} else if err != nil {
+				log.AddContext(ctx).Errorf("%s volume %s error: %v", failover.Spec.Action, vol.SourceVolume, err)
+				vol.Message = err.Error()
+				errs = append(errs, err)
+			}
+		}
+
+		switch vol.Status {
+		case xuanwuv1.FailoverVolumeCompleted:
+			completed++
+		case xuanwuv1.FailoverVolumeFailed:
+			failed++
+		default:
+		}
+	}
+
+	total := len(failoverClone.Status.Volumes)
+	failoverClone.Status.Ready = generateReadyString(completed, total)
+	if completed+failed == total {
+		failoverClone.Status.Phase = xuanwuv1.VolumeFailoverCompleted
+		failoverClone.Status.CompletedAt = &metav1.Time{Time: time.Now().Local()}
+		msg := fmt.Sprintf("%s %d volumes to backend %s completed, the workloads using them should be restarted",
+			failover.Spec.Action, completed, failover.Spec.TargetBackend)
+		if failed != 0 {
+			failoverClone.Status.Phase = xuanwuv1.VolumeFailoverFailed
+			msg = fmt.Sprintf("%s %d of %d volumes to backend %s failed",
+				failover.Spec.Action, failed, total, failover.Spec.TargetBackend)
+			ctrl.eventRecorder.Event(failover, corev1.EventTypeWarning, FailoverFailedReason, msg)
+		} else {
+			ctrl.eventRecorder.Event(failover, corev1.EventTypeNormal, FailoverCompletedReason, msg)
+		}
+	}
+
+	updated, err := ctrl.clientSet.XuanwuV1().VolumeFailovers().UpdateStatus(ctx, failoverClone,
+		metav1.UpdateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("update status of failover %s error: %w", failover.Name, err)
+	}
+
+	if len(errs) != 0 {
+		return updated, errors.Join(errs...)
+	}
+
+	if updated.Status.Phase == xuanwuv1.VolumeFailoverRunning {
+		ctrl.failoverQueue.AddAfter(failover.Name, ctrl.reconcileDelay)
+	}
+	return updated, nil
+}
+
+// switchVolume switches the volume on storage and re-points the PersistentVolume to the target backend.
+// Switching on storage is idempotent, so it is always called until the target PersistentVolume is recorded,
+// then the PersistentVolume is rebound by the next reconcile after the record is persisted. The error wraps
+// errFailoverAborted if the provider reports that the volume can never be switched.
+func (ctrl *VolumeFailoverController) switchVolume(ctx context.Context, failover *xuanwuv1.VolumeFailover,
+	vol *xuanwuv1.FailoverVolume) error {
+	if vol.Status == xuanwuv1.FailoverVolumeRebinding && vol.TargetPersistentVolume != nil {
+		if err := pkgutils.RecreatePersistentVolume(ctx, ctrl.client, vol.TargetPersistentVolume); err != nil {
+			return err
+		}
+
+		vol.Status = xuanwuv1.FailoverVolumeCompleted
+		vol.TargetPersistentVolume = nil
+		vol.Message = ""
+		return nil
+	}
+
+	pv, err := ctrl.client.CoreV1().PersistentVolumes().Get(ctx, vol.PersistentVolumeName, metav1.GetOptions{})
+	if apiErrors.IsNotFound(err) {
+		vol.Status = xuanwuv1.FailoverVolumeFailed
+		vol.Message = fmt.Sprintf("persistent volume %s does not exist", vol.PersistentVolumeName)
+		if vol.TargetVolumeHandle != "" {
+			vol.Message = fmt.Sprintf("persistent volume %s is lost during rebinding, recreate it with "+
+				"volume handle %s manually", vol.PersistentVolumeName, vol.TargetVolumeHandle)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("get pv %s error: %w", vol.PersistentVolumeName, err)
+	}
+
+	if pv.Spec.CSI != nil && vol.TargetVolumeHandle != "" && pv.Spec.CSI.VolumeHandle == vol.TargetVolumeHandle {
+		vol.Status = xuanwuv1.FailoverVolumeCompleted
+		vol.Message = ""
+		return nil
+	}
+
+	parameters, err := ctrl.getStorageClassParameters(ctx, pv.Spec.StorageClassName)
+	if err != nil {
+		return err
+	}
+
+	request := &drcsi.ModifyVolumeRequest{
+		VolumeId:               vol.SourceVolumeHandle,
+		StorageClassParameters: parameters,
+		MutableParameters: map[string]string{
+			volume.FailoverActionKey:        string(failover.Spec.Action),
+			volume.FailoverTargetBackendKey: failover.Spec.TargetBackend,
+		},
+	}
+	log.AddContext(ctx).Infof("call modify interface start, failover:%s, request body: %+v", failover.Name, request)
+	response, err := ctrl.modifyClient.ModifyVolume(ctx, request)
+	if status.Code(err) == codes.Aborted {
+		return fmt.Errorf("%w: %s", errFailoverAborted, status.Convert(err).Message())
+	}
+	if err != nil {
+		return fmt.Errorf("call modify volume interface error: %w", err)
+	}
+	log.AddContext(ctx).Infof("call modify interface end, failover:%s, response body: %+v", failover.Name, response)
+
+	attributes := response.GetVolumeAttributes()
+	finished, err := strconv.ParseBool(attributes[volume.FailoverFinishedKey])
+	if err != nil || !finished {
+		vol.Status = xuanwuv1.FailoverVolumeSyncing
+		vol.Message = ""
+		return nil
+	}
+
+	vol.Status = xuanwuv1.FailoverVolumeRebinding
+	vol.TargetVolumeHandle = attributes[volume.FailoverVolumeIdKey]
+	if vol.TargetVolumeHandle == "" {
+		return fmt.Errorf("the volume id on backend %s is not returned", failover.Spec.TargetBackend)
+	}
+
+	vol.TargetPersistentVolume = buildTargetPersistentVolume(pv, vol.TargetVolumeHandle, attributes)
+	vol.Message = ""
+	return nil
+}
+
+// setFailoverFailed sets the failover which can never be finished to failed, so that it is not retried any more
+func (ctrl *VolumeFailoverController) setFailoverFailed(ctx context.Context,
+	failover *xuanwuv1.VolumeFailover, cause error) (*xuanwuv1.VolumeFailover, error) {
+	ctrl.eventRecorder.Event(failover, corev1.EventTypeWarning, FailoverFailedReason, cause.Error())
+	failoverClone := failover.DeepCopy()
+	failoverClone.Status.Phase = xuanwuv1.VolumeFailoverFailed
+	failoverClone.Status.CompletedAt = &metav1.Time{Time: time.Now().Local()}
+	log.AddContext(ctx).Infof("set failover %s to %s, error: %v", failover.Name, xuanwuv1.VolumeFailoverFailed, cause)
+	return ctrl.clientSet.XuanwuV1().VolumeFailovers().UpdateStatus(ctx, failoverClone, metav1.UpdateOptions{})
+}
+
+func (ctrl *VolumeFailoverController) getStorageClassParameters(ctx context.Context,
+	name string) (map[string]string, error) {
+	if name == "" {
+		return nil, nil
+	}
+
+	class, err := ctrl.client.StorageV1().StorageClasses().Get(ctx, name, metav1.GetOptions{})
+	if apiErrors.IsNotFound(err) {
+		log.AddContext(ctx).Warningf("storageclass %s does not exist, failover without its parameters", name)
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get storageclass %s error: %w", name, err)
+	}
+
+	return class.Parameters, nil
+}
+
+func generateReadyString(completed, total int) string {
+	return strconv.Itoa(completed) + "/" + strconv.Itoa(total)
+}
@@ -0,0 +1,344 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package failover
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	xuanwuv1 "github.com/Huawei/eSDK_K8S_Plugin/v4/client/apis/xuanwu/v1"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/lib/drcsi"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/client/clientset/versioned/fake"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/volume"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
+)
+
+const (
+	logName = "failoverTest.log"
+
+	testProvisioner = "csi.huawei.com"
+	testPVName      = "pvc-1"
+	testSCName      = "sc-1"
+)
+
+func TestMain(m *testing.M) {
+	log.MockInitLogging(logName)
+	defer log.MockStopLogging(logName)
+
+	m.Run()
+}
+
+type mockModifyClient struct {
+	modifyFunc func(ctx context.Context, in *drcsi.ModifyVolumeRequest) (*drcsi.ModifyVolumeResponse, error)
+}
+
+func (m *mockModifyClient) ModifyVolume(ctx context.Context, in *drcsi.ModifyVolumeRequest,
+	opts ...grpc.CallOption) (*drcsi.ModifyVolumeResponse, error) {
+	if m.modifyFunc != nil {
+		return m.modifyFunc(ctx, in)
+	}
+	return nil, nil
+}
+
+func newTestPV(name, scName, handle string) *corev1.PersistentVolume {
+	return &corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			Finalizers: []string{"kubernetes.io/pv-protection"},
+		},
+		Spec: corev1.PersistentVolumeSpec{
+			StorageClassName:              scName,
+			PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimDelete,
+			ClaimRef: &corev1.ObjectReference{
+				Namespace: "default", Name: "claim-" + name, UID: types.UID("uid-" + name), ResourceVersion: "10",
+			},
+			PersistentVolumeSource: corev1.PersistentVolumeSource{
+				CSI: &corev1.CSIPersistentVolumeSource{
+					Driver:           testProvisioner,
+					VolumeHandle:     handle,
+					VolumeAttributes: map[string]string{"backend": "backend-a", "lunWWN": "wwn-a"},
+				},
+			},
+		},
+		Status: corev1.PersistentVolumeStatus{Phase: corev1.VolumeBound},
+	}
+}
+
+func newTestFailover(phase xuanwuv1.VolumeFailoverPhase, volumes []xuanwuv1.FailoverVolume) *xuanwuv1.VolumeFailover {
+	return &xuanwuv1.VolumeFailover{
+		ObjectMeta: metav1.ObjectMeta{Name: "failover-1"},
+		Spec: xuanwuv1.VolumeFailoverSpec{
+			Action:        xuanwuv1.VolumeFailoverActionFailover,
+			Source:        &xuanwuv1.VolumeFailoverSpecSource{Kind: "StorageClass", Name: testSCName},
+			TargetBackend: "backend-b",
+		},
+		Status: xuanwuv1.VolumeFailoverStatus{Phase: phase, Volumes: volumes},
+	}
+}
+
+func newTestController(failover *xuanwuv1.VolumeFailover, modifyClient drcsi.ModifyVolumeInterfaceClient,
+	objects ...interface{}) *VolumeFailoverController {
+	k8sClient := k8sfake.NewSimpleClientset()
+	for _, obj := range objects {
+		switch o := obj.(type) {
+		case *corev1.PersistentVolume:
+			_, _ = k8sClient.CoreV1().PersistentVolumes().Create(context.Background(), o, metav1.CreateOptions{})
+		case *storagev1.StorageClass:
+			_, _ = k8sClient.StorageV1().StorageClasses().Create(context.Background(), o, metav1.CreateOptions{})
+		}
+	}
+
+	return &VolumeFailoverController{
+		client:         k8sClient,
+		clientSet:      fake.NewSimpleClientset(failover),
+		modifyClient:   modifyClient,
+		eventRecorder:  record.NewFakeRecorder(100),
+		failoverQueue:  workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+		reconcileDelay: defaultReconcileDelay,
+		provisioner:    testProvisioner,
+	}
+}
+
+func TestCollectFailoverVolumes_WithStorageClassSource(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	failover := newTestFailover(xuanwuv1.VolumeFailoverPending, nil)
+	otherPV := newTestPV("pvc-2", "sc-2", "backend-a.pvc-2")
+	unboundPV := newTestPV("pvc-3", testSCName, "backend-a.pvc-3")
+	unboundPV.Status.Phase = corev1.VolumeAvailable
+	ctrl := newTestController(failover, nil, newTestPV(testPVName, testSCName, "backend-a.pvc-1"),
+		otherPV, unboundPV)
+
+	// action
+	result, err := ctrl.collectFailoverVolumes(ctx, failover)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, xuanwuv1.VolumeFailoverRunning, result.Status.Phase)
+	assert.Equal(t, "0/1", result.Status.Ready)
+	assert.Equal(t, []xuanwuv1.FailoverVolume{{
+		PersistentVolumeName: testPVName,
+		SourceVolume:         "default/claim-pvc-1",
+		SourceVolumeHandle:   "backend-a.pvc-1",
+		Status:               xuanwuv1.FailoverVolumePending,
+	}}, result.Status.Volumes)
+}
+
+func TestCollectFailoverVolumes_WithInvalidSourceKind(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	failover := newTestFailover(xuanwuv1.VolumeFailoverPending, nil)
+	failover.Spec.Source.Kind = "Pod"
+	ctrl := newTestController(failover, nil)
+
+	// action
+	result, err := ctrl.collectFailoverVolumes(ctx, failover)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, xuanwuv1.VolumeFailoverFailed, result.Status.Phase)
+	assert.NotNil(t, result.Status.CompletedAt)
+}
+
+func TestSwitchFailoverVolumes_Completed(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	failover := newTestFailover(xuanwuv1.VolumeFailoverRunning, []xuanwuv1.FailoverVolume{{
+		PersistentVolumeName: testPVName,
+		SourceVolumeHandle:   "backend-a.pvc-1",
+		Status:               xuanwuv1.FailoverVolumePending,
+	}})
+	var request *drcsi.ModifyVolumeRequest
+	modifyClient := &mockModifyClient{
+		modifyFunc: func(ctx context.Context, in *drcsi.ModifyVolumeRequest) (*drcsi.ModifyVolumeResponse, error) {
+			request = in
+			return &drcsi.ModifyVolumeResponse{VolumeAttributes: map[string]string{
+				volume.FailoverFinishedKey: "true",
+				volume.FailoverVolumeIdKey: "backend-b.pvc-1",
+				"backend":                  "backend-b",
+				"lunWWN":                   "wwn-b",
+			}}, nil
+		},
+	}
+	sc := &storagev1.StorageClass{ObjectMeta: metav1.ObjectMeta{Name: testSCName},
+		Parameters: map[string]string{"volumeType": "lun"}}
+	ctrl := newTestController(failover, modifyClient, newTestPV(testPVName, testSCName, "backend-a.pvc-1"), sc)
+
+	// action
+	recorded, recordErr := ctrl.switchFailoverVolumes(ctx, failover)
+	result, err := ctrl.switchFailoverVolumes(ctx, recorded)
+
+	// assert
+	assert.NoError(t, recordErr)
+	assert.Equal(t, xuanwuv1.FailoverVolumeRebinding, recorded.Status.Volumes[0].Status)
+	assert.Equal(t, "backend-b.pvc-1", recorded.Status.Volumes[0].TargetPersistentVolume.Spec.CSI.VolumeHandle)
+	assert.NoError(t, err)
+	assert.Equal(t, xuanwuv1.VolumeFailoverCompleted, result.Status.Phase)
+	assert.Equal(t, "1/1", result.Status.Ready)
+	assert.Equal(t, xuanwuv1.FailoverVolumeCompleted, result.Status.Volumes[0].Status)
+	assert.Nil(t, result.Status.Volumes[0].TargetPersistentVolume)
+	assert.Equal(t, map[string]string{"volumeType": "lun"}, request.StorageClassParameters)
+	assert.Equal(t, "Failover", request.MutableParameters[volume.FailoverActionKey])
+	pv, err := ctrl.client.CoreV1().PersistentVolumes().Get(ctx, testPVName, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "backend-b.pvc-1", pv.Spec.CSI.VolumeHandle)
+	assert.Equal(t, map[string]string{"backend": "backend-b", "lunWWN": "wwn-b"}, pv.Spec.CSI.VolumeAttributes)
+	assert.Equal(t, corev1.PersistentVolumeReclaimDelete, pv.Spec.PersistentVolumeReclaimPolicy)
+	assert.Equal(t, types.UID("uid-pvc-1"), pv.Spec.ClaimRef.UID)
+}
+
+func TestSwitchFailoverVolumes_RecreateDeletedPersistentVolume(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	target := newTestPV(testPVName, testSCName, "backend-b.pvc-1")
+	failover := newTestFailover(xuanwuv1.VolumeFailoverRunning, []xuanwuv1.FailoverVolume{{
+		PersistentVolumeName:   testPVName,
+		SourceVolumeHandle:     "backend-a.pvc-1",
+		TargetVolumeHandle:     "backend-b.pvc-1",
+		Status:                 xuanwuv1.FailoverVolumeRebinding,
+		TargetPersistentVolume: target,
+	}})
+	modifyClient := &mockModifyClient{
+		modifyFunc: func(ctx context.Context, in *drcsi.ModifyVolumeRequest) (*drcsi.ModifyVolumeResponse, error) {
+			t.Fatal("the volume must not be switched again after the target pv is recorded")
+			return nil, nil
+		},
+	}
+	ctrl := newTestController(failover, modifyClient)
+
+	// action
+	result, err := ctrl.switchFailoverVolumes(ctx, failover)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, xuanwuv1.FailoverVolumeCompleted, result.Status.Volumes[0].Status)
+	pv, err := ctrl.client.CoreV1().PersistentVolumes().Get(ctx, testPVName, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "backend-b.pvc-1", pv.Spec.CSI.VolumeHandle)
+}
+
+func TestSwitchFailoverVolumes_Syncing(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	failover := newTestFailover(xuanwuv1.VolumeFailoverRunning, []xuanwuv1.FailoverVolume{{
+		PersistentVolumeName: testPVName,
+		SourceVolumeHandle:   "backend-b.pvc-1",
+		Status:               xuanwuv1.FailoverVolumePending,
+	}})
+	failover.Spec.Action = xuanwuv1.VolumeFailoverActionFailback
+	modifyClient := &mockModifyClient{
+		modifyFunc: func(ctx context.Context, in *drcsi.ModifyVolumeRequest) (*drcsi.ModifyVolumeResponse, error) {
+			return &drcsi.ModifyVolumeResponse{
+				VolumeAttributes: map[string]string{volume.FailoverFinishedKey: "false"}}, nil
+		},
+	}
+	ctrl := newTestController(failover, modifyClient, newTestPV(testPVName, testSCName, "backend-b.pvc-1"))
+
+	// action
+	result, err := ctrl.switchFailoverVolumes(ctx, failover)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, xuanwuv1.VolumeFailoverRunning, result.Status.Phase)
+	assert.Equal(t, "0/1", result.Status.Ready)
+	assert.Equal(t, xuanwuv1.FailoverVolumeSyncing, result.Status.Volumes[0].Status)
+	pv, err := ctrl.client.CoreV1().PersistentVolumes().Get(ctx, testPVName, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "backend-b.pvc-1", pv.Spec.CSI.VolumeHandle)
+}
+
+func TestSwitchFailoverVolumes_ModifyVolumeFailed(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	failover := newTestFailover(xuanwuv1.VolumeFailoverRunning, []xuanwuv1.FailoverVolume{{
+		PersistentVolumeName: testPVName,
+		SourceVolumeHandle:   "backend-a.pvc-1",
+		Status:               xuanwuv1.FailoverVolumePending,
+	}})
+	modifyClient := &mockModifyClient{
+		modifyFunc: func(ctx context.Context, in *drcsi.ModifyVolumeRequest) (*drcsi.ModifyVolumeResponse, error) {
+			return nil, errors.New("mock error")
+		},
+	}
+	ctrl := newTestController(failover, modifyClient, newTestPV(testPVName, testSCName, "backend-a.pvc-1"))
+
+	// action
+	result, err := ctrl.switchFailoverVolumes(ctx, failover)
+
+	// assert
+	assert.ErrorContains(t, err, "mock error")
+	assert.Equal(t, xuanwuv1.VolumeFailoverRunning, result.Status.Phase)
+	assert.Contains(t, result.Status.Volumes[0].Message, "mock error")
+}
+
+func TestSwitchFailoverVolumes_Aborted(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	failover := newTestFailover(xuanwuv1.VolumeFailoverRunning, []xuanwuv1.FailoverVolume{{
+		PersistentVolumeName: testPVName,
+		SourceVolumeHandle:   "backend-a.pvc-1",
+		Status:               xuanwuv1.FailoverVolumePending,
+	}})
+	modifyClient := &mockModifyClient{
+		modifyFunc: func(ctx context.Context, in *drcsi.ModifyVolumeRequest) (*drcsi.ModifyVolumeResponse, error) {
+			return nil, status.Error(codes.Aborted, "lun pvc-1 is not protected by remote replication or hypermetro")
+		},
+	}
+	ctrl := newTestController(failover, modifyClient, newTestPV(testPVName, testSCName, "backend-a.pvc-1"))
+
+	// action
+	result, err := ctrl.switchFailoverVolumes(ctx, failover)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, xuanwuv1.VolumeFailoverFailed, result.Status.Phase)
+	assert.Equal(t, "0/1", result.Status.Ready)
+	assert.Equal(t, xuanwuv1.FailoverVolumeFailed, result.Status.Volumes[0].Status)
+	assert.Contains(t, result.Status.Volumes[0].Message, "is not protected")
+}
+
+func TestSwitchFailoverVolumes_PersistentVolumeNotFound(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	failover := newTestFailover(xuanwuv1.VolumeFailoverRunning, []xuanwuv1.FailoverVolume{{
+		PersistentVolumeName: testPVName,
+		SourceVolumeHandle:   "backend-a.pvc-1",
+		Status:               xuanwuv1.FailoverVolumePending,
+	}})
+	ctrl := newTestController(failover, &mockModifyClient{})
+
+	// action
+	result, err := ctrl.switchFailoverVolumes(ctx, failover)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, xuanwuv1.VolumeFailoverFailed, result.Status.Phase)
+	assert.Equal(t, xuanwuv1.FailoverVolumeFailed, result.Status.Volumes[0].Status)
+}
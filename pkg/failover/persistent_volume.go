@@ -0,0 +1,43 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package failover contains VolumeFailover controller definitions and synchronization functions
+package failover
+
+import (
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	pkgUtils "github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/utils"
+)
+
+const failoverAttributePrefix = "failover"
+
+// buildTargetPersistentVolume builds the PersistentVolume with the volume handle on the target backend, the attributes
+// of the failover action are not kept in the PersistentVolume.
+func buildTargetPersistentVolume(pv *corev1.PersistentVolume, volumeHandle string,
+	attributes map[string]string) *corev1.PersistentVolume {
+	volumeAttributes := make(map[string]string, len(attributes))
+	for key, value := range attributes {
+		if strings.HasPrefix(key, failoverAttributePrefix) {
+			continue
+		}
+		volumeAttributes[key] = value
+	}
+
+	return pkgUtils.BuildRebindPersistentVolume(pv, volumeHandle, volumeAttributes)
+}
@@ -0,0 +1,252 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package migration contains VolumeMigration controller definitions and synchronization functions
+package migration
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	storageinformers "k8s.io/client-go/informers/storage/v1"
+	"k8s.io/client-go/kubernetes"
+	coreV1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	storagelisters "k8s.io/client-go/listers/storage/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	xuanwuv1 "github.com/Huawei/eSDK_K8S_Plugin/v4/client/apis/xuanwu/v1"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/lib/drcsi"
+	clientset "github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/client/clientset/versioned"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/client/clientset/versioned/scheme"
+	external "github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/client/informers/externalversions"
+	migrationinformers "github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/client/informers/externalversions/xuanwu/v1"
+	migrationlisters "github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/client/listers/xuanwu/v1"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/modify"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
+)
+
+const (
+	// defaultRetryMaxDelay is used when option function RetryMaxDelay is omitted
+	defaultRetryMaxDelay = 5 * time.Minute
+
+	// defaultRetryBaseDelay is used when option function RetryBaseDelay is omitted
+	defaultRetryBaseDelay = 5 * time.Second
+
+	// defaultReconcileDelay is used when option function ReconcileDelay is omitted
+	defaultReconcileDelay = 10 * time.Second
+
+	// defaultProvisioner is used when option function Provisioner is omitted
+	defaultProvisioner = "csi.huawei.com"
+
+	// defaultWorkerThreads is used when option function WorkerThreads is omitted
+	defaultWorkerThreads = 4
+
+	// migrationResource is used uniquely identifies migration work queue
+	migrationResource = "vmig"
+
+	// eventResourceName is used to record event
+	eventResourceName = "volume-migration-mgnt"
+)
+
+// VolumeMigrationController controller of volume migration
+type VolumeMigrationController struct {
+	clientSet            clientset.Interface
+	client               kubernetes.Interface
+	modifyClient         drcsi.ModifyVolumeInterfaceClient
+	eventRecorder        record.EventRecorder
+	migrationQueue       workqueue.RateLimitingInterface
+	migrationInformer    migrationinformers.VolumeMigrationInformer
+	migrationLister      migrationlisters.VolumeMigrationLister
+	migrationListerSync  cache.InformerSynced
+	attachmentLister     storagelisters.VolumeAttachmentLister
+	attachmentListerSync cache.InformerSynced
+	migrationWorker      *modify.ObjectWorker
+	retryMaxDelay        time.Duration
+	retryBaseDelay       time.Duration
+	reconcileDelay       time.Duration
+	workerThreads        int
+	provisioner          string
+}
+
+// NewVolumeMigrationController instance a controller
+func NewVolumeMigrationController(ctx context.Context, client kubernetes.Interface,
+	clientSet clientset.Interface, factory external.SharedInformerFactory,
+	options ...func(controller *VolumeMigrationController)) *VolumeMigrationController {
+	ctr := &VolumeMigrationController{
+		client:         client,
+		clientSet:      clientSet,
+		retryBaseDelay: defaultRetryBaseDelay,
+		retryMaxDelay:  defaultRetryMaxDelay,
+		reconcileDelay: defaultReconcileDelay,
+		workerThreads:  defaultWorkerThreads,
+		provisioner:    defaultProvisioner,
+	}
+
+	// add custom options
+	for _, option := range options {
+		option(ctr)
+	}
+
+	broadcaster := record.NewBroadcaster()
+	broadcaster.StartRecordingToSink(&coreV1.EventSinkImpl{Interface: client.CoreV1().Events(v1.NamespaceAll)})
+	ctr.eventRecorder = broadcaster.NewRecorder(scheme.Scheme, v1.EventSource{Component: eventResourceName})
+	ctr.migrationInformer = factory.Xuanwu().V1().VolumeMigrations()
+	ctr.migrationLister = ctr.migrationInformer.Lister()
+	ctr.migrationListerSync = ctr.migrationInformer.Informer().HasSynced
+	rateLimiter := workqueue.NewItemExponentialFailureRateLimiter(ctr.retryBaseDelay, ctr.retryMaxDelay)
+	queueConfig := workqueue.RateLimitingQueueConfig{Name: migrationResource}
+	ctr.migrationQueue = workqueue.NewRateLimitingQueueWithConfig(rateLimiter, queueConfig)
+
+	// add event handler
+	ctr.AddMigrationHandler(ctx)
+
+	// add workers
+	ctr.migrationWorker = modify.NewObjectWorker(migrationResource, ctr.migrationQueue,
+		modify.SyncFunc(ctr.syncMigrationWork))
+	return ctr
+}
+
+// Run will sync informer caches and starting workers. It will block until stopCh is closed
+func (ctrl *VolumeMigrationController) Run(ctx context.Context, stopCh <-chan struct{}) {
+	defer ctrl.migrationQueue.ShutDown()
+
+	log.AddContext(ctx).Infoln("starting volume migration controller")
+	defer log.AddContext(ctx).Infoln("shutting down volume migration controller")
+
+	if !cache.WaitForCacheSync(stopCh, ctrl.migrationListerSync, ctrl.attachmentListerSync) {
+		log.AddContext(ctx).Errorln("cannot sync caches")
+		return
+	}
+
+	log.AddContext(ctx).Infoln("starting workers")
+	for i := 0; i < ctrl.workerThreads; i++ {
+		go wait.Until(func() { ctrl.migrationWorker.Run(ctx) }, time.Second, stopCh)
+	}
+
+	log.AddContext(ctx).Infoln("started workers")
+	defer log.AddContext(ctx).Infoln("shutting down workers")
+	if stopCh != nil {
+		sign := <-stopCh
+		log.AddContext(ctx).Infof("volume migration controller exited, reason: [%v]", sign)
+	}
+}
+
+// AddMigrationHandler add migration event handler
+func (ctrl *VolumeMigrationController) AddMigrationHandler(
+	ctx context.Context) *VolumeMigrationController {
+	_, err := ctrl.migrationInformer.Informer().AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) { ctrl.enqueueMigration(obj) },
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				if !isStatusRefreshed(oldObj, newObj) {
+					ctrl.enqueueMigration(newObj)
+				}
+			},
+		},
+	)
+	if err != nil {
+		log.AddContext(ctx).Errorf("Add migration event handler failed, error: %v", err)
+	}
+	return ctrl
+}
+
+func (ctrl *VolumeMigrationController) enqueueMigration(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+	if migration, ok := obj.(*xuanwuv1.VolumeMigration); ok {
+		objName, err := cache.DeletionHandlingMetaNamespaceKeyFunc(migration)
+		if err != nil {
+			log.Errorf("failed to get migration key from object [%v] err: [%v]", migration, err)
+			return
+		}
+		log.Infof("enqueued migration [%v] for sync", objName)
+		ctrl.migrationQueue.Add(objName)
+	}
+}
+
+// isStatusRefreshed checks whether the update only refreshes the status in the same phase, such as the progress.
+// The work of this case has been delayed by the worker, so it is not enqueued again.
+func isStatusRefreshed(oldObj, newObj interface{}) bool {
+	oldMigration, ok := oldObj.(*xuanwuv1.VolumeMigration)
+	if !ok {
+		return false
+	}
+	newMigration, ok := newObj.(*xuanwuv1.VolumeMigration)
+	if !ok {
+		return false
+	}
+
+	return oldMigration.ResourceVersion != newMigration.ResourceVersion &&
+		oldMigration.Generation == newMigration.Generation &&
+		oldMigration.DeletionTimestamp.Equal(newMigration.DeletionTimestamp) &&
+		oldMigration.Status.Phase == newMigration.Status.Phase
+}
+
+// WorkerThreads used to configure the number of working threads.
+func WorkerThreads(workerThreads int) func(controller *VolumeMigrationController) {
+	return func(ctr *VolumeMigrationController) {
+		ctr.workerThreads = workerThreads
+	}
+}
+
+// RetryMaxDelay used to configure the max interval of retry.
+func RetryMaxDelay(retryMaxDelay time.Duration) func(controller *VolumeMigrationController) {
+	return func(ctr *VolumeMigrationController) {
+		ctr.retryMaxDelay = retryMaxDelay
+	}
+}
+
+// RetryBaseDelay used to configure the start interval of retry.
+func RetryBaseDelay(retryBaseDelay time.Duration) func(controller *VolumeMigrationController) {
+	return func(ctr *VolumeMigrationController) {
+		ctr.retryBaseDelay = retryBaseDelay
+	}
+}
+
+// ReconcileDelay used to configure the interval of checking the progress of migration.
+func ReconcileDelay(reconcileDelay time.Duration) func(controller *VolumeMigrationController) {
+	return func(ctr *VolumeMigrationController) {
+		ctr.reconcileDelay = reconcileDelay
+	}
+}
+
+// Provisioner used to configure the driver name.
+func Provisioner(provisioner string) func(controller *VolumeMigrationController) {
+	return func(ctr *VolumeMigrationController) {
+		ctr.provisioner = provisioner
+	}
+}
+
+// AttachmentInformer used to configure the informer of VolumeAttachments.
+func AttachmentInformer(informer storageinformers.VolumeAttachmentInformer) func(
+	controller *VolumeMigrationController) {
+	return func(ctr *VolumeMigrationController) {
+		ctr.attachmentLister = informer.Lister()
+		ctr.attachmentListerSync = informer.Informer().HasSynced
+	}
+}
+
+// ClientOfModify used to configure the modify client.
+func ClientOfModify(modifyClient drcsi.ModifyVolumeInterfaceClient) func(controller *VolumeMigrationController) {
+	return func(ctr *VolumeMigrationController) {
+		ctr.modifyClient = modifyClient
+	}
+}
@@ -0,0 +1,491 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package migration contains VolumeMigration controller definitions and synchronization functions
+package migration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xuanwuv1 "github.com/Huawei/eSDK_K8S_Plugin/v4/client/apis/xuanwu/v1"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/lib/drcsi"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/finalizers"
+	pkgutils "github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/utils"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/volume"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
+)
+
+const (
+	// StartMigrationReason reason of migration is started
+	StartMigrationReason = "MigrationStarted"
+
+	// WaitDetachReason reason of migration is waiting for the volume to be detached
+	WaitDetachReason = "WaitingForDetach"
+
+	// MigrationFailedReason reason of migration failed
+	MigrationFailedReason = "MigrationFailed"
+
+	// MigrationCompletedReason reason of migration completed
+	MigrationCompletedReason = "MigrationCompleted"
+
+	// ProtectMigrationFinalizer is added to the migration which is not completed or failed, so that the objects
+	// created on storage and the publish fence are released before the migration is deleted
+	ProtectMigrationFinalizer = "xuanwu.huawei.io/volumemigration-protection"
+)
+
+var (
+	// errMigrationAborted means the migration is at fault on storage and can never be finished, so it is not retried
+	errMigrationAborted = errors.New("migration aborted on storage")
+	// errMigrationCutOver means the volume has been cut over on storage, so the migration can only be completed
+	errMigrationCutOver = errors.New("migration cut over on storage")
+)
+
+// migrationResult is the response of the provider to start the migration or to query the progress of it
+type migrationResult struct {
+	started      bool
+	progress     int
+	finished     bool
+	volumeHandle string
+	attributes   map[string]string
+}
+
+func (ctrl *VolumeMigrationController) syncMigrationWork(ctx context.Context, name string) error {
+	log.AddContext(ctx).Debugf("start sync VolumeMigration: %s", name)
+	defer log.AddContext(ctx).Debugf("finish sync VolumeMigration: %s", name)
+	migration, err := ctrl.migrationLister.Get(name)
+	if err != nil {
+		if apiErrors.IsNotFound(err) {
+			log.AddContext(ctx).Infof("migration:%s is no longer exists, end this work", name)
+			return nil
+		}
+		return fmt.Errorf("get migration:%s from the indexer cache error: %w", name, err)
+	}
+
+	if migration.DeletionTimestamp != nil {
+		return ctrl.syncDeleteMigration(ctx, migration)
+	}
+
+	syncFunctions := []func(context.Context, *xuanwuv1.VolumeMigration) (*xuanwuv1.VolumeMigration, error){
+		ctrl.setMigrationFinalizer,
+		ctrl.setMigrationPending,
+		ctrl.startMigration,
+		ctrl.waitMigration,
+	}
+	for _, syncFunction := range syncFunctions {
+		if migration, err = syncFunction(ctx, migration); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ctrl *VolumeMigrationController) setMigrationFinalizer(ctx context.Context,
+	migration *xuanwuv1.VolumeMigration) (*xuanwuv1.VolumeMigration, error) {
+	if isMigrationFinished(migration) || finalizers.ContainsFinalizer(migration, ProtectMigrationFinalizer) {
+		return migration, nil
+	}
+
+	migrationClone := migration.DeepCopy()
+	finalizers.SetFinalizer(migrationClone, ProtectMigrationFinalizer)
+	updated, err := ctrl.clientSet.XuanwuV1().VolumeMigrations().Update(ctx, migrationClone, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("add finalizer to migration %s error: %w", migration.Name, err)
+	}
+
+	return updated, nil
+}
+
+// syncDeleteMigration rolls back the migration which is deleted before it is completed or failed. The objects created
+// on storage and the publish fence are released before the finalizer is removed, otherwise they are left on storage
+// and the volume could never be published again. The migration which has been cut over on storage is completed
+// instead, because the PersistentVolume must be rebound to the volume handle on the target backend.
+func (ctrl *VolumeMigrationController) syncDeleteMigration(ctx context.Context,
+	migration *xuanwuv1.VolumeMigration) error {
+	if !finalizers.ContainsFinalizer(migration, ProtectMigrationFinalizer) {
+		return nil
+	}
+
+	if !isMigrationFinished(migration) {
+		err := ctrl.rollbackMigration(ctx, migration)
+		if errors.Is(err, errMigrationCutOver) && migration.Status.Phase == xuanwuv1.VolumeMigrationRunning {
+			log.AddContext(ctx).Infof("migration %s is cut over on storage, complete it before deletion",
+				migration.Name)
+			updated, err := ctrl.waitMigration(ctx, migration)
+			if err != nil || updated.Status.Phase != xuanwuv1.VolumeMigrationCompleted {
+				return err
+			}
+			migration = updated
+		} else if err != nil {
+			_, err = ctrl.setMigrationMessage(ctx, migration.DeepCopy(), err)
+			return err
+		}
+
+		if err = ctrl.removePublishFence(ctx, migration); err != nil {
+			return err
+		}
+	}
+
+	migrationClone := migration.DeepCopy()
+	finalizers.RemoveFinalizer(migrationClone, ProtectMigrationFinalizer)
+	_, err := ctrl.clientSet.XuanwuV1().VolumeMigrations().Update(ctx, migrationClone, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("remove finalizer of migration %s error: %w", migration.Name, err)
+	}
+
+	log.AddContext(ctx).Infof("migration %s is released for deletion", migration.Name)
+	return nil
+}
+
+func (ctrl *VolumeMigrationController) setMigrationPending(ctx context.Context,
+	migration *xuanwuv1.VolumeMigration) (*xuanwuv1.VolumeMigration, error) {
+	if migration.Status.Phase != "" {
+		return migration, nil
+	}
+
+	defer log.AddContext(ctx).Infof("set migration %s to %s", migration.Name, xuanwuv1.VolumeMigrationPending)
+	migrationClone := migration.DeepCopy()
+	migrationClone.Status.Phase = xuanwuv1.VolumeMigrationPending
+	return ctrl.updateStatus(ctx, migrationClone)
+}
+
+// startMigration starts the migration on storage. The volume migrated to another backend is copied offline,
+// so it is started once the volume is detached from all nodes. The publish fence is recorded on the PersistentVolume
+// before checking the attachments, so that the volume is not published again by the driver since then, and the
+// PersistentVolume is recorded in the status before, so that the fence can be removed if the migration is deleted.
+func (ctrl *VolumeMigrationController) startMigration(ctx context.Context,
+	migration *xuanwuv1.VolumeMigration) (*xuanwuv1.VolumeMigration, error) {
+	if migration.Status.Phase != xuanwuv1.VolumeMigrationPending {
+		return migration, nil
+	}
+
+	migrationClone := migration.DeepCopy()
+	pv, err := ctrl.getMigrationSource(ctx, migration.Spec.PersistentVolumeClaim)
+	if errors.Is(err, errInvalidSource) {
+		return ctrl.setMigrationFailed(ctx, migrationClone, err)
+	}
+	if err != nil {
+		return ctrl.setMigrationMessage(ctx, migrationClone, err)
+	}
+	migrationClone.Status.PersistentVolumeName = pv.Name
+	migrationClone.Status.SourceVolumeHandle = pv.Spec.CSI.VolumeHandle
+
+	if pkgutils.IsCrossBackendMigration(migration, pv.Spec.CSI.VolumeHandle) {
+		if migration.Status.SourceVolumeHandle == "" {
+			if migrationClone, err = ctrl.updateStatus(ctx, migrationClone); err != nil {
+				return nil, err
+			}
+		}
+
+		err = pkgutils.SetPublishFence(ctx, ctrl.client, pv.Name, publishFenceOwner(migration))
+		if err != nil {
+			return ctrl.setMigrationMessage(ctx, migrationClone, err)
+		}
+
+		node, err := pkgutils.GetAttachedNode(ctrl.attachmentLister, pv.Name, false)
+		if err != nil {
+			return ctrl.setMigrationMessage(ctx, migrationClone, err)
+		}
+		if node != "" {
+			return ctrl.waitForDetach(ctx, migration, migrationClone, node)
+		}
+	}
+
+	result, err := ctrl.modifyMigration(ctx, migrationClone, false)
+	if errors.Is(err, errMigrationAborted) {
+		return ctrl.setMigrationFailed(ctx, migrationClone, err)
+	}
+	if err != nil {
+		return ctrl.setMigrationMessage(ctx, migrationClone, err)
+	}
+	if !result.started {
+		return ctrl.setMigrationMessage(ctx, migrationClone, errors.New("the migration is not started on storage"))
+	}
+
+	ctrl.eventRecorder.Event(migration, corev1.EventTypeNormal, StartMigrationReason,
+		fmt.Sprintf("migrate pv %s to pool %s", pv.Name, migration.Spec.TargetPool))
+	migrationClone.Status.Phase = xuanwuv1.VolumeMigrationRunning
+	migrationClone.Status.CopyStarted = true
+	migrationClone.Status.Progress = generateProgressString(result.progress)
+	migrationClone.Status.Message = ""
+	migrationClone.Status.StartedAt = &metav1.Time{Time: time.Now().Local()}
+	log.AddContext(ctx).Infof("set migration %s to %s", migration.Name, xuanwuv1.VolumeMigrationRunning)
+	return ctrl.updateStatus(ctx, migrationClone)
+}
+
+func (ctrl *VolumeMigrationController) waitForDetach(ctx context.Context, migration,
+	migrationClone *xuanwuv1.VolumeMigration, node string) (*xuanwuv1.VolumeMigration, error) {
+	msg := fmt.Sprintf("pv %s is attached to node %s, stop the workloads using it to start the migration",
+		migrationClone.Status.PersistentVolumeName, node)
+	if migration.Status.Message != msg {
+		ctrl.eventRecorder.Event(migration, corev1.EventTypeWarning, WaitDetachReason, msg)
+	}
+	migrationClone.Status.Message = msg
+	updated, err := ctrl.updateStatus(ctx, migrationClone)
+	if err != nil {
+		return nil, err
+	}
+
+	ctrl.migrationQueue.AddAfter(migration.Name, ctrl.reconcileDelay)
+	return updated, nil
+}
+
+// waitMigration refreshes the progress of the running migration until it is finished on storage,
+// then the PersistentVolume is rebound to the volume handle on the target backend. The storage is allowed to
+// delete the source volume only after the start of the migration has been recorded in the status.
+func (ctrl *VolumeMigrationController) waitMigration(ctx context.Context,
+	migration *xuanwuv1.VolumeMigration) (*xuanwuv1.VolumeMigration, error) {
+	if migration.Status.Phase != xuanwuv1.VolumeMigrationRunning {
+		return migration, nil
+	}
+
+	// give the storage a while to create the migration task after the migration is started
+	if migration.Status.StartedAt != nil {
+		if elapsed := time.Since(migration.Status.StartedAt.Time); elapsed < ctrl.reconcileDelay {
+			ctrl.migrationQueue.AddAfter(migration.Name, ctrl.reconcileDelay-elapsed)
+			return migration, nil
+		}
+	}
+
+	migrationClone := migration.DeepCopy()
+	if pkgutils.IsCrossBackendMigration(migration, migration.Status.SourceVolumeHandle) {
+		// the source volume must not be replaced if it has been written by any node during the copy
+		node, err := pkgutils.GetAttachedNode(ctrl.attachmentLister, migration.Status.PersistentVolumeName, true)
+		if err != nil {
+			return ctrl.setMigrationMessage(ctx, migrationClone, err)
+		}
+		if node != "" {
+			return ctrl.setMigrationFailed(ctx, migrationClone, fmt.Errorf("pv %s is attached to node %s "+
+				"during the migration, the migration is aborted and the source volume is kept",
+				migration.Status.PersistentVolumeName, node))
+		}
+	}
+
+	result, err := ctrl.modifyMigration(ctx, migrationClone, migration.Status.CopyStarted)
+	if errors.Is(err, errMigrationAborted) {
+		return ctrl.setMigrationFailed(ctx, migrationClone, err)
+	}
+	if err != nil {
+		return ctrl.setMigrationMessage(ctx, migrationClone, err)
+	}
+
+	migrationClone.Status.CopyStarted = migration.Status.CopyStarted || result.started
+	migrationClone.Status.Progress = generateProgressString(result.progress)
+	migrationClone.Status.Message = ""
+	if result.finished {
+		if result.volumeHandle == "" {
+			err = errors.New("the volume id after the migration is not returned")
+			return ctrl.setMigrationMessage(ctx, migrationClone, err)
+		}
+
+		if migrationClone.Status.TargetPersistentVolume == nil {
+			target, err := ctrl.buildTargetPersistentVolume(ctx, migration.Status.PersistentVolumeName,
+				result.volumeHandle, result.attributes)
+			if err != nil {
+				return ctrl.setMigrationMessage(ctx, migrationClone, err)
+			}
+
+			// the target is recorded before the PersistentVolume is deleted, so it can be created by the retry
+			migrationClone.Status.TargetVolumeHandle = result.volumeHandle
+			migrationClone.Status.TargetPersistentVolume = target
+			if migrationClone, err = ctrl.updateStatus(ctx, migrationClone); err != nil {
+				return nil, err
+			}
+		}
+
+		err = pkgutils.RecreatePersistentVolume(ctx, ctrl.client, migrationClone.Status.TargetPersistentVolume)
+		if err != nil {
+			return ctrl.setMigrationMessage(ctx, migrationClone, err)
+		}
+		if err = ctrl.removePublishFence(ctx, migrationClone); err != nil {
+			return ctrl.setMigrationMessage(ctx, migrationClone, err)
+		}
+
+		migrationClone.Status.TargetPersistentVolume = nil
+		migrationClone.Status.Phase = xuanwuv1.VolumeMigrationCompleted
+		migrationClone.Status.CompletedAt = &metav1.Time{Time: time.Now().Local()}
+		ctrl.eventRecorder.Event(migration, corev1.EventTypeNormal, MigrationCompletedReason,
+			fmt.Sprintf("migrate pv %s to pool %s completed, volume handle: %s",
+				migration.Status.PersistentVolumeName, migration.Spec.TargetPool, result.volumeHandle))
+		log.AddContext(ctx).Infof("set migration %s to %s", migration.Name, xuanwuv1.VolumeMigrationCompleted)
+	}
+
+	updated, err := ctrl.updateStatus(ctx, migrationClone)
+	if err != nil {
+		return nil, err
+	}
+
+	if !result.finished {
+		ctrl.migrationQueue.AddAfter(migration.Name, ctrl.reconcileDelay)
+	}
+	return updated, nil
+}
+
+// modifyMigration calls the provider to start the migration or to query the progress of it. The error wraps
+// errMigrationAborted if the provider reports that the migration can never be finished.
+func (ctrl *VolumeMigrationController) modifyMigration(ctx context.Context,
+	migration *xuanwuv1.VolumeMigration, started bool) (*migrationResult, error) {
+	request := &drcsi.ModifyVolumeRequest{
+		VolumeId: migration.Status.SourceVolumeHandle,
+		MutableParameters: map[string]string{
+			volume.MigrationTargetBackendKey: migration.Spec.TargetBackend,
+			volume.MigrationTargetPoolKey:    migration.Spec.TargetPool,
+			volume.MigrationStartedKey:       strconv.FormatBool(started),
+		},
+	}
+	log.AddContext(ctx).Infof("call modify interface start, migration:%s, request body: %+v", migration.Name, request)
+	response, err := ctrl.modifyClient.ModifyVolume(ctx, request)
+	if status.Code(err) == codes.Aborted {
+		return nil, fmt.Errorf("%w: %s", errMigrationAborted, status.Convert(err).Message())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("call modify volume interface error: %w", err)
+	}
+	log.AddContext(ctx).Infof("call modify interface end, migration:%s, response body: %+v", migration.Name, response)
+
+	attributes := response.GetVolumeAttributes()
+	copyStarted, err := strconv.ParseBool(attributes[volume.MigrationStartedKey])
+	if err != nil {
+		copyStarted = false
+	}
+	progress, err := strconv.Atoi(attributes[volume.MigrationProgressKey])
+	if err != nil {
+		progress = 0
+	}
+	finished, err := strconv.ParseBool(attributes[volume.MigrationFinishedKey])
+	if err != nil {
+		finished = false
+	}
+
+	return &migrationResult{
+		started:      copyStarted,
+		progress:     progress,
+		finished:     finished,
+		volumeHandle: attributes[volume.MigrationVolumeIdKey],
+		attributes:   attributes,
+	}, nil
+}
+
+// rollbackMigration calls the provider to delete the objects created on storage by the migration, the error wraps
+// errMigrationCutOver if the provider reports that the volume has been cut over to the target.
+func (ctrl *VolumeMigrationController) rollbackMigration(ctx context.Context,
+	migration *xuanwuv1.VolumeMigration) error {
+	if migration.Status.SourceVolumeHandle == "" {
+		// the migration is never started on storage before the source volume is recorded
+		return nil
+	}
+
+	request := &drcsi.ModifyVolumeRequest{
+		VolumeId: migration.Status.SourceVolumeHandle,
+		MutableParameters: map[string]string{
+			volume.MigrationTargetBackendKey: migration.Spec.TargetBackend,
+			volume.MigrationTargetPoolKey:    migration.Spec.TargetPool,
+			volume.MigrationRollbackKey:      strconv.FormatBool(true),
+		},
+	}
+	log.AddContext(ctx).Infof("call modify interface to roll back migration:%s, request body: %+v",
+		migration.Name, request)
+	_, err := ctrl.modifyClient.ModifyVolume(ctx, request)
+	if status.Code(err) == codes.FailedPrecondition {
+		return fmt.Errorf("%w: %s", errMigrationCutOver, status.Convert(err).Message())
+	}
+	if err != nil {
+		return fmt.Errorf("roll back migration on storage error: %w", err)
+	}
+
+	return nil
+}
+
+// setMigrationMessage records the error in the status, and returns the error so that the work is retried.
+func (ctrl *VolumeMigrationController) setMigrationMessage(ctx context.Context,
+	migration *xuanwuv1.VolumeMigration, cause error) (*xuanwuv1.VolumeMigration, error) {
+	log.AddContext(ctx).Errorf("migration %s error: %v", migration.Name, cause)
+	migration.Status.Message = cause.Error()
+	if _, err := ctrl.updateStatus(ctx, migration); err != nil {
+		return nil, errors.Join(cause, err)
+	}
+
+	return nil, cause
+}
+
+// setMigrationFailed rolls back the migration on storage and removes the publish fence before the migration is set
+// to failed, the error is recorded and retried if they can not be released.
+func (ctrl *VolumeMigrationController) setMigrationFailed(ctx context.Context,
+	migration *xuanwuv1.VolumeMigration, cause error) (*xuanwuv1.VolumeMigration, error) {
+	log.AddContext(ctx).Errorf("migration %s failed: %v", migration.Name, cause)
+	err := ctrl.rollbackMigration(ctx, migration)
+	if errors.Is(err, errMigrationCutOver) {
+		// the objects on storage hold the data of the volume since the cutover, so they are kept
+		cause = errors.Join(cause, err)
+	} else if err != nil {
+		return ctrl.setMigrationMessage(ctx, migration, errors.Join(cause, err))
+	}
+
+	if err = ctrl.removePublishFence(ctx, migration); err != nil {
+		return ctrl.setMigrationMessage(ctx, migration, errors.Join(cause, err))
+	}
+
+	ctrl.eventRecorder.Event(migration, corev1.EventTypeWarning, MigrationFailedReason, cause.Error())
+	migration.Status.Phase = xuanwuv1.VolumeMigrationFailed
+	migration.Status.Message = cause.Error()
+	migration.Status.CompletedAt = &metav1.Time{Time: time.Now().Local()}
+	return ctrl.updateStatus(ctx, migration)
+}
+
+// removePublishFence removes the publish fence recorded on the PersistentVolume by the cross-backend migration
+func (ctrl *VolumeMigrationController) removePublishFence(ctx context.Context,
+	migration *xuanwuv1.VolumeMigration) error {
+	if migration.Status.PersistentVolumeName == "" ||
+		!pkgutils.IsCrossBackendMigration(migration, migration.Status.SourceVolumeHandle) {
+		return nil
+	}
+
+	return pkgutils.RemovePublishFence(ctx, ctrl.client, migration.Status.PersistentVolumeName,
+		publishFenceOwner(migration))
+}
+
+func (ctrl *VolumeMigrationController) updateStatus(ctx context.Context,
+	migration *xuanwuv1.VolumeMigration) (*xuanwuv1.VolumeMigration, error) {
+	updated, err := ctrl.clientSet.XuanwuV1().VolumeMigrations().UpdateStatus(ctx, migration,
+		metav1.UpdateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("update status of migration %s error: %w", migration.Name, err)
+	}
+
+	return updated, nil
+}
+
+func isMigrationFinished(migration *xuanwuv1.VolumeMigration) bool {
+	return migration.Status.Phase == xuanwuv1.VolumeMigrationCompleted ||
+		migration.Status.Phase == xuanwuv1.VolumeMigrationFailed
+}
+
+func publishFenceOwner(migration *xuanwuv1.VolumeMigration) string {
+	return pkgutils.PublishFenceOwner("VolumeMigration", migration.Name)
+}
+
+func generateProgressString(progress int) string {
+	return strconv.Itoa(progress) + "%"
+}
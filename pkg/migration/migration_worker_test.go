@@ -0,0 +1,624 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package migration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	storagelisters "k8s.io/client-go/listers/storage/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	xuanwuv1 "github.com/Huawei/eSDK_K8S_Plugin/v4/client/apis/xuanwu/v1"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/lib/drcsi"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/client/clientset/versioned/fake"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/volume"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
+)
+
+const (
+	logName = "migrationTest.log"
+
+	testProvisioner        = "csi.huawei.com"
+	testPVName             = "pvc-1"
+	testVolumeHandle       = "backend-a.pvc-1"
+	testTargetVolumeHandle = "backend-b.pvc-1"
+	testFenceOwner         = "VolumeMigration/migration-1"
+)
+
+func TestMain(m *testing.M) {
+	log.MockInitLogging(logName)
+	defer log.MockStopLogging(logName)
+
+	m.Run()
+}
+
+type mockModifyClient struct {
+	modifyFunc func(ctx context.Context, in *drcsi.ModifyVolumeRequest) (*drcsi.ModifyVolumeResponse, error)
+}
+
+func (m *mockModifyClient) ModifyVolume(ctx context.Context, in *drcsi.ModifyVolumeRequest,
+	opts ...grpc.CallOption) (*drcsi.ModifyVolumeResponse, error) {
+	if m.modifyFunc != nil {
+		return m.modifyFunc(ctx, in)
+	}
+	return nil, nil
+}
+
+func newTestMigration(phase xuanwuv1.VolumeMigrationPhase, targetBackend string) *xuanwuv1.VolumeMigration {
+	return &xuanwuv1.VolumeMigration{
+		ObjectMeta: metav1.ObjectMeta{Name: "migration-1"},
+		Spec: xuanwuv1.VolumeMigrationSpec{
+			PersistentVolumeClaim: xuanwuv1.VolumeMigrationSource{Namespace: "default", Name: "claim-1"},
+			TargetBackend:         targetBackend,
+			TargetPool:            "pool2",
+		},
+		Status: xuanwuv1.VolumeMigrationStatus{Phase: phase},
+	}
+}
+
+func newTestAttachment() *storagev1.VolumeAttachment {
+	pvName := testPVName
+	return &storagev1.VolumeAttachment{
+		ObjectMeta: metav1.ObjectMeta{Name: "csi-1"},
+		Spec: storagev1.VolumeAttachmentSpec{
+			Attacher: testProvisioner,
+			NodeName: "node-1",
+			Source:   storagev1.VolumeAttachmentSource{PersistentVolumeName: &pvName},
+		},
+	}
+}
+
+func newTestController(migration *xuanwuv1.VolumeMigration, modifyClient drcsi.ModifyVolumeInterfaceClient,
+	objects ...runtime.Object) *VolumeMigrationController {
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "claim-1"},
+		Spec:       corev1.PersistentVolumeClaimSpec{VolumeName: testPVName},
+	}
+	pv := &corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: testPVName},
+		Spec: corev1.PersistentVolumeSpec{
+			PersistentVolumeSource: corev1.PersistentVolumeSource{
+				CSI: &corev1.CSIPersistentVolumeSource{Driver: testProvisioner, VolumeHandle: testVolumeHandle,
+					VolumeAttributes: map[string]string{"backend": "backend-a", "name": testPVName}},
+			},
+			PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimDelete,
+			ClaimRef:                      &corev1.ObjectReference{Namespace: "default", Name: "claim-1"},
+		},
+	}
+
+	attachments := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, object := range objects {
+		if attachment, ok := object.(*storagev1.VolumeAttachment); ok {
+			_ = attachments.Add(attachment)
+		}
+	}
+
+	return &VolumeMigrationController{
+		client:           k8sfake.NewSimpleClientset(append(objects, pvc, pv)...),
+		clientSet:        fake.NewSimpleClientset(migration),
+		modifyClient:     modifyClient,
+		eventRecorder:    record.NewFakeRecorder(100),
+		migrationQueue:   workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+		attachmentLister: storagelisters.NewVolumeAttachmentLister(attachments),
+		reconcileDelay:   defaultReconcileDelay,
+		provisioner:      testProvisioner,
+	}
+}
+
+func setTestPublishFence(t *testing.T, ctrl *VolumeMigrationController, owner string) {
+	pv, err := ctrl.client.CoreV1().PersistentVolumes().Get(context.Background(), testPVName, metav1.GetOptions{})
+	assert.NoError(t, err)
+	pv.Annotations = map[string]string{constants.PublishFenceAnnotationKey: owner}
+	_, err = ctrl.client.CoreV1().PersistentVolumes().Update(context.Background(), pv, metav1.UpdateOptions{})
+	assert.NoError(t, err)
+}
+
+func getTestPublishFence(t *testing.T, ctrl *VolumeMigrationController) string {
+	pv, err := ctrl.client.CoreV1().PersistentVolumes().Get(context.Background(), testPVName, metav1.GetOptions{})
+	assert.NoError(t, err)
+	return pv.Annotations[constants.PublishFenceAnnotationKey]
+}
+
+func TestStartMigration_SameBackendAttached(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	migration := newTestMigration(xuanwuv1.VolumeMigrationPending, "")
+	var request *drcsi.ModifyVolumeRequest
+	modifyClient := &mockModifyClient{
+		modifyFunc: func(ctx context.Context, in *drcsi.ModifyVolumeRequest) (*drcsi.ModifyVolumeResponse, error) {
+			request = in
+			return &drcsi.ModifyVolumeResponse{VolumeAttributes: map[string]string{
+				volume.MigrationStartedKey:  "true",
+				volume.MigrationProgressKey: "0",
+				volume.MigrationFinishedKey: "false",
+			}}, nil
+		},
+	}
+	ctrl := newTestController(migration, modifyClient, newTestAttachment())
+
+	// action
+	result, err := ctrl.startMigration(ctx, migration)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, xuanwuv1.VolumeMigrationRunning, result.Status.Phase)
+	assert.Equal(t, testPVName, result.Status.PersistentVolumeName)
+	assert.Equal(t, testVolumeHandle, result.Status.SourceVolumeHandle)
+	assert.True(t, result.Status.CopyStarted)
+	assert.NotNil(t, result.Status.StartedAt)
+	assert.Equal(t, testVolumeHandle, request.VolumeId)
+	assert.Equal(t, map[string]string{
+		volume.MigrationTargetBackendKey: "",
+		volume.MigrationTargetPoolKey:    "pool2",
+		volume.MigrationStartedKey:       "false",
+	}, request.MutableParameters)
+}
+
+func TestStartMigration_CrossBackendAttached(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	migration := newTestMigration(xuanwuv1.VolumeMigrationPending, "backend-b")
+	modifyClient := &mockModifyClient{
+		modifyFunc: func(ctx context.Context, in *drcsi.ModifyVolumeRequest) (*drcsi.ModifyVolumeResponse, error) {
+			t.Fatal("migration to another backend must not be started when the volume is attached")
+			return nil, nil
+		},
+	}
+	ctrl := newTestController(migration, modifyClient, newTestAttachment())
+
+	// action
+	result, err := ctrl.startMigration(ctx, migration)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, xuanwuv1.VolumeMigrationPending, result.Status.Phase)
+	assert.Equal(t, testVolumeHandle, result.Status.SourceVolumeHandle)
+	assert.Contains(t, result.Status.Message, "is attached to node node-1")
+	assert.Equal(t, testFenceOwner, getTestPublishFence(t, ctrl))
+}
+
+func TestStartMigration_FencedByAnotherOwner(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	migration := newTestMigration(xuanwuv1.VolumeMigrationPending, "backend-b")
+	modifyClient := &mockModifyClient{
+		modifyFunc: func(ctx context.Context, in *drcsi.ModifyVolumeRequest) (*drcsi.ModifyVolumeResponse, error) {
+			t.Fatal("migration must not be started when the volume is fenced by another owner")
+			return nil, nil
+		},
+	}
+	ctrl := newTestController(migration, modifyClient)
+	setTestPublishFence(t, ctrl, "VolumeSnapshotRollback/rollback-1")
+
+	// action
+	_, err := ctrl.startMigration(ctx, migration)
+
+	// assert
+	assert.ErrorContains(t, err, "fenced by VolumeSnapshotRollback/rollback-1")
+	assert.Equal(t, "VolumeSnapshotRollback/rollback-1", getTestPublishFence(t, ctrl))
+}
+
+func TestStartMigration_ClaimNotExist(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	migration := newTestMigration(xuanwuv1.VolumeMigrationPending, "")
+	migration.Spec.PersistentVolumeClaim.Name = "claim-2"
+	ctrl := newTestController(migration, &mockModifyClient{})
+
+	// action
+	result, err := ctrl.startMigration(ctx, migration)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, xuanwuv1.VolumeMigrationFailed, result.Status.Phase)
+	assert.Contains(t, result.Status.Message, "pvc default/claim-2 does not exist")
+}
+
+func TestWaitMigration_CompletedAndRebound(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	migration := newTestMigration(xuanwuv1.VolumeMigrationRunning, "backend-b")
+	migration.Status.PersistentVolumeName = testPVName
+	migration.Status.SourceVolumeHandle = testVolumeHandle
+	migration.Status.CopyStarted = true
+	migration.Status.StartedAt = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+	var request *drcsi.ModifyVolumeRequest
+	modifyClient := &mockModifyClient{
+		modifyFunc: func(ctx context.Context, in *drcsi.ModifyVolumeRequest) (*drcsi.ModifyVolumeResponse, error) {
+			request = in
+			return &drcsi.ModifyVolumeResponse{VolumeAttributes: map[string]string{
+				volume.MigrationStartedKey:  "true",
+				volume.MigrationProgressKey: "100",
+				volume.MigrationFinishedKey: "true",
+				volume.MigrationVolumeIdKey: testTargetVolumeHandle,
+				"backend":                   "backend-b",
+			}}, nil
+		},
+	}
+	ctrl := newTestController(migration, modifyClient)
+	setTestPublishFence(t, ctrl, testFenceOwner)
+
+	// action
+	result, err := ctrl.waitMigration(ctx, migration)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Empty(t, getTestPublishFence(t, ctrl))
+	assert.Equal(t, "true", request.MutableParameters[volume.MigrationStartedKey])
+	assert.Equal(t, xuanwuv1.VolumeMigrationCompleted, result.Status.Phase)
+	assert.Equal(t, "100%", result.Status.Progress)
+	assert.Equal(t, testTargetVolumeHandle, result.Status.TargetVolumeHandle)
+	pv, err := ctrl.client.CoreV1().PersistentVolumes().Get(ctx, testPVName, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, testTargetVolumeHandle, pv.Spec.CSI.VolumeHandle)
+	assert.Equal(t, map[string]string{"backend": "backend-b", "name": testPVName}, pv.Spec.CSI.VolumeAttributes)
+	assert.Equal(t, corev1.PersistentVolumeReclaimDelete, pv.Spec.PersistentVolumeReclaimPolicy)
+}
+
+func TestWaitMigration_InProgress(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	migration := newTestMigration(xuanwuv1.VolumeMigrationRunning, "")
+	migration.Status.SourceVolumeHandle = testVolumeHandle
+	migration.Status.StartedAt = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+	modifyClient := &mockModifyClient{
+		modifyFunc: func(ctx context.Context, in *drcsi.ModifyVolumeRequest) (*drcsi.ModifyVolumeResponse, error) {
+			return &drcsi.ModifyVolumeResponse{VolumeAttributes: map[string]string{
+				volume.MigrationStartedKey:  "true",
+				volume.MigrationProgressKey: "40",
+				volume.MigrationFinishedKey: "false",
+			}}, nil
+		},
+	}
+	ctrl := newTestController(migration, modifyClient)
+
+	// action
+	result, err := ctrl.waitMigration(ctx, migration)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, xuanwuv1.VolumeMigrationRunning, result.Status.Phase)
+	assert.Equal(t, "40%", result.Status.Progress)
+}
+
+func TestWaitMigration_AbortedOnStorage(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	migration := newTestMigration(xuanwuv1.VolumeMigrationRunning, "")
+	migration.Status.SourceVolumeHandle = testVolumeHandle
+	migration.Status.CopyStarted = true
+	migration.Status.StartedAt = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+	var rolledBack bool
+	modifyClient := &mockModifyClient{
+		modifyFunc: func(ctx context.Context, in *drcsi.ModifyVolumeRequest) (*drcsi.ModifyVolumeResponse, error) {
+			if in.MutableParameters[volume.MigrationRollbackKey] == "true" {
+				rolledBack = true
+				return &drcsi.ModifyVolumeResponse{}, nil
+			}
+			return nil, status.Error(codes.Aborted, "migration of lun pvc-1 is at fault status")
+		},
+	}
+	ctrl := newTestController(migration, modifyClient)
+
+	// action
+	result, err := ctrl.waitMigration(ctx, migration)
+
+	// assert
+	assert.NoError(t, err)
+	assert.True(t, rolledBack)
+	assert.Equal(t, xuanwuv1.VolumeMigrationFailed, result.Status.Phase)
+	assert.Contains(t, result.Status.Message, "is at fault status")
+	assert.NotNil(t, result.Status.CompletedAt)
+	assert.Zero(t, ctrl.migrationQueue.Len())
+}
+
+func TestWaitMigration_RecordStartedBeforeCutover(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	migration := newTestMigration(xuanwuv1.VolumeMigrationRunning, "backend-b")
+	migration.Status.SourceVolumeHandle = testVolumeHandle
+	migration.Status.StartedAt = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+	var request *drcsi.ModifyVolumeRequest
+	modifyClient := &mockModifyClient{
+		modifyFunc: func(ctx context.Context, in *drcsi.ModifyVolumeRequest) (*drcsi.ModifyVolumeResponse, error) {
+			request = in
+			return &drcsi.ModifyVolumeResponse{VolumeAttributes: map[string]string{
+				volume.MigrationStartedKey:  "true",
+				volume.MigrationProgressKey: "100",
+				volume.MigrationFinishedKey: "false",
+			}}, nil
+		},
+	}
+	ctrl := newTestController(migration, modifyClient)
+
+	// action
+	result, err := ctrl.waitMigration(ctx, migration)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, "false", request.MutableParameters[volume.MigrationStartedKey])
+	assert.Equal(t, xuanwuv1.VolumeMigrationRunning, result.Status.Phase)
+	assert.True(t, result.Status.CopyStarted)
+}
+
+func TestStartMigration_NotStartedOnStorage(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	migration := newTestMigration(xuanwuv1.VolumeMigrationPending, "backend-b")
+	modifyClient := &mockModifyClient{
+		modifyFunc: func(ctx context.Context, in *drcsi.ModifyVolumeRequest) (*drcsi.ModifyVolumeResponse, error) {
+			return &drcsi.ModifyVolumeResponse{VolumeAttributes: map[string]string{}}, nil
+		},
+	}
+	ctrl := newTestController(migration, modifyClient)
+
+	// action
+	_, err := ctrl.startMigration(ctx, migration)
+
+	// assert
+	assert.ErrorContains(t, err, "not started on storage")
+	updated, getErr := ctrl.clientSet.XuanwuV1().VolumeMigrations().Get(ctx, migration.Name, metav1.GetOptions{})
+	assert.NoError(t, getErr)
+	assert.Equal(t, xuanwuv1.VolumeMigrationPending, updated.Status.Phase)
+	assert.False(t, updated.Status.CopyStarted)
+}
+
+func TestWaitMigration_AttachedDuringCopy(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	migration := newTestMigration(xuanwuv1.VolumeMigrationRunning, "backend-b")
+	migration.Status.PersistentVolumeName = testPVName
+	migration.Status.SourceVolumeHandle = testVolumeHandle
+	migration.Status.CopyStarted = true
+	migration.Status.StartedAt = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+	modifyClient := &mockModifyClient{
+		modifyFunc: func(ctx context.Context, in *drcsi.ModifyVolumeRequest) (*drcsi.ModifyVolumeResponse, error) {
+			if in.MutableParameters[volume.MigrationRollbackKey] != "true" {
+				t.Fatal("migration must not be cut over when the volume is attached during the copy")
+			}
+			return &drcsi.ModifyVolumeResponse{}, nil
+		},
+	}
+	attachment := newTestAttachment()
+	attachment.Status.Attached = true
+	ctrl := newTestController(migration, modifyClient, attachment)
+	setTestPublishFence(t, ctrl, testFenceOwner)
+
+	// action
+	result, err := ctrl.waitMigration(ctx, migration)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, xuanwuv1.VolumeMigrationFailed, result.Status.Phase)
+	assert.Contains(t, result.Status.Message, "is attached to node node-1 during the migration")
+	assert.Empty(t, getTestPublishFence(t, ctrl))
+}
+
+func TestWaitMigration_FencedAttachmentPending(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	migration := newTestMigration(xuanwuv1.VolumeMigrationRunning, "backend-b")
+	migration.Status.PersistentVolumeName = testPVName
+	migration.Status.SourceVolumeHandle = testVolumeHandle
+	migration.Status.CopyStarted = true
+	migration.Status.StartedAt = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+	modifyClient := &mockModifyClient{
+		modifyFunc: func(ctx context.Context, in *drcsi.ModifyVolumeRequest) (*drcsi.ModifyVolumeResponse, error) {
+			return &drcsi.ModifyVolumeResponse{VolumeAttributes: map[string]string{
+				volume.MigrationStartedKey:  "true",
+				volume.MigrationProgressKey: "50",
+				volume.MigrationFinishedKey: "false",
+			}}, nil
+		},
+	}
+	ctrl := newTestController(migration, modifyClient, newTestAttachment())
+
+	// action
+	result, err := ctrl.waitMigration(ctx, migration)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, xuanwuv1.VolumeMigrationRunning, result.Status.Phase)
+	assert.Equal(t, "50%", result.Status.Progress)
+}
+
+func TestWaitMigration_RecreateDeletedPersistentVolume(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	migration := newTestMigration(xuanwuv1.VolumeMigrationRunning, "backend-b")
+	migration.Status.PersistentVolumeName = testPVName
+	migration.Status.SourceVolumeHandle = testVolumeHandle
+	migration.Status.TargetVolumeHandle = testTargetVolumeHandle
+	migration.Status.CopyStarted = true
+	migration.Status.StartedAt = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+	migration.Status.TargetPersistentVolume = &corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: testPVName},
+		Spec: corev1.PersistentVolumeSpec{
+			PersistentVolumeSource: corev1.PersistentVolumeSource{
+				CSI: &corev1.CSIPersistentVolumeSource{Driver: testProvisioner, VolumeHandle: testTargetVolumeHandle},
+			},
+		},
+	}
+	modifyClient := &mockModifyClient{
+		modifyFunc: func(ctx context.Context, in *drcsi.ModifyVolumeRequest) (*drcsi.ModifyVolumeResponse, error) {
+			return &drcsi.ModifyVolumeResponse{VolumeAttributes: map[string]string{
+				volume.MigrationStartedKey:  "true",
+				volume.MigrationProgressKey: "100",
+				volume.MigrationFinishedKey: "true",
+				volume.MigrationVolumeIdKey: testTargetVolumeHandle,
+			}}, nil
+		},
+	}
+	ctrl := newTestController(migration, modifyClient)
+	err := ctrl.client.CoreV1().PersistentVolumes().Delete(ctx, testPVName, metav1.DeleteOptions{})
+	assert.NoError(t, err)
+
+	// action
+	result, err := ctrl.waitMigration(ctx, migration)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, xuanwuv1.VolumeMigrationCompleted, result.Status.Phase)
+	assert.Nil(t, result.Status.TargetPersistentVolume)
+	pv, err := ctrl.client.CoreV1().PersistentVolumes().Get(ctx, testPVName, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, testTargetVolumeHandle, pv.Spec.CSI.VolumeHandle)
+}
+
+func TestSetMigrationFinalizer(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	migration := newTestMigration(xuanwuv1.VolumeMigrationPending, "backend-b")
+	ctrl := newTestController(migration, &mockModifyClient{})
+
+	// action
+	result, err := ctrl.setMigrationFinalizer(ctx, migration)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, []string{ProtectMigrationFinalizer}, result.Finalizers)
+}
+
+func TestSyncDeleteMigration_RollbackBeforeRelease(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	migration := newTestDeletedMigration(xuanwuv1.VolumeMigrationRunning)
+	var request *drcsi.ModifyVolumeRequest
+	modifyClient := &mockModifyClient{
+		modifyFunc: func(ctx context.Context, in *drcsi.ModifyVolumeRequest) (*drcsi.ModifyVolumeResponse, error) {
+			request = in
+			return &drcsi.ModifyVolumeResponse{}, nil
+		},
+	}
+	ctrl := newTestController(migration, modifyClient)
+	setTestPublishFence(t, ctrl, testFenceOwner)
+
+	// action
+	err := ctrl.syncDeleteMigration(ctx, migration)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, "true", request.MutableParameters[volume.MigrationRollbackKey])
+	assert.Equal(t, testVolumeHandle, request.VolumeId)
+	assert.Empty(t, getTestPublishFence(t, ctrl))
+	assert.Empty(t, getTestMigrationFinalizers(t, ctrl))
+}
+
+func TestSyncDeleteMigration_RollbackFailed(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	migration := newTestDeletedMigration(xuanwuv1.VolumeMigrationRunning)
+	modifyClient := &mockModifyClient{
+		modifyFunc: func(ctx context.Context, in *drcsi.ModifyVolumeRequest) (*drcsi.ModifyVolumeResponse, error) {
+			return nil, status.Error(codes.Unavailable, "storage is offline")
+		},
+	}
+	ctrl := newTestController(migration, modifyClient)
+	setTestPublishFence(t, ctrl, testFenceOwner)
+
+	// action
+	err := ctrl.syncDeleteMigration(ctx, migration)
+
+	// assert
+	assert.ErrorContains(t, err, "storage is offline")
+	assert.Equal(t, testFenceOwner, getTestPublishFence(t, ctrl))
+	assert.Equal(t, []string{ProtectMigrationFinalizer}, getTestMigrationFinalizers(t, ctrl))
+}
+
+func TestSyncDeleteMigration_CompleteCutOver(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	migration := newTestDeletedMigration(xuanwuv1.VolumeMigrationRunning)
+	modifyClient := &mockModifyClient{
+		modifyFunc: func(ctx context.Context, in *drcsi.ModifyVolumeRequest) (*drcsi.ModifyVolumeResponse, error) {
+			if in.MutableParameters[volume.MigrationRollbackKey] == "true" {
+				return nil, status.Error(codes.FailedPrecondition, "lun pvc-1 is cut over to pool pool2")
+			}
+			return &drcsi.ModifyVolumeResponse{VolumeAttributes: map[string]string{
+				volume.MigrationStartedKey:  "true",
+				volume.MigrationProgressKey: "100",
+				volume.MigrationFinishedKey: "true",
+				volume.MigrationVolumeIdKey: testTargetVolumeHandle,
+			}}, nil
+		},
+	}
+	ctrl := newTestController(migration, modifyClient)
+	setTestPublishFence(t, ctrl, testFenceOwner)
+
+	// action
+	err := ctrl.syncDeleteMigration(ctx, migration)
+
+	// assert
+	assert.NoError(t, err)
+	pv, err := ctrl.client.CoreV1().PersistentVolumes().Get(ctx, testPVName, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, testTargetVolumeHandle, pv.Spec.CSI.VolumeHandle)
+	assert.Empty(t, getTestPublishFence(t, ctrl))
+	assert.Empty(t, getTestMigrationFinalizers(t, ctrl))
+}
+
+func TestSyncDeleteMigration_FinishedMigration(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	migration := newTestDeletedMigration(xuanwuv1.VolumeMigrationFailed)
+	modifyClient := &mockModifyClient{
+		modifyFunc: func(ctx context.Context, in *drcsi.ModifyVolumeRequest) (*drcsi.ModifyVolumeResponse, error) {
+			t.Fatal("the failed migration has been rolled back")
+			return nil, nil
+		},
+	}
+	ctrl := newTestController(migration, modifyClient)
+
+	// action
+	err := ctrl.syncDeleteMigration(ctx, migration)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Empty(t, getTestMigrationFinalizers(t, ctrl))
+}
+
+func newTestDeletedMigration(phase xuanwuv1.VolumeMigrationPhase) *xuanwuv1.VolumeMigration {
+	migration := newTestMigration(phase, "backend-b")
+	migration.Finalizers = []string{ProtectMigrationFinalizer}
+	migration.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	migration.Status.PersistentVolumeName = testPVName
+	migration.Status.SourceVolumeHandle = testVolumeHandle
+	migration.Status.CopyStarted = true
+	migration.Status.StartedAt = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+	return migration
+}
+
+func getTestMigrationFinalizers(t *testing.T, ctrl *VolumeMigrationController) []string {
+	migration, err := ctrl.clientSet.XuanwuV1().VolumeMigrations().Get(context.Background(), "migration-1",
+		metav1.GetOptions{})
+	assert.NoError(t, err)
+	return migration.Finalizers
+}
@@ -0,0 +1,88 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package migration contains VolumeMigration controller definitions and synchronization functions
+package migration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xuanwuv1 "github.com/Huawei/eSDK_K8S_Plugin/v4/client/apis/xuanwu/v1"
+	pkgutils "github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/utils"
+)
+
+const migrationAttributePrefix = "migration"
+
+// errInvalidSource means the migration can never succeed, so it is not retried
+var errInvalidSource = errors.New("invalid migration source")
+
+// getMigrationSource resolves the PersistentVolume bound to the PersistentVolumeClaim of the migration.
+// The errors wrapping errInvalidSource are permanent, and the others are retried.
+func (ctrl *VolumeMigrationController) getMigrationSource(ctx context.Context,
+	source xuanwuv1.VolumeMigrationSource) (*corev1.PersistentVolume, error) {
+	claimMeta := pkgutils.MakeMetaWithNamespace(source.Namespace, source.Name)
+	pvc, err := ctrl.client.CoreV1().PersistentVolumeClaims(source.Namespace).Get(ctx, source.Name,
+		metav1.GetOptions{})
+	if apiErrors.IsNotFound(err) {
+		return nil, fmt.Errorf("%w: pvc %s does not exist", errInvalidSource, claimMeta)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get pvc %s error: %w", claimMeta, err)
+	}
+	if pvc.Spec.VolumeName == "" {
+		return nil, fmt.Errorf("%w: pvc %s is not bound", errInvalidSource, claimMeta)
+	}
+
+	pv, err := ctrl.client.CoreV1().PersistentVolumes().Get(ctx, pvc.Spec.VolumeName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("get pv %s error: %w", pvc.Spec.VolumeName, err)
+	}
+	if pv.Spec.CSI == nil || pv.Spec.CSI.Driver != ctrl.provisioner || pv.Spec.CSI.VolumeHandle == "" {
+		return nil, fmt.Errorf("%w: pv %s is not provisioned by %s", errInvalidSource, pv.Name, ctrl.provisioner)
+	}
+
+	return pv, nil
+}
+
+// buildTargetPersistentVolume builds the PersistentVolume with the volume handle on the target backend once the
+// migration is finished, the attributes of the migration are not kept in it.
+func (ctrl *VolumeMigrationController) buildTargetPersistentVolume(ctx context.Context, pvName, volumeHandle string,
+	attributes map[string]string) (*corev1.PersistentVolume, error) {
+	pv, err := ctrl.client.CoreV1().PersistentVolumes().Get(ctx, pvName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("get pv %s error: %w", pvName, err)
+	}
+	if pv.Spec.CSI == nil {
+		return nil, fmt.Errorf("pv %s is not a csi volume", pvName)
+	}
+
+	volumeAttributes := make(map[string]string, len(attributes))
+	for key, value := range attributes {
+		if strings.HasPrefix(key, migrationAttributePrefix) {
+			continue
+		}
+		volumeAttributes[key] = value
+	}
+
+	return pkgutils.BuildRebindPersistentVolume(pv, volumeHandle, volumeAttributes), nil
+}
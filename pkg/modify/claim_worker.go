@@ -33,8 +33,10 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 
 	xuanwuv1 "github.com/Huawei/eSDK_K8S_Plugin/v4/client/apis/xuanwu/v1"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
 	pkgutils "github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/utils"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/oceanstor/client"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/oceanstor/smartx"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
 )
@@ -52,6 +54,21 @@ const (
 	// MetroPairSyncSpeed creates hyper metro pair synchronization speed
 	MetroPairSyncSpeed = "metroPairSyncSpeed"
 
+	// QoSFeatures creates, updates or deletes the qos of the volume, an empty value deletes the qos
+	QoSFeatures = "qos"
+
+	// AuthClientFeatures replaces the auth clients of the nfs share, multiple clients are separated by ";"
+	AuthClientFeatures = "authClient"
+
+	// AllSquashFeatures changes the all squash of the nfs share auth clients
+	AllSquashFeatures = "allSquash"
+
+	// RootSquashFeatures changes the root squash of the nfs share auth clients
+	RootSquashFeatures = "rootSquash"
+
+	// SnapshotDirectoryVisibilityFeatures changes the snapshot directory visibility of the filesystem
+	SnapshotDirectoryVisibilityFeatures = "snapshotDirectoryVisibility"
+
 	// CreateFailedReason reason of created claim failed
 	CreateFailedReason = "CreatingFailed"
 
@@ -75,10 +92,14 @@ const (
 
 	// ContentRollbackPolicy rollback content reclaim policy
 	ContentRollbackPolicy = "rollback"
+
+	snapshotDirectoryVisible   = "visible"
+	snapshotDirectoryInvisible = "invisible"
 )
 
 var (
-	supportFeatures        = []string{HyperMetroFeatures, MetroPairSyncSpeed}
+	supportFeatures = []string{HyperMetroFeatures, MetroPairSyncSpeed, QoSFeatures, AuthClientFeatures,
+		AllSquashFeatures, RootSquashFeatures, SnapshotDirectoryVisibilityFeatures}
 	syncFuncList           []func(context.Context, *xuanwuv1.VolumeModifyClaim) (*xuanwuv1.VolumeModifyClaim, error)
 	onceInitSyncFuncList   sync.Once
 	deleteFuncList         []func(context.Context, *xuanwuv1.VolumeModifyClaim) (*xuanwuv1.VolumeModifyClaim, error)
@@ -86,6 +107,12 @@ var (
 	featureCheckFunc       = map[string]func(string, map[string]string) error{
 		HyperMetroFeatures: checkHyperMetro,
 		MetroPairSyncSpeed: checkMetroPairSyncSpeed,
+		QoSFeatures:        checkQoS,
+		AuthClientFeatures: checkAuthClient,
+		AllSquashFeatures:  checkAllSquash,
+		RootSquashFeatures: checkRootSquash,
+
+		SnapshotDirectoryVisibilityFeatures: checkSnapshotDirectoryVisibility,
 	}
 )
 
@@ -723,6 +750,59 @@ func checkMetroPairSyncSpeed(value string, params map[string]string) error {
 	return nil
 }
 
+func checkQoS(value string, _ map[string]string) error {
+	if value == "" {
+		return nil
+	}
+
+	// the product of the backend is unknown here, so the qos is checked with the common parameters of oceanstor,
+	// and the parameters supported by the product are checked by the backend when the volume is modified
+	qos, err := smartx.ExtractQoSParameters(context.Background(), constants.OceanStorV5, value)
+	if err == nil {
+		_, err = smartx.ValidateQoSParameters(constants.OceanStorV5, qos)
+	}
+	if err != nil {
+		return fmt.Errorf("check spec failed: paramter qos must be a json object with integer values, "+
+			"such as {\"MAXIOPS\": 1000}, but got [%s]: %w", value, err)
+	}
+
+	return nil
+}
+
+func checkAuthClient(value string, _ map[string]string) error {
+	for _, client := range strings.Split(value, ";") {
+		if strings.TrimSpace(client) != "" {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("check spec failed: paramter authClient can not be empty")
+}
+
+func checkAllSquash(value string, _ map[string]string) error {
+	return checkEnumParameter(AllSquashFeatures, value, constants.AllSquash, constants.NoAllSquash)
+}
+
+func checkRootSquash(value string, _ map[string]string) error {
+	return checkEnumParameter(RootSquashFeatures, value, constants.RootSquash, constants.NoRootSquash)
+}
+
+func checkSnapshotDirectoryVisibility(value string, _ map[string]string) error {
+	return checkEnumParameter(SnapshotDirectoryVisibilityFeatures, value,
+		snapshotDirectoryVisible, snapshotDirectoryInvisible)
+}
+
+func checkEnumParameter(key, value string, candidates ...string) error {
+	for _, candidate := range candidates {
+		if strings.EqualFold(value, candidate) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("check spec failed: paramter %s must be one of [%s], but got [%s]",
+		key, strings.Join(candidates, ","), value)
+}
+
 func (ctrl *VolumeModifyController) updateClaimStatusWithRetry(ctx context.Context, claim *xuanwuv1.VolumeModifyClaim,
 	retryTimes int) (*xuanwuv1.VolumeModifyClaim, error) {
 	var err error
@@ -425,3 +425,58 @@ func TestDeleteContent_CompletedPhaseDeleting(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, xuanwuv1.VolumeModifyClaimDeleting, result.Status.Phase)
 }
+
+func TestCheckParameters_QoS(t *testing.T) {
+	// arrange
+	tests := []struct {
+		name    string
+		params  map[string]string
+		wantErr bool
+	}{
+		{name: "valid qos", params: map[string]string{"qos": `{"MAXIOPS": 1000, "MAXBANDWIDTH": 100}`}},
+		{name: "delete qos", params: map[string]string{"qos": ""}},
+		{name: "qos is not a json object", params: map[string]string{"qos": "MAXIOPS=1000"}, wantErr: true},
+		{name: "qos value is not a number", params: map[string]string{"qos": `{"MAXIOPS": "1000"}`}, wantErr: true},
+		{name: "qos value is not an integer", params: map[string]string{"qos": `{"MAXIOPS": 1000.5}`}, wantErr: true},
+		{name: "qos without limit", params: map[string]string{"qos": `{"IOTYPE": 2}`}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// action
+			err := checkParameters(tt.params)
+
+			// assert
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func TestCheckParameters_ShareOptions(t *testing.T) {
+	// arrange
+	tests := []struct {
+		name    string
+		params  map[string]string
+		wantErr bool
+	}{
+		{name: "valid auth client", params: map[string]string{"authClient": "192.168.1.1;192.168.1.2"}},
+		{name: "empty auth client", params: map[string]string{"authClient": " ; "}, wantErr: true},
+		{name: "valid squash", params: map[string]string{"allSquash": "all_squash", "rootSquash": "no_root_squash"}},
+		{name: "invalid all squash", params: map[string]string{"allSquash": "squash"}, wantErr: true},
+		{name: "invalid root squash", params: map[string]string{"rootSquash": "no_squash"}, wantErr: true},
+		{name: "valid snapshot directory visibility", params: map[string]string{
+			"snapshotDirectoryVisibility": "invisible"}},
+		{name: "invalid snapshot directory visibility", params: map[string]string{
+			"snapshotDirectoryVisibility": "hidden"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// action
+			err := checkParameters(tt.params)
+
+			// assert
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 	"sync"
 	"time"
 
@@ -200,6 +201,16 @@ func (ctrl *VolumeModifyController) callVolumeModify(ctx context.Context,
 	log.AddContext(ctx).Infof("call modify interface end, content:%s, response body: %+v",
 		content.Name, response)
 
+	if modified := getModifiedParameters(response.VolumeAttributes); len(modified) != 0 {
+		content = content.DeepCopy()
+		content.Status.ModifiedParameters = modified
+	}
+
+	if previous := getPreviousParameters(response.VolumeAttributes, content.Status.PreviousParameters); previous != nil {
+		content = content.DeepCopy()
+		content.Status.PreviousParameters = previous
+	}
+
 	if response.VolumeAttributes["storage"] == constants.OceanStorSan &&
 		response.VolumeAttributes["needStaging"] == "true" {
 		stagingContent, err := ctrl.setContentStatus(ctx, content, xuanwuv1.VolumeModifyContentStaging)
@@ -378,7 +389,8 @@ func (ctrl *VolumeModifyController) doRollback(ctx context.Context, content *xua
 	request := &drcsi.ModifyVolumeRequest{
 		VolumeId:               content.Spec.VolumeHandle,
 		StorageClassParameters: content.Spec.StorageClassParameters,
-		MutableParameters:      generateRollbackParams(content.Spec.Parameters),
+		MutableParameters: generateRollbackParams(content.Spec.Parameters, content.Spec.StorageClassParameters,
+			content.Status.PreviousParameters),
 	}
 	log.AddContext(ctx).Infof("start rollback content:%s, request body: %+v", content.Name, request)
 	response, err := ctrl.modifyClient.ModifyVolume(ctx, request)
@@ -391,14 +403,81 @@ func (ctrl *VolumeModifyController) doRollback(ctx context.Context, content *xua
 	return content, nil
 }
 
-func generateRollbackParams(params map[string]string) map[string]string {
+func generateRollbackParams(params, classParams, previousParams map[string]string) map[string]string {
 	rollback := make(map[string]string)
 	if _, ok := params[HyperMetroFeatures]; ok {
 		rollback[HyperMetroFeatures] = "false"
 	}
+
+	// restore the qos before the modification, the qos of the storage class is restored if it is not recorded,
+	// and the qos is deleted if the storage class does not have one
+	if _, ok := params[QoSFeatures]; ok {
+		if previousQoS, recorded := previousParams[QoSFeatures]; recorded {
+			rollback[QoSFeatures] = previousQoS
+		} else {
+			rollback[QoSFeatures] = classParams[QoSFeatures]
+		}
+	}
+
+	// restore the export options before the modification, the export options of the storage class are restored
+	// if they are not recorded, and the squash falls back to the default value of creation
+	shareDefaults := map[string]string{
+		AuthClientFeatures:                  "",
+		AllSquashFeatures:                   constants.NoAllSquash,
+		RootSquashFeatures:                  constants.NoRootSquash,
+		SnapshotDirectoryVisibilityFeatures: "",
+	}
+	for key, defaultValue := range shareDefaults {
+		if _, ok := params[key]; !ok {
+			continue
+		}
+		if value := previousParams[key]; value != "" {
+			rollback[key] = value
+		} else if value := classParams[key]; value != "" {
+			rollback[key] = value
+		} else if defaultValue != "" {
+			rollback[key] = defaultValue
+		}
+	}
 	return rollback
 }
 
+// getPreviousParameters merges the values before the modification of the response attributes into the recorded ones,
+// the values before the first modification are kept, because the retries see the values which have been modified.
+// It returns nil if nothing new is recorded.
+func getPreviousParameters(attributes, recorded map[string]string) map[string]string {
+	var previous map[string]string
+	for key, value := range attributes {
+		param, ok := strings.CutPrefix(key, constants.PreviousAttributeKeyPrefix)
+		if !ok {
+			continue
+		}
+		if _, exist := recorded[param]; exist {
+			continue
+		}
+
+		if previous == nil {
+			previous = make(map[string]string, len(recorded)+1)
+			for recordedKey, recordedValue := range recorded {
+				previous[recordedKey] = recordedValue
+			}
+		}
+		previous[param] = value
+	}
+	return previous
+}
+
+// getModifiedParameters returns the parameters which are modified in place from the attributes of the response
+func getModifiedParameters(attributes map[string]string) map[string]string {
+	modified := make(map[string]string)
+	for key, value := range attributes {
+		if utils.Contains(supportFeatures, key) {
+			modified[key] = value
+		}
+	}
+	return modified
+}
+
 func canRetry(new, old *xuanwuv1.VolumeModifyContent) bool {
 	if new.DeletionTimestamp != nil {
 		return true
@@ -405,3 +405,153 @@ func TestWaitVolumeStaged_WhenScanFinished(t *testing.T) {
 	assert.NotNil(t, result)
 	assert.Equal(t, xuanwuv1.VolumeModifyContentCompleted, result.Status.Phase)
 }
+
+func TestCallVolumeModify_RecordModifiedParameters(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	fakeClient := fake.NewSimpleClientset()
+	mockClient := &mockModifyClient{
+		modifyFunc: func(ctx context.Context, in *drcsi.ModifyVolumeRequest) (*drcsi.ModifyVolumeResponse, error) {
+			return &drcsi.ModifyVolumeResponse{
+				VolumeAttributes: map[string]string{"authClient": "192.168.1.2", "rootSquash": "root_squash"},
+			}, nil
+		},
+	}
+	ctrl := &VolumeModifyController{
+		clientSet:     fakeClient,
+		contentClient: fakeClient,
+		modifyClient:  mockClient,
+		eventRecorder: record.NewFakeRecorder(1000),
+	}
+	content := &xuanwuv1.VolumeModifyContent{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-content"},
+		Status:     xuanwuv1.VolumeModifyContentStatus{Phase: xuanwuv1.VolumeModifyContentCreating},
+		Spec: xuanwuv1.VolumeModifyContentSpec{
+			VolumeHandle: "test-volume",
+			Parameters:   map[string]string{"authClient": "192.168.1.2", "rootSquash": "root_squash"},
+		},
+	}
+	_, err := fakeClient.XuanwuV1().VolumeModifyContents().Create(ctx, content, metav1.CreateOptions{})
+	assert.NoError(t, err)
+
+	// act
+	result, err := ctrl.callVolumeModify(ctx, content)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, xuanwuv1.VolumeModifyContentCompleted, result.Status.Phase)
+	assert.Equal(t, map[string]string{"authClient": "192.168.1.2", "rootSquash": "root_squash"},
+		result.Status.ModifiedParameters)
+}
+
+func TestGenerateRollbackParams_RestoreStorageClassParameters(t *testing.T) {
+	// arrange
+	tests := []struct {
+		name           string
+		params         map[string]string
+		classParams    map[string]string
+		previousParams map[string]string
+		want           map[string]string
+	}{
+		{name: "restore previous qos", params: map[string]string{"qos": `{"MAXIOPS": 2000}`},
+			classParams:    map[string]string{"qos": `{"MAXIOPS": 1000}`},
+			previousParams: map[string]string{"qos": `{"MAXIOPS":1500}`},
+			want:           map[string]string{"qos": `{"MAXIOPS":1500}`}},
+		{name: "delete qos when volume had none", params: map[string]string{"qos": `{"MAXIOPS": 2000}`},
+			classParams: map[string]string{"qos": `{"MAXIOPS": 1000}`}, previousParams: map[string]string{"qos": ""},
+			want: map[string]string{"qos": ""}},
+		{name: "restore qos of storage class", params: map[string]string{"qos": `{"MAXIOPS": 2000}`},
+			classParams: map[string]string{"qos": `{"MAXIOPS": 1000}`},
+			want:        map[string]string{"qos": `{"MAXIOPS": 1000}`}},
+		{name: "delete qos when storage class has none", params: map[string]string{"qos": `{"MAXIOPS": 2000}`},
+			classParams: map[string]string{}, want: map[string]string{"qos": ""}},
+		{name: "rollback hyperMetro", params: map[string]string{"hyperMetro": "true"},
+			want: map[string]string{"hyperMetro": "false"}},
+		{name: "restore export options of storage class",
+			params: map[string]string{"authClient": "192.168.1.2", "allSquash": "all_squash",
+				"rootSquash": "root_squash", "snapshotDirectoryVisibility": "invisible"},
+			classParams: map[string]string{"authClient": "*", "snapshotDirectoryVisibility": "visible"},
+			want: map[string]string{"authClient": "*", "allSquash": "no_all_squash", "rootSquash": "no_root_squash",
+				"snapshotDirectoryVisibility": "visible"}},
+		{name: "restore previous export options",
+			params:         map[string]string{"authClient": "192.168.1.2", "allSquash": "all_squash"},
+			classParams:    map[string]string{"authClient": "*"},
+			previousParams: map[string]string{"authClient": "192.168.1.1;192.168.1.3", "allSquash": "no_all_squash"},
+			want:           map[string]string{"authClient": "192.168.1.1;192.168.1.3", "allSquash": "no_all_squash"}},
+		{name: "restore previous snapshot directory visibility when storage class has none",
+			params:         map[string]string{"snapshotDirectoryVisibility": "visible"},
+			previousParams: map[string]string{"snapshotDirectoryVisibility": "invisible"},
+			want:           map[string]string{"snapshotDirectoryVisibility": "invisible"}},
+		{name: "keep snapshot directory visibility when storage class has none",
+			params: map[string]string{"snapshotDirectoryVisibility": "invisible"}, want: map[string]string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// action
+			got := generateRollbackParams(tt.params, tt.classParams, tt.previousParams)
+
+			// assert
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCallVolumeModify_RecordPreviousQoS(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	fakeClient := fake.NewSimpleClientset()
+	mockClient := &mockModifyClient{
+		modifyFunc: func(ctx context.Context, in *drcsi.ModifyVolumeRequest) (*drcsi.ModifyVolumeResponse, error) {
+			return &drcsi.ModifyVolumeResponse{
+				VolumeAttributes: map[string]string{"qos": `{"MAXIOPS": 2000}`, "previous.qos": `{"MAXIOPS":1500}`},
+			}, nil
+		},
+	}
+	ctrl := &VolumeModifyController{
+		clientSet:     fakeClient,
+		contentClient: fakeClient,
+		modifyClient:  mockClient,
+		eventRecorder: record.NewFakeRecorder(1000),
+	}
+	content := &xuanwuv1.VolumeModifyContent{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-content"},
+		Status:     xuanwuv1.VolumeModifyContentStatus{Phase: xuanwuv1.VolumeModifyContentCreating},
+		Spec: xuanwuv1.VolumeModifyContentSpec{
+			VolumeHandle: "test-volume",
+			Parameters:   map[string]string{"qos": `{"MAXIOPS": 2000}`},
+		},
+	}
+	_, err := fakeClient.XuanwuV1().VolumeModifyContents().Create(ctx, content, metav1.CreateOptions{})
+	assert.NoError(t, err)
+
+	// act
+	result, err := ctrl.callVolumeModify(ctx, content)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"qos": `{"MAXIOPS": 2000}`}, result.Status.ModifiedParameters)
+	assert.Equal(t, map[string]string{"qos": `{"MAXIOPS":1500}`}, result.Status.PreviousParameters)
+}
+
+func TestGetPreviousParameters_KeepRecordedValues(t *testing.T) {
+	// arrange
+	attributes := map[string]string{"authClient": "192.168.1.2", "previous.authClient": "192.168.1.2",
+		"previous.rootSquash": "root_squash"}
+	recorded := map[string]string{"authClient": "192.168.1.1"}
+
+	// act
+	got := getPreviousParameters(attributes, recorded)
+
+	// assert
+	assert.Equal(t, map[string]string{"authClient": "192.168.1.1", "rootSquash": "root_squash"}, got)
+	assert.Equal(t, map[string]string{"authClient": "192.168.1.1"}, recorded)
+}
+
+func TestGetPreviousParameters_NothingNew(t *testing.T) {
+	// act
+	got := getPreviousParameters(map[string]string{"previous.qos": ""}, map[string]string{"qos": "{}"})
+
+	// assert
+	assert.Nil(t, got)
+}
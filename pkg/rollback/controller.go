@@ -0,0 +1,261 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package rollback contains VolumeSnapshotRollback controller definitions and synchronization functions
+package rollback
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
+	storageinformers "k8s.io/client-go/informers/storage/v1"
+	"k8s.io/client-go/kubernetes"
+	coreV1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	storagelisters "k8s.io/client-go/listers/storage/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	xuanwuv1 "github.com/Huawei/eSDK_K8S_Plugin/v4/client/apis/xuanwu/v1"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/lib/drcsi"
+	clientset "github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/client/clientset/versioned"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/client/clientset/versioned/scheme"
+	external "github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/client/informers/externalversions"
+	rollbackinformers "github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/client/informers/externalversions/xuanwu/v1"
+	rollbacklisters "github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/client/listers/xuanwu/v1"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/modify"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
+)
+
+const (
+	// defaultRetryMaxDelay is used when option function RetryMaxDelay is omitted
+	defaultRetryMaxDelay = 5 * time.Minute
+
+	// defaultRetryBaseDelay is used when option function RetryBaseDelay is omitted
+	defaultRetryBaseDelay = 5 * time.Second
+
+	// defaultReconcileDelay is used when option function ReconcileDelay is omitted
+	defaultReconcileDelay = 10 * time.Second
+
+	// defaultProvisioner is used when option function Provisioner is omitted
+	defaultProvisioner = "csi.huawei.com"
+
+	// defaultWorkerThreads is used when option function WorkerThreads is omitted
+	defaultWorkerThreads = 4
+
+	// rollbackResource is used uniquely identifies rollback work queue
+	rollbackResource = "vsr"
+
+	// eventResourceName is used to record event
+	eventResourceName = "volume-snapshot-rollback-mgnt"
+)
+
+// VolumeSnapshotRollbackController controller of volume snapshot rollback
+type VolumeSnapshotRollbackController struct {
+	clientSet            clientset.Interface
+	client               kubernetes.Interface
+	dynamicClient        dynamic.Interface
+	modifyClient         drcsi.ModifyVolumeInterfaceClient
+	eventRecorder        record.EventRecorder
+	rollbackQueue        workqueue.RateLimitingInterface
+	rollbackInformer     rollbackinformers.VolumeSnapshotRollbackInformer
+	rollbackLister       rollbacklisters.VolumeSnapshotRollbackLister
+	rollbackListerSync   cache.InformerSynced
+	attachmentLister     storagelisters.VolumeAttachmentLister
+	attachmentListerSync cache.InformerSynced
+	rollbackWorker       *modify.ObjectWorker
+	retryMaxDelay        time.Duration
+	retryBaseDelay       time.Duration
+	reconcileDelay       time.Duration
+	workerThreads        int
+	provisioner          string
+}
+
+// NewVolumeSnapshotRollbackController instance a controller
+func NewVolumeSnapshotRollbackController(ctx context.Context, client kubernetes.Interface,
+	clientSet clientset.Interface, factory external.SharedInformerFactory,
+	options ...func(controller *VolumeSnapshotRollbackController)) *VolumeSnapshotRollbackController {
+	ctr := &VolumeSnapshotRollbackController{
+		client:         client,
+		clientSet:      clientSet,
+		retryBaseDelay: defaultRetryBaseDelay,
+		retryMaxDelay:  defaultRetryMaxDelay,
+		reconcileDelay: defaultReconcileDelay,
+		workerThreads:  defaultWorkerThreads,
+		provisioner:    defaultProvisioner,
+	}
+
+	// add custom options
+	for _, option := range options {
+		option(ctr)
+	}
+
+	broadcaster := record.NewBroadcaster()
+	broadcaster.StartRecordingToSink(&coreV1.EventSinkImpl{Interface: client.CoreV1().Events(v1.NamespaceAll)})
+	ctr.eventRecorder = broadcaster.NewRecorder(scheme.Scheme, v1.EventSource{Component: eventResourceName})
+	ctr.rollbackInformer = factory.Xuanwu().V1().VolumeSnapshotRollbacks()
+	ctr.rollbackLister = ctr.rollbackInformer.Lister()
+	ctr.rollbackListerSync = ctr.rollbackInformer.Informer().HasSynced
+	rateLimiter := workqueue.NewItemExponentialFailureRateLimiter(ctr.retryBaseDelay, ctr.retryMaxDelay)
+	queueConfig := workqueue.RateLimitingQueueConfig{Name: rollbackResource}
+	ctr.rollbackQueue = workqueue.NewRateLimitingQueueWithConfig(rateLimiter, queueConfig)
+
+	// add event handler
+	ctr.AddRollbackHandler(ctx)
+
+	// add workers
+	ctr.rollbackWorker = modify.NewObjectWorker(rollbackResource, ctr.rollbackQueue,
+		modify.SyncFunc(ctr.syncRollbackWork))
+	return ctr
+}
+
+// Run will sync informer caches and starting workers. It will block until stopCh is closed
+func (ctrl *VolumeSnapshotRollbackController) Run(ctx context.Context, stopCh <-chan struct{}) {
+	defer ctrl.rollbackQueue.ShutDown()
+
+	log.AddContext(ctx).Infoln("starting volume snapshot rollback controller")
+	defer log.AddContext(ctx).Infoln("shutting down volume snapshot rollback controller")
+
+	if !cache.WaitForCacheSync(stopCh, ctrl.rollbackListerSync, ctrl.attachmentListerSync) {
+		log.AddContext(ctx).Errorln("cannot sync caches")
+		return
+	}
+
+	log.AddContext(ctx).Infoln("starting workers")
+	for i := 0; i < ctrl.workerThreads; i++ {
+		go wait.Until(func() { ctrl.rollbackWorker.Run(ctx) }, time.Second, stopCh)
+	}
+
+	log.AddContext(ctx).Infoln("started workers")
+	defer log.AddContext(ctx).Infoln("shutting down workers")
+	if stopCh != nil {
+		sign := <-stopCh
+		log.AddContext(ctx).Infof("volume snapshot rollback controller exited, reason: [%v]", sign)
+	}
+}
+
+// AddRollbackHandler add rollback event handler
+func (ctrl *VolumeSnapshotRollbackController) AddRollbackHandler(
+	ctx context.Context) *VolumeSnapshotRollbackController {
+	_, err := ctrl.rollbackInformer.Informer().AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) { ctrl.enqueueRollback(obj) },
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				if !isStatusRefreshed(oldObj, newObj) {
+					ctrl.enqueueRollback(newObj)
+				}
+			},
+		},
+	)
+	if err != nil {
+		log.AddContext(ctx).Errorf("Add rollback event handler failed, error: %v", err)
+	}
+	return ctrl
+}
+
+func (ctrl *VolumeSnapshotRollbackController) enqueueRollback(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+	if rollback, ok := obj.(*xuanwuv1.VolumeSnapshotRollback); ok {
+		objName, err := cache.DeletionHandlingMetaNamespaceKeyFunc(rollback)
+		if err != nil {
+			log.Errorf("failed to get rollback key from object [%v] err: [%v]", rollback, err)
+			return
+		}
+		log.Infof("enqueued rollback [%v] for sync", objName)
+		ctrl.rollbackQueue.Add(objName)
+	}
+}
+
+// isStatusRefreshed checks whether the update only refreshes the status in the same phase, such as the progress.
+// The work of this case has been delayed by the worker, so it is not enqueued again.
+func isStatusRefreshed(oldObj, newObj interface{}) bool {
+	oldRollback, ok := oldObj.(*xuanwuv1.VolumeSnapshotRollback)
+	if !ok {
+		return false
+	}
+	newRollback, ok := newObj.(*xuanwuv1.VolumeSnapshotRollback)
+	if !ok {
+		return false
+	}
+
+	return oldRollback.ResourceVersion != newRollback.ResourceVersion &&
+		oldRollback.Generation == newRollback.Generation &&
+		oldRollback.DeletionTimestamp.Equal(newRollback.DeletionTimestamp) &&
+		oldRollback.Status.Phase == newRollback.Status.Phase
+}
+
+// WorkerThreads used to configure the number of working threads.
+func WorkerThreads(workerThreads int) func(controller *VolumeSnapshotRollbackController) {
+	return func(ctr *VolumeSnapshotRollbackController) {
+		ctr.workerThreads = workerThreads
+	}
+}
+
+// RetryMaxDelay used to configure the max interval of retry.
+func RetryMaxDelay(retryMaxDelay time.Duration) func(controller *VolumeSnapshotRollbackController) {
+	return func(ctr *VolumeSnapshotRollbackController) {
+		ctr.retryMaxDelay = retryMaxDelay
+	}
+}
+
+// RetryBaseDelay used to configure the start interval of retry.
+func RetryBaseDelay(retryBaseDelay time.Duration) func(controller *VolumeSnapshotRollbackController) {
+	return func(ctr *VolumeSnapshotRollbackController) {
+		ctr.retryBaseDelay = retryBaseDelay
+	}
+}
+
+// ReconcileDelay used to configure the interval of checking the progress of rollback.
+func ReconcileDelay(reconcileDelay time.Duration) func(controller *VolumeSnapshotRollbackController) {
+	return func(ctr *VolumeSnapshotRollbackController) {
+		ctr.reconcileDelay = reconcileDelay
+	}
+}
+
+// Provisioner used to configure the driver name.
+func Provisioner(provisioner string) func(controller *VolumeSnapshotRollbackController) {
+	return func(ctr *VolumeSnapshotRollbackController) {
+		ctr.provisioner = provisioner
+	}
+}
+
+// AttachmentInformer used to configure the informer of VolumeAttachments.
+func AttachmentInformer(informer storageinformers.VolumeAttachmentInformer) func(
+	controller *VolumeSnapshotRollbackController) {
+	return func(ctr *VolumeSnapshotRollbackController) {
+		ctr.attachmentLister = informer.Lister()
+		ctr.attachmentListerSync = informer.Informer().HasSynced
+	}
+}
+
+// ClientOfModify used to configure the modify client.
+func ClientOfModify(modifyClient drcsi.ModifyVolumeInterfaceClient) func(controller *VolumeSnapshotRollbackController) {
+	return func(ctr *VolumeSnapshotRollbackController) {
+		ctr.modifyClient = modifyClient
+	}
+}
+
+// ClientOfDynamic used to configure the dynamic client, which is used to get the VolumeSnapshots.
+func ClientOfDynamic(dynamicClient dynamic.Interface) func(controller *VolumeSnapshotRollbackController) {
+	return func(ctr *VolumeSnapshotRollbackController) {
+		ctr.dynamicClient = dynamicClient
+	}
+}
@@ -0,0 +1,373 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package rollback contains VolumeSnapshotRollback controller definitions and synchronization functions
+package rollback
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xuanwuv1 "github.com/Huawei/eSDK_K8S_Plugin/v4/client/apis/xuanwu/v1"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/lib/drcsi"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/finalizers"
+	pkgutils "github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/utils"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/volume"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
+)
+
+const (
+	// StartRollbackReason reason of rollback is started
+	StartRollbackReason = "RollbackStarted"
+
+	// WaitDetachReason reason of rollback is waiting for the volume to be detached
+	WaitDetachReason = "WaitingForDetach"
+
+	// RollbackFailedReason reason of rollback failed
+	RollbackFailedReason = "RollbackFailed"
+
+	// RollbackCompletedReason reason of rollback completed
+	RollbackCompletedReason = "RollbackCompleted"
+
+	// ProtectRollbackFinalizer is added to the rollback which is not completed or failed, so that the publish fence
+	// is released before the rollback is deleted
+	ProtectRollbackFinalizer = "xuanwu.huawei.io/volumesnapshotrollback-protection"
+)
+
+// errRollbackAborted means the rollback is at fault on storage and can never be finished, so it is not retried
+var errRollbackAborted = errors.New("rollback aborted on storage")
+
+func (ctrl *VolumeSnapshotRollbackController) syncRollbackWork(ctx context.Context, name string) error {
+	log.AddContext(ctx).Debugf("start sync VolumeSnapshotRollback: %s", name)
+	defer log.AddContext(ctx).Debugf("finish sync VolumeSnapshotRollback: %s", name)
+	rollback, err := ctrl.rollbackLister.Get(name)
+	if err != nil {
+		if apiErrors.IsNotFound(err) {
+			log.AddContext(ctx).Infof("rollback:%s is no longer exists, end this work", name)
+			return nil
+		}
+		return fmt.Errorf("get rollback:%s from the indexer cache error: %w", name, err)
+	}
+
+	if rollback.DeletionTimestamp != nil {
+		return ctrl.syncDeleteRollback(ctx, rollback)
+	}
+
+	syncFunctions := []func(context.Context, *xuanwuv1.VolumeSnapshotRollback) (
+		*xuanwuv1.VolumeSnapshotRollback, error){
+		ctrl.setRollbackFinalizer,
+		ctrl.setRollbackPending,
+		ctrl.startRollback,
+		ctrl.waitRollback,
+	}
+	for _, syncFunction := range syncFunctions {
+		if rollback, err = syncFunction(ctx, rollback); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ctrl *VolumeSnapshotRollbackController) setRollbackFinalizer(ctx context.Context,
+	rollback *xuanwuv1.VolumeSnapshotRollback) (*xuanwuv1.VolumeSnapshotRollback, error) {
+	if isRollbackFinished(rollback) || finalizers.ContainsFinalizer(rollback, ProtectRollbackFinalizer) {
+		return rollback, nil
+	}
+
+	rollbackClone := rollback.DeepCopy()
+	finalizers.SetFinalizer(rollbackClone, ProtectRollbackFinalizer)
+	updated, err := ctrl.clientSet.XuanwuV1().VolumeSnapshotRollbacks().Update(ctx, rollbackClone,
+		metav1.UpdateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("add finalizer to rollback %s error: %w", rollback.Name, err)
+	}
+
+	return updated, nil
+}
+
+// syncDeleteRollback releases the publish fence before the finalizer of the deleted rollback is removed, otherwise
+// the volume could never be published again. The rollback running on storage can not be stopped, so the fence is
+// kept until the rollback is finished.
+func (ctrl *VolumeSnapshotRollbackController) syncDeleteRollback(ctx context.Context,
+	rollback *xuanwuv1.VolumeSnapshotRollback) error {
+	if !finalizers.ContainsFinalizer(rollback, ProtectRollbackFinalizer) {
+		return nil
+	}
+
+	if rollback.Status.Phase == xuanwuv1.VolumeSnapshotRollbackRunning {
+		log.AddContext(ctx).Infof("rollback %s is running on storage, wait for it before deletion", rollback.Name)
+		updated, err := ctrl.waitRollback(ctx, rollback)
+		if err != nil || !isRollbackFinished(updated) {
+			return err
+		}
+		rollback = updated
+	}
+
+	if !isRollbackFinished(rollback) {
+		if err := ctrl.removePublishFence(ctx, rollback); err != nil {
+			return err
+		}
+	}
+
+	rollbackClone := rollback.DeepCopy()
+	finalizers.RemoveFinalizer(rollbackClone, ProtectRollbackFinalizer)
+	_, err := ctrl.clientSet.XuanwuV1().VolumeSnapshotRollbacks().Update(ctx, rollbackClone, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("remove finalizer of rollback %s error: %w", rollback.Name, err)
+	}
+
+	log.AddContext(ctx).Infof("rollback %s is released for deletion", rollback.Name)
+	return nil
+}
+
+func (ctrl *VolumeSnapshotRollbackController) setRollbackPending(ctx context.Context,
+	rollback *xuanwuv1.VolumeSnapshotRollback) (*xuanwuv1.VolumeSnapshotRollback, error) {
+	if rollback.Status.Phase != "" {
+		return rollback, nil
+	}
+
+	defer log.AddContext(ctx).Infof("set rollback %s to %s", rollback.Name, xuanwuv1.VolumeSnapshotRollbackPending)
+	rollbackClone := rollback.DeepCopy()
+	rollbackClone.Status.Phase = xuanwuv1.VolumeSnapshotRollbackPending
+	return ctrl.updateStatus(ctx, rollbackClone)
+}
+
+// startRollback starts the rollback on storage once the volume is detached from all nodes. The publish fence is
+// recorded on the PersistentVolume before checking the attachments, so that the volume is not published again by
+// the driver until the rollback is finished, and the PersistentVolume is recorded in the status before, so that the
+// fence can be removed if the rollback is deleted.
+func (ctrl *VolumeSnapshotRollbackController) startRollback(ctx context.Context,
+	rollback *xuanwuv1.VolumeSnapshotRollback) (*xuanwuv1.VolumeSnapshotRollback, error) {
+	if rollback.Status.Phase != xuanwuv1.VolumeSnapshotRollbackPending {
+		return rollback, nil
+	}
+
+	rollbackClone := rollback.DeepCopy()
+	target, err := ctrl.getRollbackTarget(ctx, rollback.Spec.VolumeSnapshot)
+	if errors.Is(err, errInvalidSource) {
+		return ctrl.setRollbackFailed(ctx, rollbackClone, err)
+	}
+	if err != nil {
+		return ctrl.setRollbackMessage(ctx, rollbackClone, err)
+	}
+	rollbackClone.Status.PersistentVolumeName = target.persistentVolumeName
+	rollbackClone.Status.VolumeHandle = target.volumeHandle
+	rollbackClone.Status.SnapshotHandle = target.snapshotHandle
+	if rollback.Status.PersistentVolumeName == "" {
+		if rollbackClone, err = ctrl.updateStatus(ctx, rollbackClone); err != nil {
+			return nil, err
+		}
+	}
+
+	err = pkgutils.SetPublishFence(ctx, ctrl.client, target.persistentVolumeName, publishFenceOwner(rollback))
+	if err != nil {
+		return ctrl.setRollbackMessage(ctx, rollbackClone, err)
+	}
+
+	node, err := pkgutils.GetAttachedNode(ctrl.attachmentLister, target.persistentVolumeName, false)
+	if err != nil {
+		return ctrl.setRollbackMessage(ctx, rollbackClone, err)
+	}
+	if node != "" {
+		msg := fmt.Sprintf("pv %s is attached to node %s, stop the workloads using it to start the rollback",
+			target.persistentVolumeName, node)
+		if rollback.Status.Message != msg {
+			ctrl.eventRecorder.Event(rollback, corev1.EventTypeWarning, WaitDetachReason, msg)
+		}
+		rollbackClone.Status.Message = msg
+		updated, err := ctrl.updateStatus(ctx, rollbackClone)
+		if err != nil {
+			return nil, err
+		}
+		ctrl.rollbackQueue.AddAfter(rollback.Name, ctrl.reconcileDelay)
+		return updated, nil
+	}
+
+	progress, _, err := ctrl.modifyRollback(ctx, rollbackClone, false)
+	if errors.Is(err, errRollbackAborted) {
+		return ctrl.setRollbackFailed(ctx, rollbackClone, err)
+	}
+	if err != nil {
+		return ctrl.setRollbackMessage(ctx, rollbackClone, err)
+	}
+
+	ctrl.eventRecorder.Event(rollback, corev1.EventTypeNormal, StartRollbackReason,
+		fmt.Sprintf("rollback pv %s to snapshot %s", target.persistentVolumeName, target.snapshotHandle))
+	rollbackClone.Status.Phase = xuanwuv1.VolumeSnapshotRollbackRunning
+	rollbackClone.Status.Progress = generateProgressString(progress)
+	rollbackClone.Status.Message = ""
+	rollbackClone.Status.StartedAt = &metav1.Time{Time: time.Now().Local()}
+	log.AddContext(ctx).Infof("set rollback %s to %s", rollback.Name, xuanwuv1.VolumeSnapshotRollbackRunning)
+	return ctrl.updateStatus(ctx, rollbackClone)
+}
+
+// waitRollback refreshes the progress of the running rollback until it is finished on storage. The volume is kept
+// detached by the publish fence during the rollback, which is removed once the rollback is finished.
+func (ctrl *VolumeSnapshotRollbackController) waitRollback(ctx context.Context,
+	rollback *xuanwuv1.VolumeSnapshotRollback) (*xuanwuv1.VolumeSnapshotRollback, error) {
+	if rollback.Status.Phase != xuanwuv1.VolumeSnapshotRollbackRunning {
+		return rollback, nil
+	}
+
+	// give the storage a while to switch the status of the snapshot after the rollback is started
+	if rollback.Status.StartedAt != nil {
+		if elapsed := time.Since(rollback.Status.StartedAt.Time); elapsed < ctrl.reconcileDelay {
+			ctrl.rollbackQueue.AddAfter(rollback.Name, ctrl.reconcileDelay-elapsed)
+			return rollback, nil
+		}
+	}
+
+	rollbackClone := rollback.DeepCopy()
+	progress, finished, err := ctrl.modifyRollback(ctx, rollbackClone, true)
+	if errors.Is(err, errRollbackAborted) {
+		return ctrl.setRollbackFailed(ctx, rollbackClone, err)
+	}
+	if err != nil {
+		return ctrl.setRollbackMessage(ctx, rollbackClone, err)
+	}
+
+	rollbackClone.Status.Progress = generateProgressString(progress)
+	rollbackClone.Status.Message = ""
+	if finished {
+		if err = ctrl.removePublishFence(ctx, rollbackClone); err != nil {
+			return ctrl.setRollbackMessage(ctx, rollbackClone, err)
+		}
+
+		rollbackClone.Status.Phase = xuanwuv1.VolumeSnapshotRollbackCompleted
+		rollbackClone.Status.CompletedAt = &metav1.Time{Time: time.Now().Local()}
+		ctrl.eventRecorder.Event(rollback, corev1.EventTypeNormal, RollbackCompletedReason,
+			fmt.Sprintf("rollback pv %s to snapshot %s completed", rollback.Status.PersistentVolumeName,
+				rollback.Status.SnapshotHandle))
+		log.AddContext(ctx).Infof("set rollback %s to %s", rollback.Name, xuanwuv1.VolumeSnapshotRollbackCompleted)
+	}
+
+	updated, err := ctrl.updateStatus(ctx, rollbackClone)
+	if err != nil {
+		return nil, err
+	}
+
+	if !finished {
+		ctrl.rollbackQueue.AddAfter(rollback.Name, ctrl.reconcileDelay)
+	}
+	return updated, nil
+}
+
+// modifyRollback calls the provider to start the rollback or to query the progress of it, and returns the progress
+// in percent and whether the rollback is finished. The error wraps errRollbackAborted if the provider reports that
+// the rollback can never be finished.
+func (ctrl *VolumeSnapshotRollbackController) modifyRollback(ctx context.Context,
+	rollback *xuanwuv1.VolumeSnapshotRollback, started bool) (int, bool, error) {
+	request := &drcsi.ModifyVolumeRequest{
+		VolumeId: rollback.Status.VolumeHandle,
+		MutableParameters: map[string]string{
+			volume.RollbackSnapshotIdKey: rollback.Status.SnapshotHandle,
+			volume.RollbackStartedKey:    strconv.FormatBool(started),
+		},
+	}
+	log.AddContext(ctx).Infof("call modify interface start, rollback:%s, request body: %+v", rollback.Name, request)
+	response, err := ctrl.modifyClient.ModifyVolume(ctx, request)
+	if status.Code(err) == codes.Aborted {
+		return 0, false, fmt.Errorf("%w: %s", errRollbackAborted, status.Convert(err).Message())
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("call modify volume interface error: %w", err)
+	}
+	log.AddContext(ctx).Infof("call modify interface end, rollback:%s, response body: %+v", rollback.Name, response)
+
+	attributes := response.GetVolumeAttributes()
+	progress, err := strconv.Atoi(attributes[volume.RollbackProgressKey])
+	if err != nil {
+		progress = 0
+	}
+	finished, err := strconv.ParseBool(attributes[volume.RollbackFinishedKey])
+	if err != nil {
+		finished = false
+	}
+
+	return progress, finished, nil
+}
+
+// setRollbackMessage records the error in the status, and returns the error so that the work is retried.
+func (ctrl *VolumeSnapshotRollbackController) setRollbackMessage(ctx context.Context,
+	rollback *xuanwuv1.VolumeSnapshotRollback, cause error) (*xuanwuv1.VolumeSnapshotRollback, error) {
+	log.AddContext(ctx).Errorf("rollback %s error: %v", rollback.Name, cause)
+	rollback.Status.Message = cause.Error()
+	if _, err := ctrl.updateStatus(ctx, rollback); err != nil {
+		return nil, errors.Join(cause, err)
+	}
+
+	return nil, cause
+}
+
+// setRollbackFailed removes the publish fence before the rollback is set to failed, the error is recorded and retried
+// if the fence can not be removed.
+func (ctrl *VolumeSnapshotRollbackController) setRollbackFailed(ctx context.Context,
+	rollback *xuanwuv1.VolumeSnapshotRollback, cause error) (*xuanwuv1.VolumeSnapshotRollback, error) {
+	log.AddContext(ctx).Errorf("rollback %s failed: %v", rollback.Name, cause)
+	if err := ctrl.removePublishFence(ctx, rollback); err != nil {
+		return ctrl.setRollbackMessage(ctx, rollback, errors.Join(cause, err))
+	}
+
+	ctrl.eventRecorder.Event(rollback, corev1.EventTypeWarning, RollbackFailedReason, cause.Error())
+	rollback.Status.Phase = xuanwuv1.VolumeSnapshotRollbackFailed
+	rollback.Status.Message = cause.Error()
+	rollback.Status.CompletedAt = &metav1.Time{Time: time.Now().Local()}
+	return ctrl.updateStatus(ctx, rollback)
+}
+
+// removePublishFence removes the publish fence recorded on the PersistentVolume by the rollback
+func (ctrl *VolumeSnapshotRollbackController) removePublishFence(ctx context.Context,
+	rollback *xuanwuv1.VolumeSnapshotRollback) error {
+	if rollback.Status.PersistentVolumeName == "" {
+		return nil
+	}
+
+	return pkgutils.RemovePublishFence(ctx, ctrl.client, rollback.Status.PersistentVolumeName,
+		publishFenceOwner(rollback))
+}
+
+func (ctrl *VolumeSnapshotRollbackController) updateStatus(ctx context.Context,
+	rollback *xuanwuv1.VolumeSnapshotRollback) (*xuanwuv1.VolumeSnapshotRollback, error) {
+	updated, err := ctrl.clientSet.XuanwuV1().VolumeSnapshotRollbacks().UpdateStatus(ctx, rollback,
+		metav1.UpdateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("update status of rollback %s error: %w", rollback.Name, err)
+	}
+
+	return updated, nil
+}
+
+func isRollbackFinished(rollback *xuanwuv1.VolumeSnapshotRollback) bool {
+	return rollback.Status.Phase == xuanwuv1.VolumeSnapshotRollbackCompleted ||
+		rollback.Status.Phase == xuanwuv1.VolumeSnapshotRollbackFailed
+}
+
+func publishFenceOwner(rollback *xuanwuv1.VolumeSnapshotRollback) string {
+	return pkgutils.PublishFenceOwner("VolumeSnapshotRollback", rollback.Name)
+}
+
+func generateProgressString(progress int) string {
+	return strconv.Itoa(progress) + "%"
+}
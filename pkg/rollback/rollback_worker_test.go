@@ -0,0 +1,368 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package rollback
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	storagelisters "k8s.io/client-go/listers/storage/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	xuanwuv1 "github.com/Huawei/eSDK_K8S_Plugin/v4/client/apis/xuanwu/v1"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/lib/drcsi"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/client/clientset/versioned/fake"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/volume"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
+)
+
+const (
+	logName = "rollbackTest.log"
+
+	testProvisioner    = "csi.huawei.com"
+	testPVName         = "pvc-1"
+	testVolumeHandle   = "backend-a.pvc-1"
+	testSnapshotHandle = "backend-a.10.snapshot-1"
+)
+
+func TestMain(m *testing.M) {
+	log.MockInitLogging(logName)
+	defer log.MockStopLogging(logName)
+
+	m.Run()
+}
+
+type mockModifyClient struct {
+	modifyFunc func(ctx context.Context, in *drcsi.ModifyVolumeRequest) (*drcsi.ModifyVolumeResponse, error)
+}
+
+func (m *mockModifyClient) ModifyVolume(ctx context.Context, in *drcsi.ModifyVolumeRequest,
+	opts ...grpc.CallOption) (*drcsi.ModifyVolumeResponse, error) {
+	if m.modifyFunc != nil {
+		return m.modifyFunc(ctx, in)
+	}
+	return nil, nil
+}
+
+func newTestRollback(phase xuanwuv1.VolumeSnapshotRollbackPhase) *xuanwuv1.VolumeSnapshotRollback {
+	return &xuanwuv1.VolumeSnapshotRollback{
+		ObjectMeta: metav1.ObjectMeta{Name: "rollback-1"},
+		Spec: xuanwuv1.VolumeSnapshotRollbackSpec{
+			VolumeSnapshot: xuanwuv1.VolumeSnapshotRollbackSource{Namespace: "default", Name: "snapshot-1"},
+		},
+		Status: xuanwuv1.VolumeSnapshotRollbackStatus{Phase: phase},
+	}
+}
+
+func newTestSnapshotObjects(driver string) []runtime.Object {
+	snapshot := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "snapshot.storage.k8s.io/v1",
+		"kind":       "VolumeSnapshot",
+		"metadata":   map[string]interface{}{"name": "snapshot-1", "namespace": "default"},
+		"spec": map[string]interface{}{
+			"source": map[string]interface{}{"persistentVolumeClaimName": "claim-1"},
+		},
+		"status": map[string]interface{}{"readyToUse": true, "boundVolumeSnapshotContentName": "content-1"},
+	}}
+	content := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "snapshot.storage.k8s.io/v1",
+		"kind":       "VolumeSnapshotContent",
+		"metadata":   map[string]interface{}{"name": "content-1"},
+		"spec":       map[string]interface{}{"driver": driver},
+		"status":     map[string]interface{}{"snapshotHandle": testSnapshotHandle},
+	}}
+	return []runtime.Object{snapshot, content}
+}
+
+func newTestController(rollback *xuanwuv1.VolumeSnapshotRollback, modifyClient drcsi.ModifyVolumeInterfaceClient,
+	snapshotObjects []runtime.Object, objects ...runtime.Object) *VolumeSnapshotRollbackController {
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "claim-1"},
+		Spec:       corev1.PersistentVolumeClaimSpec{VolumeName: testPVName},
+	}
+	pv := &corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: testPVName},
+		Spec: corev1.PersistentVolumeSpec{PersistentVolumeSource: corev1.PersistentVolumeSource{
+			CSI: &corev1.CSIPersistentVolumeSource{Driver: testProvisioner, VolumeHandle: testVolumeHandle},
+		}},
+	}
+
+	attachments := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, object := range objects {
+		if attachment, ok := object.(*storagev1.VolumeAttachment); ok {
+			_ = attachments.Add(attachment)
+		}
+	}
+
+	return &VolumeSnapshotRollbackController{
+		client:           k8sfake.NewSimpleClientset(append(objects, pvc, pv)...),
+		dynamicClient:    dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), snapshotObjects...),
+		clientSet:        fake.NewSimpleClientset(rollback),
+		modifyClient:     modifyClient,
+		eventRecorder:    record.NewFakeRecorder(100),
+		rollbackQueue:    workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+		attachmentLister: storagelisters.NewVolumeAttachmentLister(attachments),
+		reconcileDelay:   defaultReconcileDelay,
+		provisioner:      testProvisioner,
+	}
+}
+
+func setTestPublishFence(t *testing.T, ctrl *VolumeSnapshotRollbackController, owner string) {
+	pv, err := ctrl.client.CoreV1().PersistentVolumes().Get(context.Background(), testPVName, metav1.GetOptions{})
+	assert.NoError(t, err)
+	pv.Annotations = map[string]string{constants.PublishFenceAnnotationKey: owner}
+	_, err = ctrl.client.CoreV1().PersistentVolumes().Update(context.Background(), pv, metav1.UpdateOptions{})
+	assert.NoError(t, err)
+}
+
+func getTestPublishFence(t *testing.T, ctrl *VolumeSnapshotRollbackController) string {
+	pv, err := ctrl.client.CoreV1().PersistentVolumes().Get(context.Background(), testPVName, metav1.GetOptions{})
+	assert.NoError(t, err)
+	return pv.Annotations[constants.PublishFenceAnnotationKey]
+}
+
+func TestStartRollback_Success(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	rollback := newTestRollback(xuanwuv1.VolumeSnapshotRollbackPending)
+	var request *drcsi.ModifyVolumeRequest
+	modifyClient := &mockModifyClient{
+		modifyFunc: func(ctx context.Context, in *drcsi.ModifyVolumeRequest) (*drcsi.ModifyVolumeResponse, error) {
+			request = in
+			return &drcsi.ModifyVolumeResponse{VolumeAttributes: map[string]string{
+				volume.RollbackProgressKey: "0",
+				volume.RollbackFinishedKey: "false",
+			}}, nil
+		},
+	}
+	ctrl := newTestController(rollback, modifyClient, newTestSnapshotObjects(testProvisioner))
+
+	// action
+	result, err := ctrl.startRollback(ctx, rollback)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, xuanwuv1.VolumeSnapshotRollbackRunning, result.Status.Phase)
+	assert.Equal(t, testPVName, result.Status.PersistentVolumeName)
+	assert.Equal(t, "0%", result.Status.Progress)
+	assert.NotNil(t, result.Status.StartedAt)
+	assert.Equal(t, testVolumeHandle, request.VolumeId)
+	assert.Equal(t, map[string]string{
+		volume.RollbackSnapshotIdKey: testSnapshotHandle,
+		volume.RollbackStartedKey:    "false",
+	}, request.MutableParameters)
+	assert.Equal(t, "VolumeSnapshotRollback/rollback-1", getTestPublishFence(t, ctrl))
+}
+
+func TestStartRollback_VolumeAttached(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	rollback := newTestRollback(xuanwuv1.VolumeSnapshotRollbackPending)
+	pvName := testPVName
+	attachment := &storagev1.VolumeAttachment{
+		ObjectMeta: metav1.ObjectMeta{Name: "csi-1"},
+		Spec: storagev1.VolumeAttachmentSpec{
+			Attacher: testProvisioner,
+			NodeName: "node-1",
+			Source:   storagev1.VolumeAttachmentSource{PersistentVolumeName: &pvName},
+		},
+	}
+	modifyClient := &mockModifyClient{
+		modifyFunc: func(ctx context.Context, in *drcsi.ModifyVolumeRequest) (*drcsi.ModifyVolumeResponse, error) {
+			t.Fatal("rollback must not be started when the volume is attached")
+			return nil, nil
+		},
+	}
+	ctrl := newTestController(rollback, modifyClient, newTestSnapshotObjects(testProvisioner), attachment)
+
+	// action
+	result, err := ctrl.startRollback(ctx, rollback)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, xuanwuv1.VolumeSnapshotRollbackPending, result.Status.Phase)
+	assert.Contains(t, result.Status.Message, "is attached to node node-1")
+	assert.Equal(t, "VolumeSnapshotRollback/rollback-1", getTestPublishFence(t, ctrl))
+}
+
+func TestStartRollback_FencedByOthers(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	rollback := newTestRollback(xuanwuv1.VolumeSnapshotRollbackPending)
+	modifyClient := &mockModifyClient{
+		modifyFunc: func(ctx context.Context, in *drcsi.ModifyVolumeRequest) (*drcsi.ModifyVolumeResponse, error) {
+			t.Fatal("rollback must not be started when the volume is fenced by others")
+			return nil, nil
+		},
+	}
+	ctrl := newTestController(rollback, modifyClient, newTestSnapshotObjects(testProvisioner))
+	setTestPublishFence(t, ctrl, "VolumeMigration/migration-1")
+
+	// action
+	_, err := ctrl.startRollback(ctx, rollback)
+
+	// assert
+	assert.ErrorContains(t, err, "is fenced by VolumeMigration/migration-1")
+	assert.Equal(t, "VolumeMigration/migration-1", getTestPublishFence(t, ctrl))
+}
+
+func TestStartRollback_AbortedOnStorage(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	rollback := newTestRollback(xuanwuv1.VolumeSnapshotRollbackPending)
+	modifyClient := &mockModifyClient{
+		modifyFunc: func(ctx context.Context, in *drcsi.ModifyVolumeRequest) (*drcsi.ModifyVolumeResponse, error) {
+			return nil, status.Error(codes.Aborted, "lun pvc-1 is a hypermetro lun")
+		},
+	}
+	ctrl := newTestController(rollback, modifyClient, newTestSnapshotObjects(testProvisioner))
+
+	// action
+	result, err := ctrl.startRollback(ctx, rollback)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, xuanwuv1.VolumeSnapshotRollbackFailed, result.Status.Phase)
+	assert.Contains(t, result.Status.Message, "lun pvc-1 is a hypermetro lun")
+	assert.Empty(t, getTestPublishFence(t, ctrl))
+}
+
+func TestStartRollback_SnapshotOfOtherDriver(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	rollback := newTestRollback(xuanwuv1.VolumeSnapshotRollbackPending)
+	ctrl := newTestController(rollback, &mockModifyClient{}, newTestSnapshotObjects("other.csi.io"))
+
+	// action
+	result, err := ctrl.startRollback(ctx, rollback)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, xuanwuv1.VolumeSnapshotRollbackFailed, result.Status.Phase)
+	assert.Contains(t, result.Status.Message, "is not provisioned by csi.huawei.com")
+}
+
+func TestWaitRollback_Completed(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	rollback := newTestRollback(xuanwuv1.VolumeSnapshotRollbackRunning)
+	rollback.Status.PersistentVolumeName = testPVName
+	rollback.Status.VolumeHandle = testVolumeHandle
+	rollback.Status.SnapshotHandle = testSnapshotHandle
+	rollback.Status.StartedAt = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+	modifyClient := &mockModifyClient{
+		modifyFunc: func(ctx context.Context, in *drcsi.ModifyVolumeRequest) (*drcsi.ModifyVolumeResponse, error) {
+			return &drcsi.ModifyVolumeResponse{VolumeAttributes: map[string]string{
+				volume.RollbackProgressKey: "100",
+				volume.RollbackFinishedKey: "true",
+			}}, nil
+		},
+	}
+	ctrl := newTestController(rollback, modifyClient, nil)
+	setTestPublishFence(t, ctrl, "VolumeSnapshotRollback/rollback-1")
+
+	// action
+	result, err := ctrl.waitRollback(ctx, rollback)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, xuanwuv1.VolumeSnapshotRollbackCompleted, result.Status.Phase)
+	assert.Equal(t, "100%", result.Status.Progress)
+	assert.NotNil(t, result.Status.CompletedAt)
+	assert.Empty(t, getTestPublishFence(t, ctrl))
+}
+
+func TestWaitRollback_AbortedOnStorage(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	rollback := newTestRollback(xuanwuv1.VolumeSnapshotRollbackRunning)
+	rollback.Status.PersistentVolumeName = testPVName
+	rollback.Status.VolumeHandle = testVolumeHandle
+	rollback.Status.SnapshotHandle = testSnapshotHandle
+	rollback.Status.StartedAt = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+	modifyClient := &mockModifyClient{
+		modifyFunc: func(ctx context.Context, in *drcsi.ModifyVolumeRequest) (*drcsi.ModifyVolumeResponse, error) {
+			return nil, status.Error(codes.Aborted, "snapshot snapshot-1 is at fault status")
+		},
+	}
+	ctrl := newTestController(rollback, modifyClient, nil)
+	setTestPublishFence(t, ctrl, "VolumeSnapshotRollback/rollback-1")
+
+	// action
+	result, err := ctrl.waitRollback(ctx, rollback)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, xuanwuv1.VolumeSnapshotRollbackFailed, result.Status.Phase)
+	assert.Contains(t, result.Status.Message, "snapshot snapshot-1 is at fault status")
+	assert.Empty(t, getTestPublishFence(t, ctrl))
+}
+
+func TestSyncDeleteRollback_PendingReleasesFence(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	rollback := newTestRollback(xuanwuv1.VolumeSnapshotRollbackPending)
+	rollback.Status.PersistentVolumeName = testPVName
+	rollback.Finalizers = []string{ProtectRollbackFinalizer}
+	ctrl := newTestController(rollback, &mockModifyClient{}, nil)
+	setTestPublishFence(t, ctrl, "VolumeSnapshotRollback/rollback-1")
+
+	// action
+	err := ctrl.syncDeleteRollback(ctx, rollback)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Empty(t, getTestPublishFence(t, ctrl))
+	updated, err := ctrl.clientSet.XuanwuV1().VolumeSnapshotRollbacks().Get(ctx, rollback.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Empty(t, updated.Finalizers)
+}
+
+func TestWaitRollback_JustStarted(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	rollback := newTestRollback(xuanwuv1.VolumeSnapshotRollbackRunning)
+	rollback.Status.StartedAt = &metav1.Time{Time: time.Now()}
+	ctrl := newTestController(rollback, &mockModifyClient{
+		modifyFunc: func(ctx context.Context, in *drcsi.ModifyVolumeRequest) (*drcsi.ModifyVolumeResponse, error) {
+			t.Fatal("progress must not be queried right after the rollback is started")
+			return nil, nil
+		},
+	}, nil)
+
+	// action
+	result, err := ctrl.waitRollback(ctx, rollback)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, xuanwuv1.VolumeSnapshotRollbackRunning, result.Status.Phase)
+}
@@ -0,0 +1,117 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package rollback contains VolumeSnapshotRollback controller definitions and synchronization functions
+package rollback
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xuanwuv1 "github.com/Huawei/eSDK_K8S_Plugin/v4/client/apis/xuanwu/v1"
+	pkgutils "github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/utils"
+)
+
+var (
+	volumeSnapshotResource = schema.GroupVersionResource{
+		Group: "snapshot.storage.k8s.io", Version: "v1", Resource: "volumesnapshots"}
+	volumeSnapshotContentResource = schema.GroupVersionResource{
+		Group: "snapshot.storage.k8s.io", Version: "v1", Resource: "volumesnapshotcontents"}
+
+	// errInvalidSource means the rollback can never succeed, so it is not retried
+	errInvalidSource = errors.New("invalid rollback source")
+)
+
+// rollbackTarget is the PersistentVolume and the storage snapshot of a rollback
+type rollbackTarget struct {
+	persistentVolumeName string
+	volumeHandle         string
+	snapshotHandle       string
+}
+
+// getRollbackTarget resolves the source PersistentVolume and the storage snapshot of the VolumeSnapshot.
+// The errors wrapping errInvalidSource are permanent, and the others are retried.
+func (ctrl *VolumeSnapshotRollbackController) getRollbackTarget(ctx context.Context,
+	source xuanwuv1.VolumeSnapshotRollbackSource) (*rollbackTarget, error) {
+	snapshotMeta := pkgutils.MakeMetaWithNamespace(source.Namespace, source.Name)
+	snapshot, err := ctrl.dynamicClient.Resource(volumeSnapshotResource).Namespace(source.Namespace).Get(ctx,
+		source.Name, metav1.GetOptions{})
+	if apiErrors.IsNotFound(err) {
+		return nil, fmt.Errorf("%w: volume snapshot %s does not exist", errInvalidSource, snapshotMeta)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get volume snapshot %s error: %w", snapshotMeta, err)
+	}
+
+	claimName, _, _ := unstructured.NestedString(snapshot.Object, "spec", "source", "persistentVolumeClaimName")
+	if claimName == "" {
+		return nil, fmt.Errorf("%w: volume snapshot %s is not taken from a persistent volume claim",
+			errInvalidSource, snapshotMeta)
+	}
+	readyToUse, _, _ := unstructured.NestedBool(snapshot.Object, "status", "readyToUse")
+	contentName, _, _ := unstructured.NestedString(snapshot.Object, "status", "boundVolumeSnapshotContentName")
+	if !readyToUse || contentName == "" {
+		return nil, fmt.Errorf("volume snapshot %s is not ready to use", snapshotMeta)
+	}
+
+	content, err := ctrl.dynamicClient.Resource(volumeSnapshotContentResource).Get(ctx, contentName,
+		metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("get volume snapshot content %s error: %w", contentName, err)
+	}
+	driver, _, _ := unstructured.NestedString(content.Object, "spec", "driver")
+	if driver != ctrl.provisioner {
+		return nil, fmt.Errorf("%w: volume snapshot %s is not provisioned by %s",
+			errInvalidSource, snapshotMeta, ctrl.provisioner)
+	}
+	snapshotHandle, _, _ := unstructured.NestedString(content.Object, "status", "snapshotHandle")
+	if snapshotHandle == "" {
+		return nil, fmt.Errorf("snapshot handle of volume snapshot content %s is empty", contentName)
+	}
+
+	claimMeta := pkgutils.MakeMetaWithNamespace(source.Namespace, claimName)
+	pvc, err := ctrl.client.CoreV1().PersistentVolumeClaims(source.Namespace).Get(ctx, claimName,
+		metav1.GetOptions{})
+	if apiErrors.IsNotFound(err) {
+		return nil, fmt.Errorf("%w: source pvc %s does not exist", errInvalidSource, claimMeta)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get pvc %s error: %w", claimMeta, err)
+	}
+	if pvc.Spec.VolumeName == "" {
+		return nil, fmt.Errorf("%w: source pvc %s is not bound", errInvalidSource, claimMeta)
+	}
+
+	pv, err := ctrl.client.CoreV1().PersistentVolumes().Get(ctx, pvc.Spec.VolumeName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("get pv %s error: %w", pvc.Spec.VolumeName, err)
+	}
+	if pv.Spec.CSI == nil || pv.Spec.CSI.Driver != ctrl.provisioner || pv.Spec.CSI.VolumeHandle == "" {
+		return nil, fmt.Errorf("%w: pv %s is not provisioned by %s", errInvalidSource, pv.Name, ctrl.provisioner)
+	}
+
+	return &rollbackTarget{
+		persistentVolumeName: pv.Name,
+		volumeHandle:         pv.Spec.CSI.VolumeHandle,
+		snapshotHandle:       snapshotHandle,
+	}, nil
+}
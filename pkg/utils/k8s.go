@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2025. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -26,6 +26,7 @@ import (
 
 	coreV1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
 	clientV1 "k8s.io/client-go/kubernetes/typed/core/v1"
@@ -389,6 +390,25 @@ func GetK8SAndCrdClient(ctx context.Context) (*kubernetes.Clientset, *clientSet.
 	return k8sClient, crdClient, nil
 }
 
+// GetDynamicClient used to get the dynamic client, which is used to access the resources without typed clients
+func GetDynamicClient(ctx context.Context) (dynamic.Interface, error) {
+	kubeConfig := app.GetGlobalConfig().KubeConfig
+	config, err := k8sutils.BuildConfig(kubeConfig, k8sutils.QPS(app.GetGlobalConfig().KubeAPIQPS),
+		k8sutils.Burst(app.GetGlobalConfig().KubeAPIBurst))
+	if err != nil {
+		log.AddContext(ctx).Errorf("Error getting cluster config, kube config: %s, error %v", kubeConfig, err)
+		return nil, err
+	}
+
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		log.AddContext(ctx).Errorf("Error getting dynamic client error %v", err)
+		return nil, err
+	}
+
+	return dynamicClient, nil
+}
+
 // InitRecorder used to init event recorder
 func InitRecorder(client kubernetes.Interface, componentName string) record.EventRecorder {
 	eventBroadcaster := record.NewBroadcaster()
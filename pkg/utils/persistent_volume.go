@@ -0,0 +1,128 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package utils to provide k8s resource utils
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	coreV1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
+)
+
+const (
+	pvDeletedPollInterval = time.Second
+	pvDeletedPollTimeout  = 30 * time.Second
+)
+
+// RecreatePersistentVolume used to recreate the PersistentVolume as the target, because the volume handle
+// of a PersistentVolume is immutable. The target is built by BuildRebindPersistentVolume, and it must be persisted
+// by the caller before, so that the PersistentVolume can be created by the retry if it has been deleted by a previous
+// attempt which is interrupted. It is skipped if the PersistentVolume has been rebound.
+func RecreatePersistentVolume(ctx context.Context, client kubernetes.Interface, target *coreV1.PersistentVolume) error {
+	if target == nil || target.Spec.CSI == nil {
+		return errors.New("the target pv to rebind is not specified")
+	}
+
+	volumeHandle := target.Spec.CSI.VolumeHandle
+	pv, err := client.CoreV1().PersistentVolumes().Get(ctx, target.Name, metav1.GetOptions{})
+	if apiErrors.IsNotFound(err) {
+		return createRebindPersistentVolume(ctx, client, target)
+	}
+	if err != nil {
+		return fmt.Errorf("get pv %s error: %w", target.Name, err)
+	}
+	if pv.Spec.CSI != nil && pv.Spec.CSI.VolumeHandle == volumeHandle {
+		return nil
+	}
+
+	// retain the volume and drop the finalizers, otherwise the storage volume would be deleted by the provisioner
+	// or the deletion of the PersistentVolume would be blocked by the bound claim.
+	pvClone := pv.DeepCopy()
+	pvClone.Spec.PersistentVolumeReclaimPolicy = coreV1.PersistentVolumeReclaimRetain
+	pvClone.Finalizers = nil
+	_, err = client.CoreV1().PersistentVolumes().Update(ctx, pvClone, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("retain pv %s error: %w", pv.Name, err)
+	}
+
+	err = client.CoreV1().PersistentVolumes().Delete(ctx, pv.Name, metav1.DeleteOptions{})
+	if err != nil && !apiErrors.IsNotFound(err) {
+		return fmt.Errorf("delete pv %s error: %w", pv.Name, err)
+	}
+
+	err = wait.PollUntilContextTimeout(ctx, pvDeletedPollInterval, pvDeletedPollTimeout, true,
+		func(ctx context.Context) (bool, error) {
+			_, err := client.CoreV1().PersistentVolumes().Get(ctx, pv.Name, metav1.GetOptions{})
+			if apiErrors.IsNotFound(err) {
+				return true, nil
+			}
+			return false, err
+		})
+	if err != nil {
+		return fmt.Errorf("wait for pv %s to be deleted error: %w", pv.Name, err)
+	}
+
+	return createRebindPersistentVolume(ctx, client, target)
+}
+
+func createRebindPersistentVolume(ctx context.Context, client kubernetes.Interface,
+	target *coreV1.PersistentVolume) error {
+	_, err := client.CoreV1().PersistentVolumes().Create(ctx, target, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("create pv %s with volume handle %s error: %w",
+			target.Name, target.Spec.CSI.VolumeHandle, err)
+	}
+
+	log.AddContext(ctx).Infof("rebind pv %s to volume handle %s", target.Name, target.Spec.CSI.VolumeHandle)
+	return nil
+}
+
+// BuildRebindPersistentVolume builds the PersistentVolume which replaces pv with another volume handle.
+// The attributes are merged into the volume attributes, and the claimRef is kept, so the claim is bound to it again.
+func BuildRebindPersistentVolume(pv *coreV1.PersistentVolume, volumeHandle string,
+	attributes map[string]string) *coreV1.PersistentVolume {
+	target := &coreV1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        pv.Name,
+			Labels:      pv.Labels,
+			Annotations: pv.Annotations,
+		},
+		Spec: *pv.Spec.DeepCopy(),
+	}
+
+	target.Spec.CSI.VolumeHandle = volumeHandle
+	if target.Spec.CSI.VolumeAttributes == nil {
+		target.Spec.CSI.VolumeAttributes = make(map[string]string)
+	}
+	for key, value := range attributes {
+		target.Spec.CSI.VolumeAttributes[key] = value
+	}
+
+	if target.Spec.ClaimRef != nil {
+		target.Spec.ClaimRef.ResourceVersion = ""
+	}
+	return target
+}
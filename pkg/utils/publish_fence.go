@@ -0,0 +1,116 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package utils to provide k8s resource utils
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	storagelisters "k8s.io/client-go/listers/storage/v1"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
+)
+
+// PublishFenceOwner returns the owner of the publish fence recorded on the PersistentVolume by a custom resource
+func PublishFenceOwner(kind, name string) string {
+	return kind + "/" + name
+}
+
+// SetPublishFence records the publish fence of the owner on the PersistentVolume, the driver refuses to publish
+// the volume until the fence is removed. It fails if the volume has been fenced by another owner.
+func SetPublishFence(ctx context.Context, client kubernetes.Interface, pvName, owner string) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		pv, err := client.CoreV1().PersistentVolumes().Get(ctx, pvName, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("get pv %s error: %w", pvName, err)
+		}
+
+		current := pv.Annotations[constants.PublishFenceAnnotationKey]
+		if current == owner {
+			return nil
+		}
+		if current != "" {
+			return fmt.Errorf("pv %s is fenced by %s", pvName, current)
+		}
+
+		pvClone := pv.DeepCopy()
+		if pvClone.Annotations == nil {
+			pvClone.Annotations = make(map[string]string)
+		}
+		pvClone.Annotations[constants.PublishFenceAnnotationKey] = owner
+		if _, err = client.CoreV1().PersistentVolumes().Update(ctx, pvClone, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+
+		log.AddContext(ctx).Infof("pv %s is fenced from publishing by %s", pvName, owner)
+		return nil
+	})
+}
+
+// RemovePublishFence removes the publish fence of the owner from the PersistentVolume,
+// the fence of another owner is kept.
+func RemovePublishFence(ctx context.Context, client kubernetes.Interface, pvName, owner string) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		pv, err := client.CoreV1().PersistentVolumes().Get(ctx, pvName, metav1.GetOptions{})
+		if apiErrors.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("get pv %s error: %w", pvName, err)
+		}
+		if pv.Annotations[constants.PublishFenceAnnotationKey] != owner {
+			return nil
+		}
+
+		pvClone := pv.DeepCopy()
+		delete(pvClone.Annotations, constants.PublishFenceAnnotationKey)
+		if _, err = client.CoreV1().PersistentVolumes().Update(ctx, pvClone, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+
+		log.AddContext(ctx).Infof("the publish fence of pv %s by %s is removed", pvName, owner)
+		return nil
+	})
+}
+
+// GetAttachedNode returns the node which the PersistentVolume is attached to from the cache of VolumeAttachments.
+// The attachments which are not attached yet are skipped if attachedOnly is true.
+func GetAttachedNode(lister storagelisters.VolumeAttachmentLister, pvName string, attachedOnly bool) (string, error) {
+	attachments, err := lister.List(labels.Everything())
+	if err != nil {
+		return "", fmt.Errorf("list volume attachments error: %w", err)
+	}
+
+	for _, attachment := range attachments {
+		source := attachment.Spec.Source.PersistentVolumeName
+		if attachedOnly && !attachment.Status.Attached {
+			continue
+		}
+		if source != nil && *source == pvName {
+			return attachment.Spec.NodeName, nil
+		}
+	}
+
+	return "", nil
+}
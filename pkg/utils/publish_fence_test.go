@@ -0,0 +1,133 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	storagelisters "k8s.io/client-go/listers/storage/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
+)
+
+func newFenceTestPersistentVolume(owner string) *corev1.PersistentVolume {
+	pv := &corev1.PersistentVolume{ObjectMeta: metav1.ObjectMeta{Name: "pvc-1"}}
+	if owner != "" {
+		pv.Annotations = map[string]string{constants.PublishFenceAnnotationKey: owner}
+	}
+	return pv
+}
+
+func getFenceTestOwner(t *testing.T, client *k8sfake.Clientset) string {
+	pv, err := client.CoreV1().PersistentVolumes().Get(context.Background(), "pvc-1", metav1.GetOptions{})
+	assert.NoError(t, err)
+	return pv.Annotations[constants.PublishFenceAnnotationKey]
+}
+
+func TestSetPublishFence_Success(t *testing.T) {
+	// arrange
+	client := k8sfake.NewSimpleClientset(newFenceTestPersistentVolume(""))
+	owner := PublishFenceOwner("VolumeMigration", "migration-1")
+
+	// action
+	err := SetPublishFence(context.Background(), client, "pvc-1", owner)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, "VolumeMigration/migration-1", getFenceTestOwner(t, client))
+}
+
+func TestSetPublishFence_FencedByAnotherOwner(t *testing.T) {
+	// arrange
+	client := k8sfake.NewSimpleClientset(newFenceTestPersistentVolume("VolumeSnapshotRollback/rollback-1"))
+
+	// action
+	err := SetPublishFence(context.Background(), client, "pvc-1", "VolumeMigration/migration-1")
+
+	// assert
+	assert.ErrorContains(t, err, "fenced by VolumeSnapshotRollback/rollback-1")
+	assert.Equal(t, "VolumeSnapshotRollback/rollback-1", getFenceTestOwner(t, client))
+}
+
+func TestRemovePublishFence(t *testing.T) {
+	// arrange
+	tests := []struct {
+		name    string
+		current string
+		want    string
+	}{
+		{name: "fenced by the owner", current: "VolumeMigration/migration-1", want: ""},
+		{name: "fenced by another owner", current: "VolumeMigration/migration-2", want: "VolumeMigration/migration-2"},
+		{name: "not fenced", current: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := k8sfake.NewSimpleClientset(newFenceTestPersistentVolume(tt.current))
+
+			// action
+			err := RemovePublishFence(context.Background(), client, "pvc-1", "VolumeMigration/migration-1")
+
+			// assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, getFenceTestOwner(t, client))
+		})
+	}
+}
+
+func TestRemovePublishFence_PersistentVolumeNotExist(t *testing.T) {
+	// arrange
+	client := k8sfake.NewSimpleClientset()
+
+	// action
+	err := RemovePublishFence(context.Background(), client, "pvc-1", "VolumeMigration/migration-1")
+
+	// assert
+	assert.NoError(t, err)
+}
+
+func TestGetAttachedNode(t *testing.T) {
+	// arrange
+	pvName := "pvc-1"
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	assert.NoError(t, indexer.Add(&storagev1.VolumeAttachment{
+		ObjectMeta: metav1.ObjectMeta{Name: "va-1"},
+		Spec: storagev1.VolumeAttachmentSpec{NodeName: "node-1",
+			Source: storagev1.VolumeAttachmentSource{PersistentVolumeName: &pvName}},
+	}))
+	lister := storagelisters.NewVolumeAttachmentLister(indexer)
+
+	// action
+	node, err := GetAttachedNode(lister, pvName, false)
+	attachedNode, attachedErr := GetAttachedNode(lister, pvName, true)
+	otherNode, otherErr := GetAttachedNode(lister, "pvc-2", false)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, "node-1", node)
+	assert.NoError(t, attachedErr)
+	assert.Empty(t, attachedNode)
+	assert.NoError(t, otherErr)
+	assert.Empty(t, otherNode)
+}
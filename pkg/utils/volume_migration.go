@@ -0,0 +1,31 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package utils to provide k8s resource utils
+package utils
+
+import (
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/cli/helper"
+	xuanwuv1 "github.com/Huawei/eSDK_K8S_Plugin/v4/client/apis/xuanwu/v1"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
+)
+
+// IsCrossBackendMigration checks whether the volume is migrated to another backend, the data of such volume is
+// copied offline, so it must not be used by any node during the migration.
+func IsCrossBackendMigration(migration *xuanwuv1.VolumeMigration, volumeHandle string) bool {
+	backendName, _ := utils.SplitVolumeId(volumeHandle)
+	return migration.Spec.TargetBackend != "" && helper.GetBackendName(migration.Spec.TargetBackend) != backendName
+}
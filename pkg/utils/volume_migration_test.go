@@ -0,0 +1,52 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	xuanwuv1 "github.com/Huawei/eSDK_K8S_Plugin/v4/client/apis/xuanwu/v1"
+)
+
+func TestIsCrossBackendMigration(t *testing.T) {
+	// arrange
+	tests := []struct {
+		name          string
+		targetBackend string
+		want          bool
+	}{
+		{name: "another backend", targetBackend: "backend-b", want: true},
+		{name: "same backend", targetBackend: "backend-a", want: false},
+		{name: "target backend omitted", targetBackend: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			migration := &xuanwuv1.VolumeMigration{
+				Spec: xuanwuv1.VolumeMigrationSpec{TargetBackend: tt.targetBackend, TargetPool: "pool2"},
+			}
+
+			// action
+			got := IsCrossBackendMigration(migration, "backend-a.pvc-1")
+
+			// assert
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
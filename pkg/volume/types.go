@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2024-2024. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2024-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -26,3 +26,50 @@ const (
 	// HyperMetro2Local indicate hyperMetro volume to local volume
 	HyperMetro2Local ModifyVolumeType = "HyperMetro2Local"
 )
+
+const (
+	// Failover indicates promote the replica of the volume on the target backend
+	Failover ModifyVolumeType = "Failover"
+	// Failback indicates resync the volume to the target backend and make it the primary end again
+	Failback ModifyVolumeType = "Failback"
+)
+
+const (
+	// FailoverActionKey is the mutable parameter key of the failover action, Failover or Failback
+	FailoverActionKey = "failoverAction"
+	// FailoverTargetBackendKey is the mutable parameter key of the backend which the volume switches to
+	FailoverTargetBackendKey = "failoverTargetBackend"
+	// FailoverVolumeIdKey is the volume attribute key of the volume id on the target backend
+	FailoverVolumeIdKey = "failoverVolumeId"
+	// FailoverFinishedKey is the volume attribute key indicates whether the failover action is finished
+	FailoverFinishedKey = "failoverFinished"
+)
+
+const (
+	// RollbackSnapshotIdKey is the mutable parameter key of the snapshot id which the volume is rolled back to
+	RollbackSnapshotIdKey = "rollbackSnapshotId"
+	// RollbackStartedKey is the mutable parameter key indicates whether the rollback has been started
+	RollbackStartedKey = "rollbackStarted"
+	// RollbackProgressKey is the volume attribute key of the rollback progress in percent
+	RollbackProgressKey = "rollbackProgress"
+	// RollbackFinishedKey is the volume attribute key indicates whether the rollback is finished
+	RollbackFinishedKey = "rollbackFinished"
+)
+
+const (
+	// MigrationTargetBackendKey is the mutable parameter key of the backend which the volume is migrated to
+	MigrationTargetBackendKey = "migrationTargetBackend"
+	// MigrationTargetPoolKey is the mutable parameter key of the pool which the volume is migrated to
+	MigrationTargetPoolKey = "migrationTargetPool"
+	// MigrationStartedKey is the mutable parameter key indicates whether the start of the migration has been
+	// recorded by the caller, and the volume attribute key indicates whether the migration is started on storage
+	MigrationStartedKey = "migrationStarted"
+	// MigrationProgressKey is the volume attribute key of the migration progress in percent
+	MigrationProgressKey = "migrationProgress"
+	// MigrationFinishedKey is the volume attribute key indicates whether the migration is finished
+	MigrationFinishedKey = "migrationFinished"
+	// MigrationVolumeIdKey is the volume attribute key of the volume id after the migration
+	MigrationVolumeIdKey = "migrationVolumeId"
+	// MigrationRollbackKey is the mutable parameter key indicates whether the migration is to be rolled back
+	MigrationRollbackKey = "migrationRollback"
+)
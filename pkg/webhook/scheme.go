@@ -1,5 +1,5 @@
 /*
-Copyright (c) Huawei Technologies Co., Ltd. 2022-2023. All rights reserved.
+Copyright (c) Huawei Technologies Co., Ltd. 2022-2026. All rights reserved.
 
 Licensed under the Apache License, Version 2.0 (the "License");
 you may not use this file except in compliance with the License.
@@ -19,6 +19,7 @@ import (
 	admissionV1 "k8s.io/api/admission/v1"
 	admissionRegistrationV1 "k8s.io/api/admissionregistration/v1"
 	coreV1 "k8s.io/api/core/v1"
+	storageV1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	utilRuntime "k8s.io/apimachinery/pkg/util/runtime"
@@ -37,4 +38,5 @@ func addToScheme(scheme *runtime.Scheme) {
 	utilRuntime.Must(coreV1.AddToScheme(scheme))
 	utilRuntime.Must(admissionV1.AddToScheme(scheme))
 	utilRuntime.Must(admissionRegistrationV1.AddToScheme(scheme))
+	utilRuntime.Must(storageV1.AddToScheme(scheme))
 }
@@ -0,0 +1,235 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	admissionV1 "k8s.io/api/admission/v1"
+	coreV1 "k8s.io/api/core/v1"
+	storageV1 "k8s.io/api/storage/v1"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/cli/helper"
+	xuanwuv1 "github.com/Huawei/eSDK_K8S_Plugin/v4/client/apis/xuanwu/v1"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/app"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/driver"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/utils"
+	fusionSmartx "github.com/Huawei/eSDK_K8S_Plugin/v4/storage/fusionstorage/smartx"
+	oceandiskSmartx "github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/oceandisk/smartx"
+	oceanstorSmartx "github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/oceanstor/smartx"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
+)
+
+const productSpecificationKey = "Product"
+
+func admitStorageClass(ar admissionV1.AdmissionReview) *admissionV1.AdmissionResponse {
+	log.Infoln("Start admit StorageClass.")
+	ctx := context.Background()
+	sc := &storageV1.StorageClass{}
+	if _, _, err := Codecs.UniversalDeserializer().Decode(ar.Request.Object.Raw, nil, sc); err != nil {
+		log.AddContext(ctx).Errorf("Decode StorageClass failed, error: %v", err)
+		return getFalseAdmissionResponse(err)
+	}
+
+	if sc.Provisioner != app.GetGlobalConfig().DriverName {
+		return getTrueAdmissionResponse()
+	}
+
+	if err := validateStorageClass(ctx, sc); err != nil {
+		log.AddContext(ctx).Errorf("Failed to validate StorageClass %s, error: %v", sc.Name, err)
+		return getFalseAdmissionResponse(fmt.Errorf("invalid StorageClass %s: %w", sc.Name, err))
+	}
+
+	log.AddContext(ctx).Infof("Successful admitting StorageClass %s.", sc.Name)
+	return getTrueAdmissionResponse()
+}
+
+func admitPersistentVolumeClaim(ar admissionV1.AdmissionReview) *admissionV1.AdmissionResponse {
+	log.Infoln("Start admit PersistentVolumeClaim.")
+	ctx := context.Background()
+	pvc := &coreV1.PersistentVolumeClaim{}
+	if _, _, err := Codecs.UniversalDeserializer().Decode(ar.Request.Object.Raw, nil, pvc); err != nil {
+		log.AddContext(ctx).Errorf("Decode PersistentVolumeClaim failed, error: %v", err)
+		return getFalseAdmissionResponse(err)
+	}
+
+	// the pvc is not provisioned dynamically by the driver, skip it
+	if pvc.Spec.StorageClassName == nil || *pvc.Spec.StorageClassName == "" || pvc.Spec.VolumeName != "" {
+		return getTrueAdmissionResponse()
+	}
+
+	sc, err := app.GetGlobalConfig().K8sUtils.GetStorageClass(ctx, *pvc.Spec.StorageClassName)
+	if err != nil {
+		// the storage class may be created after the pvc, leave it to the provisioner
+		log.AddContext(ctx).Warningf("Get StorageClass %s failed, skip validating pvc %s/%s, error: %v",
+			*pvc.Spec.StorageClassName, pvc.Namespace, pvc.Name, err)
+		return getTrueAdmissionResponse()
+	}
+
+	if sc.Provisioner != app.GetGlobalConfig().DriverName {
+		return getTrueAdmissionResponse()
+	}
+
+	if err = validatePersistentVolumeClaim(ctx, pvc, sc); err != nil {
+		log.AddContext(ctx).Errorf("Failed to validate PersistentVolumeClaim %s/%s, error: %v",
+			pvc.Namespace, pvc.Name, err)
+		return getFalseAdmissionResponse(fmt.Errorf("invalid PersistentVolumeClaim %s: %w", pvc.Name, err))
+	}
+
+	log.AddContext(ctx).Infof("Successful admitting PersistentVolumeClaim %s/%s.", pvc.Namespace, pvc.Name)
+	return getTrueAdmissionResponse()
+}
+
+func validateStorageClass(ctx context.Context, sc *storageV1.StorageClass) error {
+	if err := driver.CheckStorageClassParameters(ctx, sc.Parameters); err != nil {
+		return err
+	}
+
+	backendName, exist := sc.Parameters["backend"]
+	if !exist || backendName == "" {
+		return nil
+	}
+
+	content, err := getBackendContent(ctx, backendName)
+	if err != nil {
+		return err
+	}
+
+	if poolName, exist := sc.Parameters["pool"]; exist && poolName != "" &&
+		!slices.ContainsFunc(content.Status.Pools, func(pool xuanwuv1.Pool) bool { return pool.Name == poolName }) {
+		return fmt.Errorf("pool %s does not exist in backend %s", poolName, backendName)
+	}
+
+	storage := getBackendStorage(ctx, content)
+	if err = validateSmartX(storage, backendName, sc.Parameters); err != nil {
+		return err
+	}
+
+	if qos, exist := sc.Parameters["qos"]; exist && qos != "" {
+		return validateQoS(ctx, content, storage, qos)
+	}
+
+	return nil
+}
+
+func validatePersistentVolumeClaim(ctx context.Context, pvc *coreV1.PersistentVolumeClaim,
+	sc *storageV1.StorageClass) error {
+	block := pvc.Spec.VolumeMode != nil && *pvc.Spec.VolumeMode == coreV1.PersistentVolumeBlock
+	multiWriter := slices.Contains(pvc.Spec.AccessModes, coreV1.ReadWriteMany)
+	if err := driver.CheckClaimVolumeMode(sc.Parameters, block, multiWriter); err != nil {
+		return err
+	}
+
+	manageBackendName, err := driver.CheckClaimAnnotations(pvc.Annotations)
+	if err != nil {
+		return err
+	}
+
+	if manageBackendName != "" {
+		_, err = getBackendContent(ctx, manageBackendName)
+	}
+
+	return err
+}
+
+// getBackendContent returns the bound StorageBackendContent of the backend, the same as the backend cache loads
+func getBackendContent(ctx context.Context, backendName string) (*xuanwuv1.StorageBackendContent, error) {
+	claimMeta := utils.MakeMetaWithNamespace(app.GetGlobalConfig().Namespace, helper.GetBackendName(backendName))
+	content, err := utils.GetContentByClaimMeta(ctx, claimMeta)
+	if err != nil {
+		return nil, fmt.Errorf("backend %s does not exist: %w", backendName, err)
+	}
+
+	if content.Status == nil {
+		return nil, fmt.Errorf("backend %s is not ready, the status is empty", backendName)
+	}
+
+	return content, nil
+}
+
+// getBackendStorage returns the storage type of the backend, it is empty if the configmap can not be got,
+// and the validations depending on it are skipped
+func getBackendStorage(ctx context.Context, content *xuanwuv1.StorageBackendContent) string {
+	backendMap, err := backend.GetBackendConfigmapMap(ctx, content.Status.ConfigmapMeta)
+	if err != nil {
+		log.AddContext(ctx).Warningf("Get configmap of content %s failed, skip validating by storage type, "+
+			"error: %v", content.Name, err)
+		return ""
+	}
+
+	storage, _ := backendMap["storage"].(string)
+	return storage
+}
+
+// validateSmartX rejects the SmartDedupe, SmartCompression and SmartTier parameters on the backends whose pools
+// never advertise them, such as the FusionStorage Pacific backends, since no pool can be selected for them
+func validateSmartX(storage, backendName string, parameters map[string]string) error {
+	if storage == "" {
+		return nil
+	}
+
+	enabled := func(key string) bool {
+		value, err := strconv.ParseBool(parameters[key])
+		return err == nil && value
+	}
+	for _, smartX := range []struct {
+		key      string
+		enabled  bool
+		storages []string
+	}{
+		{key: constants.SmartDedupeKey, enabled: enabled(constants.SmartDedupeKey),
+			storages: []string{constants.OceanStorSan, constants.OceanStorNas}},
+		{key: constants.SmartCompressionKey, enabled: enabled(constants.SmartCompressionKey),
+			storages: []string{constants.OceanStorSan, constants.OceanStorNas}},
+		{key: constants.SmartTierInitialPolicyKey, enabled: parameters[constants.SmartTierInitialPolicyKey] != "",
+			storages: []string{constants.OceanStorSan}},
+		{key: constants.SmartTierRelocationPolicyKey,
+			enabled:  parameters[constants.SmartTierRelocationPolicyKey] != "",
+			storages: []string{constants.OceanStorSan}},
+	} {
+		if smartX.enabled && !slices.Contains(smartX.storages, storage) {
+			return fmt.Errorf("%s is only supported by %v backends, but the storage type of backend %s is %s",
+				smartX.key, smartX.storages, backendName, storage)
+		}
+	}
+
+	return nil
+}
+
+// validateQoS checks the qos with the validator of the plugin, which is chosen by the storage type of the backend
+func validateQoS(ctx context.Context, content *xuanwuv1.StorageBackendContent, storage, qos string) error {
+	switch storage {
+	case constants.OceanStorSan, constants.OceanStorNas, constants.OceanStorDtree:
+		product, exist := content.Status.Specification[productSpecificationKey]
+		if !exist {
+			return nil
+		}
+		return oceanstorSmartx.CheckQoSParameterSupport(ctx, constants.OceanstorVersion(product), qos)
+	case constants.OceandiskSan:
+		return oceandiskSmartx.CheckQoSParameterSupport(ctx, qos)
+	case constants.FusionSan, constants.FusionNas:
+		_, err := fusionSmartx.VerifyQos(ctx, qos)
+		return err
+	default:
+		return nil
+	}
+}
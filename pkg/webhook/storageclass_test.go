@@ -0,0 +1,200 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package webhook
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+	admissionV1 "k8s.io/api/admission/v1"
+	admissionregistrationV1 "k8s.io/api/admissionregistration/v1"
+	coreV1 "k8s.io/api/core/v1"
+	storageV1 "k8s.io/api/storage/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	xuanwuv1 "github.com/Huawei/eSDK_K8S_Plugin/v4/client/apis/xuanwu/v1"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/app"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/utils"
+)
+
+func TestAdmitStorageClass_InvalidParameters(t *testing.T) {
+	// arrange
+	sc := newFakeStorageClass(app.GetGlobalConfig().DriverName, map[string]string{"allocType": "thine"})
+
+	// action
+	resp := admitStorageClass(newAdmissionReview(t, sc))
+
+	// assert
+	assert.False(t, resp.Allowed)
+	assert.Contains(t, resp.Result.Message, "allocType [thine]")
+}
+
+func TestAdmitStorageClass_OtherProvisioner(t *testing.T) {
+	// arrange
+	sc := newFakeStorageClass("other.csi.driver", map[string]string{"allocType": "thine"})
+
+	// action
+	resp := admitStorageClass(newAdmissionReview(t, sc))
+
+	// assert
+	assert.True(t, resp.Allowed)
+}
+
+func TestAdmitStorageClass_PoolNotExist(t *testing.T) {
+	// arrange
+	sc := newFakeStorageClass(app.GetGlobalConfig().DriverName,
+		map[string]string{"backend": "backend-1", "pool": "pool-2"})
+	content := &xuanwuv1.StorageBackendContent{
+		Status: &xuanwuv1.StorageBackendContentStatus{Pools: []xuanwuv1.Pool{{Name: "pool-1"}}},
+	}
+
+	// mock
+	patches := gomonkey.ApplyFuncReturn(utils.GetContentByClaimMeta, content, nil)
+	defer patches.Reset()
+
+	// action
+	resp := admitStorageClass(newAdmissionReview(t, sc))
+
+	// assert
+	assert.False(t, resp.Allowed)
+	assert.Contains(t, resp.Result.Message, "pool pool-2 does not exist in backend backend-1")
+}
+
+func TestAdmitStorageClass_UnsupportedQoS(t *testing.T) {
+	// arrange
+	sc := newFakeStorageClass(app.GetGlobalConfig().DriverName,
+		map[string]string{"backend": "backend-1", "qos": `{"MINIOPS": 1000}`})
+	content := &xuanwuv1.StorageBackendContent{
+		Status: &xuanwuv1.StorageBackendContentStatus{Specification: map[string]string{"Product": "DoradoV3"}},
+	}
+
+	// mock
+	patches := gomonkey.ApplyFuncReturn(utils.GetContentByClaimMeta, content, nil).
+		ApplyFuncReturn(backend.GetBackendConfigmapMap, map[string]interface{}{"storage": "oceanstor-san"}, nil)
+	defer patches.Reset()
+
+	// action
+	resp := admitStorageClass(newAdmissionReview(t, sc))
+
+	// assert
+	assert.False(t, resp.Allowed)
+	assert.Contains(t, resp.Result.Message, "MINIOPS")
+}
+
+func TestAdmitStorageClass_SmartDedupeOnPacific(t *testing.T) {
+	// arrange
+	sc := newFakeStorageClass(app.GetGlobalConfig().DriverName,
+		map[string]string{"backend": "backend-1", "smartDedupe": "true"})
+	content := &xuanwuv1.StorageBackendContent{Status: &xuanwuv1.StorageBackendContentStatus{}}
+
+	// mock
+	patches := gomonkey.ApplyFuncReturn(utils.GetContentByClaimMeta, content, nil).
+		ApplyFuncReturn(backend.GetBackendConfigmapMap, map[string]interface{}{"storage": "fusionstorage-san"}, nil)
+	defer patches.Reset()
+
+	// action
+	resp := admitStorageClass(newAdmissionReview(t, sc))
+
+	// assert
+	assert.False(t, resp.Allowed)
+	assert.Contains(t, resp.Result.Message, "smartDedupe is only supported by [oceanstor-san oceanstor-nas] backends")
+}
+
+func TestAdmitStorageClass_SmartDedupeDisabledOnPacific(t *testing.T) {
+	// arrange
+	sc := newFakeStorageClass(app.GetGlobalConfig().DriverName,
+		map[string]string{"backend": "backend-1", "smartDedupe": "false"})
+	content := &xuanwuv1.StorageBackendContent{Status: &xuanwuv1.StorageBackendContentStatus{}}
+
+	// mock
+	patches := gomonkey.ApplyFuncReturn(utils.GetContentByClaimMeta, content, nil).
+		ApplyFuncReturn(backend.GetBackendConfigmapMap, map[string]interface{}{"storage": "fusionstorage-san"}, nil)
+	defer patches.Reset()
+
+	// action
+	resp := admitStorageClass(newAdmissionReview(t, sc))
+
+	// assert
+	assert.True(t, resp.Allowed)
+}
+
+func TestAdmitPersistentVolumeClaim_BlockOnFilesystem(t *testing.T) {
+	// arrange
+	scName := "sc-fs"
+	blockMode := coreV1.PersistentVolumeBlock
+	pvc := &coreV1.PersistentVolumeClaim{
+		TypeMeta:   metaV1.TypeMeta{APIVersion: "v1", Kind: "PersistentVolumeClaim"},
+		ObjectMeta: metaV1.ObjectMeta{Name: "pvc-1", Namespace: "default"},
+		Spec:       coreV1.PersistentVolumeClaimSpec{StorageClassName: &scName, VolumeMode: &blockMode},
+	}
+	sc := newFakeStorageClass(app.GetGlobalConfig().DriverName, map[string]string{"volumeType": "fs"})
+
+	// mock
+	patches := gomonkey.ApplyMethodReturn(app.GetGlobalConfig().K8sUtils, "GetStorageClass", sc, nil)
+	defer patches.Reset()
+
+	// action
+	resp := admitPersistentVolumeClaim(newAdmissionReview(t, pvc))
+
+	// assert
+	assert.False(t, resp.Allowed)
+	assert.Contains(t, resp.Result.Message, "VolumeMode is block but volumeType is fs")
+}
+
+func TestGetStorageWebHookCfg_StorageClassValidationEnabled(t *testing.T) {
+	// arrange
+	config := *app.GetGlobalConfig()
+	config.EnableStorageClassValidation = true
+
+	// mock
+	stubs := gostub.StubFunc(&app.GetGlobalConfig, &config)
+	defer stubs.Reset()
+
+	// action
+	webHookCfg, admissionWebhooks := GetStorageWebHookCfg()
+
+	// assert
+	assert.Len(t, webHookCfg.HandleFuncPair, 3)
+	assert.Len(t, admissionWebhooks, 3)
+	assert.Equal(t, admissionregistrationV1.Ignore, admissionWebhooks[1].FailurePolicy)
+}
+
+func newFakeStorageClass(provisioner string, parameters map[string]string) *storageV1.StorageClass {
+	return &storageV1.StorageClass{
+		TypeMeta:    metaV1.TypeMeta{APIVersion: "storage.k8s.io/v1", Kind: "StorageClass"},
+		ObjectMeta:  metaV1.ObjectMeta{Name: "sc-1"},
+		Provisioner: provisioner,
+		Parameters:  parameters,
+	}
+}
+
+func newAdmissionReview(t *testing.T, obj interface{}) admissionV1.AdmissionReview {
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return admissionV1.AdmissionReview{Request: &admissionV1.AdmissionRequest{
+		Operation: admissionV1.Create,
+		Object:    runtime.RawExtension{Raw: raw},
+	}}
+}
@@ -1,5 +1,5 @@
 /*
-Copyright (c) Huawei Technologies Co., Ltd. 2022-2023. All rights reserved.
+Copyright (c) Huawei Technologies Co., Ltd. 2022-2026. All rights reserved.
 
 Licensed under the Apache License, Version 2.0 (the "License");
 you may not use this file except in compliance with the License.
@@ -35,6 +35,8 @@ type AdmissionWebHookCFG struct {
 	WebhookPort   int32
 	AdmissionOps  []admissionV1.OperationType
 	AdmissionRule AdmissionRule
+	// FailurePolicy defines how the unrecognized errors from the webhook are handled, default is Fail
+	FailurePolicy admissionV1.FailurePolicyType
 }
 
 // AdmissionRule includes admission rules
@@ -87,6 +89,9 @@ func CreateValidateWebhook(ctx context.Context, webHookCfg AdmissionWebHookCFG,
 func newValidateWebhook(webhookCfg AdmissionWebHookCFG, caBundle []byte, ns string) admissionV1.ValidatingWebhook {
 	sideEffect := admissionV1.SideEffectClassNoneOnDryRun
 	failurePolicy := admissionV1.Fail
+	if webhookCfg.FailurePolicy != "" {
+		failurePolicy = webhookCfg.FailurePolicy
+	}
 	matchPolicy := admissionV1.Exact
 	return admissionV1.ValidatingWebhook{
 		Name: webhookCfg.WebhookName,
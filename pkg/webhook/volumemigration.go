@@ -0,0 +1,151 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+
+	admissionV1 "k8s.io/api/admission/v1"
+
+	xuanwuv1 "github.com/Huawei/eSDK_K8S_Plugin/v4/client/apis/xuanwu/v1"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/app"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
+	pkgUtils "github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/utils"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
+)
+
+// admitVolumeMigration rejects the migrations which can not be done on storage when they are created or their spec
+// is changed, so that the volume is never fenced from the nodes by a migration which is doomed to fail.
+func admitVolumeMigration(ar admissionV1.AdmissionReview) *admissionV1.AdmissionResponse {
+	log.Infoln("Start admit VolumeMigration.")
+	ctx := context.Background()
+	deserializer := Codecs.UniversalDeserializer()
+	migration := &xuanwuv1.VolumeMigration{}
+	if _, _, err := deserializer.Decode(ar.Request.Object.Raw, nil, migration); err != nil {
+		log.AddContext(ctx).Errorf("Decode VolumeMigration failed, error: %v", err)
+		return getFalseAdmissionResponse(err)
+	}
+
+	if ar.Request.Operation == admissionV1.Update {
+		oldMigration := &xuanwuv1.VolumeMigration{}
+		if _, _, err := deserializer.Decode(ar.Request.OldObject.Raw, nil, oldMigration); err != nil {
+			log.AddContext(ctx).Errorf("Decode old VolumeMigration failed, error: %v", err)
+			return getFalseAdmissionResponse(err)
+		}
+
+		if reflect.DeepEqual(migration.Spec, oldMigration.Spec) {
+			return getTrueAdmissionResponse()
+		}
+	}
+
+	if err := validateVolumeMigration(ctx, migration); err != nil {
+		log.AddContext(ctx).Errorf("Failed to validate VolumeMigration %s, error: %v", migration.Name, err)
+		return getFalseAdmissionResponse(fmt.Errorf("invalid VolumeMigration %s: %w", migration.Name, err))
+	}
+
+	log.AddContext(ctx).Infof("Successful admitting VolumeMigration %s.", migration.Name)
+	return getTrueAdmissionResponse()
+}
+
+// validateVolumeMigration checks the target of the migration. Only the volumes of oceanstor-san backends can be
+// migrated, to another pool of the same backend or to a pool of another oceanstor-san backend.
+func validateVolumeMigration(ctx context.Context, migration *xuanwuv1.VolumeMigration) error {
+	volumeHandle, err := getMigrationVolumeHandle(ctx, migration.Spec.PersistentVolumeClaim)
+	if err != nil {
+		return err
+	}
+
+	sourceBackendName, _ := utils.SplitVolumeId(volumeHandle)
+	sourceContent, err := getMigrationBackendContent(ctx, sourceBackendName)
+	if err != nil {
+		return err
+	}
+
+	if !pkgUtils.IsCrossBackendMigration(migration, volumeHandle) {
+		return validateMigrationPool(sourceContent, sourceBackendName, migration.Spec.TargetPool)
+	}
+
+	targetContent, err := getMigrationBackendContent(ctx, migration.Spec.TargetBackend)
+	if err != nil {
+		return err
+	}
+
+	return validateMigrationPool(targetContent, migration.Spec.TargetBackend, migration.Spec.TargetPool)
+}
+
+// getMigrationVolumeHandle returns the volume handle of the PersistentVolume bound to the migrated claim
+func getMigrationVolumeHandle(ctx context.Context, source xuanwuv1.VolumeMigrationSource) (string, error) {
+	k8sUtils := app.GetGlobalConfig().K8sUtils
+	pvc, err := k8sUtils.GetPVCByName(ctx, source.Namespace, source.Name)
+	if err != nil {
+		return "", fmt.Errorf("get pvc %s/%s failed: %w", source.Namespace, source.Name, err)
+	}
+	if pvc.Spec.VolumeName == "" {
+		return "", fmt.Errorf("pvc %s/%s is not bound", source.Namespace, source.Name)
+	}
+
+	pv, err := k8sUtils.GetPVByName(ctx, pvc.Spec.VolumeName)
+	if err != nil {
+		return "", fmt.Errorf("get pv %s failed: %w", pvc.Spec.VolumeName, err)
+	}
+	if pv.Spec.CSI == nil || pv.Spec.CSI.Driver != app.GetGlobalConfig().DriverName ||
+		pv.Spec.CSI.VolumeHandle == "" {
+		return "", fmt.Errorf("pv %s is not provisioned by %s", pv.Name, app.GetGlobalConfig().DriverName)
+	}
+
+	return pv.Spec.CSI.VolumeHandle, nil
+}
+
+// getMigrationBackendContent returns the content of the backend which supports the migration
+func getMigrationBackendContent(ctx context.Context,
+	backendName string) (*xuanwuv1.StorageBackendContent, error) {
+	content, err := getBackendContent(ctx, backendName)
+	if err != nil {
+		return nil, err
+	}
+
+	backendMap, err := backend.GetBackendConfigmapMap(ctx, content.Status.ConfigmapMeta)
+	if err != nil {
+		return nil, fmt.Errorf("get configmap of backend %s failed: %w", backendName, err)
+	}
+
+	storage, _ := backendMap["storage"].(string)
+	if storage != constants.OceanStorSan {
+		return nil, fmt.Errorf("the storage type of backend %s is %s, only %s backends "+
+			"support the migration", backendName, storage, constants.OceanStorSan)
+	}
+
+	return content, nil
+}
+
+func validateMigrationPool(content *xuanwuv1.StorageBackendContent, backendName, poolName string) error {
+	if poolName == "" {
+		return errors.New("the pool to migrate to is not specified")
+	}
+
+	if !slices.ContainsFunc(content.Status.Pools, func(pool xuanwuv1.Pool) bool { return pool.Name == poolName }) {
+		return fmt.Errorf("pool %s does not exist in backend %s", poolName, backendName)
+	}
+
+	return nil
+}
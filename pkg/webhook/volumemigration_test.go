@@ -0,0 +1,209 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+	admissionV1 "k8s.io/api/admission/v1"
+	coreV1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xuanwuv1 "github.com/Huawei/eSDK_K8S_Plugin/v4/client/apis/xuanwu/v1"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/app"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/backend"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/utils"
+)
+
+func TestAdmitVolumeMigration_SameBackend(t *testing.T) {
+	// arrange
+	migration := newFakeVolumeMigration("", "pool-2")
+	contents := map[string]*xuanwuv1.StorageBackendContent{
+		"backend-1": newFakeMigrationContent("sn-1", "pool-1", "pool-2"),
+	}
+
+	// mock
+	patches := mockMigrationSource(t, contents, "oceanstor-san")
+	defer patches.Reset()
+
+	// action
+	resp := admitVolumeMigration(newAdmissionReview(t, migration))
+
+	// assert
+	assert.True(t, resp.Allowed)
+}
+
+func TestAdmitVolumeMigration_OtherStorageDevice(t *testing.T) {
+	// arrange
+	migration := newFakeVolumeMigration("backend-2", "pool-2")
+	contents := map[string]*xuanwuv1.StorageBackendContent{
+		"backend-1": newFakeMigrationContent("sn-1", "pool-1"),
+		"backend-2": newFakeMigrationContent("sn-2", "pool-2"),
+	}
+
+	// mock
+	patches := mockMigrationSource(t, contents, "oceanstor-san")
+	defer patches.Reset()
+
+	// action
+	resp := admitVolumeMigration(newAdmissionReview(t, migration))
+
+	// assert
+	assert.True(t, resp.Allowed)
+}
+
+func TestAdmitVolumeMigration_SameStorageDevice(t *testing.T) {
+	// arrange
+	migration := newFakeVolumeMigration("backend-2", "pool-2")
+	contents := map[string]*xuanwuv1.StorageBackendContent{
+		"backend-1": newFakeMigrationContent("sn-1", "pool-1"),
+		"backend-2": newFakeMigrationContent("sn-1", "pool-2"),
+	}
+
+	// mock
+	patches := mockMigrationSource(t, contents, "oceanstor-san")
+	defer patches.Reset()
+
+	// action
+	resp := admitVolumeMigration(newAdmissionReview(t, migration))
+
+	// assert
+	assert.True(t, resp.Allowed)
+}
+
+func TestAdmitVolumeMigration_UnsupportedStorage(t *testing.T) {
+	// arrange
+	migration := newFakeVolumeMigration("", "pool-2")
+	contents := map[string]*xuanwuv1.StorageBackendContent{
+		"backend-1": newFakeMigrationContent("sn-1", "pool-1", "pool-2"),
+	}
+
+	// mock
+	patches := mockMigrationSource(t, contents, "oceanstor-nas")
+	defer patches.Reset()
+
+	// action
+	resp := admitVolumeMigration(newAdmissionReview(t, migration))
+
+	// assert
+	assert.False(t, resp.Allowed)
+	assert.Contains(t, resp.Result.Message, "only oceanstor-san backends support the migration")
+}
+
+func TestAdmitVolumeMigration_PoolNotExist(t *testing.T) {
+	// arrange
+	migration := newFakeVolumeMigration("", "pool-3")
+	contents := map[string]*xuanwuv1.StorageBackendContent{
+		"backend-1": newFakeMigrationContent("sn-1", "pool-1", "pool-2"),
+	}
+
+	// mock
+	patches := mockMigrationSource(t, contents, "oceanstor-san")
+	defer patches.Reset()
+
+	// action
+	resp := admitVolumeMigration(newAdmissionReview(t, migration))
+
+	// assert
+	assert.False(t, resp.Allowed)
+	assert.Contains(t, resp.Result.Message, "pool pool-3 does not exist in backend backend-1")
+}
+
+func TestAdmitVolumeMigration_UpdateWithoutSpecChange(t *testing.T) {
+	// arrange
+	migration := newFakeVolumeMigration("backend-2", "pool-2")
+	review := newAdmissionReview(t, migration)
+	review.Request.Operation = admissionV1.Update
+	review.Request.OldObject = review.Request.Object
+
+	// action
+	resp := admitVolumeMigration(review)
+
+	// assert
+	assert.True(t, resp.Allowed)
+}
+
+func TestGetStorageWebHookCfg_VolumeMigrationEnabled(t *testing.T) {
+	// arrange
+	config := *app.GetGlobalConfig()
+	config.EnableStorageClassValidation = false
+	config.EnableVolumeMigration = true
+
+	// mock
+	stubs := gostub.StubFunc(&app.GetGlobalConfig, &config)
+	defer stubs.Reset()
+
+	// action
+	webHookCfg, admissionWebhooks := GetStorageWebHookCfg()
+
+	// assert
+	assert.Len(t, webHookCfg.HandleFuncPair, 2)
+	assert.Len(t, admissionWebhooks, 2)
+	assert.Equal(t, migrationWebhookPath, admissionWebhooks[1].WebhookPath)
+	assert.Empty(t, admissionWebhooks[1].FailurePolicy)
+}
+
+func newFakeVolumeMigration(targetBackend, targetPool string) *xuanwuv1.VolumeMigration {
+	return &xuanwuv1.VolumeMigration{
+		TypeMeta:   metaV1.TypeMeta{APIVersion: "xuanwu.huawei.io/v1", Kind: "VolumeMigration"},
+		ObjectMeta: metaV1.ObjectMeta{Name: "migration-1"},
+		Spec: xuanwuv1.VolumeMigrationSpec{
+			PersistentVolumeClaim: xuanwuv1.VolumeMigrationSource{Name: "pvc-1", Namespace: "default"},
+			TargetBackend:         targetBackend,
+			TargetPool:            targetPool,
+		},
+	}
+}
+
+func newFakeMigrationContent(sn string, pools ...string) *xuanwuv1.StorageBackendContent {
+	content := &xuanwuv1.StorageBackendContent{Status: &xuanwuv1.StorageBackendContentStatus{SN: sn}}
+	for _, pool := range pools {
+		content.Status.Pools = append(content.Status.Pools, xuanwuv1.Pool{Name: pool})
+	}
+
+	return content
+}
+
+func mockMigrationSource(t *testing.T, contents map[string]*xuanwuv1.StorageBackendContent,
+	storage string) *gomonkey.Patches {
+	pvc := &coreV1.PersistentVolumeClaim{Spec: coreV1.PersistentVolumeClaimSpec{VolumeName: "pv-1"}}
+	pv := &coreV1.PersistentVolume{
+		ObjectMeta: metaV1.ObjectMeta{Name: "pv-1"},
+		Spec: coreV1.PersistentVolumeSpec{PersistentVolumeSource: coreV1.PersistentVolumeSource{
+			CSI: &coreV1.CSIPersistentVolumeSource{
+				Driver:       app.GetGlobalConfig().DriverName,
+				VolumeHandle: "backend-1.pvc-1",
+			},
+		}},
+	}
+
+	return gomonkey.ApplyMethodReturn(app.GetGlobalConfig().K8sUtils, "GetPVCByName", pvc, nil).
+		ApplyMethodReturn(app.GetGlobalConfig().K8sUtils, "GetPVByName", pv, nil).
+		ApplyFunc(utils.GetContentByClaimMeta,
+			func(_ context.Context, claimMeta string) (*xuanwuv1.StorageBackendContent, error) {
+				_, name, err := utils.SplitMetaNamespaceKey(claimMeta)
+				if err != nil {
+					t.Fatal(err)
+				}
+				return contents[name], nil
+			}).
+		ApplyFuncReturn(backend.GetBackendConfigmapMap, map[string]interface{}{"storage": storage}, nil)
+}
@@ -1,5 +1,5 @@
 /*
- Copyright (c) Huawei Technologies Co., Ltd. 2022-2023. All rights reserved.
+ Copyright (c) Huawei Technologies Co., Ltd. 2022-2026. All rights reserved.
 
  Licensed under the Apache License, Version 2.0 (the "License");
  you may not use this file except in compliance with the License.
@@ -34,6 +34,18 @@ const (
 	claimAPIGroups   = "xuanwu.huawei.io"
 	claimAPIVersions = "v1"
 	claimResources   = "storagebackendclaims"
+
+	storageClassWebhookPath = "/storageclass"
+	storageClassAPIGroups   = "storage.k8s.io"
+	storageClassAPIVersions = "v1"
+	storageClassResources   = "storageclasses"
+
+	pvcWebhookPath = "/persistentvolumeclaim"
+	pvcAPIVersions = "v1"
+	pvcResources   = "persistentvolumeclaims"
+
+	migrationWebhookPath = "/volumemigration"
+	migrationResources   = "volumemigrations"
 )
 
 // GetStorageWebHookCfg used to get storage webhook configuration
@@ -75,5 +87,70 @@ func GetStorageWebHookCfg() (Config, []AdmissionWebHookCFG) {
 	var admissionWebhooks []AdmissionWebHookCFG
 	admissionWebhooks = append(admissionWebhooks, admissionWebhook)
 
+	if app.GetGlobalConfig().EnableStorageClassValidation {
+		webHookCfg.HandleFuncPair = append(webHookCfg.HandleFuncPair,
+			HandleFuncPair{WebhookPath: storageClassWebhookPath, WebHookFunc: admitStorageClass},
+			HandleFuncPair{WebhookPath: pvcWebhookPath, WebHookFunc: admitPersistentVolumeClaim})
+		admissionWebhooks = append(admissionWebhooks, getVolumeAdmissionWebhooks()...)
+	}
+
+	if app.GetGlobalConfig().EnableVolumeMigration {
+		webHookCfg.HandleFuncPair = append(webHookCfg.HandleFuncPair,
+			HandleFuncPair{WebhookPath: migrationWebhookPath, WebHookFunc: admitVolumeMigration})
+		admissionWebhooks = append(admissionWebhooks, getMigrationAdmissionWebhook())
+	}
+
 	return webHookCfg, admissionWebhooks
 }
+
+// getVolumeAdmissionWebhooks returns the webhooks validating the storage classes and pvcs on creation,
+// the requests are allowed when the webhook is unavailable, so that the provisioning is never blocked by it
+func getVolumeAdmissionWebhooks() []AdmissionWebHookCFG {
+	return []AdmissionWebHookCFG{
+		{
+			WebhookName:   fmt.Sprintf("%s-storageclass.xuanwu.huawei.io", containerName),
+			ServiceName:   serviceName,
+			WebhookPath:   storageClassWebhookPath,
+			WebhookPort:   int32(app.GetGlobalConfig().WebHookPort),
+			AdmissionOps:  []admissionV1.OperationType{admissionV1.Create},
+			FailurePolicy: admissionV1.Ignore,
+			AdmissionRule: AdmissionRule{
+				APIGroups:   []string{storageClassAPIGroups},
+				APIVersions: []string{storageClassAPIVersions},
+				Resources:   []string{storageClassResources},
+			},
+		},
+		{
+			WebhookName:   fmt.Sprintf("%s-pvc.xuanwu.huawei.io", containerName),
+			ServiceName:   serviceName,
+			WebhookPath:   pvcWebhookPath,
+			WebhookPort:   int32(app.GetGlobalConfig().WebHookPort),
+			AdmissionOps:  []admissionV1.OperationType{admissionV1.Create},
+			FailurePolicy: admissionV1.Ignore,
+			AdmissionRule: AdmissionRule{
+				APIGroups:   []string{""},
+				APIVersions: []string{pvcAPIVersions},
+				Resources:   []string{pvcResources},
+			},
+		},
+	}
+}
+
+// getMigrationAdmissionWebhook returns the webhook validating the volume migrations on creation and update,
+// the requests are rejected when the webhook is unavailable, so that no migration is started without being validated
+func getMigrationAdmissionWebhook() AdmissionWebHookCFG {
+	return AdmissionWebHookCFG{
+		WebhookName: fmt.Sprintf("%s-volumemigration.xuanwu.huawei.io", containerName),
+		ServiceName: serviceName,
+		WebhookPath: migrationWebhookPath,
+		WebhookPort: int32(app.GetGlobalConfig().WebHookPort),
+		AdmissionOps: []admissionV1.OperationType{
+			admissionV1.Create,
+			admissionV1.Update},
+		AdmissionRule: AdmissionRule{
+			APIGroups:   []string{claimAPIGroups},
+			APIVersions: []string{claimAPIVersions},
+			Resources:   []string{migrationResources},
+		},
+	}
+}
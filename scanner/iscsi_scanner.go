@@ -35,7 +35,8 @@ func (s *ISCSIScanner) Scan(ctx context.Context, lunInfo interface{}) error {
 
 	var state scanState
 	for _, lun := range lunInfos {
-		sessionId, _ := iscsi.SingleConnectISCSIPortal(ctx, lun.Portal, lun.IQN, iscsi.ChapInfo{})
+		sessionId, _ := iscsi.SingleConnectISCSIPortal(ctx, lun.Portal, lun.IQN,
+			iscsi.GetChapInfo(lun.Portal, lun.IQN))
 		if sessionId == "" {
 			return fmt.Errorf("build iscsi session failed, portal: %s", lun.Portal)
 		}
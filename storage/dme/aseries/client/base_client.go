@@ -27,11 +27,13 @@ import (
 	"net/http"
 	"strconv"
 	"sync"
+	"time"
 
 	pkgUtils "github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/utils"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/metrics"
 )
 
 const (
@@ -51,6 +53,8 @@ const (
 
 	// MinParallelCount defines min parallel count
 	MinParallelCount int = 1
+
+	requestSemaphoreName = "dme-client"
 )
 
 var (
@@ -226,7 +230,7 @@ func NewBaseClient(ctx context.Context, param *storage.NewClientConfig) (*BaseCl
 		secretNamespace:  param.SecretNamespace,
 		secretName:       param.SecretName,
 		backendID:        param.BackendID,
-		requestSemaphore: utils.NewSemaphore(parallelCount),
+		requestSemaphore: utils.NewBackendSemaphore(requestSemaphoreName, param.Name, parallelCount),
 	}, nil
 }
 
@@ -245,6 +249,7 @@ func (cli *BaseClient) ReLogin(ctx context.Context) error {
 	}
 
 	err := cli.Login(ctx)
+	metrics.IncReLogin(metrics.StorageDME, err)
 	if err != nil {
 		log.AddContext(ctx).Errorf("Try to relogin error: %v", err)
 		return err
@@ -385,6 +390,10 @@ func (cli *BaseClient) Call(ctx context.Context, method string, url string, data
 	storage.RequestSemaphoreMap[key].Acquire()
 	defer storage.RequestSemaphoreMap[key].Release()
 
+	start := time.Now()
+	metricsCode := metrics.CodeUnconnected
+	defer func() { metrics.ObserveRestCall(metrics.StorageDME, method, url, metricsCode, start) }()
+
 	resp, err := cli.client.Do(req)
 	if err != nil {
 		log.AddContext(ctx).Errorf("Send request method: %s, Url: %s, error: %v", method, req.URL, err)
@@ -394,9 +403,11 @@ func (cli *BaseClient) Call(ctx context.Context, method string, url string, data
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
+		metricsCode = metrics.CodeInvalidResponse
 		log.AddContext(ctx).Errorf("Read response data error: %v", err)
 		return nil, err
 	}
+	metricsCode = getMetricsCode(resp.StatusCode)
 
 	log.FilteredLog(ctx, isFilterLog(method, url), utils.IsDebugLog(method, url, debugLog, debugLogRegex),
 		fmt.Sprintf("Response method: %s, url: %s, body: %s", method, req.URL, respBody))
@@ -404,6 +415,16 @@ func (cli *BaseClient) Call(ctx context.Context, method string, url string, data
 	return respBody, nil
 }
 
+// getMetricsCode returns the code of the response which is recorded in metrics,
+// DME reports the errors with the http status code, so the successful status is recorded as 0
+func getMetricsCode(statusCode int) string {
+	if statusCode >= http.StatusOK && statusCode < http.StatusMultipleChoices {
+		return "0"
+	}
+
+	return strconv.Itoa(statusCode)
+}
+
 // GetTaskInfos gets task infos by task id
 func (cli *BaseClient) GetTaskInfos(ctx context.Context, taskID string) ([]*Task, error) {
 	reqUrl := taskUrl + taskID
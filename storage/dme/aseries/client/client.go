@@ -27,12 +27,14 @@ import (
 type DMEASeriesClientInterface interface {
 	BaseClientInterface
 	Filesystem
+	FSSnapshot
 	System
 }
 
 // DMEASeriesClient implements DMEASeriesClientInterface
 type DMEASeriesClient struct {
 	*FilesystemClient
+	*FSSnapshotClient
 	*SystemClient
 	*BaseClient
 }
@@ -46,6 +48,7 @@ func NewClient(ctx context.Context, param *storage.NewClientConfig) (*DMEASeries
 
 	return &DMEASeriesClient{
 		FilesystemClient: &FilesystemClient{BaseClientInterface: resetClient},
+		FSSnapshotClient: &FSSnapshotClient{BaseClientInterface: resetClient},
 		SystemClient:     &SystemClient{BaseClientInterface: resetClient},
 		BaseClient:       resetClient,
 	}, nil
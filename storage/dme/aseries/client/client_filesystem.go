@@ -22,6 +22,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 )
 
 const (
@@ -34,6 +35,8 @@ const (
 	queryDataTurboShareUrl  = "/rest/fileservice/v1/dpc-shares/query"
 	deleteDataTurboShareUrl = "/rest/fileservice/v1/dpc-shares/delete"
 	queryDataTurboUserUrl   = "/rest/fileservice/v1/dpc-administrators/query"
+
+	queryFilesystemPageSize = 1000
 )
 
 // Filesystem defines interfaces for file system operations
@@ -42,6 +45,7 @@ type Filesystem interface {
 	DeleteFileSystem(ctx context.Context, fsID string) error
 	GetFileSystemByID(ctx context.Context, fsID string) (*FileSystemInfo, error)
 	GetFileSystemByName(ctx context.Context, name string) (*FileSystemInfo, error)
+	GetFileSystemsByNamePrefix(ctx context.Context, prefix string) ([]*FileSystemInfo, error)
 	CreateFileSystem(ctx context.Context, params *CreateFilesystemParams) error
 	GetDataTurboShareByPath(ctx context.Context, path string) (*DataTurboShare, error)
 	DeleteDataTurboShare(ctx context.Context, id string) error
@@ -83,7 +87,7 @@ func (cli *FilesystemClient) DeleteFileSystem(ctx context.Context, fsID string)
 // GetFileSystemByID used for get file system by id
 func (cli *FilesystemClient) GetFileSystemByID(ctx context.Context, fsID string) (*FileSystemInfo, error) {
 	reqUrl := fmt.Sprintf(filesystemWithFsIDUrl, fsID)
-	resp, err := gracefulCall[FileSystemInfo](ctx, cli, http.MethodPut, reqUrl, nil)
+	resp, err := gracefulCall[FileSystemInfo](ctx, cli, http.MethodGet, reqUrl, nil)
 	if err != nil {
 		return nil, fmt.Errorf("get filesystem for fsId: %s failed: %w", fsID, err)
 	}
@@ -111,6 +115,36 @@ func (cli *FilesystemClient) GetFileSystemByName(ctx context.Context, name strin
 	return nil, nil
 }
 
+// GetFileSystemsByNamePrefix used for get all file systems of the storage whose name starts with the prefix
+func (cli *FilesystemClient) GetFileSystemsByNamePrefix(ctx context.Context,
+	prefix string) ([]*FileSystemInfo, error) {
+	var filesystems []*FileSystemInfo
+	for pageNo := 1; ; pageNo++ {
+		param := &GetFilesystemParam{
+			Name:      prefix,
+			StorageId: cli.GetStorageID(),
+			PageNo:    pageNo,
+			PageSize:  queryFilesystemPageSize,
+		}
+		resp, err := gracefulCall[BatchQueryFilesystemResponse](ctx, cli, http.MethodPost,
+			batchQueryFilesystemUrl, param)
+		if err != nil {
+			return nil, fmt.Errorf("batch get filesystems for name prefix: %s failed: %w", prefix, err)
+		}
+
+		// the name in query is a fuzzy match, so the filesystems are filtered by the prefix again
+		for _, info := range resp.Data {
+			if strings.HasPrefix(info.Name, prefix) {
+				filesystems = append(filesystems, info)
+			}
+		}
+
+		if len(resp.Data) < queryFilesystemPageSize || int64(pageNo*queryFilesystemPageSize) >= resp.Total {
+			return filesystems, nil
+		}
+	}
+}
+
 // CreateFileSystem used for create file system
 func (cli *FilesystemClient) CreateFileSystem(ctx context.Context, params *CreateFilesystemParams) error {
 	if params == nil {
@@ -213,6 +247,8 @@ type DeleteFilesystemParam struct {
 type GetFilesystemParam struct {
 	Name      string `json:"name"`
 	StorageId string `json:"storage_id"`
+	PageNo    int    `json:"page_no,omitempty"`
+	PageSize  int    `json:"page_size,omitempty"`
 }
 
 // FilesystemSpec defines FileSystem spec
@@ -0,0 +1,144 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package client provides DME A-series storage client
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+const (
+	createFSSnapshotUrl     = "/rest/fileservice/v1/fs-snapshots"
+	batchQueryFSSnapshotUrl = "/rest/fileservice/v1/fs-snapshots/query"
+	deleteFSSnapshotUrl     = "/rest/fileservice/v1/fs-snapshots/delete"
+	cloneFilesystemUrl      = "/rest/fileservice/v1/filesystems/clone"
+)
+
+// FSSnapshot defines interfaces for file system snapshot operations
+type FSSnapshot interface {
+	CreateFSSnapshot(ctx context.Context, fsID, name string) error
+	GetFSSnapshotByName(ctx context.Context, fsID, name string) (*FSSnapshotInfo, error)
+	DeleteFSSnapshot(ctx context.Context, snapshotID string) error
+	CloneFileSystem(ctx context.Context, params *CloneFilesystemParams) error
+}
+
+// FSSnapshotClient defines client implements the FSSnapshot interface
+type FSSnapshotClient struct {
+	BaseClientInterface
+}
+
+// CreateFSSnapshot used for create file system snapshot
+func (cli *FSSnapshotClient) CreateFSSnapshot(ctx context.Context, fsID, name string) error {
+	param := &CreateFSSnapshotParam{
+		FilesystemID: fsID,
+		Name:         name,
+	}
+	err := gracefulCallWithTaskWait(ctx, cli, http.MethodPost, createFSSnapshotUrl, param)
+	if err != nil {
+		return fmt.Errorf("create snapshot %s for filesystem %s failed: %w", name, fsID, err)
+	}
+	return nil
+}
+
+// GetFSSnapshotByName used for get file system snapshot by name
+func (cli *FSSnapshotClient) GetFSSnapshotByName(ctx context.Context, fsID, name string) (*FSSnapshotInfo, error) {
+	param := &GetFSSnapshotParam{
+		FilesystemID: fsID,
+		Name:         name,
+	}
+	resp, err := gracefulCall[BatchQueryFSSnapshotResponse](ctx, cli, http.MethodPost, batchQueryFSSnapshotUrl, param)
+	if err != nil {
+		return nil, fmt.Errorf("get snapshot %s of filesystem %s failed: %w", name, fsID, err)
+	}
+	for _, info := range resp.Data {
+		if info.Name == name {
+			return info, nil
+		}
+	}
+	return nil, nil
+}
+
+// DeleteFSSnapshot used for delete file system snapshot by id
+func (cli *FSSnapshotClient) DeleteFSSnapshot(ctx context.Context, snapshotID string) error {
+	param := &DeleteFSSnapshotParam{
+		SnapshotIds: []string{snapshotID},
+	}
+	err := gracefulCallWithTaskWait(ctx, cli, http.MethodPost, deleteFSSnapshotUrl, param)
+	if err != nil {
+		return fmt.Errorf("delete filesystem snapshot for snapshot id: %s failed: %w", snapshotID, err)
+	}
+	return nil
+}
+
+// CloneFileSystem used for clone file system from the parent file system or its snapshot
+func (cli *FSSnapshotClient) CloneFileSystem(ctx context.Context, params *CloneFilesystemParams) error {
+	if params == nil {
+		return errors.New("param is nil")
+	}
+	err := gracefulCallWithTaskWait(ctx, cli, http.MethodPost, cloneFilesystemUrl, params)
+	if err != nil {
+		return fmt.Errorf("clone filesystem %s failed: %w", params.Name, err)
+	}
+	return nil
+}
+
+// CreateFSSnapshotParam defines create FileSystem snapshot param
+type CreateFSSnapshotParam struct {
+	FilesystemID string `json:"file_system_id"`
+	Name         string `json:"name"`
+}
+
+// GetFSSnapshotParam defines get FileSystem snapshot param
+type GetFSSnapshotParam struct {
+	FilesystemID string `json:"file_system_id"`
+	Name         string `json:"name"`
+}
+
+// DeleteFSSnapshotParam defines delete FileSystem snapshot param
+type DeleteFSSnapshotParam struct {
+	SnapshotIds []string `json:"snapshot_ids"`
+}
+
+// BatchQueryFSSnapshotResponse is the response of get filesystem snapshot request
+type BatchQueryFSSnapshotResponse struct {
+	Total int64             `json:"total"`
+	Data  []*FSSnapshotInfo `json:"data"`
+}
+
+// FSSnapshotInfo defines filesystem snapshot info
+type FSSnapshotInfo struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	FilesystemID string `json:"file_system_id"`
+	CreateTime   int64  `json:"create_time"` // Creation time of the snapshot, unit: millisecond.
+}
+
+// CloneFilesystemParams defines clone FileSystem param
+type CloneFilesystemParams struct {
+	StorageID           string               `json:"storage_id"`
+	ZoneID              string               `json:"zone_id"`
+	ParentFilesystemID  string               `json:"parent_file_system_id"`
+	ParentSnapshotID    string               `json:"parent_snapshot_id,omitempty"`
+	Name                string               `json:"name"`
+	Capacity            float64              `json:"capacity"`
+	Description         string               `json:"description,omitempty"`
+	CreateNfsShareParam *CreateNfsShareParam `json:"create_nfs_share_param,omitempty"`
+	CreateDpcShareParam *CreateDpcShareParam `json:"create_dpc_share_param,omitempty"`
+}
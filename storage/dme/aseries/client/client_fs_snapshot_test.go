@@ -0,0 +1,162 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package client provides DME A-series storage client
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockTaskTransport(urlKeyword string) *gomonkey.Patches {
+	return gomonkey.ApplyMethod((*MockTransport)(nil), "RoundTrip",
+		func(t *MockTransport, req *http.Request) (*http.Response, error) {
+			if strings.Contains(req.URL.String(), urlKeyword) {
+				return &http.Response{
+					StatusCode: 200,
+					Body:       io.NopCloser(bytes.NewBufferString(taskSuccessResp)),
+				}, nil
+			}
+			return &http.Response{
+				StatusCode: 200,
+				Body:       io.NopCloser(bytes.NewBufferString(queryTaskResp)),
+			}, nil
+		}).
+		ApplyFuncReturn(time.Sleep)
+}
+
+func TestFSSnapshotClient_CreateFSSnapshot_Success(t *testing.T) {
+	// Arrange
+	patch := mockTaskTransport("fs-snapshots")
+	defer patch.Reset()
+
+	// Mock
+	cli := &FSSnapshotClient{BaseClientInterface: getMockClient(200, "")}
+
+	// Action
+	err := cli.CreateFSSnapshot(context.Background(), "fs-id", "snapshot-name")
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestFSSnapshotClient_CreateFSSnapshot_Error(t *testing.T) {
+	// Arrange
+	errorResp := `{"error_code": "1077949001", "error_message": "internal error"}`
+
+	// Mock
+	cli := &FSSnapshotClient{BaseClientInterface: getMockClient(200, errorResp)}
+
+	// Action
+	err := cli.CreateFSSnapshot(context.Background(), "fs-id", "snapshot-name")
+
+	// Assert
+	assert.ErrorContains(t, err, "1077949001")
+}
+
+func TestFSSnapshotClient_GetFSSnapshotByName_Success(t *testing.T) {
+	// Arrange
+	successResp := `
+		{
+			"total": 2,
+			"data": [
+				{"id": "snapshot-id-1", "name": "snapshot-name-1", "file_system_id": "fs-id", "create_time": 1},
+				{"id": "snapshot-id", "name": "snapshot-name", "file_system_id": "fs-id", "create_time": 1700000000000}
+			]
+		}
+	`
+
+	// Mock
+	cli := &FSSnapshotClient{BaseClientInterface: getMockClient(200, successResp)}
+
+	// Action
+	snapshot, err := cli.GetFSSnapshotByName(context.Background(), "fs-id", "snapshot-name")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "snapshot-id", snapshot.ID)
+	assert.Equal(t, int64(1700000000000), snapshot.CreateTime)
+}
+
+func TestFSSnapshotClient_GetFSSnapshotByName_NotExist(t *testing.T) {
+	// Arrange
+	successResp := `{"total": 0, "data": []}`
+
+	// Mock
+	cli := &FSSnapshotClient{BaseClientInterface: getMockClient(200, successResp)}
+
+	// Action
+	snapshot, err := cli.GetFSSnapshotByName(context.Background(), "fs-id", "snapshot-name")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Nil(t, snapshot)
+}
+
+func TestFSSnapshotClient_DeleteFSSnapshot_Success(t *testing.T) {
+	// Arrange
+	patch := mockTaskTransport("fs-snapshots")
+	defer patch.Reset()
+
+	// Mock
+	cli := &FSSnapshotClient{BaseClientInterface: getMockClient(200, "")}
+
+	// Action
+	err := cli.DeleteFSSnapshot(context.Background(), "snapshot-id")
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestFSSnapshotClient_CloneFileSystem_Success(t *testing.T) {
+	// Arrange
+	patch := mockTaskTransport("filesystems")
+	defer patch.Reset()
+
+	// Mock
+	cli := &FSSnapshotClient{BaseClientInterface: getMockClient(200, "")}
+
+	// Action
+	err := cli.CloneFileSystem(context.Background(), &CloneFilesystemParams{
+		ParentFilesystemID: "fs-id",
+		ParentSnapshotID:   "snapshot-id",
+		Name:               "clone-name",
+		Capacity:           1,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestFSSnapshotClient_CloneFileSystem_NilParam(t *testing.T) {
+	// Arrange
+	cli := &FSSnapshotClient{BaseClientInterface: getMockClient(200, "")}
+
+	// Action
+	err := cli.CloneFileSystem(context.Background(), nil)
+
+	// Assert
+	assert.ErrorContains(t, err, "param is nil")
+}
@@ -74,12 +74,19 @@ type CreateVolumeModel struct {
 	AllocationType     string
 	AuthClients        []string
 	AuthUsers          []string
+	SourceVolumeName   string
+	SourceSnapshotName string
+	SnapshotParentId   string
 }
 
 func (model *CreateVolumeModel) sharePath() string {
 	return "/" + model.Name + "/"
 }
 
+func (model *CreateVolumeModel) isClone() bool {
+	return model.SourceVolumeName != "" || model.SourceSnapshotName != ""
+}
+
 // NewCreator inits a new filesystem volume creator
 func NewCreator(ctx context.Context, cli client.DMEASeriesClientInterface, params *CreateVolumeModel) *Creator {
 	return &Creator{
@@ -230,31 +237,105 @@ func (c *Creator) getCreateFilesystemParams() (*client.CreateFilesystemParams, e
 		param.Tuning = &client.Tuning{AllocationType: c.params.AllocationType}
 	}
 
+	var err error
+	param.CreateNfsShareParam, param.CreateDpcShareParam, err = c.getCreateShareParams()
+	if err != nil {
+		return nil, err
+	}
+
+	return param, nil
+}
+
+func (c *Creator) getCreateShareParams() (*client.CreateNfsShareParam, *client.CreateDpcShareParam, error) {
+	var nfsShareParam *client.CreateNfsShareParam
+	var dtShareParam *client.CreateDpcShareParam
 	deleter := NewDeleter(c.ctx, c.cli, &DeleteVolumeModel{Name: c.params.Name, Protocol: c.params.Protocol})
 
 	// Get nfs share params.
 	if len(c.params.AuthClients) != 0 {
 		if err := deleter.deleteNfsShare(); err != nil {
-			return nil, err
+			return nil, nil, err
 		}
-		param.CreateNfsShareParam = c.getCreateNfsShareParam()
+		nfsShareParam = c.getCreateNfsShareParam()
 	}
 
 	// Get data turbo share params at the same time.
 	if len(c.params.AuthUsers) != 0 {
 		if err := deleter.deleteDataTurboShare(); err != nil {
-			return nil, err
+			return nil, nil, err
 		}
-		dtShareParam, err := c.getCreateDpcShareParam()
+		var err error
+		dtShareParam, err = c.getCreateDpcShareParam()
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
-		param.CreateDpcShareParam = dtShareParam
+	}
+
+	return nfsShareParam, dtShareParam, nil
+}
+
+func (c *Creator) getCloneFilesystemParams() (*client.CloneFilesystemParams, error) {
+	parentFs, parentSnapshotId, err := c.getCloneSource()
+	if err != nil {
+		return nil, err
+	}
+
+	if c.params.Capacity < parentFs.TotalCapacityInByte {
+		return nil, fmt.Errorf("clone filesystem capacity must be >= source filesystem %s capacity %d",
+			parentFs.Name, parentFs.TotalCapacityInByte)
+	}
+
+	param := &client.CloneFilesystemParams{
+		StorageID:          c.cli.GetStorageID(),
+		ZoneID:             c.cli.GetStorageID(),
+		ParentFilesystemID: parentFs.ID,
+		ParentSnapshotID:   parentSnapshotId,
+		Name:               c.params.Name,
+		Capacity:           transDmeCapacityFromByteIoGb(c.params.Capacity),
+		Description:        c.params.Description,
+	}
+
+	param.CreateNfsShareParam, param.CreateDpcShareParam, err = c.getCreateShareParams()
+	if err != nil {
+		return nil, err
 	}
 
 	return param, nil
 }
 
+func (c *Creator) getCloneSource() (*client.FileSystemInfo, string, error) {
+	if c.params.SourceSnapshotName == "" {
+		fs, err := c.cli.GetFileSystemByName(c.ctx, c.params.SourceVolumeName)
+		if err != nil {
+			return nil, "", err
+		}
+		if fs == nil {
+			return nil, "", fmt.Errorf("clone source filesystem %s does not exist", c.params.SourceVolumeName)
+		}
+
+		return fs, "", nil
+	}
+
+	fs, err := c.cli.GetFileSystemByID(c.ctx, c.params.SnapshotParentId)
+	if err != nil {
+		return nil, "", err
+	}
+	if fs == nil {
+		return nil, "", fmt.Errorf("parent filesystem %s of snapshot %s does not exist",
+			c.params.SnapshotParentId, c.params.SourceSnapshotName)
+	}
+
+	snapshot, err := c.cli.GetFSSnapshotByName(c.ctx, c.params.SnapshotParentId, c.params.SourceSnapshotName)
+	if err != nil {
+		return nil, "", err
+	}
+	if snapshot == nil {
+		return nil, "", fmt.Errorf("source snapshot %s does not exist", c.params.SourceSnapshotName)
+	}
+
+	return fs, snapshot.ID, nil
+}
+
 func (c *Creator) setFsId() error {
 	fs, err := c.cli.GetFileSystemByName(c.ctx, c.params.Name)
 	if err != nil {
@@ -292,6 +373,9 @@ func (c *Creator) createFilesystem() error {
 	if !errors.Is(err, errFilesystemNotFound) {
 		return err
 	}
+	if c.params.isClone() {
+		return c.cloneFilesystem()
+	}
 	param, err := c.getCreateFilesystemParams()
 	if err != nil {
 		return err
@@ -303,6 +387,18 @@ func (c *Creator) createFilesystem() error {
 	return c.setFsId()
 }
 
+func (c *Creator) cloneFilesystem() error {
+	param, err := c.getCloneFilesystemParams()
+	if err != nil {
+		return err
+	}
+	err = c.cli.CloneFileSystem(c.ctx, param)
+	if err != nil {
+		return err
+	}
+	return c.setFsId()
+}
+
 func (c *Creator) rollBackendFilesystem() {
 	model := &DeleteVolumeModel{Name: c.params.Name, Protocol: c.params.Protocol}
 	deleter := NewDeleter(c.ctx, c.cli, model)
@@ -347,3 +347,82 @@ func Test_validateAndPrepareParams_WithDTFS_WithAuthUsers(t *testing.T) {
 	// assert
 	assert.NoError(t, gotErr)
 }
+
+func TestCreator_CreateFromSnapshotWithDtfsProtocol_Success(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockDMEASeriesClientInterface(mockCtrl)
+	model := &CreateVolumeModel{
+		Protocol:           constants.ProtocolDtfs,
+		Name:               fakeFsName,
+		PoolName:           fakePoolName,
+		Capacity:           constants.DmeCapacityUnitGb,
+		AuthUsers:          []string{fakeAuthUser},
+		SourceSnapshotName: "test_snapshot",
+		SnapshotParentId:   "parent-fs-id",
+	}
+	creator := NewCreator(ctx, cli, model)
+
+	// mock
+	pool := &client.HyperScalePool{RawId: fakePoolRawID}
+	parentFs := &client.FileSystemInfo{ID: "parent-fs-id", TotalCapacityInByte: constants.DmeCapacityUnitGb}
+	dtShare := &client.DataTurboShare{ID: fakeShareID}
+	cli.EXPECT().GetHyperScalePoolByName(ctx, fakePoolName).Return(pool, nil)
+	cli.EXPECT().GetFileSystemByName(ctx, fakeFsName).Return(nil, nil).Times(1)
+	cli.EXPECT().GetFileSystemByID(ctx, "parent-fs-id").Return(parentFs, nil)
+	cli.EXPECT().GetFSSnapshotByName(ctx, "parent-fs-id", "test_snapshot").
+		Return(&client.FSSnapshotInfo{ID: "snapshot-id"}, nil)
+	cli.EXPECT().GetStorageID().Return(fakeStorageID).AnyTimes()
+	cli.EXPECT().GetDataTurboShareByPath(ctx, model.sharePath()).Return(nil, nil).Times(1)
+	cli.EXPECT().GetDataTurboUserByName(ctx, fakeAuthUser).Return(&client.DataTurboAdmin{ID: "user-id"}, nil)
+	cli.EXPECT().CloneFileSystem(ctx, &client.CloneFilesystemParams{
+		StorageID:          fakeStorageID,
+		ZoneID:             fakeStorageID,
+		ParentFilesystemID: "parent-fs-id",
+		ParentSnapshotID:   "snapshot-id",
+		Name:               fakeFsName,
+		Capacity:           1,
+		CreateDpcShareParam: &client.CreateDpcShareParam{
+			Charset: storage.CharsetUtf8,
+			DpcAuth: []*client.DpcAuth{{DpcUserID: "user-id", Permission: dpcShareReadWrite}},
+		},
+	}).Return(nil)
+	cli.EXPECT().GetFileSystemByName(ctx, fakeFsName).Return(&client.FileSystemInfo{ID: fakeFsID}, nil).Times(1)
+	cli.EXPECT().GetDataTurboShareByPath(ctx, model.sharePath()).Return(dtShare, nil).Times(1)
+
+	// action
+	volume, err := creator.Create()
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, fakeFsName, volume.GetVolumeName())
+	assert.Equal(t, fakeFsID, creator.fsId)
+}
+
+func TestCreator_CloneWithSmallerCapacity_Error(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockDMEASeriesClientInterface(mockCtrl)
+	model := &CreateVolumeModel{
+		Protocol:         constants.ProtocolNfs,
+		Name:             fakeFsName,
+		Capacity:         1024,
+		AuthClients:      []string{fakeAuthClient},
+		SourceVolumeName: "source-fs",
+	}
+	creator := NewCreator(ctx, cli, model)
+
+	// mock
+	cli.EXPECT().GetFileSystemByName(ctx, "source-fs").
+		Return(&client.FileSystemInfo{ID: "source-fs-id", Name: "source-fs", TotalCapacityInByte: 2048}, nil)
+
+	// action
+	err := creator.cloneFilesystem()
+
+	// assert
+	assert.ErrorContains(t, err, "capacity")
+}
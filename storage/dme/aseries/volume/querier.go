@@ -21,6 +21,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/dme/aseries/client"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
 )
@@ -64,7 +65,7 @@ func (c *Querier) queryFilesystemInfo() error {
 		return err
 	}
 	if fs == nil {
-		return fmt.Errorf("filesystem %s does not exist", c.params.Name)
+		return fmt.Errorf("%w: filesystem %s does not exist", constants.ErrVolumeNotFound, c.params.Name)
 	}
 
 	c.capacity = fs.TotalCapacityInByte
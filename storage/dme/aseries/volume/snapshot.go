@@ -0,0 +1,77 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package volume
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/dme/aseries/client"
+)
+
+// NewSnapshotter inits a new filesystem snapshotter of the DME A-series storage
+func NewSnapshotter(ctx context.Context, cli client.DMEASeriesClientInterface,
+	params *storage.SnapshotModel) *storage.FSSnapshotter {
+	return storage.NewFSSnapshotter(ctx, &snapshotOperator{cli: cli}, params)
+}
+
+type snapshotOperator struct {
+	cli client.DMEASeriesClientInterface
+}
+
+func (o *snapshotOperator) GetFileSystem(ctx context.Context, fsName string) (*storage.FSInfo, error) {
+	fs, err := o.cli.GetFileSystemByName(ctx, fsName)
+	if err != nil || fs == nil {
+		return nil, err
+	}
+
+	return &storage.FSInfo{ID: fs.ID, SizeBytes: fs.TotalCapacityInByte}, nil
+}
+
+func (o *snapshotOperator) GetSnapshot(ctx context.Context,
+	fsID, snapshotName string) (*storage.FSSnapshotInfo, error) {
+	snapshot, err := o.cli.GetFSSnapshotByName(ctx, fsID, snapshotName)
+	if err != nil || snapshot == nil {
+		return nil, err
+	}
+
+	return &storage.FSSnapshotInfo{ID: snapshot.ID, CreationTime: time.UnixMilli(snapshot.CreateTime).Unix()}, nil
+}
+
+func (o *snapshotOperator) CreateSnapshot(ctx context.Context,
+	fsID, snapshotName string) (*storage.FSSnapshotInfo, error) {
+	if err := o.cli.CreateFSSnapshot(ctx, fsID, snapshotName); err != nil {
+		return nil, err
+	}
+
+	// the creation task of DME does not return the snapshot, so query it again
+	snapshot, err := o.GetSnapshot(ctx, fsID, snapshotName)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot == nil {
+		return nil, fmt.Errorf("snapshot %s does not exist after creation", snapshotName)
+	}
+
+	return snapshot, nil
+}
+
+func (o *snapshotOperator) DeleteSnapshot(ctx context.Context, snapshotID string) error {
+	return o.cli.DeleteFSSnapshot(ctx, snapshotID)
+}
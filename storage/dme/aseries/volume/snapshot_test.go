@@ -0,0 +1,139 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package volume
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/dme/aseries/client"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/test/mocks/mock_client"
+)
+
+const fakeSnapshotName = "test_snapshot"
+
+func TestSnapshotter_Create_Success(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockDMEASeriesClientInterface(mockCtrl)
+	snapshotter := NewSnapshotter(ctx, cli, &storage.SnapshotModel{FsName: fakeFsName, SnapshotName: fakeSnapshotName})
+	fs := &client.FileSystemInfo{ID: fakeFsID, TotalCapacityInByte: 1024}
+	snapshot := &client.FSSnapshotInfo{ID: "snapshot-id", Name: fakeSnapshotName, CreateTime: 1700000000123}
+	want := map[string]interface{}{
+		"CreationTime": int64(1700000000),
+		"SizeBytes":    int64(1024),
+		"ParentID":     fakeFsID,
+	}
+
+	// mock
+	cli.EXPECT().GetFileSystemByName(ctx, fakeFsName).Return(fs, nil)
+	cli.EXPECT().GetFSSnapshotByName(ctx, fakeFsID, fakeSnapshotName).Return(nil, nil).Times(1)
+	cli.EXPECT().CreateFSSnapshot(ctx, fakeFsID, fakeSnapshotName).Return(nil)
+	cli.EXPECT().GetFSSnapshotByName(ctx, fakeFsID, fakeSnapshotName).Return(snapshot, nil).Times(1)
+
+	// action
+	got, err := snapshotter.Create()
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestSnapshotter_Create_AlreadyExist(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockDMEASeriesClientInterface(mockCtrl)
+	snapshotter := NewSnapshotter(ctx, cli, &storage.SnapshotModel{FsName: fakeFsName, SnapshotName: fakeSnapshotName})
+	fs := &client.FileSystemInfo{ID: fakeFsID, TotalCapacityInByte: 1024}
+	snapshot := &client.FSSnapshotInfo{ID: "snapshot-id", Name: fakeSnapshotName}
+
+	// mock
+	cli.EXPECT().GetFileSystemByName(ctx, fakeFsName).Return(fs, nil)
+	cli.EXPECT().GetFSSnapshotByName(ctx, fakeFsID, fakeSnapshotName).Return(snapshot, nil)
+
+	// action
+	got, err := snapshotter.Create()
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, fakeFsID, got["ParentID"])
+}
+
+func TestSnapshotter_Create_FilesystemNotExist(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockDMEASeriesClientInterface(mockCtrl)
+	snapshotter := NewSnapshotter(ctx, cli, &storage.SnapshotModel{FsName: fakeFsName, SnapshotName: fakeSnapshotName})
+
+	// mock
+	cli.EXPECT().GetFileSystemByName(ctx, fakeFsName).Return(nil, nil)
+
+	// action
+	got, err := snapshotter.Create()
+
+	// assert
+	assert.ErrorContains(t, err, "does not exist")
+	assert.Nil(t, got)
+}
+
+func TestSnapshotter_Delete_Success(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockDMEASeriesClientInterface(mockCtrl)
+	snapshotter := NewSnapshotter(ctx, cli, &storage.SnapshotModel{ParentId: fakeFsID, SnapshotName: fakeSnapshotName})
+
+	// mock
+	cli.EXPECT().GetFSSnapshotByName(ctx, fakeFsID, fakeSnapshotName).
+		Return(&client.FSSnapshotInfo{ID: "snapshot-id"}, nil)
+	cli.EXPECT().DeleteFSSnapshot(ctx, "snapshot-id").Return(nil)
+
+	// action
+	err := snapshotter.Delete()
+
+	// assert
+	assert.NoError(t, err)
+}
+
+func TestSnapshotter_Delete_NotExist(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockDMEASeriesClientInterface(mockCtrl)
+	snapshotter := NewSnapshotter(ctx, cli, &storage.SnapshotModel{ParentId: fakeFsID, SnapshotName: fakeSnapshotName})
+
+	// mock
+	cli.EXPECT().GetFSSnapshotByName(ctx, fakeFsID, fakeSnapshotName).Return(nil, nil)
+
+	// action
+	err := snapshotter.Delete()
+
+	// assert
+	assert.NoError(t, err)
+}
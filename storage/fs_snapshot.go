@@ -0,0 +1,120 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
+)
+
+// SnapshotModel is used to create or delete a filesystem snapshot
+type SnapshotModel struct {
+	FsName       string
+	ParentId     string
+	SnapshotName string
+}
+
+// FSInfo is the filesystem info used by the snapshot operations
+type FSInfo struct {
+	ID        string
+	SizeBytes int64
+}
+
+// FSSnapshotInfo is the filesystem snapshot info used by the snapshot operations
+type FSSnapshotInfo struct {
+	ID string
+	// CreationTime is the unix timestamp in seconds
+	CreationTime int64
+}
+
+// FSSnapshotOperator defines the storage specific operations of the filesystem snapshots,
+// the getters return nil without error if the resource does not exist
+type FSSnapshotOperator interface {
+	GetFileSystem(ctx context.Context, fsName string) (*FSInfo, error)
+	GetSnapshot(ctx context.Context, fsID, snapshotName string) (*FSSnapshotInfo, error)
+	CreateSnapshot(ctx context.Context, fsID, snapshotName string) (*FSSnapshotInfo, error)
+	DeleteSnapshot(ctx context.Context, snapshotID string) error
+}
+
+// FSSnapshotter is used to manage the snapshots of a filesystem volume
+type FSSnapshotter struct {
+	ctx      context.Context
+	operator FSSnapshotOperator
+	params   *SnapshotModel
+}
+
+// NewFSSnapshotter inits a new filesystem snapshotter
+func NewFSSnapshotter(ctx context.Context, operator FSSnapshotOperator, params *SnapshotModel) *FSSnapshotter {
+	return &FSSnapshotter{
+		ctx:      ctx,
+		operator: operator,
+		params:   params,
+	}
+}
+
+// Create creates a snapshot of the filesystem and returns the snapshot info
+func (s *FSSnapshotter) Create() (map[string]interface{}, error) {
+	fs, err := s.operator.GetFileSystem(s.ctx, s.params.FsName)
+	if err != nil {
+		return nil, err
+	}
+	if fs == nil {
+		return nil, fmt.Errorf("filesystem %s does not exist", s.params.FsName)
+	}
+
+	snapshot, err := s.operator.GetSnapshot(s.ctx, fs.ID, s.params.SnapshotName)
+	if err != nil {
+		return nil, err
+	}
+
+	if snapshot != nil {
+		log.AddContext(s.ctx).Infof("The snapshot %s already exists", s.params.SnapshotName)
+		return getSnapshotReturnInfo(snapshot, fs), nil
+	}
+
+	snapshot, err = s.operator.CreateSnapshot(s.ctx, fs.ID, s.params.SnapshotName)
+	if err != nil {
+		return nil, err
+	}
+
+	return getSnapshotReturnInfo(snapshot, fs), nil
+}
+
+// Delete deletes the snapshot of the filesystem, it returns nil if the snapshot does not exist
+func (s *FSSnapshotter) Delete() error {
+	snapshot, err := s.operator.GetSnapshot(s.ctx, s.params.ParentId, s.params.SnapshotName)
+	if err != nil {
+		return err
+	}
+
+	if snapshot == nil {
+		log.AddContext(s.ctx).Infof("Snapshot %s does not exist while deleting", s.params.SnapshotName)
+		return nil
+	}
+
+	return s.operator.DeleteSnapshot(s.ctx, snapshot.ID)
+}
+
+func getSnapshotReturnInfo(snapshot *FSSnapshotInfo, fs *FSInfo) map[string]interface{} {
+	return map[string]interface{}{
+		"CreationTime": snapshot.CreationTime,
+		"SizeBytes":    fs.SizeBytes,
+		"ParentID":     fs.ID,
+	}
+}
@@ -35,6 +35,7 @@ import (
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/fusionstorage/types"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/metrics"
 )
 
 const (
@@ -48,6 +49,8 @@ const (
 	maxParallelCount     int = 30
 	minParallelCount     int = 1
 
+	requestSemaphoreName = "fusionstorage-client"
+
 	loginFailed         = 1077949061
 	loginFailedWithArg  = 1077987870
 	userPasswordInvalid = 1073754390
@@ -91,6 +94,7 @@ type IRestClient interface {
 	Qos
 	Quota
 	Snapshot
+	FSSnapshot
 	System
 	Volume
 	DTree
@@ -134,6 +138,7 @@ type NewClientConfig struct {
 	AccountName     string
 	UseCert         bool
 	CertSecretMeta  string
+	Name            string
 }
 
 // NewClient used to init a new fusion storage client
@@ -158,7 +163,7 @@ func NewClient(ctx context.Context, clientConfig *NewClientConfig) *RestClient {
 		accountName:      clientConfig.AccountName,
 		useCert:          clientConfig.UseCert,
 		certSecretMeta:   clientConfig.CertSecretMeta,
-		RequestSemaphore: utils.NewSemaphore(parallelCount),
+		RequestSemaphore: utils.NewBackendSemaphore(requestSemaphoreName, clientConfig.Name, parallelCount),
 	}
 }
 
@@ -380,6 +385,10 @@ func (cli *RestClient) doCall(ctx context.Context, method string, url string, da
 	cli.RequestSemaphore.Acquire()
 	defer cli.RequestSemaphore.Release()
 
+	start := time.Now()
+	metricsCode := metrics.CodeUnconnected
+	defer func() { metrics.ObserveRestCall(metrics.StorageFusionStorage, method, url, metricsCode, start) }()
+
 	resp, err := cli.client.Do(req)
 	if err != nil {
 		log.AddContext(ctx).Errorf("Send request method: %s, url: %s, error: %v", method, req.URL, err)
@@ -390,9 +399,11 @@ func (cli *RestClient) doCall(ctx context.Context, method string, url string, da
 
 	respBody, err = io.ReadAll(resp.Body)
 	if err != nil {
+		metricsCode = metrics.CodeInvalidResponse
 		log.AddContext(ctx).Errorf("Read response data error: %v", err)
 		return nil, nil, err
 	}
+	metricsCode = getMetricsCode(resp.StatusCode, respBody)
 
 	log.FilteredLog(ctx, isFilterLog(method, url), utils.IsDebugLog(method, url, debugLog, debugLogRegex),
 		fmt.Sprintf("Response method: %s, url: %s, body: %s", method, req.URL, respBody))
@@ -508,6 +519,7 @@ func (cli *RestClient) ReLogin(ctx context.Context) error {
 	}
 
 	err := cli.Login(ctx)
+	metrics.IncReLogin(metrics.StorageFusionStorage, err)
 	if err != nil {
 		log.AddContext(ctx).Errorf("Try to relogin error: %v", err)
 		return err
@@ -605,6 +617,25 @@ func getErrorCode(body map[string]any) (int, error) {
 	return 0, nil
 }
 
+// getMetricsCode returns the error code in the response body which is recorded in metrics,
+// the http status code is used if the body is not a json object
+func getMetricsCode(statusCode int, respBody []byte) string {
+	var body map[string]any
+	if err := json.Unmarshal(respBody, &body); err != nil {
+		if statusCode >= http.StatusOK && statusCode < http.StatusMultipleChoices {
+			return "0"
+		}
+		return strconv.Itoa(statusCode)
+	}
+
+	code, err := getErrorCode(body)
+	if err != nil {
+		return metrics.CodeInvalidResponse
+	}
+
+	return strconv.Itoa(code)
+}
+
 func newHTTPClientByBackendID(ctx context.Context, backendID string) (*http.Client, error) {
 	jar, err := cookiejar.New(nil)
 	if err != nil {
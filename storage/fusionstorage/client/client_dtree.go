@@ -35,6 +35,7 @@ const (
 // DTree is the interface for Pacific DTree
 type DTree interface {
 	GetDTreeByName(ctx context.Context, parentName, name string) (*DTreeResponse, error)
+	GetDTreesByParentName(ctx context.Context, parentName string) ([]*DTreeResponse, error)
 	CreateDTree(ctx context.Context, parentName, name, unixPermission string) (*DTreeResponse, error)
 	DeleteDTree(ctx context.Context, dtreeId string) error
 	GetDTreeNfsShareByPath(ctx context.Context, sharePath string) (*GetDTreeNfsShareResponse, error)
@@ -78,6 +79,34 @@ func (cli *RestClient) GetDTreeByName(ctx context.Context, parentName, name stri
 	return resp.Data[index], nil
 }
 
+// GetDTreesByParentName gets all dtrees of the parent filesystem
+func (cli *RestClient) GetDTreesByParentName(ctx context.Context, parentName string) ([]*DTreeResponse, error) {
+	var dtrees []*DTreeResponse
+	for offset := uint(0); ; offset += nasQueryPageSize {
+		restPath := utils.NewFusionRestPath(manageDtreePath)
+		restPath.SetQuery("file_system_name", parentName)
+		restPath.SetQuery("account_id", strconv.Itoa(cli.accountId))
+		restPath.SetRange(offset, nasQueryPageSize)
+		encodedPath, err := restPath.Encode()
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode path and queries: %w", err)
+		}
+
+		resp, err := gracefulNasGet[[]*DTreeResponse](ctx, cli, encodedPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get dtrees of %q: %w", parentName, err)
+		}
+		if resp.GetErrorCode() != 0 {
+			return nil, fmt.Errorf("error %+v from get dtrees restful response", resp.Result)
+		}
+
+		dtrees = append(dtrees, resp.Data...)
+		if uint(len(resp.Data)) < nasQueryPageSize {
+			return dtrees, nil
+		}
+	}
+}
+
 // CreateDTreeRequest defines the fields to create dtree resource
 type CreateDTreeRequest struct {
 	Name           string `json:"name"`
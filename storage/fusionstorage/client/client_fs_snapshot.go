@@ -0,0 +1,154 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/fusionstorage/utils"
+)
+
+const (
+	manageFSSnapshotPath = "/api/v2/file_service/snapshots"
+	cloneFileSystemPath  = "/api/v2/file_service/clone_file_system"
+)
+
+// FSSnapshot is the interface for Pacific filesystem snapshot
+type FSSnapshot interface {
+	GetFSSnapshotByName(ctx context.Context, fsName, snapshotName string) (*FSSnapshotResponse, error)
+	CreateFSSnapshot(ctx context.Context, fsName, snapshotName string) (*FSSnapshotResponse, error)
+	DeleteFSSnapshot(ctx context.Context, fsName, snapshotName string) error
+	CloneFSFromSnapshot(ctx context.Context, req *CloneFSFromSnapshotRequest) error
+}
+
+// FSSnapshotResponse defines the fields of filesystem snapshot response
+type FSSnapshotResponse struct {
+	Id             string `json:"id"`
+	Name           string `json:"name"`
+	FileSystemName string `json:"file_system_name"`
+	CreateTime     int64  `json:"create_time"`
+}
+
+// GetFSSnapshotByName gets the snapshot of filesystem by its name
+func (cli *RestClient) GetFSSnapshotByName(ctx context.Context, fsName, snapshotName string) (*FSSnapshotResponse,
+	error) {
+	restPath := utils.NewFusionRestPath(manageFSSnapshotPath)
+	restPath.SetQuery("file_system_name", fsName)
+	restPath.SetQuery("account_id", strconv.Itoa(cli.accountId))
+	restPath.AddFilter("name", snapshotName)
+	encodedPath, err := restPath.Encode()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode path and queries: %w", err)
+	}
+
+	resp, err := gracefulNasGet[[]*FSSnapshotResponse](ctx, cli, encodedPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get filesystem snapshot by name: %w", err)
+	}
+
+	if resp.GetErrorCode() == fileSystemNotExist {
+		return nil, nil
+	}
+
+	if resp.GetErrorCode() != 0 {
+		return nil, fmt.Errorf("error %+v from get filesystem snapshot by name restful response", resp.Result)
+	}
+
+	index := slices.IndexFunc(resp.Data, func(data *FSSnapshotResponse) bool {
+		return data.Name == snapshotName
+	})
+	if index == -1 {
+		return nil, nil
+	}
+
+	return resp.Data[index], nil
+}
+
+// CreateFSSnapshotRequest defines the fields to create filesystem snapshot
+type CreateFSSnapshotRequest struct {
+	Name           string `json:"name"`
+	FileSystemName string `json:"file_system_name"`
+	AccountId      int    `json:"account_id"`
+}
+
+// CreateFSSnapshot creates a snapshot of filesystem
+func (cli *RestClient) CreateFSSnapshot(ctx context.Context, fsName, snapshotName string) (*FSSnapshotResponse,
+	error) {
+	req := &CreateFSSnapshotRequest{
+		Name:           snapshotName,
+		FileSystemName: fsName,
+		AccountId:      cli.accountId,
+	}
+
+	resp, err := gracefulNasPost[*FSSnapshotResponse](ctx, cli, manageFSSnapshotPath, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create filesystem snapshot: %w", err)
+	}
+
+	if resp.GetErrorCode() != 0 {
+		return nil, fmt.Errorf("error %+v from create filesystem snapshot restful response", resp.Result)
+	}
+
+	return resp.Data, nil
+}
+
+// DeleteFSSnapshot deletes the snapshot of filesystem by its name
+func (cli *RestClient) DeleteFSSnapshot(ctx context.Context, fsName, snapshotName string) error {
+	req := map[string]any{
+		"name":             snapshotName,
+		"file_system_name": fsName,
+		"account_id":       cli.accountId,
+	}
+
+	resp, err := gracefulNasDelete[any](ctx, cli, manageFSSnapshotPath, req)
+	if err != nil {
+		return fmt.Errorf("failed to delete filesystem snapshot: %w", err)
+	}
+
+	if resp.GetErrorCode() != 0 {
+		return fmt.Errorf("error %+v from delete filesystem snapshot restful response", resp.Result)
+	}
+
+	return nil
+}
+
+// CloneFSFromSnapshotRequest defines the fields to clone filesystem from snapshot
+type CloneFSFromSnapshotRequest struct {
+	Name               string `json:"name"`
+	ParentFSName       string `json:"parent_file_system_name"`
+	ParentSnapshotName string `json:"parent_snapshot_name"`
+	IsShowSnapDir      *bool  `json:"is_show_snap_dir,omitempty"`
+	AccountId          int    `json:"account_id"`
+}
+
+// CloneFSFromSnapshot creates a filesystem which is cloned from the snapshot of the parent filesystem
+func (cli *RestClient) CloneFSFromSnapshot(ctx context.Context, req *CloneFSFromSnapshotRequest) error {
+	req.AccountId = cli.accountId
+	resp, err := gracefulNasPost[any](ctx, cli, cloneFileSystemPath, req)
+	if err != nil {
+		return fmt.Errorf("failed to clone filesystem from snapshot: %w", err)
+	}
+
+	if resp.GetErrorCode() != 0 {
+		return fmt.Errorf("error %+v from clone filesystem from snapshot restful response", resp.Result)
+	}
+
+	return nil
+}
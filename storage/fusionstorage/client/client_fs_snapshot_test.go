@@ -0,0 +1,159 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestClient_GetFSSnapshotByName(t *testing.T) {
+	// arrange
+	successRespBody := `{"data":[{"id":"1","name":"snap_1","file_system_name":"pvc_fs","create_time":1700000000},
+{"id":"2","name":"snap_2","file_system_name":"pvc_fs","create_time":1700000001}],"result":{"code":0}}`
+	notFoundRespBody := `{"data":[],"result":{"code":0,"description":""}}`
+	fsNotExistRespBody := `{"data":[],"result":{"code":33564678,"description":"filesystem does not exist"}}`
+	failureRespBody := `{"data":[],"result":{"code":12345,"description":"test description"}}`
+	tests := []struct {
+		name         string
+		responseBody string
+		snapshotName string
+		wantErr      bool
+		wantResp     *FSSnapshotResponse
+	}{
+		{name: "success", responseBody: successRespBody, snapshotName: "snap_2", wantErr: false,
+			wantResp: &FSSnapshotResponse{Id: "2", Name: "snap_2", FileSystemName: "pvc_fs", CreateTime: 1700000001}},
+		{name: "not found", responseBody: notFoundRespBody, snapshotName: "snap_1", wantErr: false, wantResp: nil},
+		{name: "filesystem not exist", responseBody: fsNotExistRespBody, snapshotName: "snap_1", wantErr: false,
+			wantResp: nil},
+		{name: "failure with error code", responseBody: failureRespBody, snapshotName: "snap_1", wantErr: true,
+			wantResp: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// mock
+			mockClient := getMockClient(200, tt.responseBody)
+
+			// action
+			resp, err := mockClient.GetFSSnapshotByName(context.Background(), "pvc_fs", tt.snapshotName)
+
+			// assert
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.wantResp, resp)
+		})
+	}
+}
+
+func TestRestClient_CreateFSSnapshot(t *testing.T) {
+	// arrange
+	successRespBody := `{"data":{"id":"1","name":"snap_1","file_system_name":"pvc_fs"},"result":{"code":0}}`
+	failureRespBody := `{"data":{},"result":{"code":12345,"description":"test description"}}`
+	tests := []struct {
+		name         string
+		responseBody string
+		wantErr      bool
+		wantResp     *FSSnapshotResponse
+	}{
+		{name: "success", responseBody: successRespBody, wantErr: false,
+			wantResp: &FSSnapshotResponse{Id: "1", Name: "snap_1", FileSystemName: "pvc_fs"}},
+		{name: "failure with error code", responseBody: failureRespBody, wantErr: true, wantResp: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// mock
+			mockClient := getMockClient(200, tt.responseBody)
+
+			// action
+			resp, err := mockClient.CreateFSSnapshot(context.Background(), "pvc_fs", "snap_1")
+
+			// assert
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.wantResp, resp)
+		})
+	}
+}
+
+func TestRestClient_DeleteFSSnapshot(t *testing.T) {
+	// arrange
+	tests := []struct {
+		name         string
+		responseBody string
+		wantErr      bool
+	}{
+		{name: "success", responseBody: `{"data":{},"result":{"code":0}}`, wantErr: false},
+		{name: "failure with error code", responseBody: `{"data":{},"result":{"code":12345}}`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// mock
+			mockClient := getMockClient(200, tt.responseBody)
+
+			// action
+			err := mockClient.DeleteFSSnapshot(context.Background(), "pvc_fs", "snap_1")
+
+			// assert
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRestClient_CloneFSFromSnapshot(t *testing.T) {
+	// arrange
+	tests := []struct {
+		name         string
+		responseBody string
+		wantErr      bool
+	}{
+		{name: "success", responseBody: `{"data":{},"result":{"code":0}}`, wantErr: false},
+		{name: "failure with error code", responseBody: `{"data":{},"result":{"code":12345}}`, wantErr: true},
+	}
+	req := &CloneFSFromSnapshotRequest{Name: "pvc_clone", ParentFSName: "pvc_fs", ParentSnapshotName: "snap_1"}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// mock
+			mockClient := getMockClient(200, tt.responseBody)
+
+			// action
+			err := mockClient.CloneFSFromSnapshot(context.Background(), req)
+
+			// assert
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
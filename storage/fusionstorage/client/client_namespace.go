@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2022-2025. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2022-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -23,7 +23,10 @@ import (
 	"fmt"
 	fusionURL "net/url"
 	"strconv"
+	"strings"
 
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/fusionstorage/utils"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
 )
 
@@ -31,6 +34,12 @@ const (
 	clientAlreadyExist int64 = 1077939727
 	fileSystemNotExist int64 = 33564678
 	notForbidden       int   = 0
+
+	nasQueryPageSize uint = 100
+
+	manageNamespacePath          = "/api/v2/converged_service/namespaces"
+	nfsShareAuthClientListPath   = "/api/v2/nas_protocol/nfs_share_auth_client_list"
+	manageNfsShareAuthClientPath = "/api/v2/nas_protocol/nfs_share_auth_client"
 )
 
 // Namespace is the interface for namespace
@@ -38,6 +47,7 @@ type Namespace interface {
 	CreateFileSystem(ctx context.Context, params map[string]any) (map[string]any, error)
 	DeleteFileSystem(ctx context.Context, id string) error
 	GetFileSystemByName(ctx context.Context, name string) (map[string]interface{}, error)
+	GetFileSystemsByNamePrefix(ctx context.Context, prefix string) ([]map[string]interface{}, error)
 	CreateNfsShare(ctx context.Context, params map[string]any) (map[string]any, error)
 	DeleteNfsShare(ctx context.Context, id string) error
 	GetNfsShareByPath(ctx context.Context, path string) (map[string]interface{}, error)
@@ -45,6 +55,9 @@ type Namespace interface {
 	DeleteNfsShareAccess(ctx context.Context, accessID string) error
 	GetNfsShareAccess(ctx context.Context, shareID string) (map[string]interface{}, error)
 	GetQuotaByFileSystemName(ctx context.Context, fsName string) (*QueryQuotaResponse, error)
+	UpdateFileSystem(ctx context.Context, req *UpdateFileSystemRequest) error
+	GetNfsShareAuthClients(ctx context.Context, shareID string) ([]*NfsShareAuthClientResponse, error)
+	ModifyNfsShareAuthClient(ctx context.Context, req *ModifyNfsShareAuthClientRequest) error
 }
 
 // CreateFileSystem used to create file system by params
@@ -157,6 +170,39 @@ func (cli *RestClient) GetFileSystemByName(ctx context.Context, name string) (ma
 	return nil, nil
 }
 
+// GetFileSystemsByNamePrefix used to get all file systems of the account whose name starts with the prefix
+func (cli *RestClient) GetFileSystemsByNamePrefix(ctx context.Context,
+	prefix string) ([]map[string]interface{}, error) {
+	var filesystems []map[string]interface{}
+	for offset := uint(0); ; offset += nasQueryPageSize {
+		restPath := utils.NewFusionRestPath(manageNamespacePath)
+		restPath.SetQuery("account_id", strconv.Itoa(cli.accountId))
+		restPath.SetRange(offset, nasQueryPageSize)
+		encodedPath, err := restPath.Encode()
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode path and queries: %w", err)
+		}
+
+		resp, err := gracefulNasGet[[]map[string]interface{}](ctx, cli, encodedPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get filesystems: %w", err)
+		}
+		if resp.GetErrorCode() != 0 {
+			return nil, fmt.Errorf("error %+v from get filesystems restful response", resp.Result)
+		}
+
+		for _, fs := range resp.Data {
+			if name, ok := fs["name"].(string); ok && strings.HasPrefix(name, prefix) {
+				filesystems = append(filesystems, fs)
+			}
+		}
+
+		if uint(len(resp.Data)) < nasQueryPageSize {
+			return filesystems, nil
+		}
+	}
+}
+
 // CreateNfsShare used to create nfs share by params
 func (cli *RestClient) CreateNfsShare(ctx context.Context, params map[string]any) (map[string]any, error) {
 	data := map[string]interface{}{
@@ -384,9 +430,9 @@ func (cli *RestClient) GetQuotaByFileSystemName(ctx context.Context, fsName stri
 		return nil, err
 	}
 	if fs == nil {
-		msg := fmt.Sprintf(" Filesystem %s does not exist", fsName)
+		msg := fmt.Sprintf("filesystem %s does not exist", fsName)
 		log.AddContext(ctx).Errorln(msg)
-		return nil, errors.New(msg)
+		return nil, fmt.Errorf("%w: %s", constants.ErrVolumeNotFound, msg)
 	}
 	if _, exit := fs["id"].(float64); !exit {
 		msg := fmt.Sprintf(" Filesystem %s not fount id", fsName)
@@ -401,3 +447,80 @@ func (cli *RestClient) GetQuotaByFileSystemName(ctx context.Context, fsName stri
 	}
 	return quota, nil
 }
+
+// UpdateFileSystemRequest defines the fields to update file system request
+type UpdateFileSystemRequest struct {
+	Id            int64  `json:"id"`
+	IsShowSnapDir *bool  `json:"is_show_snap_dir,omitempty"`
+	AccountId     string `json:"account_id"`
+}
+
+// UpdateFileSystem updates the attributes of file system in place
+func (cli *RestClient) UpdateFileSystem(ctx context.Context, req *UpdateFileSystemRequest) error {
+	req.AccountId = strconv.Itoa(cli.accountId)
+	resp, err := gracefulNasPut[any](ctx, cli, manageNamespacePath, req)
+	if err != nil {
+		return fmt.Errorf("failed to update filesystem: %w", err)
+	}
+
+	if resp.GetErrorCode() != 0 {
+		return fmt.Errorf("error %+v from update filesystem restful response", resp.Result)
+	}
+
+	return nil
+}
+
+// NfsShareAuthClientResponse defines the fields of nfs share auth client response
+type NfsShareAuthClientResponse struct {
+	Id          string `json:"id"`
+	AccessName  string `json:"access_name"`
+	AccessValue int    `json:"access_value"`
+	AllSquash   int    `json:"all_squash"`
+	RootSquash  int    `json:"root_squash"`
+}
+
+// GetNfsShareAuthClients gets all auth clients of the nfs share
+func (cli *RestClient) GetNfsShareAuthClients(ctx context.Context,
+	shareID string) ([]*NfsShareAuthClientResponse, error) {
+	restPath := utils.NewFusionRestPath(nfsShareAuthClientListPath)
+	restPath.SetQuery("account_id", strconv.Itoa(cli.accountId))
+	restPath.AddFilter("share_id", shareID)
+	encodedPath, err := restPath.Encode()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode path and queries: %w", err)
+	}
+
+	resp, err := gracefulNasGet[[]*NfsShareAuthClientResponse](ctx, cli, encodedPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get nfs share auth clients: %w", err)
+	}
+
+	if resp.GetErrorCode() != 0 {
+		return nil, fmt.Errorf("error %+v from get nfs share auth clients restful response", resp.Result)
+	}
+
+	return resp.Data, nil
+}
+
+// ModifyNfsShareAuthClientRequest defines the fields to modify nfs share auth client request
+type ModifyNfsShareAuthClientRequest struct {
+	Id         string `json:"id"`
+	AllSquash  int    `json:"all_squash"`
+	RootSquash int    `json:"root_squash"`
+	AccountId  int    `json:"account_id"`
+}
+
+// ModifyNfsShareAuthClient modifies the squash of nfs share auth client
+func (cli *RestClient) ModifyNfsShareAuthClient(ctx context.Context, req *ModifyNfsShareAuthClientRequest) error {
+	req.AccountId = cli.accountId
+	resp, err := gracefulNasPut[any](ctx, cli, manageNfsShareAuthClientPath, req)
+	if err != nil {
+		return fmt.Errorf("failed to modify nfs share auth client: %w", err)
+	}
+
+	if resp.GetErrorCode() != 0 {
+		return fmt.Errorf("error %+v from modify nfs share auth client restful response", resp.Result)
+	}
+
+	return nil
+}
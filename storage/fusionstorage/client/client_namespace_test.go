@@ -398,3 +398,38 @@ func TestGetNfsShareAccess(t *testing.T) {
 		assert.Equal(t, expectedResponse["data"], result)
 	})
 }
+
+func TestRestClient_GetNfsShareAuthClients(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	respBody := `{"data":[{"id":"1","access_name":"192.168.1.1","access_value":1,"all_squash":1,"root_squash":0}],
+"result":{"code":0,"description":""}}`
+	want := []*NfsShareAuthClientResponse{
+		{Id: "1", AccessName: "192.168.1.1", AccessValue: 1, AllSquash: 1, RootSquash: 0},
+	}
+
+	// mock
+	mockClient := getMockClient(200, respBody)
+
+	// action
+	got, err := mockClient.GetNfsShareAuthClients(ctx, "share-1")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestRestClient_ModifyNfsShareAuthClient_Failed(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	req := &ModifyNfsShareAuthClientRequest{Id: "1", AllSquash: 0, RootSquash: 1}
+
+	// mock
+	mockClient := getMockClient(200, `{"data":{},"result":{"code":1077939726,"description":"failed"}}`)
+
+	// action
+	err := mockClient.ModifyNfsShareAuthClient(ctx, req)
+
+	// assert
+	assert.ErrorContains(t, err, "modify nfs share auth client")
+}
@@ -32,6 +32,7 @@ type Snapshot interface {
 	CreateSnapshot(ctx context.Context, snapshotName, volName string) error
 	DeleteSnapshot(ctx context.Context, snapshotName string) error
 	GetSnapshotByName(ctx context.Context, snapshotName string) (map[string]interface{}, error)
+	GetSnapshotsByVolumeName(ctx context.Context, volName string) ([]map[string]interface{}, error)
 	CreateVolumeFromSnapshot(ctx context.Context, volName string, volSize int64, snapshotName string) error
 }
 
@@ -101,6 +102,47 @@ func (cli *RestClient) GetSnapshotByName(ctx context.Context, snapshotName strin
 	return snapshot, nil
 }
 
+// GetSnapshotsByVolumeName get all snapshots of the volume
+func (cli *RestClient) GetSnapshotsByVolumeName(ctx context.Context,
+	volName string) ([]map[string]interface{}, error) {
+	data := map[string]interface{}{
+		"volName": volName,
+	}
+
+	resp, err := cli.post(ctx, "/dsware/service/v1.3/volume/snapshot/list", data)
+	if err != nil {
+		return nil, err
+	}
+
+	result := int64(resp["result"].(float64))
+	if result != 0 {
+		errorCode, _ := resp["errorCode"].(float64)
+		if int64(errorCode) == volumeNameNotExist {
+			log.AddContext(ctx).Warningf("Volume of name %s doesn't exist", volName)
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("get snapshots of volume %s error: %d", volName, int64(errorCode))
+	}
+
+	snapshotList, ok := resp["snapshotList"].([]interface{})
+	if !ok {
+		return nil, nil
+	}
+
+	var snapshots []map[string]interface{}
+	for _, s := range snapshotList {
+		snapshot, ok := s.(map[string]interface{})
+		if !ok {
+			log.AddContext(ctx).Warningf("convert snapshot: %v to map failed", s)
+			continue
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+
+	return snapshots, nil
+}
+
 // CreateVolumeFromSnapshot creates volume from snapshot
 func (cli *RestClient) CreateVolumeFromSnapshot(ctx context.Context,
 	volName string,
@@ -29,12 +29,16 @@ const (
 	volumeNameNotExist   int64 = 50150005
 	deleteVolumeNotExist int64 = 32150005
 	queryVolumeNotExist  int64 = 31000000
+
+	// queryVolumesPageSize is the max number of volumes queried in one request
+	queryVolumesPageSize = 1000
 )
 
 // Volume is the interface for volume
 type Volume interface {
 	CreateVolume(ctx context.Context, params map[string]interface{}) error
 	GetVolumeByName(ctx context.Context, name string) (map[string]interface{}, error)
+	GetVolumesByPoolID(ctx context.Context, poolID int64) ([]map[string]interface{}, error)
 	QueryVolume(ctx context.Context, name string) (map[string]interface{}, error)
 	DeleteVolume(ctx context.Context, name string) error
 	AttachVolume(ctx context.Context, name, ip string) error
@@ -98,6 +102,43 @@ func (cli *RestClient) GetVolumeByName(ctx context.Context, name string) (map[st
 	return lun, nil
 }
 
+// GetVolumesByPoolID gets all volumes of the pool
+func (cli *RestClient) GetVolumesByPoolID(ctx context.Context, poolID int64) ([]map[string]interface{}, error) {
+	var volumes []map[string]interface{}
+	for offset := 0; ; offset += queryVolumesPageSize {
+		data := map[string]interface{}{
+			"poolId": poolID,
+			"offset": offset,
+			"limit":  queryVolumesPageSize,
+		}
+
+		resp, err := cli.post(ctx, "/dsware/service/v1.3/volume/list", data)
+		if err != nil {
+			return nil, err
+		}
+
+		result := int64(resp["result"].(float64))
+		if result != 0 {
+			errorCode, _ := resp["errorCode"].(float64)
+			return nil, fmt.Errorf("get volumes of pool %d error: %d", poolID, int64(errorCode))
+		}
+
+		volumeList, _ := resp["volumeList"].([]interface{})
+		for _, v := range volumeList {
+			volume, ok := v.(map[string]interface{})
+			if !ok {
+				log.AddContext(ctx).Warningf("convert volume: %v to map failed", v)
+				continue
+			}
+			volumes = append(volumes, volume)
+		}
+
+		if len(volumeList) < queryVolumesPageSize {
+			return volumes, nil
+		}
+	}
+}
+
 // DeleteVolume deletes volume by name
 func (cli *RestClient) DeleteVolume(ctx context.Context, name string) error {
 	data := map[string]interface{}{
@@ -21,6 +21,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/fusionstorage/client"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
 )
@@ -56,7 +57,8 @@ func (q *Querier) Query() (utils.Volume, error) {
 		return nil, err
 	}
 	if dtree == nil || dtree.Id == "" {
-		return nil, fmt.Errorf("the dtree %q of parent %q is not exist", q.params.DTreeName, q.params.ParentName)
+		return nil, fmt.Errorf("%w: the dtree %q of parent %q is not exist", constants.ErrVolumeNotFound,
+			q.params.DTreeName, q.params.ParentName)
 	}
 
 	quota, err := q.cli.GetQuotaByDTreeId(q.ctx, dtree.Id)
@@ -24,6 +24,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/fusionstorage/client"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/test/mocks/mock_client"
 )
@@ -77,7 +78,9 @@ func TestQuerier_Query_Fail(t *testing.T) {
 		// action
 		_, err := querier.Query()
 		// assert
-		require.EqualError(t, err, fmt.Sprintf("the dtree %q of parent %q is not exist", fakeDTreeName, fakeParentName))
+		require.ErrorIs(t, err, constants.ErrVolumeNotFound)
+		require.ErrorContains(t, err,
+			fmt.Sprintf("the dtree %q of parent %q is not exist", fakeDTreeName, fakeParentName))
 	})
 
 	t.Run("test query quota failed", func(t *testing.T) {
@@ -0,0 +1,268 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package volume
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/fusionstorage/client"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
+)
+
+const (
+	// ModifyAuthClientKey is the mutable parameter key of the auth clients of the nfs share, separated by ";"
+	ModifyAuthClientKey = constants.ModifyAuthClientKey
+	// ModifyAllSquashKey is the mutable parameter key of the all squash of the nfs share auth clients
+	ModifyAllSquashKey = constants.ModifyAllSquashKey
+	// ModifyRootSquashKey is the mutable parameter key of the root squash of the nfs share auth clients
+	ModifyRootSquashKey = constants.ModifyRootSquashKey
+	// ModifySnapshotDirectoryVisibilityKey is the mutable parameter key of the snapshot directory visibility
+	ModifySnapshotDirectoryVisibilityKey = constants.ModifySnapshotDirectoryVisibilityKey
+)
+
+// CheckNASModifyParameters checks the mutable parameters can be modified on the filesystem
+func CheckNASModifyParameters(params map[string]string) error {
+	supportedKeys := []string{ModifyAuthClientKey, ModifyAllSquashKey, ModifyRootSquashKey,
+		ModifySnapshotDirectoryVisibilityKey}
+	for key := range params {
+		if !utils.Contains(supportedKeys, key) {
+			return fmt.Errorf("parameter %s can not be modified, supported parameters: %v", key, supportedKeys)
+		}
+	}
+
+	return nil
+}
+
+// ModifyAttributes used to change the export options of the filesystem in place,
+// such as auth clients, all squash, root squash and snapshot directory visibility.
+func (p *NAS) ModifyAttributes(ctx context.Context, name string, params map[string]string) error {
+	if err := CheckNASModifyParameters(params); err != nil {
+		return err
+	}
+
+	if visibility, exist := params[ModifySnapshotDirectoryVisibilityKey]; exist {
+		if err := p.modifySnapshotDirectoryVisibility(ctx, name, visibility); err != nil {
+			return err
+		}
+	}
+
+	if err := p.modifyShareOptions(ctx, name, params); err != nil {
+		return err
+	}
+
+	log.AddContext(ctx).Infof("Modify attributes %v of filesystem %s success", params, name)
+	return nil
+}
+
+// GetExportOptions returns the export options of the filesystem in the format of the mutable parameters,
+// such as auth clients, all squash, root squash and snapshot directory visibility
+func (p *NAS) GetExportOptions(ctx context.Context, name string) (map[string]string, error) {
+	fs, err := p.cli.GetFileSystemByName(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("get filesystem %s error: %w", name, err)
+	}
+	if fs == nil {
+		return nil, fmt.Errorf("filesystem %s does not exist", name)
+	}
+
+	sharePath := utils.GetFSSharePath(name)
+	share, err := p.cli.GetNfsShareByPath(ctx, sharePath)
+	if err != nil {
+		return nil, fmt.Errorf("get nfs share by path %s error: %w", sharePath, err)
+	}
+	shareID, _ := utils.GetValue[string](share, "id")
+	if shareID == "" {
+		return nil, fmt.Errorf("nfs share %s does not exist", sharePath)
+	}
+
+	current, err := p.cli.GetNfsShareAuthClients(ctx, shareID)
+	if err != nil {
+		return nil, fmt.Errorf("get auth clients of share %s error: %w", sharePath, err)
+	}
+
+	clients := make([]string, 0, len(current))
+	for _, access := range current {
+		clients = append(clients, access.AccessName)
+	}
+
+	// the auth clients of the share are modified together, so the squash of any of them is the squash of the share
+	allSquashValue, rootSquashValue := noAllSquash, noRootSquash
+	if len(current) != 0 {
+		allSquashValue, rootSquashValue = current[0].AllSquash, current[0].RootSquash
+	}
+
+	options := utils.GetShareOptions(clients, allSquashValue, rootSquashValue)
+	options[ModifySnapshotDirectoryVisibilityKey] = invisibleString
+	if isShowSnapDir, _ := utils.GetValue[bool](fs, "is_show_snap_dir"); isShowSnapDir {
+		options[ModifySnapshotDirectoryVisibilityKey] = visibleString
+	}
+	return options, nil
+}
+
+func (p *NAS) modifySnapshotDirectoryVisibility(ctx context.Context, name, visibility string) error {
+	var isShowSnapDir bool
+	switch {
+	case strings.EqualFold(visibility, visibleString):
+		isShowSnapDir = true
+	case strings.EqualFold(visibility, invisibleString):
+		isShowSnapDir = false
+	default:
+		return fmt.Errorf("%s must be %q or %q, %q is invalid",
+			ModifySnapshotDirectoryVisibilityKey, visibleString, invisibleString, visibility)
+	}
+
+	fs, err := p.cli.GetFileSystemByName(ctx, name)
+	if err != nil {
+		return fmt.Errorf("get filesystem %s error: %w", name, err)
+	}
+	if fs == nil {
+		return fmt.Errorf("filesystem %s does not exist", name)
+	}
+
+	fsID, ok := utils.GetValue[float64](fs, "id")
+	if !ok {
+		return fmt.Errorf("convert id of filesystem %s to float64 failed, data: %v", name, fs["id"])
+	}
+
+	return p.cli.UpdateFileSystem(ctx, &client.UpdateFileSystemRequest{
+		Id:            int64(fsID),
+		IsShowSnapDir: &isShowSnapDir,
+	})
+}
+
+// modifyShareOptions used to change the auth clients and squash of the nfs share in place.
+// The auth clients which are not in the new list are removed, and the new ones inherit the squash of the share.
+func (p *NAS) modifyShareOptions(ctx context.Context, name string, params map[string]string) error {
+	squash, err := utils.GetModifySquash(params)
+	if err != nil {
+		return err
+	}
+	authClient, modifyAuthClient := params[ModifyAuthClientKey]
+	if !modifyAuthClient && squash.IsEmpty() {
+		return nil
+	}
+
+	sharePath := utils.GetFSSharePath(name)
+	share, err := p.cli.GetNfsShareByPath(ctx, sharePath)
+	if err != nil {
+		return fmt.Errorf("get nfs share by path %s error: %w", sharePath, err)
+	}
+	shareID, _ := utils.GetValue[string](share, "id")
+	if shareID == "" {
+		return fmt.Errorf("nfs share %s does not exist", sharePath)
+	}
+
+	current, err := p.cli.GetNfsShareAuthClients(ctx, shareID)
+	if err != nil {
+		return fmt.Errorf("get auth clients of share %s error: %w", sharePath, err)
+	}
+
+	remained := current
+	if modifyAuthClient {
+		remained, err = p.modifyAuthClients(ctx, shareID, authClient, current, squash)
+		if err != nil {
+			return fmt.Errorf("modify auth clients of share %s error: %w", sharePath, err)
+		}
+	}
+
+	if squash.IsEmpty() {
+		return nil
+	}
+
+	for _, access := range remained {
+		req := &client.ModifyNfsShareAuthClientRequest{
+			Id:         access.Id,
+			AllSquash:  access.AllSquash,
+			RootSquash: access.RootSquash,
+		}
+		if squash.AllSquash != nil {
+			req.AllSquash = *squash.AllSquash
+		}
+		if squash.RootSquash != nil {
+			req.RootSquash = *squash.RootSquash
+		}
+
+		if err = p.cli.ModifyNfsShareAuthClient(ctx, req); err != nil {
+			return fmt.Errorf("modify squash of auth client %s of share %s error: %w",
+				access.AccessName, sharePath, err)
+		}
+	}
+
+	return nil
+}
+
+// modifyAuthClients adds the new auth clients and removes the stale ones, the remained auth clients are returned.
+func (p *NAS) modifyAuthClients(ctx context.Context, shareID, authClient string,
+	current []*client.NfsShareAuthClientResponse, squash utils.ShareSquash) ([]*client.NfsShareAuthClientResponse, error) {
+	clients := utils.SplitAuthClients(authClient)
+	if len(clients) == 0 {
+		return nil, fmt.Errorf("%s can not be empty", ModifyAuthClientKey)
+	}
+
+	// the new auth clients inherit the squash of the existing ones, unless the squash is changed too
+	allSquashValue, rootSquashValue := noAllSquash, noRootSquash
+	if len(current) != 0 {
+		allSquashValue, rootSquashValue = current[0].AllSquash, current[0].RootSquash
+	}
+	if squash.AllSquash != nil {
+		allSquashValue = *squash.AllSquash
+	}
+	if squash.RootSquash != nil {
+		rootSquashValue = *squash.RootSquash
+	}
+
+	existing := make(map[string]bool, len(current))
+	for _, access := range current {
+		existing[access.AccessName] = true
+	}
+
+	for _, name := range clients {
+		if existing[name] {
+			continue
+		}
+
+		req := &client.AllowNfsShareAccessRequest{
+			AccessName:  name,
+			ShareId:     shareID,
+			AccessValue: defaultAccessValue,
+			AllSquash:   allSquashValue,
+			RootSquash:  rootSquashValue,
+		}
+		if err := p.cli.AllowNfsShareAccess(ctx, req); err != nil {
+			return nil, fmt.Errorf("allow nfs share access %v error: %w", req, err)
+		}
+	}
+
+	var remained []*client.NfsShareAuthClientResponse
+	for _, access := range current {
+		if utils.Contains(clients, access.AccessName) {
+			remained = append(remained, access)
+			continue
+		}
+
+		if err := p.cli.DeleteNfsShareAccess(ctx, access.Id); err != nil {
+			return nil, fmt.Errorf("delete auth client %s error: %w", access.AccessName, err)
+		}
+	}
+
+	log.AddContext(ctx).Infof("Modify auth clients of share %s to %v success", shareID, clients)
+	return remained, nil
+}
@@ -0,0 +1,143 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package volume
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/fusionstorage/client"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/test/mocks/mock_client"
+)
+
+func TestNAS_ModifyAttributes_ReplaceAuthClients(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockIRestClient(mockCtrl)
+	nas := NewNAS(cli)
+	current := []*client.NfsShareAuthClientResponse{
+		{Id: "access-1", AccessName: "192.168.1.1", AllSquash: noAllSquash, RootSquash: rootSquash},
+		{Id: "access-3", AccessName: "192.168.1.3", AllSquash: noAllSquash, RootSquash: rootSquash},
+	}
+
+	// mock
+	cli.EXPECT().GetNfsShareByPath(ctx, "/test_fs/").Return(map[string]interface{}{"id": "share-1"}, nil)
+	cli.EXPECT().GetNfsShareAuthClients(ctx, "share-1").Return(current, nil)
+	cli.EXPECT().AllowNfsShareAccess(ctx, &client.AllowNfsShareAccessRequest{
+		AccessName:  "192.168.1.2",
+		ShareId:     "share-1",
+		AccessValue: defaultAccessValue,
+		AllSquash:   allSquash,
+		RootSquash:  rootSquash,
+	}).Return(nil)
+	cli.EXPECT().DeleteNfsShareAccess(ctx, "access-3").Return(nil)
+	cli.EXPECT().ModifyNfsShareAuthClient(ctx, &client.ModifyNfsShareAuthClientRequest{
+		Id:         "access-1",
+		AllSquash:  allSquash,
+		RootSquash: rootSquash,
+	}).Return(nil)
+
+	// action
+	err := nas.ModifyAttributes(ctx, "test-fs",
+		map[string]string{"authClient": "192.168.1.1;192.168.1.2", "allSquash": "all_squash"})
+
+	// assert
+	assert.NoError(t, err)
+}
+
+func TestNAS_ModifyAttributes_SnapshotDirectoryVisibility(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockIRestClient(mockCtrl)
+	nas := NewNAS(cli)
+	var gotReq *client.UpdateFileSystemRequest
+
+	// mock
+	cli.EXPECT().GetFileSystemByName(ctx, "test-fs").Return(map[string]interface{}{"id": float64(10)}, nil)
+	cli.EXPECT().UpdateFileSystem(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *client.UpdateFileSystemRequest) error {
+			gotReq = req
+			return nil
+		})
+
+	// action
+	err := nas.ModifyAttributes(ctx, "test-fs", map[string]string{"snapshotDirectoryVisibility": "visible"})
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), gotReq.Id)
+	assert.True(t, *gotReq.IsShowSnapDir)
+}
+
+func TestNAS_ModifyAttributes_InvalidParameters(t *testing.T) {
+	// arrange
+	tests := []struct {
+		name    string
+		params  map[string]string
+		wantErr string
+	}{
+		{name: "unsupported key", params: map[string]string{"qos": ""}, wantErr: "can not be modified"},
+		{name: "invalid root squash", params: map[string]string{"rootSquash": "squash"},
+			wantErr: "rootSquash must be"},
+		{name: "invalid visibility", params: map[string]string{"snapshotDirectoryVisibility": "hidden"},
+			wantErr: "snapshotDirectoryVisibility must be"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// action
+			err := NewNAS(nil).ModifyAttributes(context.Background(), "test-fs", tt.params)
+
+			// assert
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestNAS_GetExportOptions(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockIRestClient(mockCtrl)
+	nas := NewNAS(cli)
+	current := []*client.NfsShareAuthClientResponse{
+		{Id: "access-3", AccessName: "192.168.1.3", AllSquash: allSquash, RootSquash: noRootSquash},
+		{Id: "access-1", AccessName: "192.168.1.1", AllSquash: allSquash, RootSquash: noRootSquash},
+	}
+
+	// mock
+	cli.EXPECT().GetFileSystemByName(ctx, "test-fs").
+		Return(map[string]interface{}{"id": float64(10), "is_show_snap_dir": false}, nil)
+	cli.EXPECT().GetNfsShareByPath(ctx, "/test_fs/").Return(map[string]interface{}{"id": "share-1"}, nil)
+	cli.EXPECT().GetNfsShareAuthClients(ctx, "share-1").Return(current, nil)
+
+	// action
+	got, err := nas.GetExportOptions(ctx, "test-fs")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"authClient": "192.168.1.1;192.168.1.3", "allSquash": "all_squash",
+		"rootSquash": "no_root_squash", "snapshotDirectoryVisibility": "invisible"}, got)
+}
@@ -245,8 +245,8 @@ func (p *NAS) preCreate(ctx context.Context, params map[string]interface{}) erro
 		return err
 	}
 
-	if v, exist := params["clonefrom"].(string); exist {
-		params["clonefrom"] = v
+	if v, exist := params["sourcesnapshotname"].(string); exist && v != "" {
+		params["fromSnapshot"] = utils.GetFSSnapshotName(v)
 	}
 
 	if err := p.preProcessQuota(ctx, params); err != nil {
@@ -320,8 +320,9 @@ func (p *NAS) createFS(ctx context.Context,
 	}
 
 	if fs == nil {
-		_, exist := params["clonefrom"]
-		if exist {
+		if _, exist := params["fromSnapshot"]; exist {
+			fs, err = p.createFromSnapshot(ctx, params)
+		} else if _, exist := params["clonefrom"]; exist {
 			fs, err = p.clone(params)
 		} else {
 			fs, err = p.cli.CreateFileSystem(ctx, params)
@@ -345,7 +346,67 @@ func (p *NAS) createFS(ctx context.Context,
 }
 
 func (p *NAS) clone(params map[string]interface{}) (map[string]interface{}, error) {
-	return nil, fmt.Errorf("unimplemented")
+	return nil, fmt.Errorf("clone filesystem from volume is unimplemented, create it from snapshot instead")
+}
+
+func (p *NAS) createFromSnapshot(ctx context.Context, params map[string]interface{}) (map[string]interface{},
+	error) {
+	fsName, ok := utils.GetValue[string](params, "name")
+	if !ok {
+		return nil, fmt.Errorf("can not get name from params %v", params)
+	}
+
+	snapshotName, ok := utils.GetValue[string](params, "fromSnapshot")
+	if !ok {
+		return nil, fmt.Errorf("can not get fromSnapshot from params %v", params)
+	}
+
+	parentName, ok := utils.GetValue[string](params, "snapshotparentid")
+	if !ok || parentName == "" {
+		return nil, fmt.Errorf("can not get the parent filesystem of snapshot %s from params %v",
+			snapshotName, params)
+	}
+
+	snapshot, err := p.cli.GetFSSnapshotByName(ctx, parentName, snapshotName)
+	if err != nil {
+		return nil, fmt.Errorf("get snapshot %s of filesystem %s error: %w", snapshotName, parentName, err)
+	}
+	if snapshot == nil {
+		return nil, fmt.Errorf("src snapshot %s of filesystem %s does not exist", snapshotName, parentName)
+	}
+
+	parent, err := p.Query(ctx, parentName)
+	if err != nil {
+		return nil, fmt.Errorf("query parent filesystem %s of snapshot %s error: %w", parentName, snapshotName, err)
+	}
+
+	capacity := utils.GetValueOrFallback(params, "capacity", int64(0))
+	if utils.TransK8SCapacity(capacity, constants.FusionFileCapacityUnit) < parent.GetSize() {
+		return nil, fmt.Errorf("clone filesystem capacity must be >= src snapshot %s", snapshotName)
+	}
+
+	req := &client.CloneFSFromSnapshotRequest{
+		Name:               fsName,
+		ParentFSName:       parentName,
+		ParentSnapshotName: snapshotName,
+	}
+	if isShowSnapDir, exist := params["isshowsnapdir"].(bool); exist {
+		req.IsShowSnapDir = &isShowSnapDir
+	}
+
+	if err = p.cli.CloneFSFromSnapshot(ctx, req); err != nil {
+		return nil, err
+	}
+
+	fs, err := p.cli.GetFileSystemByName(ctx, fsName)
+	if err != nil {
+		return nil, err
+	}
+	if fs == nil {
+		return nil, fmt.Errorf("filesystem %s cloned from snapshot %s does not exist", fsName, snapshotName)
+	}
+
+	return fs, nil
 }
 
 func (p *NAS) revertFS(ctx context.Context, taskResult map[string]interface{}) error {
@@ -778,3 +839,63 @@ func (p *NAS) Expand(ctx context.Context, fsName string, newSize int64) error {
 	}
 	return nil
 }
+
+// CreateSnapshot creates filesystem snapshot
+func (p *NAS) CreateSnapshot(ctx context.Context, fsName, snapshotName string) (map[string]interface{}, error) {
+	vol, err := p.Query(ctx, fsName)
+	if err != nil {
+		return nil, fmt.Errorf("query filesystem %s of snapshot %s error: %w", fsName, snapshotName, err)
+	}
+
+	snapshot, err := p.cli.GetFSSnapshotByName(ctx, fsName, snapshotName)
+	if err != nil {
+		return nil, fmt.Errorf("get filesystem snapshot by name %s error: %w", snapshotName, err)
+	}
+
+	if snapshot != nil {
+		log.AddContext(ctx).Infof("The snapshot %s is already exist.", snapshotName)
+		return p.getSnapshotReturnInfo(snapshot, fsName, vol.GetSize()), nil
+	}
+
+	if _, err = p.cli.CreateFSSnapshot(ctx, fsName, snapshotName); err != nil {
+		return nil, fmt.Errorf("create snapshot %s for filesystem %s error: %w", snapshotName, fsName, err)
+	}
+
+	snapshot, err = p.cli.GetFSSnapshotByName(ctx, fsName, snapshotName)
+	if err != nil {
+		return nil, fmt.Errorf("get filesystem snapshot by name %s error: %w", snapshotName, err)
+	}
+	if snapshot == nil {
+		return nil, fmt.Errorf("snapshot %s of filesystem %s does not exist after created", snapshotName, fsName)
+	}
+
+	return p.getSnapshotReturnInfo(snapshot, fsName, vol.GetSize()), nil
+}
+
+func (p *NAS) getSnapshotReturnInfo(snapshot *client.FSSnapshotResponse, fsName string,
+	size int64) map[string]interface{} {
+	return map[string]interface{}{
+		"CreationTime": snapshot.CreateTime,
+		"SizeBytes":    size,
+		"ParentID":     fsName,
+	}
+}
+
+// DeleteSnapshot deletes filesystem snapshot
+func (p *NAS) DeleteSnapshot(ctx context.Context, fsName, snapshotName string) error {
+	snapshot, err := p.cli.GetFSSnapshotByName(ctx, fsName, snapshotName)
+	if err != nil {
+		return fmt.Errorf("get filesystem snapshot by name %s error: %w", snapshotName, err)
+	}
+
+	if snapshot == nil {
+		log.AddContext(ctx).Infof("Filesystem snapshot %s to delete does not exist", snapshotName)
+		return nil
+	}
+
+	if err = p.cli.DeleteFSSnapshot(ctx, fsName, snapshotName); err != nil {
+		return fmt.Errorf("delete snapshot %s of filesystem %s error: %w", snapshotName, fsName, err)
+	}
+
+	return nil
+}
@@ -309,7 +309,7 @@ func (p *SAN) Query(ctx context.Context, name string) (utils.Volume, error) {
 	}
 
 	if vol == nil {
-		return nil, fmt.Errorf("lun %s to query does not exist", name)
+		return nil, fmt.Errorf("%w: lun %s to query does not exist", constants.ErrVolumeNotFound, name)
 	}
 
 	volObj := utils.NewVolume(name)
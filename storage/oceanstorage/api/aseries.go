@@ -46,4 +46,11 @@ const (
 	QueryKVCachePath = "/kv_cache_store/batch"
 	// ManageKVCachePath is the path for managing KVCaches.
 	ManageKVCachePath = "/kv_cache_store"
+
+	// ManageFSSnapshotPath is the path for creating and querying filesystem snapshots.
+	ManageFSSnapshotPath = "/FSSNAPSHOT"
+	// DeleteFSSnapshotPath is the path for deleting a filesystem snapshot by ID.
+	DeleteFSSnapshotPath = "/FSSNAPSHOT/%s"
+	// SplitCloneFileSystemPath is the path for splitting a clone filesystem from its parent.
+	SplitCloneFileSystemPath = "/clone_fs_split"
 )
@@ -23,6 +23,8 @@ const (
 	QueryAssociateNamespaceGroup = "/namespacegroup/associate?ASSOCIATEOBJTYPE=%d&ASSOCIATEOBJID=%s"
 	// GetNamespaceByName is the query path for getting a namespace by its name.
 	GetNamespaceByName = "/namespace?filter=NAME::%s&range=[0-100]"
+	// GetNamespacesByNamePrefix is the query path for getting namespaces by fuzzy matching their names.
+	GetNamespacesByNamePrefix = "/namespace?filter=NAME:%s"
 	// GetNamespaceByID is the query path for getting a namespace by its ID.
 	GetNamespaceByID = "/namespace/%s"
 	// AddNamespaceToGroup is the path for adding a namespace to a group.
@@ -49,4 +51,22 @@ const (
 	GetHostNamespaceId = "/namespace/associate?TYPE=11&ASSOCIATEOBJTYPE=21&ASSOCIATEOBJID=%s"
 	// UpdateNamespace is the path for updating a namespace.
 	UpdateNamespace = "/namespace/%s"
+	// CreateNamespaceSnapshot is the path for creating a namespace snapshot.
+	CreateNamespaceSnapshot = "/snapshot"
+	// GetNamespaceSnapshotByName is the query path for getting a namespace snapshot by its name.
+	GetNamespaceSnapshotByName = "/snapshot?filter=NAME::%s&range=[0-100]"
+	// DeleteNamespaceSnapshot is the path for deleting a namespace snapshot.
+	DeleteNamespaceSnapshot = "/snapshot/%s"
+	// ActivateNamespaceSnapshot is the path for activating namespace snapshots.
+	ActivateNamespaceSnapshot = "/snapshot/activate"
+	// DeactivateNamespaceSnapshot is the path for stopping a namespace snapshot.
+	DeactivateNamespaceSnapshot = "/snapshot/stop"
+	// CreateClonePair is the path for creating a clone pair.
+	CreateClonePair = "/clonepair/relation"
+	// GetClonePairByID is the query path for getting a clone pair by its ID.
+	GetClonePairByID = "/clonepair?filter=ID::%s"
+	// SyncClonePair is the path for synchronizing a clone pair.
+	SyncClonePair = "/clonepair/synchronize"
+	// DeleteClonePair is the path for deleting a clone pair.
+	DeleteClonePair = "/clonepair"
 )
@@ -36,6 +36,7 @@ type OceanASeriesClientInterface interface {
 	ASeriesVStore
 	ASeriesFilesystem
 	ASeriesDtree
+	ASeriesFSSnapshot
 
 	GetBackendID() string
 	GetDeviceSN() string
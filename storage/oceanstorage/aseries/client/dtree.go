@@ -24,6 +24,7 @@ import (
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/api"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/api/rest"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/base"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
 )
 
@@ -38,6 +39,8 @@ const (
 type ASeriesDtree interface {
 	// GetDTreeByName gets DTree by parent filesystem name and dtree name
 	GetDTreeByName(ctx context.Context, parentName, dtreeName, vstoreId string) (map[string]interface{}, error)
+	// GetDTreesByParentName gets all DTrees of the parent filesystem
+	GetDTreesByParentName(ctx context.Context, parentName, vstoreId string) ([]map[string]interface{}, error)
 	// CreateDTree creates a DTree with the specified data
 	CreateDTree(ctx context.Context, req *DTreeCreateRequest) (map[string]interface{}, error)
 	// DeleteDTreeByID deletes a DTree by ID
@@ -104,6 +107,18 @@ func (cli *OceanASeriesClient) GetDTreeByName(ctx context.Context,
 	return respData, nil
 }
 
+// GetDTreesByParentName gets all DTrees of the parent filesystem
+func (cli *OceanASeriesClient) GetDTreesByParentName(ctx context.Context,
+	parentName, vstoreId string) ([]map[string]interface{}, error) {
+	url := fmt.Sprintf("%s?PARENTNAME=%s&vstoreId=%s", api.ManageDTreePath, parentName, vstoreId)
+	dtrees, err := base.GetBatchObjs(ctx, cli, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dtrees of %q: %w", parentName, err)
+	}
+
+	return dtrees, nil
+}
+
 // DTreeCreateRequest defines the request structure for creating a DTree
 type DTreeCreateRequest struct {
 	Name            string `json:"name"`
@@ -20,6 +20,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	pkgutils "github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/utils"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage"
@@ -35,6 +36,8 @@ type ASeriesFilesystem interface {
 	base.Filesystem
 	// GetFileSystemByName used for get filesystem list by name
 	GetFileSystemByName(ctx context.Context, name, vstoreId string) (map[string]interface{}, error)
+	// GetFileSystemsByNamePrefix used for get all filesystems whose name starts with the prefix
+	GetFileSystemsByNamePrefix(ctx context.Context, prefix, vstoreId string) ([]map[string]interface{}, error)
 	// CreateFileSystem used for create file system
 	CreateFileSystem(ctx context.Context, params *CreateFilesystemParams,
 		advancedOptions map[string]interface{}) (map[string]interface{}, error)
@@ -102,6 +105,25 @@ func (cli *OceanASeriesClient) GetFileSystemByName(ctx context.Context,
 	return filesystem, nil
 }
 
+// GetFileSystemsByNamePrefix used for get all filesystems whose name starts with the prefix
+func (cli *OceanASeriesClient) GetFileSystemsByNamePrefix(ctx context.Context,
+	prefix, vstoreId string) ([]map[string]interface{}, error) {
+	url := fmt.Sprintf("%s?filter=NAME:%s&vstoreId=%s", api.ManageFileSystemPath, prefix, vstoreId)
+	fsList, err := base.GetBatchObjs(ctx, cli, url)
+	if err != nil {
+		return nil, fmt.Errorf("get filesystems by name prefix %s failed, error: %w", prefix, err)
+	}
+
+	// the filter of name is a fuzzy match, so the filesystems are filtered by the prefix again
+	var filtered []map[string]interface{}
+	for _, fs := range fsList {
+		if name, ok := fs["NAME"].(string); ok && strings.HasPrefix(name, prefix) {
+			filtered = append(filtered, fs)
+		}
+	}
+	return filtered, nil
+}
+
 // CreateFilesystemParams defines create filesystem params
 type CreateFilesystemParams struct {
 	Name            string
@@ -0,0 +1,246 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package client provides oceanstor A-series storage client
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/api"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/api/rest"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
+)
+
+const (
+	fsSnapshotNotExist     int64 = 1073754118
+	snapshotParentNotExist int64 = 1073754136
+	fsSnapshotParentType         = "40"
+	splitCloneAction             = 1
+)
+
+// ASeriesFSSnapshot defines interfaces for file system snapshot and clone operations
+type ASeriesFSSnapshot interface {
+	// GetFSSnapshotByName used for get file system snapshot by parent id and snapshot name
+	GetFSSnapshotByName(ctx context.Context, parentID, snapshotName, vstoreId string) (map[string]interface{}, error)
+	// CreateFSSnapshot used for create file system snapshot
+	CreateFSSnapshot(ctx context.Context, name, parentID, vstoreId string) (map[string]interface{}, error)
+	// DeleteFSSnapshot used for delete file system snapshot by id
+	DeleteFSSnapshot(ctx context.Context, snapshotID, vstoreId string) error
+	// CloneFileSystem used for clone file system from the parent file system or its snapshot
+	CloneFileSystem(ctx context.Context, params *CloneFilesystemParams) (map[string]interface{}, error)
+	// SplitCloneFileSystem used for split the clone file system from its parent
+	SplitCloneFileSystem(ctx context.Context, fsID, vstoreId string, splitSpeed int) error
+}
+
+// GetFSSnapshotByName used for get file system snapshot by parent id and snapshot name
+func (cli *OceanASeriesClient) GetFSSnapshotByName(ctx context.Context,
+	parentID, snapshotName, vstoreId string) (map[string]interface{}, error) {
+	path := rest.NewRequestPath(api.ManageFSSnapshotPath)
+	path.SetQuery("PARENTID", parentID)
+	path.SetQuery("vstoreId", vstoreId)
+	path.AddFilter("NAME", snapshotName)
+	encodePath, err := path.Encode()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode path and queries: %w", err)
+	}
+
+	resp, err := cli.Get(ctx, encodePath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	code, msg, err := utils.FormatRespErr(resp.Error)
+	if err != nil {
+		return nil, err
+	}
+
+	if code == snapshotParentNotExist {
+		log.AddContext(ctx).Infof("The parent filesystem %s of snapshot %s does not exist", parentID, snapshotName)
+		return map[string]interface{}{}, nil
+	}
+
+	if code != storage.SuccessCode {
+		return nil, fmt.Errorf("get filesystem snapshot %s failed, error code: %d, error msg: %s",
+			snapshotName, code, msg)
+	}
+
+	if resp.Data == nil {
+		return map[string]interface{}{}, nil
+	}
+
+	respData, ok := resp.Data.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("convert respData to array failed, data: %v", resp.Data)
+	}
+
+	if len(respData) == 0 {
+		return map[string]interface{}{}, nil
+	}
+
+	snapshot, ok := respData[0].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("convert filesystem snapshot to map failed, data: %v", respData[0])
+	}
+	return snapshot, nil
+}
+
+// CreateFSSnapshot used for create file system snapshot
+func (cli *OceanASeriesClient) CreateFSSnapshot(ctx context.Context,
+	name, parentID, vstoreId string) (map[string]interface{}, error) {
+	data := map[string]interface{}{
+		"NAME":       name,
+		"PARENTID":   parentID,
+		"PARENTTYPE": fsSnapshotParentType,
+	}
+
+	if vstoreId != "" {
+		data["vstoreId"] = vstoreId
+	}
+
+	resp, err := cli.Post(ctx, api.ManageFSSnapshotPath, data)
+	if err != nil {
+		return nil, err
+	}
+
+	code, msg, err := utils.FormatRespErr(resp.Error)
+	if err != nil {
+		return nil, err
+	}
+
+	if code != storage.SuccessCode {
+		return nil, fmt.Errorf("create snapshot %s for filesystem %s failed, error code: %d, error msg: %s",
+			name, parentID, code, msg)
+	}
+
+	respData, ok := resp.Data.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("convert filesystem snapshot to map failed, data: %v", resp.Data)
+	}
+
+	return respData, nil
+}
+
+// DeleteFSSnapshot used for delete file system snapshot by id
+func (cli *OceanASeriesClient) DeleteFSSnapshot(ctx context.Context, snapshotID, vstoreId string) error {
+	data := map[string]interface{}{}
+	if vstoreId != "" {
+		data["vstoreId"] = vstoreId
+	}
+
+	resp, err := cli.Delete(ctx, fmt.Sprintf(api.DeleteFSSnapshotPath, snapshotID), data)
+	if err != nil {
+		return err
+	}
+
+	code, msg, err := utils.FormatRespErr(resp.Error)
+	if err != nil {
+		return err
+	}
+
+	if code == fsSnapshotNotExist {
+		log.AddContext(ctx).Infof("Filesystem snapshot %s does not exist while deleting", snapshotID)
+		return nil
+	}
+
+	if code != storage.SuccessCode {
+		return fmt.Errorf("delete filesystem snapshot %s failed, error code: %d, error msg: %s",
+			snapshotID, code, msg)
+	}
+
+	return nil
+}
+
+// CloneFilesystemParams defines clone filesystem params
+type CloneFilesystemParams struct {
+	Name             string
+	ParentFsId       string
+	ParentSnapshotId string
+	Description      string
+	VstoreId         string
+}
+
+// CloneFileSystem used for clone file system from the parent file system or its snapshot
+func (cli *OceanASeriesClient) CloneFileSystem(ctx context.Context,
+	params *CloneFilesystemParams) (map[string]interface{}, error) {
+	data := map[string]interface{}{
+		"NAME":               params.Name,
+		"PARENTFILESYSTEMID": params.ParentFsId,
+		"DESCRIPTION":        params.Description,
+	}
+
+	if params.ParentSnapshotId != "" {
+		data["PARENTSNAPSHOTID"] = params.ParentSnapshotId
+	}
+
+	if params.VstoreId != "" {
+		data["vstoreId"] = params.VstoreId
+	}
+
+	resp, err := cli.Post(ctx, api.ManageFileSystemPath, data)
+	if err != nil {
+		return nil, err
+	}
+
+	code, msg, err := utils.FormatRespErr(resp.Error)
+	if err != nil {
+		return nil, err
+	}
+
+	if code != storage.SuccessCode {
+		return nil, fmt.Errorf("clone filesystem %v failed, error code: %d, error msg: %s", data, code, msg)
+	}
+
+	respData, ok := resp.Data.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("convert filesystem to map failed, data: %v", resp.Data)
+	}
+
+	return respData, nil
+}
+
+// SplitCloneFileSystem used for split the clone file system from its parent
+func (cli *OceanASeriesClient) SplitCloneFileSystem(ctx context.Context,
+	fsID, vstoreId string, splitSpeed int) error {
+	data := map[string]interface{}{
+		"ID":         fsID,
+		"SPLITSPEED": splitSpeed,
+		"action":     splitCloneAction,
+	}
+
+	if vstoreId != "" {
+		data["vstoreId"] = vstoreId
+	}
+
+	resp, err := cli.Put(ctx, api.SplitCloneFileSystemPath, data)
+	if err != nil {
+		return err
+	}
+
+	code, msg, err := utils.FormatRespErr(resp.Error)
+	if err != nil {
+		return err
+	}
+
+	if code != storage.SuccessCode {
+		return fmt.Errorf("split clone filesystem %s failed, error code: %d, error msg: %s", fsID, code, msg)
+	}
+
+	return nil
+}
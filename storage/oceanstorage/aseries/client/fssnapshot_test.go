@@ -0,0 +1,223 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package client provides oceanstor A-series storage client
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetFSSnapshotByName_Success(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	successBody := `{
+        "data": [
+            {"ID": "10", "NAME": "snapshot-name", "PARENTID": "1"}
+        ],
+        "error": {
+            "code": 0,
+            "description": "success"
+        }
+    }`
+
+	// mock
+	mockClient := getMockClientWithResponse(200, successBody)
+
+	// action
+	result, err := mockClient.GetFSSnapshotByName(ctx, "1", "snapshot-name", "0")
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, "10", result["ID"])
+}
+
+func TestGetFSSnapshotByName_NotExist(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	successBody := `{
+        "data": [],
+        "error": {
+            "code": 0,
+            "description": "success"
+        }
+    }`
+
+	// mock
+	mockClient := getMockClientWithResponse(200, successBody)
+
+	// action
+	result, err := mockClient.GetFSSnapshotByName(ctx, "1", "snapshot-name", "0")
+
+	// assert
+	require.NoError(t, err)
+	require.Empty(t, result)
+}
+
+func TestGetFSSnapshotByName_ParentNotExist(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	body := `{
+        "error": {
+            "code": 1073754136,
+            "description": "parent not exist"
+        }
+    }`
+
+	// mock
+	mockClient := getMockClientWithResponse(200, body)
+
+	// action
+	result, err := mockClient.GetFSSnapshotByName(ctx, "1", "snapshot-name", "0")
+
+	// assert
+	require.NoError(t, err)
+	require.Empty(t, result)
+}
+
+func TestCreateFSSnapshot_Success(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	successBody := `{
+        "data": {"ID": "10", "NAME": "snapshot-name", "TIMESTAMP": "1700000000"},
+        "error": {
+            "code": 0,
+            "description": "success"
+        }
+    }`
+
+	// mock
+	mockClient := getMockClientWithResponse(200, successBody)
+
+	// action
+	result, err := mockClient.CreateFSSnapshot(ctx, "snapshot-name", "1", "0")
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, "10", result["ID"])
+}
+
+func TestCreateFSSnapshot_Failed(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	body := `{
+        "error": {
+            "code": 123456,
+            "description": "failed"
+        }
+    }`
+
+	// mock
+	mockClient := getMockClientWithResponse(200, body)
+
+	// action
+	result, err := mockClient.CreateFSSnapshot(ctx, "snapshot-name", "1", "0")
+
+	// assert
+	require.ErrorContains(t, err, mockErrStr)
+	require.Nil(t, result)
+}
+
+func TestDeleteFSSnapshot_NotExist(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	body := `{
+        "error": {
+            "code": 1073754118,
+            "description": "snapshot not exist"
+        }
+    }`
+
+	// mock
+	mockClient := getMockClientWithResponse(200, body)
+
+	// action
+	err := mockClient.DeleteFSSnapshot(ctx, "10", "0")
+
+	// assert
+	require.NoError(t, err)
+}
+
+func TestDeleteFSSnapshot_Failed(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	body := `{
+        "error": {
+            "code": 123456,
+            "description": "failed"
+        }
+    }`
+
+	// mock
+	mockClient := getMockClientWithResponse(200, body)
+
+	// action
+	err := mockClient.DeleteFSSnapshot(ctx, "10", "0")
+
+	// assert
+	require.ErrorContains(t, err, mockErrStr)
+}
+
+func TestCloneFileSystem_Success(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	successBody := `{
+        "data": {"ID": "2", "NAME": "clone-name"},
+        "error": {
+            "code": 0,
+            "description": "success"
+        }
+    }`
+	params := &CloneFilesystemParams{
+		Name:             "clone-name",
+		ParentFsId:       "1",
+		ParentSnapshotId: "10",
+		VstoreId:         "0",
+	}
+
+	// mock
+	mockClient := getMockClientWithResponse(200, successBody)
+
+	// action
+	result, err := mockClient.CloneFileSystem(ctx, params)
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, "2", result["ID"])
+}
+
+func TestSplitCloneFileSystem_Failed(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	body := `{
+        "error": {
+            "code": 123456,
+            "description": "failed"
+        }
+    }`
+
+	// mock
+	mockClient := getMockClientWithResponse(200, body)
+
+	// action
+	err := mockClient.SplitCloneFileSystem(ctx, "2", "0", 2)
+
+	// assert
+	require.ErrorContains(t, err, mockErrStr)
+}
@@ -20,6 +20,7 @@ package volume
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/aseries/client"
@@ -54,12 +55,21 @@ type CreateFilesystemModel struct {
 	EnableKVCache     bool
 	EnableTimeAwareGC bool
 	GCTimeThreshold   int64
+
+	SourceFsName       string
+	SourceSnapshotName string
+	SnapshotParentId   string
+	CloneSpeed         int
 }
 
 func (model *CreateFilesystemModel) sharePath() string {
 	return "/" + model.Name + "/"
 }
 
+func (model *CreateFilesystemModel) isClone() bool {
+	return model.SourceFsName != "" || model.SourceSnapshotName != ""
+}
+
 // Creator is used to create a filesystem volume
 type Creator struct {
 	vstoreId         string
@@ -243,6 +253,10 @@ func (c *Creator) createFilesystem() error {
 		}
 	}
 
+	if c.params.isClone() {
+		return c.cloneFilesystem()
+	}
+
 	fs, err = c.cli.CreateFileSystem(c.ctx, &client.CreateFilesystemParams{
 		Name:            c.params.Name,
 		ParentId:        c.poolId,
@@ -269,6 +283,115 @@ func (c *Creator) createFilesystem() error {
 	return nil
 }
 
+func (c *Creator) cloneFilesystem() error {
+	parentFs, parentSnapshotId, err := c.getCloneSource()
+	if err != nil {
+		return err
+	}
+
+	parentFsId, ok := utils.GetValue[string](parentFs, "ID")
+	if !ok {
+		return fmt.Errorf("get clone source filesystem info %v with empty ID", parentFs)
+	}
+
+	parentCapacityStr, _ := utils.GetValue[string](parentFs, "CAPACITY")
+	parentCapacity, err := strconv.ParseInt(parentCapacityStr, constants.DefaultIntBase, constants.DefaultIntBitSize)
+	if err != nil {
+		return fmt.Errorf("failed to convert clone source filesystem %s capacity, err: %w", parentFsId, err)
+	}
+
+	if c.params.Capacity < parentCapacity {
+		return fmt.Errorf("clone filesystem capacity must be >= source filesystem %s capacity %d",
+			parentFsId, parentCapacity)
+	}
+
+	fs, err := c.cli.CloneFileSystem(c.ctx, &client.CloneFilesystemParams{
+		Name:             c.params.Name,
+		ParentFsId:       parentFsId,
+		ParentSnapshotId: parentSnapshotId,
+		Description:      c.params.Description,
+		VstoreId:         c.vstoreId,
+	})
+	if err != nil {
+		return err
+	}
+
+	fsId, ok := utils.GetValue[string](fs, "ID")
+	if !ok {
+		return fmt.Errorf("failed to clone filesystem %s, get filesystem info with empty ID", c.params.Name)
+	}
+	c.fsId = fsId
+
+	if err = c.extendAndSplitClone(parentCapacity); err != nil {
+		// The failed step is not rolled back by the transaction, and the clone would be taken as a created
+		// filesystem by the retry, so it is deleted here.
+		c.rollBackendFilesystem()
+		c.fsId = ""
+		return err
+	}
+
+	return nil
+}
+
+func (c *Creator) extendAndSplitClone(parentCapacity int64) error {
+	if c.params.Capacity > parentCapacity {
+		if err := c.cli.ExtendFileSystem(c.ctx, c.fsId, c.params.Capacity); err != nil {
+			return fmt.Errorf("failed to extend clone filesystem %s, err: %w", c.params.Name, err)
+		}
+	}
+
+	cloneSpeed := c.params.CloneSpeed
+	if cloneSpeed == 0 {
+		cloneSpeed = constants.CloneSpeedLevel3
+	}
+
+	// The clone filesystem can be used before the splitting finishes, so there is no need to wait here.
+	if err := c.cli.SplitCloneFileSystem(c.ctx, c.fsId, c.vstoreId, cloneSpeed); err != nil {
+		return fmt.Errorf("failed to split clone filesystem %s, err: %w", c.params.Name, err)
+	}
+
+	return nil
+}
+
+func (c *Creator) getCloneSource() (map[string]interface{}, string, error) {
+	if c.params.SourceSnapshotName == "" {
+		fs, err := c.cli.GetFileSystemByName(c.ctx, c.params.SourceFsName, c.vstoreId)
+		if err != nil {
+			return nil, "", err
+		}
+		if len(fs) == 0 {
+			return nil, "", fmt.Errorf("clone source filesystem %s does not exist", c.params.SourceFsName)
+		}
+
+		return fs, "", nil
+	}
+
+	fs, err := c.cli.GetFileSystemByID(c.ctx, c.params.SnapshotParentId)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(fs) == 0 {
+		return nil, "", fmt.Errorf("parent filesystem %s of snapshot %s does not exist",
+			c.params.SnapshotParentId, c.params.SourceSnapshotName)
+	}
+
+	snapshot, err := c.cli.GetFSSnapshotByName(c.ctx, c.params.SnapshotParentId,
+		c.params.SourceSnapshotName, c.vstoreId)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(snapshot) == 0 {
+		return nil, "", fmt.Errorf("source snapshot %s does not exist", c.params.SourceSnapshotName)
+	}
+
+	snapshotId, ok := utils.GetValue[string](snapshot, "ID")
+	if !ok {
+		return nil, "", fmt.Errorf("get snapshot info %v with empty ID", snapshot)
+	}
+
+	return fs, snapshotId, nil
+}
+
 func (c *Creator) rollBackendFilesystem() {
 	if c.fsId == "" {
 		return
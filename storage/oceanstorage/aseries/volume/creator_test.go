@@ -598,3 +598,159 @@ func setupCreateWithNfsAndKVCacheFailedMocksRollback(t *testing.T, ctx context.C
 		mock.Reset()
 	}
 }
+
+func TestCreator_CreateFilesystem_CloneFromSnapshotSuccess(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockOceanASeriesClientInterface(mockCtrl)
+	model := &CreateFilesystemModel{
+		Name:               fakeFsName,
+		Capacity:           2048,
+		SourceSnapshotName: "test-snapshot",
+		SnapshotParentId:   "parent-fs-id",
+	}
+	creator := NewCreator(ctx, cli, model)
+	creator.vstoreId = fakeVstoreID
+
+	// mock
+	cli.EXPECT().GetFileSystemByName(ctx, fakeFsName, fakeVstoreID).Return(map[string]interface{}{}, nil)
+	cli.EXPECT().GetFileSystemByID(ctx, "parent-fs-id").
+		Return(map[string]interface{}{"ID": "parent-fs-id", "CAPACITY": "1024"}, nil)
+	cli.EXPECT().GetFSSnapshotByName(ctx, "parent-fs-id", "test-snapshot", fakeVstoreID).
+		Return(map[string]interface{}{"ID": "snapshot-id"}, nil)
+	cli.EXPECT().CloneFileSystem(ctx, &client.CloneFilesystemParams{
+		Name:             fakeFsName,
+		ParentFsId:       "parent-fs-id",
+		ParentSnapshotId: "snapshot-id",
+		VstoreId:         fakeVstoreID,
+	}).Return(map[string]interface{}{"ID": fakeFsID}, nil)
+	cli.EXPECT().ExtendFileSystem(ctx, fakeFsID, int64(2048)).Return(nil)
+	cli.EXPECT().SplitCloneFileSystem(ctx, fakeFsID, fakeVstoreID, constants.CloneSpeedLevel3).Return(nil)
+
+	// action
+	err := creator.createFilesystem()
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, fakeFsID, creator.fsId)
+}
+
+func TestCreator_CreateFilesystem_CloneDeletedWhenSplitFailed(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockOceanASeriesClientInterface(mockCtrl)
+	model := &CreateFilesystemModel{
+		Name:         fakeFsName,
+		Capacity:     2048,
+		SourceFsName: "source-fs",
+	}
+	creator := NewCreator(ctx, cli, model)
+	creator.vstoreId = fakeVstoreID
+
+	// mock
+	cli.EXPECT().GetFileSystemByName(ctx, fakeFsName, fakeVstoreID).Return(map[string]interface{}{}, nil)
+	cli.EXPECT().GetFileSystemByName(ctx, "source-fs", fakeVstoreID).
+		Return(map[string]interface{}{"ID": "source-fs-id", "CAPACITY": "1024"}, nil)
+	cli.EXPECT().CloneFileSystem(ctx, gomock.Any()).Return(map[string]interface{}{"ID": fakeFsID}, nil)
+	cli.EXPECT().ExtendFileSystem(ctx, fakeFsID, int64(2048)).Return(nil)
+	cli.EXPECT().SplitCloneFileSystem(ctx, fakeFsID, fakeVstoreID, constants.CloneSpeedLevel3).
+		Return(errors.New("split error"))
+	cli.EXPECT().DeleteFileSystem(ctx, map[string]interface{}{"ID": fakeFsID}).Return(nil)
+
+	// action
+	err := creator.createFilesystem()
+
+	// assert
+	assert.ErrorContains(t, err, "split error")
+	assert.Empty(t, creator.fsId)
+}
+
+func TestCreator_CreateFilesystem_CloneDeletedWhenExtendFailed(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockOceanASeriesClientInterface(mockCtrl)
+	model := &CreateFilesystemModel{
+		Name:         fakeFsName,
+		Capacity:     2048,
+		SourceFsName: "source-fs",
+	}
+	creator := NewCreator(ctx, cli, model)
+	creator.vstoreId = fakeVstoreID
+
+	// mock
+	cli.EXPECT().GetFileSystemByName(ctx, fakeFsName, fakeVstoreID).Return(map[string]interface{}{}, nil)
+	cli.EXPECT().GetFileSystemByName(ctx, "source-fs", fakeVstoreID).
+		Return(map[string]interface{}{"ID": "source-fs-id", "CAPACITY": "1024"}, nil)
+	cli.EXPECT().CloneFileSystem(ctx, gomock.Any()).Return(map[string]interface{}{"ID": fakeFsID}, nil)
+	cli.EXPECT().ExtendFileSystem(ctx, fakeFsID, int64(2048)).Return(errors.New("extend error"))
+	cli.EXPECT().DeleteFileSystem(ctx, map[string]interface{}{"ID": fakeFsID}).Return(nil)
+
+	// action
+	err := creator.createFilesystem()
+
+	// assert
+	assert.ErrorContains(t, err, "extend error")
+	assert.Empty(t, creator.fsId)
+}
+
+func TestCreator_CreateFilesystem_CloneWithSmallerCapacity(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockOceanASeriesClientInterface(mockCtrl)
+	model := &CreateFilesystemModel{
+		Name:         fakeFsName,
+		Capacity:     512,
+		SourceFsName: "source-fs",
+	}
+	creator := NewCreator(ctx, cli, model)
+	creator.vstoreId = fakeVstoreID
+
+	// mock
+	cli.EXPECT().GetFileSystemByName(ctx, fakeFsName, fakeVstoreID).Return(map[string]interface{}{}, nil)
+	cli.EXPECT().GetFileSystemByName(ctx, "source-fs", fakeVstoreID).
+		Return(map[string]interface{}{"ID": "source-fs-id", "CAPACITY": "1024"}, nil)
+
+	// action
+	err := creator.createFilesystem()
+
+	// assert
+	assert.ErrorContains(t, err, "capacity")
+	assert.Empty(t, creator.fsId)
+}
+
+func TestCreator_CreateFilesystem_CloneSourceSnapshotNotExist(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockOceanASeriesClientInterface(mockCtrl)
+	model := &CreateFilesystemModel{
+		Name:               fakeFsName,
+		Capacity:           1024,
+		SourceSnapshotName: "test-snapshot",
+		SnapshotParentId:   "parent-fs-id",
+	}
+	creator := NewCreator(ctx, cli, model)
+	creator.vstoreId = fakeVstoreID
+
+	// mock
+	cli.EXPECT().GetFileSystemByName(ctx, fakeFsName, fakeVstoreID).Return(map[string]interface{}{}, nil)
+	cli.EXPECT().GetFileSystemByID(ctx, "parent-fs-id").
+		Return(map[string]interface{}{"ID": "parent-fs-id", "CAPACITY": "1024"}, nil)
+	cli.EXPECT().GetFSSnapshotByName(ctx, "parent-fs-id", "test-snapshot", fakeVstoreID).
+		Return(map[string]interface{}{}, nil)
+
+	// action
+	err := creator.createFilesystem()
+
+	// assert
+	assert.ErrorContains(t, err, "does not exist")
+}
@@ -21,6 +21,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/aseries/client"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
 )
@@ -53,7 +54,8 @@ func (q *Querier) Query() (utils.Volume, error) {
 		return nil, err
 	}
 	if dtree == nil {
-		return nil, fmt.Errorf("the dtree %s of parent %s is not exist", q.dtreeName, q.parentName)
+		return nil, fmt.Errorf("%w: the dtree %s of parent %s is not exist", constants.ErrVolumeNotFound,
+			q.dtreeName, q.parentName)
 	}
 
 	dtreeID, ok := utils.GetValue[string](dtree, "ID")
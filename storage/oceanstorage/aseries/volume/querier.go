@@ -81,7 +81,7 @@ func (c *Querier) queryAndInjectFilesystemInfo() error {
 		return err
 	}
 	if len(fs) == 0 {
-		return fmt.Errorf("filesystem %s does not exist", c.params.Name)
+		return fmt.Errorf("%w: filesystem %s does not exist", constants.ErrVolumeNotFound, c.params.Name)
 	}
 
 	err = c.validateWorkloadType(fs)
@@ -0,0 +1,96 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package volume
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/aseries/client"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
+)
+
+// NewSnapshotter inits a new filesystem snapshotter of the OceanStor A-series storage
+func NewSnapshotter(ctx context.Context, cli client.OceanASeriesClientInterface,
+	params *storage.SnapshotModel) *storage.FSSnapshotter {
+	return storage.NewFSSnapshotter(ctx, &snapshotOperator{cli: cli, vStoreID: cli.GetvStoreID()}, params)
+}
+
+type snapshotOperator struct {
+	cli      client.OceanASeriesClientInterface
+	vStoreID string
+}
+
+func (o *snapshotOperator) GetFileSystem(ctx context.Context, fsName string) (*storage.FSInfo, error) {
+	fs, err := o.cli.GetFileSystemByName(ctx, fsName, o.vStoreID)
+	if err != nil || len(fs) == 0 {
+		return nil, err
+	}
+
+	fsId, ok := utils.GetValue[string](fs, "ID")
+	if !ok {
+		return nil, fmt.Errorf("get filesystem %s info with empty ID", fsName)
+	}
+
+	capacityStr, _ := utils.GetValue[string](fs, "CAPACITY")
+	capacity, err := strconv.ParseInt(capacityStr, constants.DefaultIntBase, constants.DefaultIntBitSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert filesystem %s capacity, err: %w", fsName, err)
+	}
+
+	return &storage.FSInfo{ID: fsId, SizeBytes: capacity * constants.AllocationUnitBytes}, nil
+}
+
+func (o *snapshotOperator) GetSnapshot(ctx context.Context,
+	fsID, snapshotName string) (*storage.FSSnapshotInfo, error) {
+	snapshot, err := o.cli.GetFSSnapshotByName(ctx, fsID, snapshotName, o.vStoreID)
+	if err != nil || len(snapshot) == 0 {
+		return nil, err
+	}
+
+	return convertSnapshotInfo(snapshot)
+}
+
+func (o *snapshotOperator) CreateSnapshot(ctx context.Context,
+	fsID, snapshotName string) (*storage.FSSnapshotInfo, error) {
+	snapshot, err := o.cli.CreateFSSnapshot(ctx, snapshotName, fsID, o.vStoreID)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertSnapshotInfo(snapshot)
+}
+
+func (o *snapshotOperator) DeleteSnapshot(ctx context.Context, snapshotID string) error {
+	return o.cli.DeleteFSSnapshot(ctx, snapshotID, o.vStoreID)
+}
+
+func convertSnapshotInfo(snapshot map[string]interface{}) (*storage.FSSnapshotInfo, error) {
+	snapshotId, ok := utils.GetValue[string](snapshot, "ID")
+	if !ok {
+		return nil, fmt.Errorf("get snapshot info %v with empty ID", snapshot)
+	}
+
+	timestamp, _ := utils.GetValue[string](snapshot, "TIMESTAMP")
+	return &storage.FSSnapshotInfo{
+		ID:           snapshotId,
+		CreationTime: utils.ParseIntWithDefault(timestamp, constants.DefaultIntBase, constants.DefaultIntBitSize, 0),
+	}, nil
+}
@@ -24,10 +24,13 @@ import (
 	"fmt"
 	"math/big"
 	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/metrics"
 )
 
 var (
@@ -119,6 +122,16 @@ func (resp *Response) getInt64Code() (int64, error) {
 	return code, nil
 }
 
+// MetricsCode returns the error code of response which is recorded in metrics
+func (resp *Response) MetricsCode() string {
+	code, err := resp.getInt64Code()
+	if err != nil {
+		return metrics.CodeInvalidResponse
+	}
+
+	return strconv.FormatInt(code, 10)
+}
+
 // MaskRequestData masks the sensitive data
 func MaskRequestData(data map[string]any) map[string]any {
 	sensitiveKey := []string{"user", "password", "iqn", "tgt", "tgtname", "initiatorname"}
@@ -177,7 +190,11 @@ func GetBatchObjs(ctx context.Context, cli RestClientInterface, url string) ([]m
 
 func getObj(ctx context.Context, cli RestClientInterface,
 	url string, start, end int) ([]map[string]interface{}, error) {
-	objUrl := fmt.Sprintf("%s?range=[%d-%d]", url, start, end)
+	separator := "?"
+	if strings.Contains(url, "?") {
+		separator = "&"
+	}
+	objUrl := fmt.Sprintf("%s%srange=[%d-%d]", url, separator, start, end)
 	resp, err := cli.Get(ctx, objUrl, nil)
 	if err != nil {
 		return nil, err
@@ -28,11 +28,13 @@ import (
 	"net/http"
 	"strconv"
 	"sync"
+	"time"
 
 	pkgUtils "github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/utils"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/metrics"
 )
 
 const (
@@ -44,6 +46,9 @@ const (
 
 	// MinParallelCount defines min parallel count
 	MinParallelCount int = 1
+
+	// RestClientSemaphoreName defines the metrics name of the rest client request semaphore
+	RestClientSemaphoreName = "oceanstor-client"
 )
 
 var (
@@ -143,7 +148,7 @@ func NewRestClient(ctx context.Context, param *storage.NewClientConfig) (*RestCl
 		SecretNamespace:  param.SecretNamespace,
 		Client:           httpClient,
 		BackendID:        param.BackendID,
-		RequestSemaphore: utils.NewSemaphore(parallelCount),
+		RequestSemaphore: utils.NewBackendSemaphore(RestClientSemaphoreName, param.Name, parallelCount),
 	}, nil
 }
 
@@ -212,6 +217,10 @@ func (cli *RestClient) BaseCall(ctx context.Context,
 		defer storage.RequestSemaphoreMap[storage.UninitializedStorage].Release()
 	}
 
+	start := time.Now()
+	metricsCode := metrics.CodeUnconnected
+	defer func() { metrics.ObserveRestCall(metrics.StorageOceanStor, method, url, metricsCode, start) }()
+
 	resp, err := cli.Client.Do(req)
 	if err != nil {
 		log.AddContext(ctx).Errorf("Send request method: %s, Url: %s, error: %v", method, req.URL, err)
@@ -219,6 +228,7 @@ func (cli *RestClient) BaseCall(ctx context.Context,
 	}
 	defer resp.Body.Close()
 
+	metricsCode = metrics.CodeInvalidResponse
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
 		log.AddContext(ctx).Errorf("Read response data error: %v", err)
@@ -234,6 +244,7 @@ func (cli *RestClient) BaseCall(ctx context.Context,
 		return Response{}, err
 	}
 
+	metricsCode = r.MetricsCode()
 	return r, nil
 }
 
@@ -407,7 +418,7 @@ func (cli *RestClient) setDataFromRespData(ctx context.Context, resp Response) e
 	}
 
 	if storage.RequestSemaphoreMap[cli.DeviceId] == nil {
-		storage.RequestSemaphoreMap[cli.DeviceId] = utils.NewSemaphore(storage.MaxStorageThreads)
+		storage.RequestSemaphoreMap[cli.DeviceId] = storage.NewRequestSemaphore(cli.DeviceId)
 	}
 
 	cli.Token, ok = utils.GetValue[string](respData, "iBaseToken")
@@ -463,6 +474,7 @@ func (cli *RestClient) ReLogin(ctx context.Context) error {
 	}
 
 	err := cli.Login(ctx)
+	metrics.IncReLogin(metrics.StorageOceanStor, err)
 	if err != nil {
 		log.AddContext(ctx).Errorf("try to relogin error: %v", err)
 		return err
@@ -40,6 +40,8 @@ type OceandiskClientInterface interface {
 
 	Namespace
 	NamespaceGroup
+	NamespaceSnapshot
+	NamespaceClone
 
 	GetBackendID() string
 	GetDeviceSN() string
@@ -22,9 +22,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/api"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/base"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
 )
@@ -36,6 +38,9 @@ const (
 	namespaceNotExist       int64 = 1077936859
 	parameterIncorrect      int64 = 50331651
 	objectNameAlreadyExist  int64 = 1077948993
+	snapshotNotExist        int64 = 1077937880
+	snapshotNotActivated    int64 = 1077937891
+	clonePairNotExist       int64 = 1073798147
 
 	// NamespaceType is Associated object type of Namespace
 	NamespaceType = "11"
@@ -49,6 +54,8 @@ const (
 type Namespace interface {
 	// GetNamespaceByName used for get namespace by name
 	GetNamespaceByName(ctx context.Context, name string) (map[string]interface{}, error)
+	// GetNamespacesByNamePrefix used for get all namespaces whose name starts with the prefix
+	GetNamespacesByNamePrefix(ctx context.Context, prefix string) ([]map[string]interface{}, error)
 	// GetNamespaceByID used for get namespace by id
 	GetNamespaceByID(ctx context.Context, id string) (map[string]interface{}, error)
 	// GetNamespaceCountOfHost used for get namespace count of host
@@ -67,6 +74,32 @@ type Namespace interface {
 	UpdateNamespace(ctx context.Context, namespaceID string, params map[string]interface{}) error
 }
 
+// NamespaceSnapshot defines interfaces for namespace snapshot operations
+type NamespaceSnapshot interface {
+	// GetNamespaceSnapshotByName used for get namespace snapshot by name
+	GetNamespaceSnapshotByName(ctx context.Context, name string) (map[string]interface{}, error)
+	// CreateNamespaceSnapshot used for create namespace snapshot
+	CreateNamespaceSnapshot(ctx context.Context, name, namespaceID string) (map[string]interface{}, error)
+	// ActivateNamespaceSnapshot used for activate namespace snapshot
+	ActivateNamespaceSnapshot(ctx context.Context, snapshotID string) error
+	// DeactivateNamespaceSnapshot used for stop namespace snapshot
+	DeactivateNamespaceSnapshot(ctx context.Context, snapshotID string) error
+	// DeleteNamespaceSnapshot used for delete namespace snapshot
+	DeleteNamespaceSnapshot(ctx context.Context, snapshotID string) error
+}
+
+// NamespaceClone defines interfaces for namespace clone pair operations
+type NamespaceClone interface {
+	// CreateClonePair used for create clone pair from the source namespace or snapshot to the target namespace
+	CreateClonePair(ctx context.Context, srcID, dstNamespaceID string, cloneSpeed int) (map[string]interface{}, error)
+	// GetClonePairInfo used for get clone pair info
+	GetClonePairInfo(ctx context.Context, clonePairID string) (map[string]interface{}, error)
+	// SyncClonePair used for synchronize clone pair
+	SyncClonePair(ctx context.Context, clonePairID string) error
+	// DeleteClonePair used for delete clone pair
+	DeleteClonePair(ctx context.Context, clonePairID string) error
+}
+
 // NamespaceGroup defines interfaces for namespacegroup operations
 type NamespaceGroup interface {
 	// QueryAssociateNamespaceGroup used for query associate namespace group by object type and object id
@@ -152,6 +185,23 @@ func (cli *OceandiskClient) GetNamespaceByName(ctx context.Context, name string)
 	return namespace, nil
 }
 
+// GetNamespacesByNamePrefix used for get all namespaces whose name starts with the prefix
+func (cli *OceandiskClient) GetNamespacesByNamePrefix(ctx context.Context,
+	prefix string) ([]map[string]interface{}, error) {
+	namespaces, err := base.GetBatchObjs(ctx, cli, fmt.Sprintf(api.GetNamespacesByNamePrefix, prefix))
+	if err != nil {
+		return nil, fmt.Errorf("get namespaces by name prefix %s failed, error: %w", prefix, err)
+	}
+
+	var filtered []map[string]interface{}
+	for _, namespace := range namespaces {
+		if name, ok := namespace["NAME"].(string); ok && strings.HasPrefix(name, prefix) {
+			filtered = append(filtered, namespace)
+		}
+	}
+	return filtered, nil
+}
+
 // GetNamespaceByID used for get namespace by id
 func (cli *OceandiskClient) GetNamespaceByID(ctx context.Context, id string) (map[string]interface{}, error) {
 	url := fmt.Sprintf(api.GetNamespaceByID, id)
@@ -608,3 +658,278 @@ func (cli *OceandiskClient) UpdateNamespace(ctx context.Context,
 
 	return nil
 }
+
+// GetNamespaceSnapshotByName used for get namespace snapshot by name
+func (cli *OceandiskClient) GetNamespaceSnapshotByName(ctx context.Context,
+	name string) (map[string]interface{}, error) {
+	url := fmt.Sprintf(api.GetNamespaceSnapshotByName, name)
+	resp, err := cli.Get(ctx, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	code, msg, err := utils.FormatRespErr(resp.Error)
+	if err != nil {
+		return nil, err
+	}
+
+	if code != 0 {
+		return nil, fmt.Errorf("get namespace snapshot %s info failed, error code: %d, error msg: %s",
+			name, code, msg)
+	}
+
+	if resp.Data == nil {
+		log.AddContext(ctx).Infof("namespace snapshot %s does not exist, a nil list is got", name)
+		return map[string]interface{}{}, nil
+	}
+
+	respData, ok := resp.Data.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("convert respData to arr failed, data: %v", resp.Data)
+	}
+	if len(respData) <= 0 {
+		log.AddContext(ctx).Infof("namespace snapshot %s does not exist", name)
+		return map[string]interface{}{}, nil
+	}
+
+	snapshot, ok := respData[0].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("convert namespace snapshot to map failed, data: %v", respData[0])
+	}
+	return snapshot, nil
+}
+
+// CreateNamespaceSnapshot used for create namespace snapshot
+func (cli *OceandiskClient) CreateNamespaceSnapshot(ctx context.Context,
+	name, namespaceID string) (map[string]interface{}, error) {
+	data := map[string]interface{}{
+		"NAME":       name,
+		"PARENTID":   namespaceID,
+		"PARENTTYPE": AssociateObjTypeNamespace,
+	}
+
+	resp, err := cli.Post(ctx, api.CreateNamespaceSnapshot, data)
+	if err != nil {
+		return nil, err
+	}
+
+	code, msg, err := utils.FormatRespErr(resp.Error)
+	if err != nil {
+		return nil, err
+	}
+
+	if code != 0 {
+		return nil, fmt.Errorf("create snapshot %s for namespace %s failed, error code: %d, error msg: %s",
+			name, namespaceID, code, msg)
+	}
+
+	respData, ok := resp.Data.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("convert respData to map failed, data: %v", resp.Data)
+	}
+	return respData, nil
+}
+
+// ActivateNamespaceSnapshot used for activate namespace snapshot
+func (cli *OceandiskClient) ActivateNamespaceSnapshot(ctx context.Context, snapshotID string) error {
+	data := map[string]interface{}{
+		"SNAPSHOTLIST": []string{snapshotID},
+	}
+
+	resp, err := cli.Post(ctx, api.ActivateNamespaceSnapshot, data)
+	if err != nil {
+		return err
+	}
+
+	code, msg, err := utils.FormatRespErr(resp.Error)
+	if err != nil {
+		return err
+	}
+
+	if code != 0 {
+		return fmt.Errorf("activate namespace snapshot %s failed, error code: %d, error msg: %s",
+			snapshotID, code, msg)
+	}
+
+	return nil
+}
+
+// DeactivateNamespaceSnapshot used for stop namespace snapshot
+func (cli *OceandiskClient) DeactivateNamespaceSnapshot(ctx context.Context, snapshotID string) error {
+	data := map[string]interface{}{
+		"ID": snapshotID,
+	}
+
+	resp, err := cli.Put(ctx, api.DeactivateNamespaceSnapshot, data)
+	if err != nil {
+		return err
+	}
+
+	code, msg, err := utils.FormatRespErr(resp.Error)
+	if err != nil {
+		return err
+	}
+
+	if code == snapshotNotActivated {
+		log.AddContext(ctx).Infof("namespace snapshot %s is not activated", snapshotID)
+		return nil
+	}
+
+	if code != 0 {
+		return fmt.Errorf("deactivate namespace snapshot %s failed, error code: %d, error msg: %s",
+			snapshotID, code, msg)
+	}
+
+	return nil
+}
+
+// DeleteNamespaceSnapshot used for delete namespace snapshot
+func (cli *OceandiskClient) DeleteNamespaceSnapshot(ctx context.Context, snapshotID string) error {
+	url := fmt.Sprintf(api.DeleteNamespaceSnapshot, snapshotID)
+	resp, err := cli.Delete(ctx, url, nil)
+	if err != nil {
+		return err
+	}
+
+	code, msg, err := utils.FormatRespErr(resp.Error)
+	if err != nil {
+		return err
+	}
+
+	if code == snapshotNotExist {
+		log.AddContext(ctx).Infof("namespace snapshot %s does not exist while deleting", snapshotID)
+		return nil
+	}
+
+	if code != 0 {
+		return fmt.Errorf("delete namespace snapshot %s failed, error code: %d, error msg: %s",
+			snapshotID, code, msg)
+	}
+
+	return nil
+}
+
+// CreateClonePair used for create clone pair from the source namespace or snapshot to the target namespace
+func (cli *OceandiskClient) CreateClonePair(ctx context.Context,
+	srcID, dstNamespaceID string, cloneSpeed int) (map[string]interface{}, error) {
+	data := map[string]interface{}{
+		"copyRate":          cloneSpeed,
+		"sourceID":          srcID,
+		"targetID":          dstNamespaceID,
+		"isNeedSynchronize": "0",
+	}
+
+	resp, err := cli.Post(ctx, api.CreateClonePair, data)
+	if err != nil {
+		return nil, err
+	}
+
+	code, msg, err := utils.FormatRespErr(resp.Error)
+	if err != nil {
+		return nil, err
+	}
+
+	if code != 0 {
+		return nil, fmt.Errorf("create clone pair from %s to %s failed, error code: %d, error msg: %s",
+			srcID, dstNamespaceID, code, msg)
+	}
+
+	respData, ok := resp.Data.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("convert respData to map failed, data: %v", resp.Data)
+	}
+	return respData, nil
+}
+
+// GetClonePairInfo used for get clone pair info
+func (cli *OceandiskClient) GetClonePairInfo(ctx context.Context,
+	clonePairID string) (map[string]interface{}, error) {
+	url := fmt.Sprintf(api.GetClonePairByID, clonePairID)
+	resp, err := cli.Get(ctx, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	code, msg, err := utils.FormatRespErr(resp.Error)
+	if err != nil {
+		return nil, err
+	}
+
+	if code != 0 {
+		return nil, fmt.Errorf("get clone pair %s info failed, error code: %d, error msg: %s",
+			clonePairID, code, msg)
+	}
+
+	if resp.Data == nil {
+		log.AddContext(ctx).Infof("clone pair %s does not exist, a nil list is got", clonePairID)
+		return map[string]interface{}{}, nil
+	}
+
+	respData, ok := resp.Data.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("convert respData to arr failed, data: %v", resp.Data)
+	}
+	if len(respData) <= 0 {
+		log.AddContext(ctx).Infof("clone pair %s does not exist", clonePairID)
+		return map[string]interface{}{}, nil
+	}
+
+	clonePair, ok := respData[0].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("convert clone pair to map failed, data: %v", respData[0])
+	}
+	return clonePair, nil
+}
+
+// SyncClonePair used for synchronize clone pair
+func (cli *OceandiskClient) SyncClonePair(ctx context.Context, clonePairID string) error {
+	data := map[string]interface{}{
+		"ID":         clonePairID,
+		"copyAction": 0,
+	}
+
+	resp, err := cli.Put(ctx, api.SyncClonePair, data)
+	if err != nil {
+		return err
+	}
+
+	code, msg, err := utils.FormatRespErr(resp.Error)
+	if err != nil {
+		return err
+	}
+
+	if code != 0 {
+		return fmt.Errorf("sync clone pair %s failed, error code: %d, error msg: %s", clonePairID, code, msg)
+	}
+
+	return nil
+}
+
+// DeleteClonePair used for delete clone pair, the target namespace is kept
+func (cli *OceandiskClient) DeleteClonePair(ctx context.Context, clonePairID string) error {
+	data := map[string]interface{}{
+		"ID":             clonePairID,
+		"isDeleteDstLun": false,
+	}
+
+	resp, err := cli.Delete(ctx, api.DeleteClonePair, data)
+	if err != nil {
+		return err
+	}
+
+	code, msg, err := utils.FormatRespErr(resp.Error)
+	if err != nil {
+		return err
+	}
+
+	if code == clonePairNotExist {
+		log.AddContext(ctx).Infof("clone pair %s does not exist while deleting", clonePairID)
+		return nil
+	}
+
+	if code != 0 {
+		return fmt.Errorf("delete clone pair %s failed, error code: %d, error msg: %s", clonePairID, code, msg)
+	}
+
+	return nil
+}
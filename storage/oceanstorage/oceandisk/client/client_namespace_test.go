@@ -1512,3 +1512,176 @@ func TestBaseClient_UpdateNamespace_Success(t *testing.T) {
 		mock.Reset()
 	})
 }
+
+func TestBaseClient_GetNamespaceSnapshotByName_Success(t *testing.T) {
+	// arrange
+	name := "snapshot"
+	client, err := NewClient(context.Background(), &storage.NewClientConfig{})
+	if err != nil {
+		return
+	}
+
+	snapshot := map[string]interface{}{"ID": "1", "NAME": name, "PARENTID": "2"}
+	mockResponse := base.Response{
+		Error: map[string]interface{}{
+			"code":        float64(0),
+			"description": "0",
+		},
+		Data: []interface{}{snapshot},
+	}
+
+	// mock
+	mock := gomonkey.NewPatches()
+	mock.ApplyMethodReturn(&base.RestClient{}, "Get", mockResponse, nil)
+
+	// action
+	getRes, getErr := client.GetNamespaceSnapshotByName(context.Background(), name)
+
+	// assert
+	if !reflect.DeepEqual(snapshot, getRes) || getErr != nil {
+		t.Errorf("TestBaseClient_GetNamespaceSnapshotByName_Success failed, "+
+			"wantRes = %v, gotRes = %v, wantErr = nil, gotErr = %v", snapshot, getRes, getErr)
+	}
+
+	// cleanup
+	t.Cleanup(func() {
+		mock.Reset()
+	})
+}
+
+func TestBaseClient_GetNamespaceSnapshotByName_NotExist(t *testing.T) {
+	// arrange
+	client, err := NewClient(context.Background(), &storage.NewClientConfig{})
+	if err != nil {
+		return
+	}
+
+	mockResponse := base.Response{
+		Error: map[string]interface{}{
+			"code":        float64(0),
+			"description": "0",
+		},
+	}
+
+	// mock
+	mock := gomonkey.NewPatches()
+	mock.ApplyMethodReturn(&base.RestClient{}, "Get", mockResponse, nil)
+
+	// action
+	getRes, getErr := client.GetNamespaceSnapshotByName(context.Background(), "snapshot")
+
+	// assert
+	if len(getRes) != 0 || getErr != nil {
+		t.Errorf("TestBaseClient_GetNamespaceSnapshotByName_NotExist failed, "+
+			"wantRes = empty, gotRes = %v, wantErr = nil, gotErr = %v", getRes, getErr)
+	}
+
+	// cleanup
+	t.Cleanup(func() {
+		mock.Reset()
+	})
+}
+
+func TestBaseClient_DeleteNamespaceSnapshot_NotExistSuccess(t *testing.T) {
+	// arrange
+	client, err := NewClient(context.Background(), &storage.NewClientConfig{})
+	if err != nil {
+		return
+	}
+
+	mockResponse := base.Response{
+		Error: map[string]interface{}{
+			"code":        float64(snapshotNotExist),
+			"description": "snapshot does not exist",
+		},
+	}
+
+	// mock
+	mock := gomonkey.NewPatches()
+	mock.ApplyMethodReturn(&base.RestClient{}, "Delete", mockResponse, nil)
+
+	// action
+	getErr := client.DeleteNamespaceSnapshot(context.Background(), "1")
+
+	// assert
+	if getErr != nil {
+		t.Errorf("TestBaseClient_DeleteNamespaceSnapshot_NotExistSuccess failed, "+
+			"wantErr = nil, gotErr = %v", getErr)
+	}
+
+	// cleanup
+	t.Cleanup(func() {
+		mock.Reset()
+	})
+}
+
+func TestBaseClient_CreateClonePair_CodeError(t *testing.T) {
+	// arrange
+	srcID, dstID := "1", "2"
+	client, err := NewClient(context.Background(), &storage.NewClientConfig{})
+	if err != nil {
+		return
+	}
+
+	errCode := 1
+	errMsg := "unknown error"
+	mockResponse := base.Response{
+		Error: map[string]interface{}{
+			"code":        float64(errCode),
+			"description": errMsg,
+		},
+	}
+	wantErr := fmt.Errorf("create clone pair from %s to %s failed, error code: %d, error msg: %s",
+		srcID, dstID, errCode, errMsg)
+
+	// mock
+	mock := gomonkey.NewPatches()
+	mock.ApplyMethodReturn(&base.RestClient{}, "Post", mockResponse, nil)
+
+	// action
+	_, getErr := client.CreateClonePair(context.Background(), srcID, dstID, constants.CloneSpeedLevel3)
+
+	// assert
+	if !reflect.DeepEqual(wantErr, getErr) {
+		t.Errorf("TestBaseClient_CreateClonePair_CodeError failed, "+
+			"wantErr = %v, gotErr = %v", wantErr, getErr)
+	}
+
+	// cleanup
+	t.Cleanup(func() {
+		mock.Reset()
+	})
+}
+
+func TestBaseClient_DeleteClonePair_NotExistSuccess(t *testing.T) {
+	// arrange
+	client, err := NewClient(context.Background(), &storage.NewClientConfig{})
+	if err != nil {
+		return
+	}
+
+	mockResponse := base.Response{
+		Error: map[string]interface{}{
+			"code":        float64(clonePairNotExist),
+			"description": "clone pair does not exist",
+		},
+	}
+
+	// mock
+	mock := gomonkey.NewPatches()
+	mock.ApplyMethodReturn(&base.RestClient{}, "Delete", mockResponse, nil)
+
+	// action
+	getErr := client.DeleteClonePair(context.Background(), "1")
+
+	// assert
+	if getErr != nil {
+		t.Errorf("TestBaseClient_DeleteClonePair_NotExistSuccess failed, "+
+			"wantErr = nil, gotErr = %v", getErr)
+	}
+
+	// cleanup
+	t.Cleanup(func() {
+		mock.Reset()
+	})
+}
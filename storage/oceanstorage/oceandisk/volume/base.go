@@ -21,6 +21,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/oceandisk/client"
@@ -59,6 +60,7 @@ func (p *Base) commonPreCreate(ctx context.Context, params map[string]interface{
 	analyzers := [...]func(context.Context, map[string]interface{}) error{
 		p.getPoolID,
 		p.getQoS,
+		p.getCloneSpeed,
 	}
 
 	for _, analyzer := range analyzers {
@@ -114,6 +116,41 @@ func (p *Base) getQoS(ctx context.Context, params map[string]interface{}) error
 	return nil
 }
 
+func (p *Base) getCloneSpeed(_ context.Context, params map[string]interface{}) error {
+	if params == nil {
+		return errors.New("getCloneSpeed params is nil")
+	}
+
+	_, srcVolumeExist := params["sourcevolumename"].(string)
+	_, srcSnapshotExist := params["sourcesnapshotname"].(string)
+	if !srcVolumeExist && !srcSnapshotExist {
+		return nil
+	}
+
+	if v, exist := params["clonespeed"].(string); exist && v != "" {
+		speed, err := strconv.Atoi(v)
+		if err != nil || speed < constants.CloneSpeedLevel1 || speed > constants.CloneSpeedLevel4 {
+			return fmt.Errorf("error config %s for clonespeed", v)
+		}
+		params["clonespeed"] = speed
+	} else {
+		params["clonespeed"] = constants.CloneSpeedLevel3
+	}
+
+	return nil
+}
+
+func (p *Base) getSnapshotReturnInfo(snapshot map[string]interface{}, snapshotSize int64) map[string]interface{} {
+	timestamp, _ := utils.GetValue[string](snapshot, "TIMESTAMP")
+	parentID, _ := utils.GetValue[string](snapshot, "PARENTID")
+	snapshotCreated := utils.ParseIntWithDefault(timestamp, constants.DefaultIntBase, constants.DefaultIntBitSize, 0)
+	return map[string]interface{}{
+		"CreationTime": snapshotCreated,
+		"SizeBytes":    snapshotSize * constants.AllocationUnitBytes,
+		"ParentID":     parentID,
+	}
+}
+
 func (p *Base) setWorkLoadID(ctx context.Context, cli client.OceandiskClientInterface,
 	params map[string]interface{}) error {
 	if params == nil {
@@ -21,6 +21,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/oceandisk/client"
@@ -30,6 +31,20 @@ import (
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
 )
 
+const (
+	waitUntilTimeout  = 6 * time.Hour
+	waitUntilInterval = 5 * time.Second
+
+	clonePairHealthStatusFault         = "1"
+	clonePairRunningStatusUnsyncing    = "0"
+	clonePairRunningStatusSyncing      = "1"
+	clonePairRunningStatusNormal       = "2"
+	clonePairRunningStatusInitializing = "3"
+
+	snapshotRunningStatusActive   = "43"
+	snapshotRunningStatusInactive = "45"
+)
+
 // SAN provides base san client
 type SAN struct {
 	Base
@@ -50,6 +65,12 @@ func (p *SAN) preCreate(ctx context.Context, params map[string]interface{}) erro
 		return err
 	}
 
+	if v, exist := params["sourcevolumename"].(string); exist {
+		params["clonefrom"] = v
+	} else if v, exist := params["sourcesnapshotname"].(string); exist {
+		params["fromSnapshot"] = utils.GetSnapshotName(v)
+	}
+
 	err = p.setWorkLoadID(ctx, p.cli, params)
 	if err != nil {
 		return err
@@ -94,21 +115,254 @@ func (p *SAN) createLocalNamespace(ctx context.Context, params, taskResult map[s
 
 	// 2. Do create.
 	if len(namespace) == 0 {
-		createNamespaceParams, err := client.MakeCreateNamespaceParams(params)
+		namespace, err = p.doCreateNamespace(ctx, params)
+	} else if isCloneRequest(params) {
+		err = p.waitNamespaceCloneFinish(ctx, namespace)
+	}
+	if err != nil {
+		log.AddContext(ctx).Errorf("Create namespace %s error: %v", namespaceName, err)
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"localNamespaceID": namespace["ID"],
+		"namespaceWWN":     namespace["WWN"],
+	}, nil
+}
+
+func (p *SAN) doCreateNamespace(ctx context.Context, params map[string]interface{}) (map[string]interface{}, error) {
+	if _, exist := params["clonefrom"]; exist {
+		return p.clone(ctx, params)
+	}
+
+	if _, exist := params["fromSnapshot"]; exist {
+		return p.fromSnapshotByClonePair(ctx, params)
+	}
+
+	createNamespaceParams, err := client.MakeCreateNamespaceParams(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.cli.CreateNamespace(ctx, *createNamespaceParams)
+}
+
+func isCloneRequest(params map[string]interface{}) bool {
+	_, cloneExist := params["clonefrom"]
+	_, fromSnapshotExist := params["fromSnapshot"]
+	return cloneExist || fromSnapshotExist
+}
+
+func (p *SAN) clone(ctx context.Context, params map[string]interface{}) (map[string]interface{}, error) {
+	cloneFrom, ok := params["clonefrom"].(string)
+	if !ok {
+		return nil, fmt.Errorf("assert clonefrom: [%v] to string failed", params["clonefrom"])
+	}
+
+	srcNamespace, err := p.cli.GetNamespaceByName(ctx, cloneFrom)
+	if err != nil {
+		return nil, err
+	}
+	if len(srcNamespace) == 0 {
+		return nil, fmt.Errorf("clone source namespace %s does not exist", cloneFrom)
+	}
+
+	srcID, ok := utils.GetValue[string](srcNamespace, "ID")
+	if !ok {
+		return nil, fmt.Errorf("assert source namespace id: [%v] to string failed", srcNamespace["ID"])
+	}
+
+	capacityStr, ok := utils.GetValue[string](srcNamespace, "CAPACITY")
+	if !ok {
+		return nil, fmt.Errorf("assert source namespace capacity: [%v] to string failed", srcNamespace["CAPACITY"])
+	}
+
+	srcCapacity, err := strconv.ParseInt(capacityStr, constants.DefaultIntBase, constants.DefaultIntBitSize)
+	if err != nil {
+		return nil, fmt.Errorf("parse source namespace capacity: [%s] failed, error: %w", capacityStr, err)
+	}
+
+	return p.createByClonePair(ctx, params, srcID, srcCapacity)
+}
+
+func (p *SAN) fromSnapshotByClonePair(ctx context.Context,
+	params map[string]interface{}) (map[string]interface{}, error) {
+	srcSnapshotName, ok := params["fromSnapshot"].(string)
+	if !ok {
+		return nil, fmt.Errorf("assert fromSnapshot: [%v] to string failed", params["fromSnapshot"])
+	}
+
+	srcSnapshot, err := p.cli.GetNamespaceSnapshotByName(ctx, srcSnapshotName)
+	if err != nil {
+		return nil, err
+	}
+	if len(srcSnapshot) == 0 {
+		return nil, fmt.Errorf("clone source snapshot %s does not exist", srcSnapshotName)
+	}
+
+	srcID, ok := utils.GetValue[string](srcSnapshot, "ID")
+	if !ok {
+		return nil, fmt.Errorf("assert source snapshot id: [%v] to string failed", srcSnapshot["ID"])
+	}
+
+	capacityStr, ok := utils.GetValue[string](srcSnapshot, "USERCAPACITY")
+	if !ok {
+		return nil, fmt.Errorf("assert source snapshot capacity: [%v] to string failed",
+			srcSnapshot["USERCAPACITY"])
+	}
+
+	srcCapacity, err := strconv.ParseInt(capacityStr, constants.DefaultIntBase, constants.DefaultIntBitSize)
+	if err != nil {
+		return nil, fmt.Errorf("parse source snapshot capacity: [%s] failed, error: %w", capacityStr, err)
+	}
+
+	return p.createByClonePair(ctx, params, srcID, srcCapacity)
+}
+
+func (p *SAN) createByClonePair(ctx context.Context, params map[string]interface{},
+	srcID string, srcCapacity int64) (map[string]interface{}, error) {
+	createNamespaceParams, err := client.MakeCreateNamespaceParams(params)
+	if err != nil {
+		return nil, err
+	}
+
+	cloneCapacity := createNamespaceParams.Capacity
+	if cloneCapacity < srcCapacity {
+		return nil, fmt.Errorf("clone target namespace capacity [%d] must be greater than or equal to "+
+			"source capacity [%d]", cloneCapacity, srcCapacity)
+	}
+
+	cloneSpeed, ok := params["clonespeed"].(int)
+	if !ok {
+		return nil, fmt.Errorf("assert clonespeed: [%v] to int failed", params["clonespeed"])
+	}
+
+	// The target namespace is created with the source capacity, and it is expanded after the pair is created.
+	createNamespaceParams.Capacity = srcCapacity
+	dstNamespace, err := p.cli.CreateNamespace(ctx, *createNamespaceParams)
+	if err != nil {
+		return nil, err
+	}
+
+	dstID, ok := utils.GetValue[string](dstNamespace, "ID")
+	if !ok {
+		return nil, fmt.Errorf("assert target namespace id: [%v] to string failed", dstNamespace["ID"])
+	}
+
+	err = p.createClonePair(ctx, clonePairRequest{
+		srcID:         srcID,
+		dstID:         dstID,
+		srcCapacity:   srcCapacity,
+		cloneCapacity: cloneCapacity,
+		cloneSpeed:    cloneSpeed,
+	})
+	if err != nil {
+		log.AddContext(ctx).Errorf("Create clone pair from %s to namespace %s error: %v", srcID, dstID, err)
+		if deleteErr := p.cli.DeleteNamespace(ctx, dstID); deleteErr != nil {
+			log.AddContext(ctx).Warningf("Delete clone target namespace %s error: %v", dstID, deleteErr)
+		}
+		return nil, err
+	}
+
+	return dstNamespace, nil
+}
+
+type clonePairRequest struct {
+	srcID         string
+	dstID         string
+	srcCapacity   int64
+	cloneCapacity int64
+	cloneSpeed    int
+}
+
+func (p *SAN) createClonePair(ctx context.Context, req clonePairRequest) error {
+	clonePair, err := p.cli.CreateClonePair(ctx, req.srcID, req.dstID, req.cloneSpeed)
+	if err != nil {
+		return err
+	}
+
+	clonePairID, ok := utils.GetValue[string](clonePair, "ID")
+	if !ok {
+		return fmt.Errorf("assert clone pair id: [%v] to string failed", clonePair["ID"])
+	}
+
+	if req.srcCapacity < req.cloneCapacity {
+		err = p.cli.ExtendNamespace(ctx, req.dstID, req.cloneCapacity)
 		if err != nil {
-			return nil, err
+			p.deleteClonePair(ctx, clonePairID)
+			return fmt.Errorf("extend clone target namespace %s failed, error: %w", req.dstID, err)
 		}
+	}
+
+	err = p.cli.SyncClonePair(ctx, clonePairID)
+	if err != nil {
+		p.deleteClonePair(ctx, clonePairID)
+		return fmt.Errorf("sync clone pair %s failed, error: %w", clonePairID, err)
+	}
+
+	err = p.waitClonePairFinish(ctx, clonePairID)
+	if err != nil {
+		// the target namespace can not be deleted while it is still in the clone pair
+		p.deleteClonePair(ctx, clonePairID)
+		return fmt.Errorf("wait clone pair %s finish failed, error: %w", clonePairID, err)
+	}
+
+	return nil
+}
+
+func (p *SAN) waitNamespaceCloneFinish(ctx context.Context, namespace map[string]interface{}) error {
+	namespaceID, ok := utils.GetValue[string](namespace, "ID")
+	if !ok {
+		return fmt.Errorf("assert namespace id: [%v] to string failed", namespace["ID"])
+	}
+
+	// ID of clone pair is the same as the target namespace ID
+	return p.waitClonePairFinish(ctx, namespaceID)
+}
 
-		namespace, err = p.cli.CreateNamespace(ctx, *createNamespaceParams)
+func (p *SAN) waitClonePairFinish(ctx context.Context, clonePairID string) error {
+	err := utils.WaitUntil(func() (bool, error) {
+		clonePair, err := p.cli.GetClonePairInfo(ctx, clonePairID)
 		if err != nil {
-			return nil, err
+			return false, err
+		}
+		if len(clonePair) == 0 {
+			return true, nil
+		}
+
+		healthStatus, ok := utils.GetValue[string](clonePair, "copyStatus")
+		if !ok {
+			return false, fmt.Errorf("assert copyStatus: [%v] to string failed", clonePair["copyStatus"])
+		}
+		if healthStatus == clonePairHealthStatusFault {
+			return false, fmt.Errorf("clone pair %s is at fault status", clonePairID)
+		}
+
+		runningStatus, ok := utils.GetValue[string](clonePair, "syncStatus")
+		if !ok {
+			return false, fmt.Errorf("assert syncStatus: [%v] to string failed", clonePair["syncStatus"])
+		}
+		switch runningStatus {
+		case clonePairRunningStatusNormal:
+			return true, nil
+		case clonePairRunningStatusSyncing, clonePairRunningStatusInitializing, clonePairRunningStatusUnsyncing:
+			return false, nil
+		default:
+			return false, fmt.Errorf("clone pair %s running status [%s] is abnormal", clonePairID, runningStatus)
 		}
+	}, waitUntilTimeout, waitUntilInterval)
+	if err != nil {
+		return err
 	}
 
-	return map[string]interface{}{
-		"localNamespaceID": namespace["ID"],
-		"namespaceWWN":     namespace["WWN"],
-	}, nil
+	p.deleteClonePair(ctx, clonePairID)
+	return nil
+}
+
+func (p *SAN) deleteClonePair(ctx context.Context, clonePairID string) {
+	if err := p.cli.DeleteClonePair(ctx, clonePairID); err != nil {
+		log.AddContext(ctx).Warningf("Delete clone pair %s error: %v", clonePairID, err)
+	}
 }
 
 func (p *SAN) revertLocalNamespace(ctx context.Context, taskResult map[string]interface{}) error {
@@ -170,7 +424,7 @@ func (p *SAN) Query(ctx context.Context, name string, params map[string]interfac
 	}
 
 	if len(namespace) == 0 {
-		return nil, utils.Errorf(ctx, "lun [%s] to query does not exist", name)
+		return nil, fmt.Errorf("%w: lun [%s] to query does not exist", constants.ErrVolumeNotFound, name)
 	}
 
 	if err = p.validateManageWorkLoadType(ctx, params, namespace); err != nil {
@@ -347,3 +601,160 @@ func (p *SAN) expandLocalNamespace(ctx context.Context, params, taskResult map[s
 
 	return nil, p.cli.ExtendNamespace(ctx, namespaceID, newSize)
 }
+
+// CreateSnapshot creates namespace snapshot
+func (p *SAN) CreateSnapshot(ctx context.Context, namespaceName, snapshotName string) (
+	map[string]interface{}, error) {
+	namespace, err := p.cli.GetNamespaceByName(ctx, namespaceName)
+	if err != nil {
+		return nil, err
+	}
+	if len(namespace) == 0 {
+		return nil, fmt.Errorf("namespace %s to create snapshot does not exist", namespaceName)
+	}
+
+	namespaceID, ok := utils.GetValue[string](namespace, "ID")
+	if !ok {
+		return nil, fmt.Errorf("assert namespaceID: [%v] to string failed", namespace["ID"])
+	}
+
+	snapshot, err := p.cli.GetNamespaceSnapshotByName(ctx, snapshotName)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(snapshot) != 0 {
+		parentID, _ := utils.GetValue[string](snapshot, "PARENTID")
+		if parentID != namespaceID {
+			return nil, fmt.Errorf("snapshot %s already exists, but the parent namespace %s is incompatible",
+				snapshotName, namespaceName)
+		}
+
+		return p.getNamespaceSnapshotReturnInfo(snapshot)
+	}
+
+	taskFlow := flow.NewTaskFlow(ctx, "Create-Namespace-Snapshot")
+	taskFlow.AddTask("Create-Snapshot", p.createSnapshot, p.revertSnapshot)
+	taskFlow.AddTask("Activate-Snapshot", p.activateSnapshot, nil)
+
+	params := map[string]interface{}{
+		"namespaceID":  namespaceID,
+		"snapshotName": snapshotName,
+	}
+	_, err = taskFlow.Run(params)
+	if err != nil {
+		taskFlow.Revert()
+		return nil, err
+	}
+
+	snapshot, err = p.cli.GetNamespaceSnapshotByName(ctx, snapshotName)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.getNamespaceSnapshotReturnInfo(snapshot)
+}
+
+func (p *SAN) getNamespaceSnapshotReturnInfo(snapshot map[string]interface{}) (map[string]interface{}, error) {
+	userCapacity, ok := utils.GetValue[string](snapshot, "USERCAPACITY")
+	if !ok {
+		return nil, fmt.Errorf("assert snapshot capacity: [%v] to string failed", snapshot["USERCAPACITY"])
+	}
+
+	snapshotSize := utils.ParseIntWithDefault(userCapacity, constants.DefaultIntBase, constants.DefaultIntBitSize, 0)
+	return p.getSnapshotReturnInfo(snapshot, snapshotSize), nil
+}
+
+func (p *SAN) createSnapshot(ctx context.Context, params, taskResult map[string]interface{}) (
+	map[string]interface{}, error) {
+	namespaceID, ok := utils.GetValue[string](params, "namespaceID")
+	if !ok {
+		return nil, fmt.Errorf("assert namespaceID: [%v] to string failed", params["namespaceID"])
+	}
+
+	snapshotName, ok := utils.GetValue[string](params, "snapshotName")
+	if !ok {
+		return nil, fmt.Errorf("assert snapshotName: [%v] to string failed", params["snapshotName"])
+	}
+
+	snapshot, err := p.cli.CreateNamespaceSnapshot(ctx, snapshotName, namespaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshotID, ok := utils.GetValue[string](snapshot, "ID")
+	if !ok {
+		return nil, fmt.Errorf("assert snapshotID: [%v] to string failed", snapshot["ID"])
+	}
+
+	err = p.waitSnapshotReady(ctx, snapshotName)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"snapshotID": snapshotID,
+	}, nil
+}
+
+func (p *SAN) waitSnapshotReady(ctx context.Context, snapshotName string) error {
+	return utils.WaitUntil(func() (bool, error) {
+		snapshot, err := p.cli.GetNamespaceSnapshotByName(ctx, snapshotName)
+		if err != nil {
+			return false, err
+		}
+		if len(snapshot) == 0 {
+			return false, fmt.Errorf("snapshot %s does not exist while waiting it ready", snapshotName)
+		}
+
+		runningStatus, ok := utils.GetValue[string](snapshot, "RUNNINGSTATUS")
+		if !ok {
+			return false, fmt.Errorf("assert runningStatus: [%v] to string failed", snapshot["RUNNINGSTATUS"])
+		}
+
+		return runningStatus == snapshotRunningStatusActive || runningStatus == snapshotRunningStatusInactive, nil
+	}, waitUntilTimeout, waitUntilInterval)
+}
+
+func (p *SAN) revertSnapshot(ctx context.Context, taskResult map[string]interface{}) error {
+	snapshotID, ok := utils.GetValue[string](taskResult, "snapshotID")
+	if !ok || snapshotID == "" {
+		return nil
+	}
+
+	return p.cli.DeleteNamespaceSnapshot(ctx, snapshotID)
+}
+
+func (p *SAN) activateSnapshot(ctx context.Context, params, taskResult map[string]interface{}) (
+	map[string]interface{}, error) {
+	snapshotID, ok := utils.GetValue[string](taskResult, "snapshotID")
+	if !ok {
+		return nil, fmt.Errorf("assert snapshotID: [%v] to string failed", taskResult["snapshotID"])
+	}
+
+	return nil, p.cli.ActivateNamespaceSnapshot(ctx, snapshotID)
+}
+
+// DeleteSnapshot deletes namespace snapshot
+func (p *SAN) DeleteSnapshot(ctx context.Context, snapshotName string) error {
+	snapshot, err := p.cli.GetNamespaceSnapshotByName(ctx, snapshotName)
+	if err != nil {
+		return err
+	}
+	if len(snapshot) == 0 {
+		log.AddContext(ctx).Infof("Namespace snapshot %s to delete does not exist", snapshotName)
+		return nil
+	}
+
+	snapshotID, ok := utils.GetValue[string](snapshot, "ID")
+	if !ok {
+		return fmt.Errorf("assert snapshotID: [%v] to string failed", snapshot["ID"])
+	}
+
+	err = p.cli.DeactivateNamespaceSnapshot(ctx, snapshotID)
+	if err != nil {
+		return err
+	}
+
+	return p.cli.DeleteNamespaceSnapshot(ctx, snapshotID)
+}
@@ -25,9 +25,12 @@ import (
 
 	"github.com/agiledragon/gomonkey/v2"
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
 
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/oceandisk/client"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/test/mocks/mock_client"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
 )
 
@@ -261,3 +264,170 @@ func TestSAN_Query_WorkLoadTypeUnmatched(t *testing.T) {
 	assert.Nil(t, gotVolume)
 	assert.ErrorContains(t, gotErr, "the workload type is different between")
 }
+
+func TestSAN_createLocalNamespace_FromSnapshotSuccess(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	cli := mock_client.NewMockOceandiskClientInterface(mockCtrl)
+	san := NewSAN(cli)
+	params := map[string]interface{}{
+		"name":         "clone-namespace",
+		"poolID":       "0",
+		"capacity":     int64(200),
+		"description":  "",
+		"fromSnapshot": "snapshot",
+		"clonespeed":   constants.CloneSpeedLevel3,
+	}
+	want := map[string]interface{}{"localNamespaceID": "2", "namespaceWWN": "wwn"}
+
+	// mock
+	cli.EXPECT().GetNamespaceByName(ctx, "clone-namespace").Return(map[string]interface{}{}, nil)
+	cli.EXPECT().GetNamespaceSnapshotByName(ctx, "snapshot").
+		Return(map[string]interface{}{"ID": "10", "USERCAPACITY": "100"}, nil)
+	cli.EXPECT().CreateNamespace(ctx, client.CreateNamespaceParams{Name: "clone-namespace", ParentId: "0",
+		Capacity: 100}).Return(map[string]interface{}{"ID": "2", "WWN": "wwn"}, nil)
+	cli.EXPECT().CreateClonePair(ctx, "10", "2", constants.CloneSpeedLevel3).
+		Return(map[string]interface{}{"ID": "2"}, nil)
+	cli.EXPECT().ExtendNamespace(ctx, "2", int64(200)).Return(nil)
+	cli.EXPECT().SyncClonePair(ctx, "2").Return(nil)
+	cli.EXPECT().GetClonePairInfo(ctx, "2").
+		Return(map[string]interface{}{"copyStatus": "0", "syncStatus": clonePairRunningStatusNormal}, nil)
+	cli.EXPECT().DeleteClonePair(ctx, "2").Return(nil)
+
+	// action
+	got, err := san.createLocalNamespace(ctx, params, nil)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestSAN_createLocalNamespace_WaitClonePairFailed(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	cli := mock_client.NewMockOceandiskClientInterface(mockCtrl)
+	san := NewSAN(cli)
+	params := map[string]interface{}{
+		"name":         "clone-namespace",
+		"poolID":       "0",
+		"capacity":     int64(100),
+		"description":  "",
+		"fromSnapshot": "snapshot",
+		"clonespeed":   constants.CloneSpeedLevel3,
+	}
+
+	// mock
+	cli.EXPECT().GetNamespaceByName(ctx, "clone-namespace").Return(map[string]interface{}{}, nil)
+	cli.EXPECT().GetNamespaceSnapshotByName(ctx, "snapshot").
+		Return(map[string]interface{}{"ID": "10", "USERCAPACITY": "100"}, nil)
+	cli.EXPECT().CreateNamespace(ctx, client.CreateNamespaceParams{Name: "clone-namespace", ParentId: "0",
+		Capacity: 100}).Return(map[string]interface{}{"ID": "2", "WWN": "wwn"}, nil)
+	cli.EXPECT().CreateClonePair(ctx, "10", "2", constants.CloneSpeedLevel3).
+		Return(map[string]interface{}{"ID": "2"}, nil)
+	cli.EXPECT().SyncClonePair(ctx, "2").Return(nil)
+	cli.EXPECT().GetClonePairInfo(ctx, "2").
+		Return(map[string]interface{}{"copyStatus": clonePairHealthStatusFault}, nil)
+	gomock.InOrder(
+		cli.EXPECT().DeleteClonePair(ctx, "2").Return(nil),
+		cli.EXPECT().DeleteNamespace(ctx, "2").Return(nil),
+	)
+
+	// action
+	_, err := san.createLocalNamespace(ctx, params, nil)
+
+	// assert
+	assert.ErrorContains(t, err, "is at fault status")
+}
+
+func TestSAN_createLocalNamespace_SnapshotCapacityTooLarge(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	cli := mock_client.NewMockOceandiskClientInterface(mockCtrl)
+	san := NewSAN(cli)
+	params := map[string]interface{}{
+		"name":         "clone-namespace",
+		"poolID":       "0",
+		"capacity":     int64(50),
+		"description":  "",
+		"fromSnapshot": "snapshot",
+		"clonespeed":   constants.CloneSpeedLevel3,
+	}
+
+	// mock
+	cli.EXPECT().GetNamespaceByName(ctx, "clone-namespace").Return(map[string]interface{}{}, nil)
+	cli.EXPECT().GetNamespaceSnapshotByName(ctx, "snapshot").
+		Return(map[string]interface{}{"ID": "10", "USERCAPACITY": "100"}, nil)
+
+	// action
+	_, err := san.createLocalNamespace(ctx, params, nil)
+
+	// assert
+	assert.ErrorContains(t, err, "must be greater than or equal to source capacity")
+}
+
+func TestSAN_CreateSnapshot_Success(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	cli := mock_client.NewMockOceandiskClientInterface(mockCtrl)
+	san := NewSAN(cli)
+	snapshot := map[string]interface{}{"ID": "10", "PARENTID": "1", "USERCAPACITY": "100",
+		"TIMESTAMP": "1700000000", "RUNNINGSTATUS": snapshotRunningStatusInactive}
+	want := map[string]interface{}{"CreationTime": int64(1700000000),
+		"SizeBytes": int64(100 * constants.AllocationUnitBytes), "ParentID": "1"}
+
+	// mock
+	cli.EXPECT().GetNamespaceByName(ctx, "namespace").Return(map[string]interface{}{"ID": "1"}, nil)
+	cli.EXPECT().GetNamespaceSnapshotByName(ctx, "snapshot").Return(map[string]interface{}{}, nil)
+	cli.EXPECT().CreateNamespaceSnapshot(ctx, "snapshot", "1").Return(map[string]interface{}{"ID": "10"}, nil)
+	cli.EXPECT().GetNamespaceSnapshotByName(ctx, "snapshot").Return(snapshot, nil).Times(2)
+	cli.EXPECT().ActivateNamespaceSnapshot(ctx, "10").Return(nil)
+
+	// action
+	got, err := san.CreateSnapshot(ctx, "namespace", "snapshot")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestSAN_CreateSnapshot_ParentIncompatible(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	cli := mock_client.NewMockOceandiskClientInterface(mockCtrl)
+	san := NewSAN(cli)
+
+	// mock
+	cli.EXPECT().GetNamespaceByName(ctx, "namespace").Return(map[string]interface{}{"ID": "1"}, nil)
+	cli.EXPECT().GetNamespaceSnapshotByName(ctx, "snapshot").
+		Return(map[string]interface{}{"ID": "10", "PARENTID": "2"}, nil)
+
+	// action
+	_, err := san.CreateSnapshot(ctx, "namespace", "snapshot")
+
+	// assert
+	assert.ErrorContains(t, err, "is incompatible")
+}
+
+func TestSAN_DeleteSnapshot_Success(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	cli := mock_client.NewMockOceandiskClientInterface(mockCtrl)
+	san := NewSAN(cli)
+
+	// mock
+	cli.EXPECT().GetNamespaceSnapshotByName(ctx, "snapshot").Return(map[string]interface{}{"ID": "10"}, nil)
+	cli.EXPECT().DeactivateNamespaceSnapshot(ctx, "10").Return(nil)
+	cli.EXPECT().DeleteNamespaceSnapshot(ctx, "10").Return(nil)
+
+	// action
+	err := san.DeleteSnapshot(ctx, "snapshot")
+
+	// assert
+	assert.NoError(t, err)
+}
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2020-2025. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2020-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -25,6 +25,7 @@ import (
 	"io"
 	"net/http"
 	"regexp"
+	"strings"
 	"sync/atomic"
 
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
@@ -70,6 +71,7 @@ type OceanstorClientInterface interface {
 	HyperMetro
 	Lun
 	LunCopy
+	LunMigration
 	LunSnapshot
 	Replication
 	OceanstorVStore
@@ -389,6 +391,27 @@ func (cli *OceanstorClient) getObjByvStoreName(objList []interface{}) map[string
 	return nil
 }
 
+func (cli *OceanstorClient) filterObjsByNamePrefix(objList []map[string]interface{},
+	prefix string) []map[string]interface{} {
+	var filtered []map[string]interface{}
+	for _, obj := range objList {
+		name, ok := obj["NAME"].(string)
+		if !ok || !strings.HasPrefix(name, prefix) {
+			continue
+		}
+
+		vStoreName, ok := obj["vstoreName"].(string)
+		if !ok {
+			vStoreName = storage.DefaultVStore
+		}
+
+		if vStoreName == cli.GetvStoreName() {
+			filtered = append(filtered, obj)
+		}
+	}
+	return filtered
+}
+
 func (cli *OceanstorClient) systemInfoRefreshing() bool {
 	return atomic.LoadUint32(&cli.SystemInfoRefreshing) == 1
 }
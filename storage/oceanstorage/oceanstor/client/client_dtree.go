@@ -44,6 +44,8 @@ type DTree interface {
 	CreateDTree(ctx context.Context, params map[string]interface{}) (map[string]interface{}, error)
 	// GetDTreeByName use for get dTree information
 	GetDTreeByName(ctx context.Context, parentID, parentName, vStoreID, name string) (map[string]interface{}, error)
+	// GetDTreesByParentName use for get all dTrees of the parent file system
+	GetDTreesByParentName(ctx context.Context, parentName, vStoreID string) ([]map[string]interface{}, error)
 	// DeleteDTreeByID use for delete a dTree
 	DeleteDTreeByID(ctx context.Context, vStoreID, dTreeID string) error
 	// DeleteDTreeByName use for delete a dTree by name
@@ -85,6 +87,18 @@ func (cli *OceanstorClient) GetDTreeByName(ctx context.Context,
 	return cli.getResponseDataMap(ctx, resp.Data)
 }
 
+// GetDTreesByParentName use for get all dTrees of the parent file system
+func (cli *OceanstorClient) GetDTreesByParentName(ctx context.Context,
+	parentName, vStoreID string) ([]map[string]interface{}, error) {
+	url := fmt.Sprintf("/QUOTATREE?PARENTNAME=%s&vstoreId=%s", parentName, vStoreID)
+	dtrees, err := base.GetBatchObjs(ctx, cli, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dtrees of %q: %w", parentName, err)
+	}
+
+	return dtrees, nil
+}
+
 // DeleteDTreeByID use for delete a dTree
 func (cli *OceanstorClient) DeleteDTreeByID(ctx context.Context, vStoreID, dTreeID string) error {
 	url := fmt.Sprintf("/QUOTATREE")
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2022-2023. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2022-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/base"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
 )
@@ -40,8 +41,14 @@ type FSSnapshot interface {
 	CreateFSSnapshot(ctx context.Context, name, parentID string) (map[string]interface{}, error)
 	// GetFSSnapshotByName used for get file system snapshot by snapshot name
 	GetFSSnapshotByName(ctx context.Context, parentID, snapshotName string) (map[string]interface{}, error)
+	// GetFSSnapshotsByParentID used for get all file system snapshots of the parent file system
+	GetFSSnapshotsByParentID(ctx context.Context, parentID string) ([]map[string]interface{}, error)
 	// GetFSSnapshotCountByParentId used for get file system snapshot count by parent id
 	GetFSSnapshotCountByParentId(ctx context.Context, ParentId string) (int, error)
+	// RollbackFSSnapshot used for roll back the parent file system to the snapshot
+	RollbackFSSnapshot(ctx context.Context, snapshotID string) error
+	// UpdateFSSnapshot used for update file system snapshot
+	UpdateFSSnapshot(ctx context.Context, snapshotID string, params map[string]interface{}) error
 }
 
 // DeleteFSSnapshot used for delete file system snapshot by id
@@ -105,6 +112,17 @@ func (cli *OceanstorClient) GetFSSnapshotByName(ctx context.Context,
 	return snapshot, nil
 }
 
+// GetFSSnapshotsByParentID used for get all file system snapshots of the parent file system
+func (cli *OceanstorClient) GetFSSnapshotsByParentID(ctx context.Context,
+	parentID string) ([]map[string]interface{}, error) {
+	snapshots, err := base.GetBatchObjs(ctx, cli, fmt.Sprintf("/FSSNAPSHOT?PARENTID=%s", parentID))
+	if err != nil {
+		return nil, fmt.Errorf("get snapshots of filesystem %s failed, error: %w", parentID, err)
+	}
+
+	return snapshots, nil
+}
+
 // GetFSSnapshotCountByParentId used for get file system snapshot count by parent id
 func (cli *OceanstorClient) GetFSSnapshotCountByParentId(ctx context.Context, ParentId string) (int, error) {
 	url := fmt.Sprintf("/FSSNAPSHOT/count?PARENTID=%s", ParentId)
@@ -163,3 +181,45 @@ func (cli *OceanstorClient) CreateFSSnapshot(ctx context.Context,
 	}
 	return respData, nil
 }
+
+// RollbackFSSnapshot used for roll back the parent file system to the snapshot, the rollback runs in background
+func (cli *OceanstorClient) RollbackFSSnapshot(ctx context.Context, snapshotID string) error {
+	data := map[string]interface{}{
+		"ID": snapshotID,
+	}
+
+	resp, err := cli.Put(ctx, "/FSSNAPSHOT/ROLLBACK_FSSNAPSHOT", data)
+	if err != nil {
+		return err
+	}
+
+	code, ok := utils.GetValue[float64](resp.Error, "code")
+	if !ok {
+		return fmt.Errorf("get code from resp failed, resp: %v", resp)
+	}
+	if int64(code) != 0 {
+		return fmt.Errorf("rollback filesystem snapshot %s error: %v", snapshotID, code)
+	}
+
+	return nil
+}
+
+// UpdateFSSnapshot used for update file system snapshot
+func (cli *OceanstorClient) UpdateFSSnapshot(ctx context.Context,
+	snapshotID string, params map[string]interface{}) error {
+	url := fmt.Sprintf("/FSSNAPSHOT/%s", snapshotID)
+	resp, err := cli.Put(ctx, url, params)
+	if err != nil {
+		return err
+	}
+
+	code, ok := utils.GetValue[float64](resp.Error, "code")
+	if !ok {
+		return fmt.Errorf("get code from resp failed, resp: %v", resp)
+	}
+	if int64(code) != 0 {
+		return fmt.Errorf("update filesystem snapshot %s by params %v error: %v", snapshotID, params, code)
+	}
+
+	return nil
+}
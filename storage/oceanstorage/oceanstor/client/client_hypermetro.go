@@ -59,6 +59,8 @@ type HyperMetro interface {
 	SyncHyperMetroPair(ctx context.Context, pairID string) error
 	// StopHyperMetroPair used for stop hyper metro pair
 	StopHyperMetroPair(ctx context.Context, pairID string) error
+	// ForceStartHyperMetroPair used for force start hyper metro pair
+	ForceStartHyperMetroPair(ctx context.Context, pairID string) error
 }
 
 // GetHyperMetroDomainByName used for get hyper metro domain by name
@@ -285,6 +287,26 @@ func (cli *OceanstorClient) StopHyperMetroPair(ctx context.Context, pairID strin
 	return nil
 }
 
+// ForceStartHyperMetroPair used for force start hyper metro pair, the local resource of the pair becomes
+// accessible alone when the remote one is not available
+func (cli *OceanstorClient) ForceStartHyperMetroPair(ctx context.Context, pairID string) error {
+	data := map[string]interface{}{
+		"ID": pairID,
+	}
+
+	resp, err := cli.Put(ctx, "/HyperMetroPair/forcestart", data)
+	if err != nil {
+		return err
+	}
+
+	code := int64(resp.Error["code"].(float64))
+	if code != 0 {
+		return fmt.Errorf("Force start hypermetro %s error: %d", pairID, code)
+	}
+
+	return nil
+}
+
 // DeleteHyperMetroPair used for delete hyper metro pair by pair id
 func (cli *OceanstorClient) DeleteHyperMetroPair(ctx context.Context, pairID string, onlineDelete bool) error {
 	url := fmt.Sprintf("/HyperMetroPair/%s", pairID)
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2022-2025. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2022-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -25,6 +25,7 @@ import (
 
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
 	pkgUtils "github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/utils"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/base"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
 )
@@ -40,12 +41,31 @@ const (
 	maxLunNameLength = 31
 )
 
+var (
+	// SmartTierInitialPolicies maps the smartTierInitialPolicy parameter to the INITIALDISTRIBUTEPOLICY of lun
+	SmartTierInitialPolicies = map[string]int{
+		constants.SmartTierPolicyAutomatic: 0,
+		constants.SmartTierPolicyHighest:   1,
+		constants.SmartTierPolicyLowest:    2,
+	}
+
+	// SmartTierRelocationPolicies maps the smartTierRelocationPolicy parameter to the DATATRANSFERPOLICY of lun
+	SmartTierRelocationPolicies = map[string]int{
+		constants.SmartTierPolicyNone:      0,
+		constants.SmartTierPolicyAutomatic: 1,
+		constants.SmartTierPolicyHighest:   2,
+		constants.SmartTierPolicyLowest:    3,
+	}
+)
+
 // Lun defines interfaces for lun operations
 type Lun interface {
 	// QueryAssociateLunGroup used for query associate lun group by object type and object id
 	QueryAssociateLunGroup(ctx context.Context, objType int, objID string) ([]interface{}, error)
 	// GetLunByName used for get lun by name
 	GetLunByName(ctx context.Context, name string) (map[string]interface{}, error)
+	// GetLunsByNamePrefix used for get all luns whose name starts with the prefix
+	GetLunsByNamePrefix(ctx context.Context, prefix string) ([]map[string]interface{}, error)
 	// MakeLunName create lun name based on different storage models
 	MakeLunName(name string) string
 	// GetLunByID used for get lun by id
@@ -133,6 +153,17 @@ func (cli *OceanstorClient) GetLunByName(ctx context.Context, name string) (map[
 	return cli.getObjByvStoreName(respData), nil
 }
 
+// GetLunsByNamePrefix used for get all luns whose name starts with the prefix
+func (cli *OceanstorClient) GetLunsByNamePrefix(ctx context.Context,
+	prefix string) ([]map[string]interface{}, error) {
+	luns, err := base.GetBatchObjs(ctx, cli, fmt.Sprintf("/lun?filter=NAME:%s", prefix))
+	if err != nil {
+		return nil, fmt.Errorf("get luns by name prefix %s failed, error: %w", prefix, err)
+	}
+
+	return cli.filterObjsByNamePrefix(luns, prefix), nil
+}
+
 // MakeLunName v3/v5 storage support 1 to 31 characters
 func (cli *OceanstorClient) MakeLunName(name string) string {
 	if len(name) <= maxLunNameLength {
@@ -510,6 +541,22 @@ func generateCreateLunDataFromParams(params map[string]any) (map[string]any, err
 		data["WORKLOADTYPEID"] = val
 	}
 
+	if val, ok := utils.GetValue[bool](params, "smartdedupe"); ok {
+		data["ENABLESMARTDEDUP"] = val
+	}
+
+	if val, ok := utils.GetValue[bool](params, "smartcompression"); ok {
+		data["ENABLECOMPRESSION"] = val
+	}
+
+	if val, ok := utils.GetValue[int](params, "smarttierinitialpolicy"); ok {
+		data["INITIALDISTRIBUTEPOLICY"] = val
+	}
+
+	if val, ok := utils.GetValue[int](params, "smarttierrelocationpolicy"); ok {
+		data["DATATRANSFERPOLICY"] = val
+	}
+
 	if value, ok := utils.GetValue[string](params, constants.AdvancedOptionsKey); ok && value != "" {
 		advancedOptions := make(map[string]any)
 		err := json.Unmarshal([]byte(value), &advancedOptions)
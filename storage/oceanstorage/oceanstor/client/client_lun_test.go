@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2025-2025. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2025-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -87,6 +87,12 @@ func Test_generateCreateLunDataFromParams(t *testing.T) {
 			want: map[string]any{"NAME": "test-lun-name", "PARENTID": "1", "CAPACITY": int64(1024 * 1024 * 1024),
 				"DESCRIPTION": "desc from advanced options", "ALLOCTYPE": 1, "WORKLOADTYPEID": "1"},
 			wantErrContains: ""},
+		{name: "smartx parameters",
+			params: map[string]any{"name": "test-lun-name", "smartdedupe": true, "smartcompression": false,
+				"smarttierinitialpolicy": 1, "smarttierrelocationpolicy": 0},
+			want: map[string]any{"NAME": "test-lun-name", "ENABLESMARTDEDUP": true, "ENABLECOMPRESSION": false,
+				"INITIALDISTRIBUTEPOLICY": 1, "DATATRANSFERPOLICY": 0},
+			wantErrContains: ""},
 		{name: "unmarshal failed", params: map[string]any{constants.AdvancedOptionsKey: `{`}, want: nil,
 			wantErrContains: "failed to unmarshal advancedOptions"},
 	}
@@ -104,3 +110,37 @@ func Test_generateCreateLunDataFromParams(t *testing.T) {
 		})
 	}
 }
+
+func TestOceanstorClient_GetLunsByNamePrefix_Success(t *testing.T) {
+	// arrange
+	respBody := `{"data": [
+		{"NAME": "pvc-1", "CAPACITY": "2097152", "vstoreName": "dev-vStore"},
+		{"NAME": "test-pvc-2", "CAPACITY": "2097152", "vstoreName": "dev-vStore"},
+		{"NAME": "pvc-3", "CAPACITY": "2097152", "vstoreName": "other-vStore"}
+	], "error": {"code": 0, "description": "0"}}`
+
+	// mock
+	mockClient := getMockClient(200, respBody)
+
+	// action
+	luns, err := mockClient.GetLunsByNamePrefix(context.Background(), "pvc")
+
+	// assert
+	require.NoError(t, err)
+	require.Len(t, luns, 1)
+	require.Equal(t, "pvc-1", luns[0]["NAME"])
+}
+
+func TestOceanstorClient_GetLunsByNamePrefix_ErrorCode(t *testing.T) {
+	// arrange
+	respBody := `{"data": [], "error": {"code": 50331651, "description": "parameter error"}}`
+
+	// mock
+	mockClient := getMockClient(200, respBody)
+
+	// action
+	_, err := mockClient.GetLunsByNamePrefix(context.Background(), "pvc")
+
+	// assert
+	require.ErrorContains(t, err, "get luns by name prefix pvc failed")
+}
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2022-2023. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2022-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -22,6 +22,7 @@ import (
 
 	pkgUtils "github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/utils"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/base"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
 )
@@ -29,12 +30,17 @@ import (
 const (
 	lunSnapshotNotExist  int64 = 1077937880
 	snapshotNotActivated int64 = 1077937891
+
+	// snapshotRollbackSpeedMedium is the default rollback speed of lun snapshot on storage
+	snapshotRollbackSpeedMedium = 2
 )
 
 // LunSnapshot defines interfaces for lun snapshot operations
 type LunSnapshot interface {
 	// GetLunSnapshotByName used for get lun snapshot by name
 	GetLunSnapshotByName(ctx context.Context, name string) (map[string]interface{}, error)
+	// GetLunSnapshotsByParentID used for get all lun snapshots of the parent lun
+	GetLunSnapshotsByParentID(ctx context.Context, parentID string) ([]map[string]interface{}, error)
 	// DeleteLunSnapshot used for delete lun snapshot
 	DeleteLunSnapshot(ctx context.Context, snapshotID string) error
 	// CreateLunSnapshot used for create lun snapshot
@@ -43,8 +49,14 @@ type LunSnapshot interface {
 	CreateHyperMetroSnap(ctx context.Context, name, pairID string) (map[string]interface{}, error)
 	// ActivateLunSnapshot used for activate lun snapshot
 	ActivateLunSnapshot(ctx context.Context, snapshotID string) error
+	// ActivateLunSnapshots used for activate lun snapshots at the same point in time
+	ActivateLunSnapshots(ctx context.Context, snapshotIDs []string) error
 	// DeactivateLunSnapshot used for stop lun snapshot
 	DeactivateLunSnapshot(ctx context.Context, snapshotID string) error
+	// RollbackLunSnapshot used for roll back the parent lun to the snapshot
+	RollbackLunSnapshot(ctx context.Context, snapshotID string) error
+	// UpdateLunSnapshot used for update lun snapshot
+	UpdateLunSnapshot(ctx context.Context, snapshotID string, params map[string]interface{}) error
 }
 
 // CreateLunSnapshot used for create lun snapshot
@@ -135,6 +147,17 @@ func (cli *OceanstorClient) GetLunSnapshotByName(ctx context.Context, name strin
 	return snapshot, nil
 }
 
+// GetLunSnapshotsByParentID used for get all lun snapshots of the parent lun
+func (cli *OceanstorClient) GetLunSnapshotsByParentID(ctx context.Context,
+	parentID string) ([]map[string]interface{}, error) {
+	snapshots, err := base.GetBatchObjs(ctx, cli, fmt.Sprintf("/snapshot?filter=PARENTID::%s", parentID))
+	if err != nil {
+		return nil, fmt.Errorf("get snapshots of lun %s failed, error: %w", parentID, err)
+	}
+
+	return snapshots, nil
+}
+
 // DeleteLunSnapshot used for delete lun snapshot
 func (cli *OceanstorClient) DeleteLunSnapshot(ctx context.Context, snapshotID string) error {
 	url := fmt.Sprintf("/snapshot/%s", snapshotID)
@@ -155,6 +178,26 @@ func (cli *OceanstorClient) DeleteLunSnapshot(ctx context.Context, snapshotID st
 	return nil
 }
 
+// UpdateLunSnapshot used for update lun snapshot
+func (cli *OceanstorClient) UpdateLunSnapshot(ctx context.Context,
+	snapshotID string, params map[string]interface{}) error {
+	url := fmt.Sprintf("/snapshot/%s", snapshotID)
+	resp, err := cli.Put(ctx, url, params)
+	if err != nil {
+		return err
+	}
+
+	code, ok := utils.GetValue[float64](resp.Error, "code")
+	if !ok {
+		return fmt.Errorf("get code from resp failed, resp: %v", resp)
+	}
+	if int64(code) != 0 {
+		return fmt.Errorf("update lun snapshot %s by params %v error: %v", snapshotID, params, code)
+	}
+
+	return nil
+}
+
 // ActivateLunSnapshot used for activate lun snapshot
 func (cli *OceanstorClient) ActivateLunSnapshot(ctx context.Context, snapshotID string) error {
 	data := map[string]interface{}{
@@ -174,6 +217,29 @@ func (cli *OceanstorClient) ActivateLunSnapshot(ctx context.Context, snapshotID
 	return nil
 }
 
+// ActivateLunSnapshots used for activate lun snapshots at the same point in time,
+// the snapshots activated in one request are consistent with each other
+func (cli *OceanstorClient) ActivateLunSnapshots(ctx context.Context, snapshotIDs []string) error {
+	data := map[string]interface{}{
+		"SNAPSHOTLIST": snapshotIDs,
+	}
+
+	resp, err := cli.Post(ctx, "/snapshot/activate", data)
+	if err != nil {
+		return err
+	}
+
+	code, ok := utils.GetValue[float64](resp.Error, "code")
+	if !ok {
+		return fmt.Errorf("get code from resp failed, resp: %v", resp)
+	}
+	if int64(code) != storage.SuccessCode {
+		return fmt.Errorf("activate snapshots %v error: %v", snapshotIDs, code)
+	}
+
+	return nil
+}
+
 // DeactivateLunSnapshot used for stop lun snapshot
 func (cli *OceanstorClient) DeactivateLunSnapshot(ctx context.Context, snapshotID string) error {
 	data := map[string]interface{}{
@@ -195,3 +261,26 @@ func (cli *OceanstorClient) DeactivateLunSnapshot(ctx context.Context, snapshotI
 
 	return nil
 }
+
+// RollbackLunSnapshot used for roll back the parent lun to the snapshot, the rollback runs in background
+func (cli *OceanstorClient) RollbackLunSnapshot(ctx context.Context, snapshotID string) error {
+	data := map[string]interface{}{
+		"ID":            snapshotID,
+		"ROLLBACKSPEED": snapshotRollbackSpeedMedium,
+	}
+
+	resp, err := cli.Put(ctx, "/snapshot/rollback", data)
+	if err != nil {
+		return err
+	}
+
+	code, ok := utils.GetValue[float64](resp.Error, "code")
+	if !ok {
+		return fmt.Errorf("get code from resp failed, resp: %v", resp)
+	}
+	if int64(code) != storage.SuccessCode {
+		return fmt.Errorf("rollback snapshot %s error: %v", snapshotID, code)
+	}
+
+	return nil
+}
@@ -64,3 +64,78 @@ func TestCreateHyperMetroSnapshot_Failed(t *testing.T) {
 	// assert
 	assert.Error(t, err)
 }
+
+func TestOceanstorClient_GetLunSnapshotsByParentID_Success(t *testing.T) {
+	// arrange
+	respBody := `{"data": [
+		{"ID": "1", "NAME": "snapshot-1", "PARENTID": "10", "USERCAPACITY": "2097152"},
+		{"ID": "2", "NAME": "snapshot-2", "PARENTID": "10", "USERCAPACITY": "2097152"}
+	], "error": {"code": 0, "description": "0"}}`
+
+	// mock
+	mockClient := getMockClient(http.StatusOK, respBody)
+
+	// action
+	snapshots, err := mockClient.GetLunSnapshotsByParentID(context.Background(), "10")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Len(t, snapshots, 2)
+	assert.Equal(t, "snapshot-2", snapshots[1]["NAME"])
+}
+
+func TestOceanstorClient_GetLunSnapshotsByParentID_ErrorCode(t *testing.T) {
+	// arrange
+	respBody := `{"data": [], "error": {"code": 50331651, "description": "parameter error"}}`
+
+	// mock
+	mockClient := getMockClient(http.StatusOK, respBody)
+
+	// action
+	_, err := mockClient.GetLunSnapshotsByParentID(context.Background(), "10")
+
+	// assert
+	assert.ErrorContains(t, err, "get snapshots of lun 10 failed")
+}
+
+func TestOceanstorClient_ActivateLunSnapshots_Success(t *testing.T) {
+	// arrange
+	respBody := `{"data": {}, "error": {"code": 0, "description": "0"}}`
+
+	// mock
+	mockClient := getMockClient(http.StatusOK, respBody)
+
+	// action
+	err := mockClient.ActivateLunSnapshots(context.Background(), []string{"1", "2"})
+
+	// assert
+	assert.NoError(t, err)
+}
+
+func TestOceanstorClient_ActivateLunSnapshots_ErrorCode(t *testing.T) {
+	// arrange
+	respBody := `{"data": {}, "error": {"code": 1077937891, "description": "snapshot error"}}`
+
+	// mock
+	mockClient := getMockClient(http.StatusOK, respBody)
+
+	// action
+	err := mockClient.ActivateLunSnapshots(context.Background(), []string{"1", "2"})
+
+	// assert
+	assert.ErrorContains(t, err, "activate snapshots [1 2] error")
+}
+
+func TestOceanstorClient_RollbackLunSnapshot_ErrorCode(t *testing.T) {
+	// arrange
+	respBody := `{"data": {}, "error": {"code": 1077937891, "description": "snapshot error"}}`
+
+	// mock
+	mockClient := getMockClient(http.StatusOK, respBody)
+
+	// action
+	err := mockClient.RollbackLunSnapshot(context.Background(), "1")
+
+	// assert
+	assert.ErrorContains(t, err, "rollback snapshot 1 error")
+}
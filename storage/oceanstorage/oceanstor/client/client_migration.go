@@ -0,0 +1,130 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	pkgUtils "github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/utils"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
+)
+
+const (
+	lunMigrationType = "253"
+	// lunMigrationWorkModeOnline means the lun keeps serving the hosts during the migration
+	lunMigrationWorkModeOnline = 0
+)
+
+// LunMigration defines interfaces for SmartMigration operations of lun
+type LunMigration interface {
+	// CreateLunMigration used for migrate the source lun to the pool of the target lun
+	CreateLunMigration(ctx context.Context, srcLunID, dstLunID string, speed int) (map[string]interface{}, error)
+	// GetLunMigration used for get the migration task of the source lun
+	GetLunMigration(ctx context.Context, srcLunID string) (map[string]interface{}, error)
+	// DeleteLunMigration used for delete the migration task of the source lun
+	DeleteLunMigration(ctx context.Context, srcLunID string) error
+}
+
+// CreateLunMigration used for migrate the source lun to the pool of the target lun, the source lun keeps its id,
+// wwn and mappings, and the target lun is consumed by the migration
+func (cli *OceanstorClient) CreateLunMigration(ctx context.Context, srcLunID, dstLunID string,
+	speed int) (map[string]interface{}, error) {
+	data := map[string]interface{}{
+		"TYPE":        lunMigrationType,
+		"PARENTID":    srcLunID,
+		"TARGETLUNID": dstLunID,
+		"SPEED":       speed,
+		"WORKMODE":    lunMigrationWorkModeOnline,
+	}
+
+	resp, err := cli.Post(ctx, "/LUN_MIGRATION", data)
+	if err != nil {
+		return nil, err
+	}
+
+	code, ok := utils.GetValue[float64](resp.Error, "code")
+	if !ok {
+		return nil, fmt.Errorf("get code from resp failed, resp: %v", resp)
+	}
+	if code != 0 {
+		return nil, fmt.Errorf("create lun migration from %s to %s error: %d", srcLunID, dstLunID, int64(code))
+	}
+
+	respData, ok := resp.Data.(map[string]interface{})
+	if !ok {
+		return nil, pkgUtils.Errorf(ctx, "convert respData to map failed, data: %v", resp.Data)
+	}
+	return respData, nil
+}
+
+// GetLunMigration used for get the migration task of the source lun, nil is returned if it does not exist
+func (cli *OceanstorClient) GetLunMigration(ctx context.Context, srcLunID string) (map[string]interface{}, error) {
+	url := fmt.Sprintf("/LUN_MIGRATION?filter=PARENTID::%s", srcLunID)
+	resp, err := cli.Get(ctx, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	code, ok := utils.GetValue[float64](resp.Error, "code")
+	if !ok {
+		return nil, fmt.Errorf("get code from resp failed, resp: %v", resp)
+	}
+	if code != 0 {
+		return nil, fmt.Errorf("get migration of lun %s error: %d", srcLunID, int64(code))
+	}
+
+	if resp.Data == nil {
+		log.AddContext(ctx).Infof("Migration of lun %s does not exist", srcLunID)
+		return nil, nil
+	}
+
+	respData, ok := resp.Data.([]interface{})
+	if !ok {
+		return nil, pkgUtils.Errorf(ctx, "convert respData to arr failed, data: %v", resp.Data)
+	}
+	if len(respData) == 0 {
+		log.AddContext(ctx).Infof("Migration of lun %s does not exist", srcLunID)
+		return nil, nil
+	}
+
+	migration, ok := respData[0].(map[string]interface{})
+	if !ok {
+		return nil, pkgUtils.Errorf(ctx, "convert migration to map failed, data: %v", respData[0])
+	}
+	return migration, nil
+}
+
+// DeleteLunMigration used for delete the migration task of the source lun
+func (cli *OceanstorClient) DeleteLunMigration(ctx context.Context, srcLunID string) error {
+	url := fmt.Sprintf("/LUN_MIGRATION/%s", srcLunID)
+	resp, err := cli.Delete(ctx, url, nil)
+	if err != nil {
+		return err
+	}
+
+	code, ok := utils.GetValue[float64](resp.Error, "code")
+	if !ok {
+		return fmt.Errorf("get code from resp failed, resp: %v", resp)
+	}
+	if code != 0 {
+		return fmt.Errorf("delete migration of lun %s error: %d", srcLunID, int64(code))
+	}
+
+	return nil
+}
@@ -44,6 +44,10 @@ type Replication interface {
 	SyncReplicationPair(ctx context.Context, pairID string) error
 	// SplitReplicationPair used for split replication pair by pair id
 	SplitReplicationPair(ctx context.Context, pairID string) error
+	// SwitchReplicationPair used for switch the primary and secondary role of replication pair
+	SwitchReplicationPair(ctx context.Context, pairID string) error
+	// CancelReplicationSecondaryWriteLock used for make the secondary resource of replication pair writable
+	CancelReplicationSecondaryWriteLock(ctx context.Context, pairID string) error
 }
 
 // CreateReplicationPair used for create replication pair
@@ -105,6 +109,44 @@ func (cli *OceanstorClient) SyncReplicationPair(ctx context.Context, pairID stri
 	return nil
 }
 
+// SwitchReplicationPair used for switch the primary and secondary role of replication pair
+func (cli *OceanstorClient) SwitchReplicationPair(ctx context.Context, pairID string) error {
+	data := map[string]interface{}{
+		"ID": pairID,
+	}
+
+	resp, err := cli.Put(ctx, "/REPLICATIONPAIR/switch", data)
+	if err != nil {
+		return err
+	}
+
+	code := int64(resp.Error["code"].(float64))
+	if code != 0 {
+		return fmt.Errorf("Switch replication pair %s error: %d", pairID, code)
+	}
+
+	return nil
+}
+
+// CancelReplicationSecondaryWriteLock used for make the secondary resource of replication pair writable
+func (cli *OceanstorClient) CancelReplicationSecondaryWriteLock(ctx context.Context, pairID string) error {
+	data := map[string]interface{}{
+		"ID": pairID,
+	}
+
+	resp, err := cli.Put(ctx, "/REPLICATIONPAIR/CANCEL_SECODARY_WRITE_LOCK", data)
+	if err != nil {
+		return err
+	}
+
+	code := int64(resp.Error["code"].(float64))
+	if code != 0 {
+		return fmt.Errorf("Cancel secondary write lock of replication pair %s error: %d", pairID, code)
+	}
+
+	return nil
+}
+
 // DeleteReplicationPair used for delete replication pair by pair id
 func (cli *OceanstorClient) DeleteReplicationPair(ctx context.Context, pairID string) error {
 	url := fmt.Sprintf("/REPLICATIONPAIR/%s", pairID)
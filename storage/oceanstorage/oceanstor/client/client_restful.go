@@ -30,6 +30,7 @@ import (
 	"strconv"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
 	pkgUtils "github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/utils"
@@ -37,6 +38,7 @@ import (
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/base"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/metrics"
 )
 
 // RestClient defines client implements the rest interface
@@ -94,7 +96,7 @@ func NewRestClient(ctx context.Context, param *NewClientConfig) (*RestClient, er
 		VStoreName:       param.VstoreName,
 		Client:           httpClient,
 		BackendID:        param.BackendID,
-		RequestSemaphore: utils.NewSemaphore(parallelCount),
+		RequestSemaphore: utils.NewBackendSemaphore(base.RestClientSemaphoreName, param.Name, parallelCount),
 	}, nil
 }
 
@@ -170,6 +172,10 @@ func (cli *RestClient) BaseCall(ctx context.Context, method string, url string,
 		defer storage.RequestSemaphoreMap[storage.UninitializedStorage].Release()
 	}
 
+	start := time.Now()
+	metricsCode := metrics.CodeUnconnected
+	defer func() { metrics.ObserveRestCall(metrics.StorageOceanStor, method, url, metricsCode, start) }()
+
 	resp, err := cli.Client.Do(req)
 	if err != nil {
 		log.AddContext(ctx).Errorf("Send request method: %s, Url: %s, error: %v", method, req.URL, err)
@@ -177,6 +183,7 @@ func (cli *RestClient) BaseCall(ctx context.Context, method string, url string,
 	}
 	defer resp.Body.Close()
 
+	metricsCode = metrics.CodeInvalidResponse
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
 		log.AddContext(ctx).Errorf("Read response data error: %v", err)
@@ -192,6 +199,7 @@ func (cli *RestClient) BaseCall(ctx context.Context, method string, url string,
 		return base.Response{}, err
 	}
 
+	metricsCode = r.MetricsCode()
 	return r, nil
 }
 
@@ -331,7 +339,7 @@ func (cli *RestClient) setDataFromRespData(ctx context.Context, resp base.Respon
 	}
 
 	if storage.RequestSemaphoreMap[cli.DeviceId] == nil {
-		storage.RequestSemaphoreMap[cli.DeviceId] = utils.NewSemaphore(storage.MaxStorageThreads)
+		storage.RequestSemaphoreMap[cli.DeviceId] = storage.NewRequestSemaphore(cli.DeviceId)
 	}
 
 	cli.Token, ok = respData["iBaseToken"].(string)
@@ -394,6 +402,7 @@ func (cli *RestClient) ReLogin(ctx context.Context) error {
 	}
 
 	err := cli.Login(ctx)
+	metrics.IncReLogin(metrics.StorageOceanStor, err)
 	if err != nil {
 		log.AddContext(ctx).Errorf("Try to relogin error: %v", err)
 		return err
@@ -47,10 +47,14 @@ type OceanstorFilesystem interface {
 	SafeDeleteNfsShare(ctx context.Context, id, vStoreID string) error
 	// GetFileSystemByName used for get file system by name
 	GetFileSystemByName(ctx context.Context, name string) (map[string]interface{}, error)
+	// GetFileSystemsByNamePrefix used for get all file systems whose name starts with the prefix
+	GetFileSystemsByNamePrefix(ctx context.Context, prefix string) ([]map[string]interface{}, error)
 	// CreateFileSystem used for create file system
 	CreateFileSystem(ctx context.Context, params map[string]interface{}) (map[string]interface{}, error)
 	// ModifyNfsShareAccess modifies nfs share auth client access value
 	ModifyNfsShareAccess(ctx context.Context, accessID, vStoreID string, accessVal constants.AuthClientAccessVal) error
+	// ModifyNfsShareAccessSquash modifies nfs share auth client all squash and root squash
+	ModifyNfsShareAccessSquash(ctx context.Context, accessID, vStoreID string, allSquash, rootSquash int) error
 	// CheckNfsShareAccessStatus checks access status of nfs share
 	CheckNfsShareAccessStatus(ctx context.Context, sharePath, client, vStoreID string,
 		accessVal constants.AuthClientAccessVal) (bool, error)
@@ -128,6 +132,17 @@ func (cli *OceanstorClient) GetFileSystemByName(ctx context.Context, name string
 	return cli.getObjByvStoreName(respData), nil
 }
 
+// GetFileSystemsByNamePrefix used for get all file systems whose name starts with the prefix
+func (cli *OceanstorClient) GetFileSystemsByNamePrefix(ctx context.Context,
+	prefix string) ([]map[string]interface{}, error) {
+	fsList, err := base.GetBatchObjs(ctx, cli, fmt.Sprintf("/filesystem?filter=NAME:%s", prefix))
+	if err != nil {
+		return nil, fmt.Errorf("get filesystems by name prefix %s failed, error: %w", prefix, err)
+	}
+
+	return cli.filterObjsByNamePrefix(fsList, prefix), nil
+}
+
 // CreateFileSystem used for create file system
 func (cli *OceanstorClient) CreateFileSystem(ctx context.Context, params map[string]interface{}) (
 	map[string]interface{}, error) {
@@ -173,6 +188,23 @@ func (cli *OceanstorClient) ModifyNfsShareAccess(ctx context.Context, accessID,
 	return resp.AssertErrorCode()
 }
 
+// ModifyNfsShareAccessSquash modifies nfs share auth client all squash and root squash
+func (cli *OceanstorClient) ModifyNfsShareAccessSquash(ctx context.Context, accessID, vStoreID string,
+	allSquash, rootSquash int) error {
+	req := map[string]any{
+		"ID":         accessID,
+		"vstoreId":   vStoreID,
+		"ALLSQUASH":  allSquash,
+		"ROOTSQUASH": rootSquash,
+	}
+	resp, err := cli.Put(ctx, "/NFS_SHARE_AUTH_CLIENT", req)
+	if err != nil {
+		return err
+	}
+
+	return resp.AssertErrorCode()
+}
+
 // CheckNfsShareAccessStatus checks access status of nfs share
 func (cli *OceanstorClient) CheckNfsShareAccessStatus(ctx context.Context, sharePath, client, vStoreID string,
 	accessVal constants.AuthClientAccessVal) (bool, error) {
@@ -23,6 +23,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"strconv"
 	"strings"
 	"time"
 
@@ -274,30 +275,9 @@ func (p *Client) getQosName(objID, objType string) string {
 func (p *Client) CreateQos(ctx context.Context,
 	objID, objType, vStoreID string,
 	params map[string]int) (string, error) {
-	var err error
-	var lowerLimit bool
-
-	for k := range params {
-		if strings.HasPrefix(k, "MIN") || strings.HasPrefix(k, "LATENCY") {
-			lowerLimit = true
-		}
-	}
-
-	if lowerLimit {
-		data := map[string]interface{}{
-			"IOPRIORITY": 3,
-		}
-
-		if objType == "fs" {
-			err = p.cli.UpdateFileSystem(ctx, objID, data)
-		} else {
-			err = p.cli.UpdateLun(ctx, objID, data)
-		}
-
-		if err != nil {
-			log.AddContext(ctx).Errorf("Upgrade obj %s of type %s IOPRIORITY error: %v", objID, objID, err)
-			return "", err
-		}
+	err := p.upgradeIOPriority(ctx, objID, objType, params)
+	if err != nil {
+		return "", err
 	}
 
 	name := p.getQosName(objID, objType)
@@ -337,21 +317,9 @@ func (p *Client) DeleteQos(ctx context.Context, qosID, objID, objType, vStoreID
 		return err
 	}
 
-	var objList []string
-
-	listObj := "LUNLIST"
-	if objType == "fs" {
-		listObj = "FSLIST"
-	}
-
-	listStr, ok := qos[listObj].(string)
-	if !ok {
-		return errors.New("qos volume list is expected as marshaled string")
-	}
-
-	err = json.Unmarshal([]byte(listStr), &objList)
+	listObj := getQosListKey(objType)
+	objList, err := getQosObjList(ctx, qos, objType)
 	if err != nil {
-		log.AddContext(ctx).Errorf("Unmarshal %s error: %v", listStr, err)
 		return err
 	}
 
@@ -392,6 +360,182 @@ func (p *Client) DeleteQos(ctx context.Context, qosID, objID, objType, vStoreID
 	return nil
 }
 
+// ModifyQos changes the qos of the object from the qos qosID to the new params.
+// The qos is updated in place if it only serves the object and the new params cover all its limits. Otherwise,
+// since an object can only belong to one qos, the object is removed from the old qos before the new one is created,
+// and it is put back to the old qos if the new one fails to be created.
+func (p *Client) ModifyQos(ctx context.Context, qosID, objID, objType, vStoreID string, params map[string]int) error {
+	qos, err := p.cli.GetQosByID(ctx, qosID, vStoreID)
+	if err != nil {
+		log.AddContext(ctx).Errorf("Get qos by ID %s error: %v", qosID, err)
+		return err
+	}
+
+	objList, err := getQosObjList(ctx, qos, objType)
+	if err != nil {
+		return err
+	}
+
+	oldParams := getQosParams(qos)
+	if len(objList) == 1 && objList[0] == objID && coversQosLimits(params, oldParams) {
+		return p.updateQos(ctx, qosID, objID, objType, vStoreID, params)
+	}
+
+	if err := p.DeleteQos(ctx, qosID, objID, objType, vStoreID); err != nil {
+		return err
+	}
+
+	if _, err := p.CreateQos(ctx, objID, objType, vStoreID, params); err != nil {
+		p.restoreQos(ctx, qosID, objID, objType, vStoreID, objList, oldParams)
+		return err
+	}
+
+	return nil
+}
+
+// GetQosConfig returns the qos qosID in the format of the qos parameter of the StorageClass,
+// so that the qos can be restored by the config, the latency of DoradoV6 and V7 is converted back to millisecond.
+func (p *Client) GetQosConfig(ctx context.Context, qosID, vStoreID string,
+	product constants.OceanstorVersion) (string, error) {
+	qos, err := p.cli.GetQosByID(ctx, qosID, vStoreID)
+	if err != nil {
+		log.AddContext(ctx).Errorf("Get qos by ID %s error: %v", qosID, err)
+		return "", err
+	}
+
+	params := getQosParams(qos)
+	if latency, exist := params["LATENCY"]; exist && product.IsDoradoV6OrV7() {
+		params["LATENCY"] = latency / kilo
+	}
+
+	config, err := json.Marshal(params)
+	if err != nil {
+		return "", fmt.Errorf("marshal qos %v error: %w", params, err)
+	}
+
+	return string(config), nil
+}
+
+func (p *Client) updateQos(ctx context.Context, qosID, objID, objType, vStoreID string, params map[string]int) error {
+	if err := p.upgradeIOPriority(ctx, objID, objType, params); err != nil {
+		return err
+	}
+
+	data := make(map[string]interface{}, len(params))
+	for k, v := range params {
+		data[k] = v
+	}
+
+	if err := p.cli.UpdateQos(ctx, qosID, vStoreID, data); err != nil {
+		log.AddContext(ctx).Errorf("Update qos %s to %v error: %v", qosID, params, err)
+		return err
+	}
+
+	return nil
+}
+
+func (p *Client) restoreQos(ctx context.Context, qosID, objID, objType, vStoreID string,
+	objList []string, oldParams map[string]int) {
+	var err error
+	if len(objList) > 1 {
+		// the old qos is still serving other objects, just put the object back
+		err = p.cli.UpdateQos(ctx, qosID, vStoreID, map[string]interface{}{getQosListKey(objType): objList})
+	} else {
+		_, err = p.CreateQos(ctx, objID, objType, vStoreID, oldParams)
+	}
+
+	if err != nil {
+		log.AddContext(ctx).Errorf("Restore qos %v of obj %s of type %s error: %v",
+			oldParams, objID, objType, err)
+	}
+}
+
+func (p *Client) upgradeIOPriority(ctx context.Context, objID, objType string, params map[string]int) error {
+	var lowerLimit bool
+	for k := range params {
+		if strings.HasPrefix(k, "MIN") || strings.HasPrefix(k, "LATENCY") {
+			lowerLimit = true
+		}
+	}
+
+	if !lowerLimit {
+		return nil
+	}
+
+	var err error
+	data := map[string]interface{}{
+		"IOPRIORITY": 3,
+	}
+
+	if objType == "fs" {
+		err = p.cli.UpdateFileSystem(ctx, objID, data)
+	} else {
+		err = p.cli.UpdateLun(ctx, objID, data)
+	}
+
+	if err != nil {
+		log.AddContext(ctx).Errorf("Upgrade obj %s of type %s IOPRIORITY error: %v", objID, objType, err)
+		return err
+	}
+
+	return nil
+}
+
+func getQosListKey(objType string) string {
+	if objType == "fs" {
+		return "FSLIST"
+	}
+
+	return "LUNLIST"
+}
+
+func getQosObjList(ctx context.Context, qos map[string]interface{}, objType string) ([]string, error) {
+	listStr, ok := qos[getQosListKey(objType)].(string)
+	if !ok {
+		return nil, errors.New("qos volume list is expected as marshaled string")
+	}
+
+	var objList []string
+	if err := json.Unmarshal([]byte(listStr), &objList); err != nil {
+		log.AddContext(ctx).Errorf("Unmarshal %s error: %v", listStr, err)
+		return nil, err
+	}
+
+	return objList, nil
+}
+
+// getQosParams returns the limits and io type set in the qos
+func getQosParams(qos map[string]interface{}) map[string]int {
+	params := make(map[string]int)
+	for key := range oceanStorCommonParameters {
+		if value, err := strconv.Atoi(utils.GetValueOrFallback(qos, key, "")); err == nil && value > 0 {
+			params[key] = value
+		}
+	}
+
+	if value, err := strconv.Atoi(utils.GetValueOrFallback(qos, "IOTYPE", "")); err == nil {
+		params["IOTYPE"] = value
+	}
+
+	return params
+}
+
+// coversQosLimits checks whether all the limits of the old qos are set in the new params,
+// otherwise the limits not in the new params would be kept by updating the old qos in place.
+func coversQosLimits(params, oldParams map[string]int) bool {
+	for key := range oldParams {
+		if _, ok := oceanStorCommonParameters[key]; !ok {
+			continue
+		}
+
+		if _, ok := params[key]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
 // CreateLunSnapshot creates lun snapshot
 func (p *Client) CreateLunSnapshot(ctx context.Context, name, srcLunID string) (map[string]interface{}, error) {
 	snapshot, err := p.cli.CreateLunSnapshot(ctx, name, srcLunID)
@@ -69,6 +69,7 @@ func (p *Base) commonPreCreate(ctx context.Context, params map[string]interface{
 		p.getQoS,
 		p.getFileMode,
 		p.getMetroPairSyncSpeed,
+		p.getReplicationSyncPeriod,
 	}
 
 	for _, analyzer := range analyzers {
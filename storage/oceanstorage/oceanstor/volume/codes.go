@@ -28,14 +28,13 @@ const (
 	remoteDeviceHealthStatus        = "1"
 	remoteDeviceRunningStatusLinkUp = "10"
 
-	replicationPairRunningStatusNormal = "1"
-	replicationPairRunningStatusSync   = "23"
-
 	replicationVStorePairRunningStatusNormal = "1"
 	replicationVStorePairRunningStatusSync   = "23"
 
 	replicationRolePrimary = "0"
 
+	replicationPairHealthStatusFault = "2"
+
 	systemVStore = "0"
 
 	hyperMetroPairHealthStatusFault = "2"
@@ -45,16 +44,19 @@ const (
 	hyperMetroPairRunningStatusSyncing = "23"
 	hyperMetroPairRunningStatusInvalid = "35"
 	hyperMetroPairRunningStatusPause   = "41"
+	hyperMetroPairRunningStatusForced  = "93"
 	hyperMetroPairRunningStatusError   = "94"
 	hyperMetroPairRunningStatusToSync  = "100"
 
 	hyperMetroDomainRunningStatusNormal = "1"
 
-	lunCopyHealthStatusFault    = "2"
-	lunCopyRunningStatusQueuing = "37"
-	lunCopyRunningStatusCopying = "39"
-	lunCopyRunningStatusStop    = "38"
-	lunCopyRunningStatusPaused  = "41"
+	lunCopyHealthStatusFault      = "2"
+	lunCopyRunningStatusNormal    = "36"
+	lunCopyRunningStatusQueuing   = "37"
+	lunCopyRunningStatusCopying   = "39"
+	lunCopyRunningStatusStop      = "38"
+	lunCopyRunningStatusCompleted = "40"
+	lunCopyRunningStatusPaused    = "41"
 
 	clonePairHealthStatusFault         = "1"
 	clonePairRunningStatusUnsyncing    = "0"
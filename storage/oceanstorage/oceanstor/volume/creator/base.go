@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2024-2025. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2024-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -53,6 +53,8 @@ type BaseCreator struct {
 	allocType          int
 	isShowSnapDir      *bool
 	snapshotReservePer *int
+	smartDedupe        *bool
+	smartCompression   *bool
 
 	qos map[string]int
 
@@ -106,6 +108,14 @@ func (c *BaseCreator) Init(params *Parameter) {
 		c.snapshotReservePer = &val
 	}
 
+	if val, ok := params.SmartDedupe(); ok {
+		c.smartDedupe = &val
+	}
+
+	if val, ok := params.SmartCompression(); ok {
+		c.smartCompression = &val
+	}
+
 	if params.Product().IsDoradoV6OrV7() && params.IsHyperMetro() {
 		c.vStoreId = c.cli.GetvStoreID()
 	}
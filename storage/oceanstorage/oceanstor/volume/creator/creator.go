@@ -67,6 +67,8 @@ func NewFromParameters(
 		return NewModifyCreatorFromParams(activeCli, standbyCli, params), nil
 	} else if params.IsHyperMetro() {
 		return NewHyperMetroCreatorFromParams(activeCli, standbyCli, params), nil
+	} else if params.IsReplication() {
+		return NewReplicationCreatorFromParams(activeCli, standbyCli, params), nil
 	}
 
 	return newSingle(params, activeCli), nil
@@ -83,7 +85,7 @@ func validateParameters(
 		return ErrNotFoundCli
 	}
 
-	if params.IsHyperMetro() && standbyCli == nil {
+	if (params.IsHyperMetro() || params.IsReplication()) && standbyCli == nil {
 		return ErrNotFoundCli
 	}
 
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2024-2025. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2024-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -205,6 +205,14 @@ func (creator *FilesystemCreator) genCreateRequest(ctx context.Context, poolId s
 		req["SNAPSHOTRESERVEPER"] = *creator.snapshotReservePer
 	}
 
+	if creator.smartDedupe != nil {
+		req["ENABLEDEDUP"] = *creator.smartDedupe
+	}
+
+	if creator.smartCompression != nil {
+		req["ENABLECOMPRESSION"] = *creator.smartCompression
+	}
+
 	if creator.workloadTypeID != "" {
 		id, err := strconv.ParseUint(creator.workloadTypeID, 0, 32)
 		if err != nil {
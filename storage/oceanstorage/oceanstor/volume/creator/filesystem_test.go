@@ -0,0 +1,58 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package creator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/oceanstor/client"
+)
+
+func TestFilesystemCreator_genCreateRequest_WithSmartDedupeAndCompression(t *testing.T) {
+	// arrange
+	params := NewParameter(map[string]any{
+		PvcNameKey:          "test-fs",
+		SmartDedupeKey:      true,
+		SmartCompressionKey: false,
+	})
+	creator := NewFsCreatorFromParams(&client.OceanstorClient{}, params)
+
+	// action
+	req, err := creator.genCreateRequest(context.Background(), "pool-id")
+
+	// assert
+	require.NoError(t, err)
+	assert.Equal(t, true, req["ENABLEDEDUP"])
+	assert.Equal(t, false, req["ENABLECOMPRESSION"])
+}
+
+func TestFilesystemCreator_genCreateRequest_WithoutSmartDedupeAndCompression(t *testing.T) {
+	// arrange
+	creator := NewFsCreatorFromParams(&client.OceanstorClient{}, NewParameter(map[string]any{PvcNameKey: "test-fs"}))
+
+	// action
+	req, err := creator.genCreateRequest(context.Background(), "pool-id")
+
+	// assert
+	require.NoError(t, err)
+	assert.NotContains(t, req, "ENABLEDEDUP")
+	assert.NotContains(t, req, "ENABLECOMPRESSION")
+}
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2024-2025. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2024-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -112,6 +112,12 @@ const (
 	VStorePairIdKey = "vstorepairid"
 	// ReplicationKey is the string of Replication's key
 	ReplicationKey = "replication"
+	// ReplicationSyncPeriodKey is the string of ReplicationSyncPeriod's key
+	ReplicationSyncPeriodKey = "replicationsyncperiod"
+	// ReplicationVStorePairIdKey is the string of ReplicationVStorePairId's key
+	ReplicationVStorePairIdKey = "replicationVStorePairID"
+	// RemoteDeviceIdKey is the string of RemoteDeviceId's key
+	RemoteDeviceIdKey = "remoteDeviceID"
 	// FsPermissionKey is the string of FsPermission's key
 	FsPermissionKey = "fspermission"
 	// SnapshotFromKey is the string of FromSnapshot's key
@@ -126,6 +132,10 @@ const (
 	IsShowSnapDirKey = "isshowsnapdir"
 	// SnapshotReservePerKey is the string of SnapshotReservePer's key
 	SnapshotReservePerKey = "reservedsnapshotspaceratio"
+	// SmartDedupeKey is the string of SmartDedupe's key
+	SmartDedupeKey = "smartdedupe"
+	// SmartCompressionKey is the string of SmartCompression's key
+	SmartCompressionKey = "smartcompression"
 	// AccessKrb5Key is the string of AccessKrb5's key
 	AccessKrb5Key = "accesskrb5"
 	// AccessKrb5iKey is the string of AccessKrb5i's key
@@ -257,6 +267,21 @@ func (p *Parameter) IsReplication() bool {
 	return utils.GetValueOrFallback(p.params, ReplicationKey, false)
 }
 
+// ReplicationSyncPeriod gets the ReplicationSyncPeriod value of the params map.
+func (p *Parameter) ReplicationSyncPeriod() int {
+	return utils.GetValueOrFallback(p.params, ReplicationSyncPeriodKey, 0)
+}
+
+// ReplicationVStorePairId gets the ReplicationVStorePairId value of the params map.
+func (p *Parameter) ReplicationVStorePairId() string {
+	return utils.GetValueOrFallback(p.params, ReplicationVStorePairIdKey, "")
+}
+
+// RemoteDeviceId gets the RemoteDeviceId value of the params map.
+func (p *Parameter) RemoteDeviceId() string {
+	return utils.GetValueOrFallback(p.params, RemoteDeviceIdKey, "")
+}
+
 // FsPermission gets the FsPermission value of the params map.
 func (p *Parameter) FsPermission() string {
 	return utils.GetValueOrFallback(p.params, FsPermissionKey, "")
@@ -302,6 +327,16 @@ func (p *Parameter) SnapshotReservePer() (int, bool) {
 	return utils.GetValue[int](p.params, SnapshotReservePerKey)
 }
 
+// SmartDedupe gets the SmartDedupe value of the params map.
+func (p *Parameter) SmartDedupe() (bool, bool) {
+	return utils.GetValue[bool](p.params, SmartDedupeKey)
+}
+
+// SmartCompression gets the SmartCompression value of the params map.
+func (p *Parameter) SmartCompression() (bool, bool) {
+	return utils.GetValue[bool](p.params, SmartCompressionKey)
+}
+
 // AccessKrb5 gets the AccessKrb5 value of the params map.
 func (p *Parameter) AccessKrb5() int {
 	val := AccessKrb(utils.GetValueOrFallback(p.params, AccessKrb5Key, ""))
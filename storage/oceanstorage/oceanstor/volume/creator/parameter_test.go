@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2024-2025. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2024-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -460,6 +460,21 @@ func TestParameters_ProductIsV6(t *testing.T) {
 	require.True(t, got)
 }
 
+func TestParameter_SmartDedupeAndCompression(t *testing.T) {
+	// arrange
+	in := map[string]any{"smartdedupe": true}
+	params := creator.NewParameter(in)
+
+	// action
+	dedupe, dedupeOk := params.SmartDedupe()
+	_, compressionOk := params.SmartCompression()
+
+	// assert
+	require.True(t, dedupe)
+	require.True(t, dedupeOk)
+	require.False(t, compressionOk)
+}
+
 func TestParameter_AdvancedOptions(t *testing.T) {
 	// arrange
 	value := `{"CAPACITYTHRESHOLD": 90}`
@@ -0,0 +1,158 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package creator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/oceanstor/client"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
+)
+
+const (
+	// ReplicationLunResType is the resource type of the lun in the replication pair
+	ReplicationLunResType = 11
+	// ReplicationFsResType is the resource type of the filesystem in the replication pair
+	ReplicationFsResType = 40
+
+	// ReplicationPairRunningStatusNormal is the running status of the replication pair which is normal
+	ReplicationPairRunningStatusNormal = "1"
+	// ReplicationPairRunningStatusSync is the running status of the replication pair which is synchronizing
+	ReplicationPairRunningStatusSync = "23"
+
+	// asynchronous remote replication
+	replicationModelAsync = 2
+	// the timed synchronization starts counting when the previous synchronization begins
+	replicationSyncTypeTimedWaitAfterBegin = 2
+	replicationSpeedHighest                = 4
+)
+
+// ReplicationPairParam is the parameter to create the async remote replication pair
+type ReplicationPairParam struct {
+	LocalResID     string
+	RemoteResID    string
+	RemoteDeviceID string
+	VStorePairID   string
+	ResType        int
+	SyncPeriod     int
+}
+
+// CreateReplicationPair creates the async remote replication pair and starts the first synchronization,
+// the pair is deleted if the synchronization fails to start
+func CreateReplicationPair(ctx context.Context, cli client.OceanstorClientInterface,
+	param *ReplicationPairParam) (string, error) {
+	data := map[string]interface{}{
+		"LOCALRESID":       param.LocalResID,
+		"LOCALRESTYPE":     param.ResType,
+		"REMOTEDEVICEID":   param.RemoteDeviceID,
+		"REMOTERESID":      param.RemoteResID,
+		"REPLICATIONMODEL": replicationModelAsync,
+		"SYNCHRONIZETYPE":  replicationSyncTypeTimedWaitAfterBegin,
+		"TIMINGVAL":        param.SyncPeriod,
+		"SPEED":            replicationSpeedHighest,
+	}
+	if param.VStorePairID != "" {
+		data["VSTOREPAIRID"] = param.VStorePairID
+	}
+
+	pair, err := cli.CreateReplicationPair(ctx, data)
+	if err != nil {
+		log.AddContext(ctx).Errorf("Create replication pair error: %v", err)
+		return "", err
+	}
+
+	pairID, ok := pair["ID"].(string)
+	if !ok {
+		return "", fmt.Errorf("convert replication pairID to string failed, data: %v", pair["ID"])
+	}
+
+	err = cli.SyncReplicationPair(ctx, pairID)
+	if err != nil {
+		log.AddContext(ctx).Errorf("Sync replication pair %s error: %v", pairID, err)
+		if delErr := cli.DeleteReplicationPair(ctx, pairID); delErr != nil {
+			log.AddContext(ctx).Errorf("Delete replication pair %s error: %v", pairID, delErr)
+		}
+		return "", err
+	}
+
+	return pairID, nil
+}
+
+// RevertReplicationPair splits the replication pair if it is running, then deletes it
+func RevertReplicationPair(ctx context.Context, cli client.OceanstorClientInterface, pairID string) error {
+	pair, err := cli.GetReplicationPairByID(ctx, pairID)
+	if err != nil {
+		return err
+	}
+
+	if IsReplicationPairRunning(pair) {
+		if err := cli.SplitReplicationPair(ctx, pairID); err != nil {
+			log.AddContext(ctx).Warningf("Split replication pair %s error: %v", pairID, err)
+		}
+	}
+
+	return cli.DeleteReplicationPair(ctx, pairID)
+}
+
+// GetReplicationPairID returns the id of the existing replication pair between the local and remote resource
+func GetReplicationPairID(ctx context.Context, cli client.OceanstorClientInterface,
+	localResID, remoteResID string, resType int) (string, error) {
+	pairs, err := GetPrimaryReplicationPairs(ctx, cli, localResID, resType)
+	if err != nil {
+		return "", err
+	}
+
+	for _, pair := range pairs {
+		if pair["REMOTERESID"] == remoteResID {
+			pairID, _ := utils.GetValue[string](pair, "ID")
+			return pairID, nil
+		}
+	}
+
+	return "", nil
+}
+
+// GetPrimaryReplicationPairs returns the replication pairs whose local resource is the primary end,
+// the pairs of which local resource is the secondary end are managed by the remote site.
+func GetPrimaryReplicationPairs(ctx context.Context, cli client.OceanstorClientInterface,
+	resID string, resType int) ([]map[string]interface{}, error) {
+	pairs, err := cli.GetReplicationPairByResID(ctx, resID, resType)
+	if err != nil {
+		log.AddContext(ctx).Errorf("Get replication pairs of resource %s error: %v", resID, err)
+		return nil, err
+	}
+
+	var primaryPairs []map[string]interface{}
+	for _, pair := range pairs {
+		if role, _ := utils.GetValue[string](pair, "ISPRIMARY"); role == "false" {
+			log.AddContext(ctx).Warningf("Local resource %s of replication pair %v is not primary, skip it",
+				resID, pair["ID"])
+			continue
+		}
+		primaryPairs = append(primaryPairs, pair)
+	}
+
+	return primaryPairs, nil
+}
+
+// IsReplicationPairRunning checks whether the replication pair is normal or synchronizing
+func IsReplicationPairRunning(pair map[string]interface{}) bool {
+	status, _ := utils.GetValue[string](pair, "RUNNINGSTATUS")
+	return status == ReplicationPairRunningStatusNormal || status == ReplicationPairRunningStatusSync
+}
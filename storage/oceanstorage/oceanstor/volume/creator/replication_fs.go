@@ -0,0 +1,157 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package creator provides creator of volume
+package creator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/oceanstor/client"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
+)
+
+var _ VolumeCreator = (*ReplicationFsCreator)(nil)
+
+// ReplicationFsCreator is the filesystem creator that creates a filesystem protected by async remote replication.
+type ReplicationFsCreator struct {
+	*BaseCreator
+	active  SingleVolumeCreator
+	standby StandbyVolumeCreator
+
+	remoteDeviceId          string
+	replicationVStorePairId string
+	syncPeriod              int
+}
+
+// NewReplicationCreatorFromParams returns an instance of ReplicationFsCreator
+func NewReplicationCreatorFromParams(
+	activeCli client.OceanstorClientInterface,
+	standbyCli client.OceanstorClientInterface,
+	params *Parameter,
+) *ReplicationFsCreator {
+	// the replication pair can only be created after the clone filesystem is split from its parent.
+	params.SetWaitForSplit(true)
+	activeCreator := newSingle(params, activeCli)
+
+	// the remote filesystem is the secondary end of the replication pair, which is read-only before failover,
+	// so, it'll skip nfs share and qos creation when create the filesystem on the replica storage.
+	isSkipNfsShare := params.IsSkipNfsShareAndQos()
+	params.SetIsSkipNfsShare(true)
+	standbyCreator := NewFsCreatorFromParams(standbyCli, params)
+	standbyCreator.storagePoolName = params.RemoteStoragePool()
+	standbyCreator.storagePoolId = params.RemotePoolId()
+	params.SetIsSkipNfsShare(isSkipNfsShare)
+
+	base := &BaseCreator{cli: activeCli}
+	base.Init(params)
+	return &ReplicationFsCreator{
+		BaseCreator:             base,
+		active:                  activeCreator,
+		standby:                 standbyCreator,
+		remoteDeviceId:          params.RemoteDeviceId(),
+		replicationVStorePairId: params.ReplicationVStorePairId(),
+		syncPeriod:              params.ReplicationSyncPeriod(),
+	}
+}
+
+// CreateVolume creates a replication filesystem volume on the storage backend.
+func (creator *ReplicationFsCreator) CreateVolume(ctx context.Context) (utils.Volume, error) {
+	var activeFs utils.Volume
+	var standbyFs utils.Volume
+	creator.transaction.Then(func() error {
+		var err error
+		activeFs, err = creator.active.CreateVolume(ctx)
+		return err
+	}, func() {
+		creator.active.rollback(ctx)
+	})
+	creator.transaction.Then(func() error {
+		var err error
+		creator.standby.setStandbyParameters(creator.active.getCreatedFilesystem())
+		standbyFs, err = creator.standby.CreateVolume(ctx)
+		if err != nil {
+			return err
+		}
+		if activeFs.GetVolumeName() != standbyFs.GetVolumeName() {
+			return fmt.Errorf("the volume of primary end and that of the secondary end not match")
+		}
+		return nil
+	}, func() {
+		creator.standby.rollback(ctx)
+	})
+
+	var pairId string
+	creator.transaction.Then(func() error {
+		var err error
+		pairId, err = creator.createReplicationPair(ctx, activeFs.GetID(), standbyFs.GetID())
+		return err
+	}, func() {
+		if err := creator.rollbackReplicationPair(ctx, pairId); err != nil {
+			log.AddContext(ctx).Errorf("failed to rollback replication pair %s, error: %v", pairId, err)
+		}
+	})
+	err := creator.transaction.Commit()
+	if err != nil {
+		creator.rollback(ctx)
+		return nil, err
+	}
+
+	volume := utils.NewVolume(activeFs.GetVolumeName())
+	volume.SetID(activeFs.GetID())
+	volume.SetSize(utils.TransK8SCapacity(creator.capacity, constants.AllocationUnitBytes))
+	return volume, nil
+}
+
+func (creator *ReplicationFsCreator) rollback(ctx context.Context) {
+	creator.transaction.Rollback()
+}
+
+func (creator *ReplicationFsCreator) createReplicationPair(ctx context.Context,
+	localFsId, remoteFsId string) (string, error) {
+	pairId, err := GetReplicationPairID(ctx, creator.cli, localFsId, remoteFsId, ReplicationFsResType)
+	if err != nil {
+		return "", fmt.Errorf("get replication pairs of filesystem %s error: %w", localFsId, err)
+	}
+	if pairId != "" {
+		return pairId, nil
+	}
+
+	pairId, err = CreateReplicationPair(ctx, creator.cli, &ReplicationPairParam{
+		LocalResID:     localFsId,
+		RemoteResID:    remoteFsId,
+		RemoteDeviceID: creator.remoteDeviceId,
+		VStorePairID:   creator.replicationVStorePairId,
+		ResType:        ReplicationFsResType,
+		SyncPeriod:     creator.syncPeriod,
+	})
+	if err != nil {
+		return "", fmt.Errorf("create nas replication pair error: %w", err)
+	}
+
+	return pairId, nil
+}
+
+func (creator *ReplicationFsCreator) rollbackReplicationPair(ctx context.Context, pairId string) error {
+	if pairId == "" {
+		return nil
+	}
+
+	return RevertReplicationPair(ctx, creator.cli, pairId)
+}
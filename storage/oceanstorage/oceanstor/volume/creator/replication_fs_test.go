@@ -0,0 +1,128 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package creator provides creator of volume
+package creator
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/test/mocks/mock_client"
+)
+
+func newReplicationTestParams(skipNfsShare bool) *Parameter {
+	return NewParameter(map[string]any{
+		PvcNameKey:               "test-fs",
+		PoolIDKey:                "pool-1",
+		RemotePoolIdKey:          "remote-pool-1",
+		CapacityKey:              int64(2097152),
+		ReplicationKey:           true,
+		RemoteDeviceIdKey:        "device-1",
+		ReplicationSyncPeriodKey: 600,
+		IsSkipNfsShareAndQoS:     skipNfsShare,
+	})
+}
+
+func TestNewReplicationCreatorFromParams(t *testing.T) {
+	// arrange
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	activeCli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	standbyCli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	params := newReplicationTestParams(false)
+
+	// act
+	creator := NewReplicationCreatorFromParams(activeCli, standbyCli, params)
+
+	// assert
+	active, ok := creator.active.(*FilesystemCreator)
+	assert.True(t, ok)
+	assert.True(t, active.isCreateNfsShare)
+	standby, ok := creator.standby.(*FilesystemCreator)
+	assert.True(t, ok)
+	assert.False(t, standby.isCreateNfsShare)
+	assert.Equal(t, "remote-pool-1", standby.storagePoolId)
+	assert.False(t, params.IsSkipNfsShareAndQos())
+	assert.Equal(t, 600, creator.syncPeriod)
+}
+
+func TestReplicationFsCreator_CreateVolume_Success(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	activeCli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	standbyCli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	creator := NewReplicationCreatorFromParams(activeCli, standbyCli, newReplicationTestParams(true))
+
+	// mock
+	activeCli.EXPECT().GetFileSystemByName(ctx, "test-fs").Return(nil, nil)
+	activeCli.EXPECT().CreateFileSystem(ctx, gomock.Any()).Return(map[string]any{"ID": "fs-1"}, nil)
+	standbyCli.EXPECT().GetFileSystemByName(ctx, "test-fs").Return(nil, nil)
+	standbyCli.EXPECT().CreateFileSystem(ctx, gomock.Any()).Return(map[string]any{"ID": "remote-fs-1"}, nil)
+	activeCli.EXPECT().GetReplicationPairByResID(ctx, "fs-1", ReplicationFsResType).Return(nil, nil)
+	activeCli.EXPECT().CreateReplicationPair(ctx, map[string]any{
+		"LOCALRESID":       "fs-1",
+		"LOCALRESTYPE":     ReplicationFsResType,
+		"REMOTEDEVICEID":   "device-1",
+		"REMOTERESID":      "remote-fs-1",
+		"REPLICATIONMODEL": replicationModelAsync,
+		"SYNCHRONIZETYPE":  replicationSyncTypeTimedWaitAfterBegin,
+		"TIMINGVAL":        600,
+		"SPEED":            replicationSpeedHighest,
+	}).Return(map[string]any{"ID": "pair-1"}, nil)
+	activeCli.EXPECT().SyncReplicationPair(ctx, "pair-1").Return(nil)
+
+	// act
+	volume, err := creator.CreateVolume(ctx)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, "test-fs", volume.GetVolumeName())
+	assert.Equal(t, "fs-1", volume.GetID())
+}
+
+func TestReplicationFsCreator_CreateVolume_CreatePairFailed(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	activeCli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	standbyCli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	creator := NewReplicationCreatorFromParams(activeCli, standbyCli, newReplicationTestParams(true))
+
+	// mock
+	activeCli.EXPECT().GetFileSystemByName(ctx, "test-fs").Return(nil, nil)
+	activeCli.EXPECT().CreateFileSystem(ctx, gomock.Any()).Return(map[string]any{"ID": "fs-1"}, nil)
+	standbyCli.EXPECT().GetFileSystemByName(ctx, "test-fs").Return(nil, nil)
+	standbyCli.EXPECT().CreateFileSystem(ctx, gomock.Any()).Return(map[string]any{"ID": "remote-fs-1"}, nil)
+	activeCli.EXPECT().GetReplicationPairByResID(ctx, "fs-1", ReplicationFsResType).Return(nil, nil)
+	activeCli.EXPECT().CreateReplicationPair(ctx, gomock.Any()).Return(nil, errors.New("mock error"))
+	standbyCli.EXPECT().DeleteFileSystem(ctx, map[string]any{"ID": "remote-fs-1"}).Return(nil)
+	activeCli.EXPECT().DeleteFileSystem(ctx, map[string]any{"ID": "fs-1"}).Return(nil)
+
+	// act
+	volume, err := creator.CreateVolume(ctx)
+
+	// assert
+	assert.Nil(t, volume)
+	assert.ErrorContains(t, err, "mock error")
+}
@@ -0,0 +1,95 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package creator
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/test/mocks/mock_client"
+)
+
+func TestCreateReplicationPair_SyncFailed(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	param := &ReplicationPairParam{LocalResID: "lun-1", RemoteResID: "remote-lun-1", RemoteDeviceID: "device-1",
+		VStorePairID: "vstore-pair-1", ResType: ReplicationLunResType, SyncPeriod: 600}
+
+	// mock
+	cli.EXPECT().CreateReplicationPair(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, data map[string]interface{}) (map[string]interface{}, error) {
+			assert.Equal(t, "vstore-pair-1", data["VSTOREPAIRID"])
+			return map[string]interface{}{"ID": "pair-1"}, nil
+		})
+	cli.EXPECT().SyncReplicationPair(ctx, "pair-1").Return(errors.New("sync error"))
+	cli.EXPECT().DeleteReplicationPair(ctx, "pair-1").Return(nil)
+
+	// act
+	pairID, err := CreateReplicationPair(ctx, cli, param)
+
+	// assert
+	assert.Empty(t, pairID)
+	assert.ErrorContains(t, err, "sync error")
+}
+
+func TestRevertReplicationPair_SplitRunningPair(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+
+	// mock
+	cli.EXPECT().GetReplicationPairByID(ctx, "pair-1").
+		Return(map[string]interface{}{"ID": "pair-1", "RUNNINGSTATUS": ReplicationPairRunningStatusSync}, nil)
+	cli.EXPECT().SplitReplicationPair(ctx, "pair-1").Return(nil)
+	cli.EXPECT().DeleteReplicationPair(ctx, "pair-1").Return(nil)
+
+	// act
+	err := RevertReplicationPair(ctx, cli, "pair-1")
+
+	// assert
+	assert.NoError(t, err)
+}
+
+func TestGetReplicationPairID_SkipSecondaryPair(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+
+	// mock
+	cli.EXPECT().GetReplicationPairByResID(ctx, "fs-1", ReplicationFsResType).Return([]map[string]interface{}{
+		{"ID": "pair-1", "REMOTERESID": "remote-fs-1", "ISPRIMARY": "false"},
+		{"ID": "pair-2", "REMOTERESID": "remote-fs-1", "ISPRIMARY": "true"},
+	}, nil)
+
+	// act
+	pairID, err := GetReplicationPairID(ctx, cli, "fs-1", "remote-fs-1", ReplicationFsResType)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, "pair-2", pairID)
+}
@@ -372,7 +372,7 @@ func (p *DTree) allowShareAccess(ctx context.Context, params, taskResult map[str
 	}, nil
 }
 
-func (p *DTree) getCurrentShareAccess(ctx context.Context, shareID, vStoreID string,
+func (p *Base) getCurrentShareAccess(ctx context.Context, shareID, vStoreID string,
 	cli client.OceanstorClientInterface) (map[string]interface{}, error) {
 	count, err := cli.GetNfsShareAccessCount(ctx, shareID, vStoreID)
 	if err != nil {
@@ -666,8 +666,8 @@ func (p *DTree) Query(ctx context.Context, name, parentName, vstoreID string) (u
 	}
 
 	if dtree == nil {
-		return nil, utils.Errorf(ctx, "dtree to query does not exist, dtree:%q parentName:%q vstoreID:%q ",
-			name, parentName, vstoreID)
+		return nil, fmt.Errorf("%w: dtree to query does not exist, dtree:%q parentName:%q vstoreID:%q",
+			constants.ErrVolumeNotFound, name, parentName, vstoreID)
 	}
 
 	batchQueryParam := map[string]interface{}{
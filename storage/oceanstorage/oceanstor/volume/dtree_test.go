@@ -205,7 +205,8 @@ func Test_QueryVolume_Fail(t *testing.T) {
 		volume, err := dtree.Query(ctx, fakeDTreeName, fakeParentName, fakeVStoreID)
 
 		// assert
-		require.EqualError(t, err, fmt.Sprintf("dtree to query does not exist, dtree:%q parentName:%q vstoreID:%q ",
+		require.ErrorIs(t, err, constants.ErrVolumeNotFound)
+		require.ErrorContains(t, err, fmt.Sprintf("dtree to query does not exist, dtree:%q parentName:%q vstoreID:%q",
 			fakeDTreeName, fakeParentName, fakeVStoreID))
 		require.Nil(t, volume)
 	})
@@ -0,0 +1,413 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package volume
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/oceanstor/volume/creator"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
+)
+
+const (
+	// the secondary resource of replication pair is readable and writable
+	replicationSecondaryAccessReadWrite = "3"
+)
+
+// ErrNotProtectedVolume means the volume is neither protected by remote replication nor hypermetro
+var ErrNotProtectedVolume = fmt.Errorf("%w: volume is not protected by remote replication or hypermetro",
+	constants.ErrFailoverFailed)
+
+// promoteReplicationPairs makes the local resource of the replication pairs serve as the primary,
+// the pair is split and the write lock of the local secondary resource is canceled.
+func (p *Base) promoteReplicationPairs(ctx context.Context, resID string, resType int) error {
+	pairs, err := p.cli.GetReplicationPairByResID(ctx, resID, resType)
+	if err != nil {
+		log.AddContext(ctx).Errorf("Get replication pairs of resource %s error: %v", resID, err)
+		return err
+	}
+	if len(pairs) == 0 {
+		return fmt.Errorf("%w: replication pair of resource %s does not exist",
+			constants.ErrFailoverFailed, resID)
+	}
+
+	for _, pair := range pairs {
+		pairID, ok := utils.GetValue[string](pair, "ID")
+		if !ok {
+			return fmt.Errorf("convert replication pairID to string failed, data: %v", pair["ID"])
+		}
+
+		if role, _ := utils.GetValue[string](pair, "ISPRIMARY"); role != "false" {
+			log.AddContext(ctx).Infof("Local resource %s of replication pair %s is already primary", resID, pairID)
+			continue
+		}
+
+		if creator.IsReplicationPairRunning(pair) {
+			if err := p.cli.SplitReplicationPair(ctx, pairID); err != nil {
+				log.AddContext(ctx).Errorf("Split replication pair %s error: %v", pairID, err)
+				return err
+			}
+		}
+
+		if access, _ := utils.GetValue[string](pair, "SECRESACCESS"); access == replicationSecondaryAccessReadWrite {
+			continue
+		}
+		if err := p.cli.CancelReplicationSecondaryWriteLock(ctx, pairID); err != nil {
+			log.AddContext(ctx).Errorf("Cancel secondary write lock of replication pair %s error: %v", pairID, err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// failbackReplicationPairs resynchronizes the data written on the remote end during the failover back to the
+// local resource and then gives the primary role back to it. It returns true once the local resource is the
+// primary end of all the running pairs.
+func (p *Base) failbackReplicationPairs(ctx context.Context, resID string, resType int) (bool, error) {
+	pairs, err := p.cli.GetReplicationPairByResID(ctx, resID, resType)
+	if err != nil {
+		log.AddContext(ctx).Errorf("Get replication pairs of resource %s error: %v", resID, err)
+		return false, err
+	}
+	if len(pairs) == 0 {
+		return false, fmt.Errorf("%w: replication pair of resource %s does not exist",
+			constants.ErrFailoverFailed, resID)
+	}
+
+	finished := true
+	for _, pair := range pairs {
+		pairFinished, err := p.failbackReplicationPair(ctx, pair)
+		if err != nil {
+			return false, err
+		}
+		finished = finished && pairFinished
+	}
+
+	return finished, nil
+}
+
+func (p *Base) failbackReplicationPair(ctx context.Context, pair map[string]interface{}) (bool, error) {
+	pairID, ok := utils.GetValue[string](pair, "ID")
+	if !ok {
+		return false, fmt.Errorf("convert replication pairID to string failed, data: %v", pair["ID"])
+	}
+
+	role, _ := utils.GetValue[string](pair, "ISPRIMARY")
+	status, _ := utils.GetValue[string](pair, "RUNNINGSTATUS")
+	if role != "false" {
+		if creator.IsReplicationPairRunning(pair) {
+			log.AddContext(ctx).Infof("Replication pair %s is running with local primary, failback finished", pairID)
+			return true, nil
+		}
+
+		// the remote end holds the newest data, hand the primary role over to it so as to resync back.
+		if err := p.cli.SwitchReplicationPair(ctx, pairID); err != nil {
+			log.AddContext(ctx).Errorf("Switch replication pair %s error: %v", pairID, err)
+			return false, err
+		}
+		return false, p.cli.SyncReplicationPair(ctx, pairID)
+	}
+
+	switch status {
+	case creator.ReplicationPairRunningStatusSync:
+		log.AddContext(ctx).Infof("Replication pair %s is synchronizing, wait for it to finish", pairID)
+		return false, nil
+	case creator.ReplicationPairRunningStatusNormal:
+		for _, step := range []func(context.Context, string) error{
+			p.cli.SplitReplicationPair,
+			p.cli.SwitchReplicationPair,
+			p.cli.SyncReplicationPair,
+		} {
+			if err := step(ctx, pairID); err != nil {
+				log.AddContext(ctx).Errorf("Fail back replication pair %s error: %v", pairID, err)
+				return false, err
+			}
+		}
+		return true, nil
+	default:
+		return false, p.cli.SyncReplicationPair(ctx, pairID)
+	}
+}
+
+// promoteHyperMetroPair stops the running hypermetro pair and force starts it, so that the local resource serves alone
+// and becomes the preferred end when the pair is resynchronized
+func (p *Base) promoteHyperMetroPair(ctx context.Context, pair map[string]interface{}) error {
+	pairID, ok := utils.GetValue[string](pair, "ID")
+	if !ok {
+		return fmt.Errorf("convert hypermetro pairID to string failed, data: %v", pair["ID"])
+	}
+
+	status, _ := utils.GetValue[string](pair, "RUNNINGSTATUS")
+	switch status {
+	case hyperMetroPairRunningStatusForced:
+		log.AddContext(ctx).Infof("Hypermetro pair %s has been force started", pairID)
+		return nil
+	case hyperMetroPairRunningStatusNormal, hyperMetroPairRunningStatusToSync, hyperMetroPairRunningStatusSyncing:
+		if err := p.cli.StopHyperMetroPair(ctx, pairID); err != nil {
+			return fmt.Errorf("stop hypermetro pair %s error: %w", pairID, err)
+		}
+	default:
+	}
+
+	if err := p.cli.ForceStartHyperMetroPair(ctx, pairID); err != nil {
+		return fmt.Errorf("force start hypermetro pair %s error: %w", pairID, err)
+	}
+
+	return nil
+}
+
+// failbackHyperMetroPair resynchronizes the hypermetro pair, it returns true once the pair is normal
+func (p *Base) failbackHyperMetroPair(ctx context.Context, pair map[string]interface{}) (bool, error) {
+	pairID, ok := utils.GetValue[string](pair, "ID")
+	if !ok {
+		return false, fmt.Errorf("convert hypermetro pairID to string failed, data: %v", pair["ID"])
+	}
+
+	status, _ := utils.GetValue[string](pair, "RUNNINGSTATUS")
+	switch status {
+	case hyperMetroPairRunningStatusNormal:
+		return true, nil
+	case hyperMetroPairRunningStatusSyncing, hyperMetroPairRunningStatusToSync:
+		log.AddContext(ctx).Infof("Hypermetro pair %s is synchronizing, wait for it to finish", pairID)
+		return false, nil
+	default:
+		return false, p.cli.SyncHyperMetroPair(ctx, pairID)
+	}
+}
+
+// Failover promotes the lun which is the secondary end of the replication or hypermetro pair,
+// and returns the attributes of the lun
+func (p *SAN) Failover(ctx context.Context, name string) (map[string]string, error) {
+	lun, rss, err := p.getProtectedLun(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	lunID, _ := utils.GetValue[string](lun, "ID")
+	if rss["RemoteReplication"] == "TRUE" {
+		err = p.promoteReplicationPairs(ctx, lunID, creator.ReplicationLunResType)
+	} else {
+		err = p.promoteLunHyperMetroPair(ctx, lunID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := map[string]string{}
+	if wwn, ok := utils.GetValue[string](lun, "WWN"); ok {
+		attributes["lunWWN"] = wwn
+	}
+	return attributes, nil
+}
+
+// Failback resynchronizes the lun from the remote end and gives the primary role back to it,
+// it returns true when the failback is finished
+func (p *SAN) Failback(ctx context.Context, name string) (map[string]string, bool, error) {
+	lun, rss, err := p.getProtectedLun(ctx, name)
+	if err != nil {
+		return nil, false, err
+	}
+
+	lunID, _ := utils.GetValue[string](lun, "ID")
+	var finished bool
+	if rss["RemoteReplication"] == "TRUE" {
+		finished, err = p.failbackReplicationPairs(ctx, lunID, creator.ReplicationLunResType)
+	} else {
+		finished, err = p.failbackLunHyperMetroPair(ctx, lunID)
+	}
+	if err != nil || !finished {
+		return nil, false, err
+	}
+
+	attributes := map[string]string{}
+	if wwn, ok := utils.GetValue[string](lun, "WWN"); ok {
+		attributes["lunWWN"] = wwn
+	}
+	return attributes, true, nil
+}
+
+func (p *SAN) getProtectedLun(ctx context.Context, name string) (map[string]interface{},
+	map[string]string, error) {
+	lunName := p.cli.MakeLunName(name)
+	lun, err := p.cli.GetLunByName(ctx, lunName)
+	if err != nil {
+		log.AddContext(ctx).Errorf("Get lun by name %s error: %v", lunName, err)
+		return nil, nil, err
+	}
+	if lun == nil {
+		return nil, nil, fmt.Errorf("%w: lun %s does not exist", constants.ErrFailoverFailed, lunName)
+	}
+
+	rssStr, _ := utils.GetValue[string](lun, "HASRSSOBJECT")
+	var rss map[string]string
+	if err := json.Unmarshal([]byte(rssStr), &rss); err != nil {
+		return nil, nil, fmt.Errorf("unmarshal san HASRSSOBJECT failed, data: %v, err: %w", rssStr, err)
+	}
+
+	if rss["RemoteReplication"] != "TRUE" && rss["HyperMetro"] != "TRUE" {
+		return nil, nil, fmt.Errorf("lun %s: %w", lunName, ErrNotProtectedVolume)
+	}
+
+	return lun, rss, nil
+}
+
+func (p *SAN) promoteLunHyperMetroPair(ctx context.Context, lunID string) error {
+	pair, err := p.cli.GetHyperMetroPairByLocalObjID(ctx, lunID)
+	if err != nil {
+		return err
+	}
+	if pair == nil {
+		return fmt.Errorf("%w: hypermetro pair of lun %s does not exist", constants.ErrFailoverFailed, lunID)
+	}
+
+	return p.promoteHyperMetroPair(ctx, pair)
+}
+
+func (p *SAN) failbackLunHyperMetroPair(ctx context.Context, lunID string) (bool, error) {
+	pair, err := p.cli.GetHyperMetroPairByLocalObjID(ctx, lunID)
+	if err != nil {
+		return false, err
+	}
+	if pair == nil {
+		return false, fmt.Errorf("%w: hypermetro pair of lun %s does not exist", constants.ErrFailoverFailed, lunID)
+	}
+
+	return p.failbackHyperMetroPair(ctx, pair)
+}
+
+// Failover promotes the filesystem which is the secondary end of the replication or hypermetro pair.
+// The secondary filesystem of replication is created without nfs share, so the share is created and
+// the auth clients are granted when promoting it.
+func (p *NAS) Failover(ctx context.Context, fsName string, authClients []string) error {
+	fs, replicationIDs, hyperMetroIDs, err := p.getProtectedFilesystem(ctx, fsName)
+	if err != nil {
+		return err
+	}
+
+	fsID, _ := utils.GetValue[string](fs, "ID")
+	if len(replicationIDs) == 0 {
+		for _, pairID := range hyperMetroIDs {
+			pair, err := p.cli.GetHyperMetroPair(ctx, pairID)
+			if err != nil {
+				return err
+			}
+			if err := p.promoteHyperMetroPair(ctx, pair); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if err := p.promoteReplicationPairs(ctx, fsID, creator.ReplicationFsResType); err != nil {
+		return err
+	}
+
+	if err := p.ensureNfsShare(ctx, fsName, fsID); err != nil {
+		return err
+	}
+
+	if len(authClients) == 0 {
+		return nil
+	}
+	return p.autoManageAuthClient(ctx, fsName, authClients, constants.AuthClientReadWrite)
+}
+
+// Failback resynchronizes the filesystem from the remote end and gives the primary role back to it,
+// it returns true when the failback is finished
+func (p *NAS) Failback(ctx context.Context, fsName string) (bool, error) {
+	fs, replicationIDs, hyperMetroIDs, err := p.getProtectedFilesystem(ctx, fsName)
+	if err != nil {
+		return false, err
+	}
+
+	if len(replicationIDs) != 0 {
+		fsID, _ := utils.GetValue[string](fs, "ID")
+		return p.failbackReplicationPairs(ctx, fsID, creator.ReplicationFsResType)
+	}
+
+	finished := true
+	for _, pairID := range hyperMetroIDs {
+		pair, err := p.cli.GetHyperMetroPair(ctx, pairID)
+		if err != nil {
+			return false, err
+		}
+		pairFinished, err := p.failbackHyperMetroPair(ctx, pair)
+		if err != nil {
+			return false, err
+		}
+		finished = finished && pairFinished
+	}
+
+	return finished, nil
+}
+
+func (p *NAS) getProtectedFilesystem(ctx context.Context, fsName string) (map[string]interface{},
+	[]string, []string, error) {
+	fs, err := p.cli.GetFileSystemByName(ctx, fsName)
+	if err != nil {
+		log.AddContext(ctx).Errorf("Get filesystem %s error: %v", fsName, err)
+		return nil, nil, nil, err
+	}
+	if fs == nil {
+		return nil, nil, nil, fmt.Errorf("%w: filesystem %s does not exist", constants.ErrFailoverFailed, fsName)
+	}
+
+	replicationIDs, err := p.parseReplicationPairs(fs)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	hyperMetroIDs, err := p.parseHyperMetroPairs(fs)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if len(replicationIDs) == 0 && len(hyperMetroIDs) == 0 {
+		return nil, nil, nil, fmt.Errorf("filesystem %s: %w", fsName, ErrNotProtectedVolume)
+	}
+
+	return fs, replicationIDs, hyperMetroIDs, nil
+}
+
+func (p *NAS) ensureNfsShare(ctx context.Context, fsName, fsID string) error {
+	sharePath := utils.GetOriginSharePath(fsName)
+	vStoreID := p.cli.GetvStoreID()
+	share, err := p.cli.GetNfsShareByPath(ctx, sharePath, vStoreID)
+	if err != nil {
+		return fmt.Errorf("get nfs share by path %s error: %w", sharePath, err)
+	}
+	if share != nil {
+		return nil
+	}
+
+	req := map[string]interface{}{
+		"sharepath":   sharePath,
+		"fsid":        fsID,
+		"description": "",
+		"vStoreID":    vStoreID,
+	}
+	if _, err := p.cli.CreateNfsShare(ctx, req); err != nil {
+		return fmt.Errorf("create nfs share %v error: %w", req, err)
+	}
+
+	log.AddContext(ctx).Infof("Create nfs share %s for the promoted filesystem %s", sharePath, fsName)
+	return nil
+}
@@ -0,0 +1,315 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package volume
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/oceanstor/volume/creator"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/test/mocks/mock_client"
+)
+
+func TestSAN_Failover_WithReplication(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	san := NewSAN(cli, nil, nil, constants.OceanStorDoradoV6)
+	lun := map[string]interface{}{
+		"ID":           "lun-456",
+		"WWN":          "wwn-456",
+		"HASRSSOBJECT": `{"RemoteReplication":"TRUE"}`,
+	}
+	pair := map[string]interface{}{
+		"ID":            "pair-1",
+		"ISPRIMARY":     "false",
+		"RUNNINGSTATUS": creator.ReplicationPairRunningStatusNormal,
+		"SECRESACCESS":  "1",
+	}
+
+	// mock
+	cli.EXPECT().MakeLunName("test-lun").Return("test-lun")
+	cli.EXPECT().GetLunByName(ctx, "test-lun").Return(lun, nil)
+	cli.EXPECT().GetReplicationPairByResID(ctx, "lun-456", creator.ReplicationLunResType).
+		Return([]map[string]interface{}{pair}, nil)
+	cli.EXPECT().SplitReplicationPair(ctx, "pair-1").Return(nil)
+	cli.EXPECT().CancelReplicationSecondaryWriteLock(ctx, "pair-1").Return(nil)
+
+	// action
+	attributes, err := san.Failover(ctx, "test-lun")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"lunWWN": "wwn-456"}, attributes)
+}
+
+func TestSAN_Failover_NotProtected(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	san := NewSAN(cli, nil, nil, constants.OceanStorDoradoV6)
+	lun := map[string]interface{}{"ID": "lun-456", "HASRSSOBJECT": `{"RemoteReplication":"FALSE"}`}
+
+	// mock
+	cli.EXPECT().MakeLunName("test-lun").Return("test-lun")
+	cli.EXPECT().GetLunByName(ctx, "test-lun").Return(lun, nil)
+
+	// action
+	_, err := san.Failover(ctx, "test-lun")
+
+	// assert
+	assert.ErrorIs(t, err, ErrNotProtectedVolume)
+	assert.ErrorIs(t, err, constants.ErrFailoverFailed)
+}
+
+func TestSAN_Failover_PairNotExist(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	san := NewSAN(cli, nil, nil, constants.OceanStorDoradoV6)
+	lun := map[string]interface{}{"ID": "lun-456", "HASRSSOBJECT": `{"RemoteReplication":"TRUE"}`}
+
+	// mock
+	cli.EXPECT().MakeLunName("test-lun").Return("test-lun")
+	cli.EXPECT().GetLunByName(ctx, "test-lun").Return(lun, nil)
+	cli.EXPECT().GetReplicationPairByResID(ctx, "lun-456", creator.ReplicationLunResType).Return(nil, nil)
+
+	// action
+	_, err := san.Failover(ctx, "test-lun")
+
+	// assert
+	assert.ErrorIs(t, err, constants.ErrFailoverFailed)
+	assert.ErrorContains(t, err, "replication pair of resource lun-456 does not exist")
+}
+
+func TestSAN_Failover_QueryLunFailed(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	san := NewSAN(cli, nil, nil, constants.OceanStorDoradoV6)
+
+	// mock
+	cli.EXPECT().MakeLunName("test-lun").Return("test-lun")
+	cli.EXPECT().GetLunByName(ctx, "test-lun").Return(nil, errors.New("connect to storage timeout"))
+
+	// action
+	_, err := san.Failover(ctx, "test-lun")
+
+	// assert
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, constants.ErrFailoverFailed)
+}
+
+func TestSAN_Failover_WithHyperMetro(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	san := NewSAN(cli, nil, nil, constants.OceanStorDoradoV6)
+	lun := map[string]interface{}{"ID": "lun-456", "HASRSSOBJECT": `{"HyperMetro":"TRUE"}`}
+	pair := map[string]interface{}{"ID": "metro-1", "RUNNINGSTATUS": hyperMetroPairRunningStatusNormal}
+
+	// mock
+	cli.EXPECT().MakeLunName("test-lun").Return("test-lun")
+	cli.EXPECT().GetLunByName(ctx, "test-lun").Return(lun, nil)
+	cli.EXPECT().GetHyperMetroPairByLocalObjID(ctx, "lun-456").Return(pair, nil)
+	cli.EXPECT().StopHyperMetroPair(ctx, "metro-1").Return(nil)
+	cli.EXPECT().ForceStartHyperMetroPair(ctx, "metro-1").Return(nil)
+
+	// action
+	_, err := san.Failover(ctx, "test-lun")
+
+	// assert
+	assert.NoError(t, err)
+}
+
+func TestBase_promoteHyperMetroPair(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  string
+		mock    func(cli *mock_client.MockOceanstorClientInterface)
+		wantErr bool
+	}{
+		{name: "remote unavailable", status: hyperMetroPairRunningStatusPause,
+			mock: func(cli *mock_client.MockOceanstorClientInterface) {
+				cli.EXPECT().ForceStartHyperMetroPair(gomock.Any(), "metro-1").Return(nil)
+			}},
+		{name: "force started", status: hyperMetroPairRunningStatusForced,
+			mock: func(cli *mock_client.MockOceanstorClientInterface) {}},
+		{name: "force start failed", status: hyperMetroPairRunningStatusError, wantErr: true,
+			mock: func(cli *mock_client.MockOceanstorClientInterface) {
+				cli.EXPECT().ForceStartHyperMetroPair(gomock.Any(), "metro-1").Return(errors.New("mock error"))
+			}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			mockCtrl := gomock.NewController(t)
+			cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+			base := &Base{cli: cli}
+			pair := map[string]interface{}{"ID": "metro-1", "RUNNINGSTATUS": tt.status}
+
+			// mock
+			tt.mock(cli)
+
+			// action
+			err := base.promoteHyperMetroPair(context.Background(), pair)
+
+			// assert
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func TestBase_failbackReplicationPair(t *testing.T) {
+	tests := []struct {
+		name         string
+		pair         map[string]interface{}
+		mock         func(cli *mock_client.MockOceanstorClientInterface)
+		wantFinished bool
+	}{
+		{
+			name: "local primary and running",
+			pair: map[string]interface{}{"ID": "pair-1", "ISPRIMARY": "true", "RUNNINGSTATUS": "1"},
+			mock: func(cli *mock_client.MockOceanstorClientInterface) {}, wantFinished: true,
+		},
+		{
+			name: "local primary and split",
+			pair: map[string]interface{}{"ID": "pair-1", "ISPRIMARY": "true", "RUNNINGSTATUS": "26"},
+			mock: func(cli *mock_client.MockOceanstorClientInterface) {
+				cli.EXPECT().SwitchReplicationPair(gomock.Any(), "pair-1").Return(nil)
+				cli.EXPECT().SyncReplicationPair(gomock.Any(), "pair-1").Return(nil)
+			},
+		},
+		{
+			name: "local secondary and synchronizing",
+			pair: map[string]interface{}{"ID": "pair-1", "ISPRIMARY": "false", "RUNNINGSTATUS": "23"},
+			mock: func(cli *mock_client.MockOceanstorClientInterface) {},
+		},
+		{
+			name: "local secondary and split",
+			pair: map[string]interface{}{"ID": "pair-1", "ISPRIMARY": "false", "RUNNINGSTATUS": "26"},
+			mock: func(cli *mock_client.MockOceanstorClientInterface) {
+				cli.EXPECT().SyncReplicationPair(gomock.Any(), "pair-1").Return(nil)
+			},
+		},
+		{
+			name: "local secondary and synchronized",
+			pair: map[string]interface{}{"ID": "pair-1", "ISPRIMARY": "false", "RUNNINGSTATUS": "1"},
+			mock: func(cli *mock_client.MockOceanstorClientInterface) {
+				gomock.InOrder(
+					cli.EXPECT().SplitReplicationPair(gomock.Any(), "pair-1").Return(nil),
+					cli.EXPECT().SwitchReplicationPair(gomock.Any(), "pair-1").Return(nil),
+					cli.EXPECT().SyncReplicationPair(gomock.Any(), "pair-1").Return(nil),
+				)
+			},
+			wantFinished: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+			san := NewSAN(cli, nil, nil, constants.OceanStorDoradoV6)
+
+			// mock
+			tt.mock(cli)
+
+			// action
+			finished, err := san.failbackReplicationPair(context.Background(), tt.pair)
+
+			// assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantFinished, finished)
+		})
+	}
+}
+
+func TestNAS_Failover_WithReplication(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	nas := NewNAS(cli, nil, nil, constants.OceanStorDoradoV6, NASHyperMetro{}, true)
+	fs := map[string]interface{}{"ID": "fs-456", "REMOTEREPLICATIONIDS": `["pair-1"]`}
+	pair := map[string]interface{}{
+		"ID":            "pair-1",
+		"ISPRIMARY":     "false",
+		"RUNNINGSTATUS": "10",
+		"SECRESACCESS":  replicationSecondaryAccessReadWrite,
+	}
+
+	// mock
+	cli.EXPECT().GetFileSystemByName(ctx, "test-fs").Return(fs, nil)
+	cli.EXPECT().GetReplicationPairByResID(ctx, "fs-456", creator.ReplicationFsResType).
+		Return([]map[string]interface{}{pair}, nil)
+	cli.EXPECT().GetvStoreID().Return("0").AnyTimes()
+	cli.EXPECT().GetNfsShareByPath(ctx, "/test-fs/", "0").Return(nil, nil)
+	cli.EXPECT().CreateNfsShare(ctx, map[string]interface{}{
+		"sharepath":   "/test-fs/",
+		"fsid":        "fs-456",
+		"description": "",
+		"vStoreID":    "0",
+	}).Return(map[string]interface{}{"ID": "share-1"}, nil)
+
+	// action
+	err := nas.Failover(ctx, "test-fs", nil)
+
+	// assert
+	assert.NoError(t, err)
+}
+
+func TestNAS_Failback_WithHyperMetro(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	nas := NewNAS(cli, nil, nil, constants.OceanStorDoradoV6, NASHyperMetro{}, true)
+	fs := map[string]interface{}{"ID": "fs-456", "HYPERMETROPAIRIDS": `["metro-1"]`}
+	pair := map[string]interface{}{"ID": "metro-1", "RUNNINGSTATUS": hyperMetroPairRunningStatusPause}
+
+	// mock
+	cli.EXPECT().GetFileSystemByName(ctx, "test-fs").Return(fs, nil)
+	cli.EXPECT().GetHyperMetroPair(ctx, "metro-1").Return(pair, nil)
+	cli.EXPECT().SyncHyperMetroPair(ctx, "metro-1").Return(nil)
+
+	// action
+	finished, err := nas.Failback(ctx, "test-fs")
+
+	// assert
+	assert.NoError(t, err)
+	assert.False(t, finished)
+}
@@ -0,0 +1,133 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package volume
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
+)
+
+// CreateGroupSnapshot creates the snapshots of the luns and activates them in one request,
+// so the snapshots of the group are consistent with each other. The snapshots are returned in the order of members.
+func (p *SAN) CreateGroupSnapshot(ctx context.Context,
+	members []utils.GroupSnapshotMember) ([]map[string]interface{}, error) {
+	if len(members) == 0 {
+		return nil, errors.New("group snapshot has no member")
+	}
+
+	var created, inactive []string
+	var activated bool
+	for _, member := range members {
+		snapshotID, active, isNew, err := p.prepareGroupSnapshotMember(ctx, member)
+		if isNew {
+			created = append(created, snapshotID)
+		}
+		if err != nil {
+			p.revertGroupSnapshot(ctx, created)
+			return nil, err
+		}
+
+		if active {
+			activated = true
+		} else {
+			inactive = append(inactive, snapshotID)
+		}
+	}
+
+	if activated && len(inactive) != 0 {
+		p.revertGroupSnapshot(ctx, created)
+		return nil, fmt.Errorf("snapshots %v of the group are not activated with the others, "+
+			"they can not be consistent any more", inactive)
+	}
+
+	if len(inactive) != 0 {
+		if err := p.cli.ActivateLunSnapshots(ctx, inactive); err != nil {
+			p.revertGroupSnapshot(ctx, created)
+			return nil, fmt.Errorf("activate snapshots of the group error: %w", err)
+		}
+	}
+
+	snapshots := make([]map[string]interface{}, 0, len(members))
+	for _, member := range members {
+		snapshot, err := p.getCreateSnapshotReturnInfo(ctx, member.SnapshotName)
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+
+	log.AddContext(ctx).Infof("Create group snapshot of luns %v success", members)
+	return snapshots, nil
+}
+
+// prepareGroupSnapshotMember creates the inactive snapshot of the member if it does not exist,
+// it returns the snapshot id, whether the snapshot is activated and whether it is created in this call.
+func (p *SAN) prepareGroupSnapshotMember(ctx context.Context,
+	member utils.GroupSnapshotMember) (string, bool, bool, error) {
+	lun, err := p.cli.GetLunByName(ctx, member.VolumeName)
+	if err != nil {
+		return "", false, false, fmt.Errorf("get lun by name %s error: %w", member.VolumeName, err)
+	}
+	lunID, ok := utils.GetValue[string](lun, "ID")
+	if !ok {
+		return "", false, false, fmt.Errorf("lun %s to create group snapshot does not exist", member.VolumeName)
+	}
+
+	snapshot, err := p.cli.GetLunSnapshotByName(ctx, member.SnapshotName)
+	if err != nil {
+		return "", false, false, fmt.Errorf("get lun snapshot by name %s error: %w", member.SnapshotName, err)
+	}
+
+	if snapshot != nil {
+		if parentID, _ := utils.GetValue[string](snapshot, "PARENTID"); parentID != lunID {
+			return "", false, false, fmt.Errorf("snapshot %s is already exist, but the parent lun %s is incompatible",
+				member.SnapshotName, member.VolumeName)
+		}
+		snapshotID, _ := utils.GetValue[string](snapshot, "ID")
+		runningStatus, _ := utils.GetValue[string](snapshot, "RUNNINGSTATUS")
+		return snapshotID, runningStatus == snapshotRunningStatusActive, false, nil
+	}
+
+	snapshot, err = p.cli.CreateLunSnapshot(ctx, member.SnapshotName, lunID)
+	if err != nil {
+		return "", false, false, fmt.Errorf("create snapshot %s for lun %s error: %w",
+			member.SnapshotName, member.VolumeName, err)
+	}
+	snapshotID, ok := utils.GetValue[string](snapshot, "ID")
+	if !ok {
+		return "", false, false, fmt.Errorf("get id of snapshot %s failed, data: %v", member.SnapshotName, snapshot)
+	}
+
+	if err = p.waitSnapshotReady(ctx, member.SnapshotName); err != nil {
+		return snapshotID, false, true, fmt.Errorf("wait snapshot %s ready error: %w", member.SnapshotName, err)
+	}
+
+	return snapshotID, false, true, nil
+}
+
+// revertGroupSnapshot deletes the inactive snapshots which are created for the group
+func (p *SAN) revertGroupSnapshot(ctx context.Context, snapshotIDs []string) {
+	for _, snapshotID := range snapshotIDs {
+		if err := p.cli.DeleteLunSnapshot(ctx, snapshotID); err != nil {
+			log.AddContext(ctx).Errorf("Revert snapshot %s of the group error: %v", snapshotID, err)
+		}
+	}
+}
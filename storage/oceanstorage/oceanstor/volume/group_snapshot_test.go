@@ -0,0 +1,113 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package volume
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/test/mocks/mock_client"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
+)
+
+func TestSAN_CreateGroupSnapshot_Success(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	san := NewSAN(cli, nil, nil, constants.OceanStorDoradoV6)
+	members := []utils.GroupSnapshotMember{
+		{VolumeName: "lun-data", SnapshotName: "snap-data"},
+		{VolumeName: "lun-wal", SnapshotName: "snap-wal"},
+	}
+	inactiveData := map[string]interface{}{"ID": "1", "PARENTID": "10", "RUNNINGSTATUS": "45",
+		"USERCAPACITY": "2097152", "TIMESTAMP": "100"}
+	inactiveWal := map[string]interface{}{"ID": "2", "PARENTID": "20", "RUNNINGSTATUS": "45",
+		"USERCAPACITY": "2097152", "TIMESTAMP": "100"}
+
+	// mock
+	cli.EXPECT().GetLunByName(ctx, "lun-data").Return(map[string]interface{}{"ID": "10"}, nil)
+	cli.EXPECT().GetLunByName(ctx, "lun-wal").Return(map[string]interface{}{"ID": "20"}, nil)
+	cli.EXPECT().GetLunSnapshotByName(ctx, "snap-data").Return(nil, nil)
+	cli.EXPECT().CreateLunSnapshot(ctx, "snap-data", "10").Return(map[string]interface{}{"ID": "1"}, nil)
+	cli.EXPECT().GetLunSnapshotByName(ctx, "snap-data").Return(inactiveData, nil).Times(2)
+	cli.EXPECT().GetLunSnapshotByName(ctx, "snap-wal").Return(inactiveWal, nil).Times(2)
+	cli.EXPECT().ActivateLunSnapshots(ctx, []string{"1", "2"}).Return(nil)
+
+	// action
+	snapshots, err := san.CreateGroupSnapshot(ctx, members)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Len(t, snapshots, 2)
+	assert.Equal(t, "20", snapshots[1]["ParentID"])
+}
+
+func TestSAN_CreateGroupSnapshot_ActivateFailed(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	san := NewSAN(cli, nil, nil, constants.OceanStorDoradoV6)
+	members := []utils.GroupSnapshotMember{{VolumeName: "lun-data", SnapshotName: "snap-data"}}
+	inactive := map[string]interface{}{"ID": "1", "PARENTID": "10", "RUNNINGSTATUS": "45"}
+
+	// mock
+	cli.EXPECT().GetLunByName(ctx, "lun-data").Return(map[string]interface{}{"ID": "10"}, nil)
+	cli.EXPECT().GetLunSnapshotByName(ctx, "snap-data").Return(nil, nil)
+	cli.EXPECT().CreateLunSnapshot(ctx, "snap-data", "10").Return(map[string]interface{}{"ID": "1"}, nil)
+	cli.EXPECT().GetLunSnapshotByName(ctx, "snap-data").Return(inactive, nil)
+	cli.EXPECT().ActivateLunSnapshots(ctx, []string{"1"}).Return(errors.New("activate error"))
+	cli.EXPECT().DeleteLunSnapshot(ctx, "1").Return(nil)
+
+	// action
+	_, err := san.CreateGroupSnapshot(ctx, members)
+
+	// assert
+	assert.ErrorContains(t, err, "activate error")
+}
+
+func TestSAN_CreateGroupSnapshot_PartlyActivated(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	san := NewSAN(cli, nil, nil, constants.OceanStorDoradoV6)
+	members := []utils.GroupSnapshotMember{
+		{VolumeName: "lun-data", SnapshotName: "snap-data"},
+		{VolumeName: "lun-wal", SnapshotName: "snap-wal"},
+	}
+
+	// mock
+	cli.EXPECT().GetLunByName(ctx, "lun-data").Return(map[string]interface{}{"ID": "10"}, nil)
+	cli.EXPECT().GetLunByName(ctx, "lun-wal").Return(map[string]interface{}{"ID": "20"}, nil)
+	cli.EXPECT().GetLunSnapshotByName(ctx, "snap-data").Return(
+		map[string]interface{}{"ID": "1", "PARENTID": "10", "RUNNINGSTATUS": "43"}, nil)
+	cli.EXPECT().GetLunSnapshotByName(ctx, "snap-wal").Return(
+		map[string]interface{}{"ID": "2", "PARENTID": "20", "RUNNINGSTATUS": "45"}, nil)
+
+	// action
+	_, err := san.CreateGroupSnapshot(ctx, members)
+
+	// assert
+	assert.ErrorContains(t, err, "can not be consistent")
+}
@@ -0,0 +1,716 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package volume
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/oceanstor/smartx"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/oceanstor/volume/creator"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
+)
+
+const (
+	lunMigrationRunningStatusFault     = "74"
+	lunMigrationRunningStatusCompleted = "76"
+
+	migrationSpeedMedium      = 2
+	migrationProgressFinished = 100
+
+	// migrationCopiedDescription is set to the description of the migration target lun after the luncopy is
+	// completed, the source lun can be deleted only if the target lun has it
+	migrationCopiedDescription = "k8s_mig_copy_completed"
+)
+
+// MigrateToPool migrates the lun to another pool of the same storage by SmartMigration, the lun keeps its id, wwn
+// and mappings, so it keeps serving the hosts during the migration. The migration is started when started is false,
+// otherwise the progress of the running migration is returned, along with whether the migration is finished.
+func (p *SAN) MigrateToPool(ctx context.Context, name, targetPool string, started bool) (int, bool, error) {
+	lunName := p.cli.MakeLunName(name)
+	lun, err := p.cli.GetLunByName(ctx, lunName)
+	if err != nil {
+		return 0, false, fmt.Errorf("get lun by name %s error: %w", lunName, err)
+	}
+	lunID, ok := utils.GetValue[string](lun, "ID")
+	if !ok {
+		return 0, false, fmt.Errorf("lun %s to migrate does not exist", lunName)
+	}
+
+	migration, err := p.cli.GetLunMigration(ctx, lunID)
+	if err != nil {
+		return 0, false, fmt.Errorf("get migration of lun %s error: %w", lunName, err)
+	}
+	if migration != nil {
+		return p.checkLunMigration(ctx, lunName, lunID, migration)
+	}
+
+	if poolName, _ := utils.GetValue[string](lun, "PARENTNAME"); poolName == targetPool {
+		log.AddContext(ctx).Infof("Lun %s is in pool %s, migration finished", lunName, targetPool)
+		return migrationProgressFinished, true, nil
+	}
+	if started {
+		return 0, false, fmt.Errorf("migration of lun %s to pool %s is not found", lunName, targetPool)
+	}
+
+	if err = checkMigrationSourceLun(lunName, lun); err != nil {
+		return 0, false, err
+	}
+
+	targetLunID, err := p.createMigrationTargetLun(ctx, lun, targetPool, getMigrationTargetLunName(p, lunName))
+	if err != nil {
+		return 0, false, err
+	}
+
+	if _, err = p.cli.CreateLunMigration(ctx, lunID, targetLunID, migrationSpeedMedium); err != nil {
+		if deleteErr := p.cli.DeleteLun(ctx, targetLunID); deleteErr != nil {
+			log.AddContext(ctx).Warningf("Delete migration target lun %s error: %v", targetLunID, deleteErr)
+		}
+		return 0, false, fmt.Errorf("migrate lun %s to pool %s error: %w", lunName, targetPool, err)
+	}
+
+	log.AddContext(ctx).Infof("Start to migrate lun %s to pool %s", lunName, targetPool)
+	return 0, false, nil
+}
+
+// MigrateToBackend migrates the lun to the pool of the target backend on the same storage by LUN copy. The data is
+// copied from a snapshot of the lun, so the lun must not be used by the hosts during the migration. After the copy
+// is finished, the source lun is deleted and the copied lun is renamed to the name of the source lun.
+// The migration is started when started is false, otherwise the progress of the running migration is returned,
+// along with whether the migration is finished. The started must be true only after the caller has recorded that a
+// previous call succeeded, the source lun is never deleted before that, because it is the only copy of the data
+// until the luncopy is completed. The completion is recorded on the target lun before the luncopy is deleted, the
+// migration fails instead of being cut over if the luncopy is not found and the completion is not recorded.
+func (p *SAN) MigrateToBackend(ctx context.Context, name string, target *SAN, targetPool string,
+	started bool) (int, bool, error) {
+	lunName := p.cli.MakeLunName(name)
+	targetLunName := getMigrationTargetLunName(target, lunName)
+	lun, err := p.cli.GetLunByName(ctx, lunName)
+	if err != nil {
+		return 0, false, fmt.Errorf("get lun by name %s error: %w", lunName, err)
+	}
+	if lun == nil && !started {
+		return 0, false, fmt.Errorf("lun %s to migrate does not exist", lunName)
+	}
+	if poolName, _ := utils.GetValue[string](lun, "PARENTNAME"); lun == nil || poolName == targetPool {
+		return target.checkMigratedLun(ctx, lunName, targetLunName, targetPool)
+	}
+	description, _ := utils.GetValue[string](lun, "DESCRIPTION")
+
+	lunID, _ := utils.GetValue[string](lun, "ID")
+	lunCopyName := getMigrationLunCopyName(lunID)
+	lunCopy, err := p.cli.GetLunCopyByName(ctx, lunCopyName)
+	if err != nil {
+		return 0, false, fmt.Errorf("get luncopy by name %s error: %w", lunCopyName, err)
+	}
+
+	if lunCopy == nil {
+		// the luncopy is deleted after its completion is recorded, so the migration is to be cut over
+		if started {
+			return p.cutoverMigration(ctx, name, description, target, targetLunName)
+		}
+
+		if err = checkMigrationSourceLun(lunName, lun); err != nil {
+			return 0, false, err
+		}
+
+		return 0, false, p.startMigrationLunCopy(ctx, lun, target, targetPool, targetLunName, lunCopyName)
+	}
+
+	lunCopyID, _ := utils.GetValue[string](lunCopy, "ID")
+	lunCopy, err = p.cli.GetLunCopyByID(ctx, lunCopyID)
+	if err != nil {
+		return 0, false, fmt.Errorf("get luncopy %s error: %w", lunCopyID, err)
+	}
+
+	if runningStatus, _ := utils.GetValue[string](lunCopy, "RUNNINGSTATUS"); runningStatus ==
+		lunCopyRunningStatusNormal {
+		// the luncopy was created but failed to be started by the previous attempt
+		if err = p.cli.StartLunCopy(ctx, lunCopyID); err != nil {
+			return 0, false, fmt.Errorf("start luncopy %s error: %w", lunCopyName, err)
+		}
+
+		log.AddContext(ctx).Infof("Restart luncopy %s of lun %s", lunCopyName, lunName)
+		return 0, false, nil
+	}
+
+	progress, finished, err := getLunCopyProgress(lunCopyName, lunCopy)
+	if err != nil || !finished || !started {
+		return progress, false, err
+	}
+
+	if err = target.markMigrationCopied(ctx, targetLunName); err != nil {
+		return 0, false, err
+	}
+
+	if err = p.deleteLunCopy(ctx, lunCopyName, true); err != nil {
+		return 0, false, fmt.Errorf("delete luncopy %s error: %w", lunCopyName, err)
+	}
+
+	return p.cutoverMigration(ctx, name, description, target, targetLunName)
+}
+
+// MigrateToDevice migrates the lun to the pool of the target backend on another storage device by remote
+// replication, because LUN copy only copies the data within one storage. The source storage must have the target
+// one as a remote device. Like MigrateToBackend, the lun must not be used by the hosts during the migration, and the
+// replication pair is split and deleted after the first synchronization is finished, then the source lun is deleted
+// and the replicated lun is renamed to the name of the source lun. The source lun is never deleted before the
+// started is true and the completion of the synchronization is recorded on the target lun.
+func (p *SAN) MigrateToDevice(ctx context.Context, name string, target *SAN, targetPool string,
+	started bool) (int, bool, error) {
+	lunName := p.cli.MakeLunName(name)
+	targetLunName := getMigrationTargetLunName(target, lunName)
+	lun, err := p.cli.GetLunByName(ctx, lunName)
+	if err != nil {
+		return 0, false, fmt.Errorf("get lun by name %s error: %w", lunName, err)
+	}
+	if lun == nil {
+		if !started {
+			return 0, false, fmt.Errorf("lun %s to migrate does not exist", lunName)
+		}
+		return target.checkMigratedLun(ctx, lunName, targetLunName, targetPool)
+	}
+	description, _ := utils.GetValue[string](lun, "DESCRIPTION")
+
+	lunID, _ := utils.GetValue[string](lun, "ID")
+	pairID, err := p.getMigrationReplicationPairID(ctx, lunID, target, targetLunName)
+	if err != nil {
+		return 0, false, err
+	}
+
+	if pairID == "" {
+		// the replication pair is deleted after its completion is recorded, so the migration is to be cut over
+		if started {
+			return p.cutoverMigration(ctx, name, description, target, targetLunName)
+		}
+
+		if err = checkMigrationSourceLun(lunName, lun); err != nil {
+			return 0, false, err
+		}
+
+		return 0, false, p.startMigrationReplication(ctx, lun, target, targetPool, targetLunName)
+	}
+
+	pair, err := p.cli.GetReplicationPairByID(ctx, pairID)
+	if err != nil {
+		return 0, false, fmt.Errorf("get replication pair %s error: %w", pairID, err)
+	}
+
+	progress, finished, err := p.getMigrationReplicationProgress(ctx, pairID, pair)
+	if err != nil || !finished || !started {
+		return progress, false, err
+	}
+
+	if err = target.markMigrationCopied(ctx, targetLunName); err != nil {
+		return 0, false, err
+	}
+
+	if err = creator.RevertReplicationPair(ctx, p.cli, pairID); err != nil {
+		return 0, false, fmt.Errorf("delete replication pair %s error: %w", pairID, err)
+	}
+
+	return p.cutoverMigration(ctx, name, description, target, targetLunName)
+}
+
+// RollbackMigrationToPool cancels the SmartMigration of the lun which is not completed and deletes the target lun
+// created for it, so the lun is kept in its source pool. It fails with constants.ErrMigrationCutOver if the lun has
+// been migrated to the target pool.
+func (p *SAN) RollbackMigrationToPool(ctx context.Context, name, targetPool string) error {
+	lunName := p.cli.MakeLunName(name)
+	lun, err := p.cli.GetLunByName(ctx, lunName)
+	if err != nil {
+		return fmt.Errorf("get lun by name %s error: %w", lunName, err)
+	}
+	lunID, ok := utils.GetValue[string](lun, "ID")
+	if !ok {
+		log.AddContext(ctx).Infof("Lun %s does not exist, no migration to roll back", lunName)
+		return nil
+	}
+
+	migration, err := p.cli.GetLunMigration(ctx, lunID)
+	if err != nil {
+		return fmt.Errorf("get migration of lun %s error: %w", lunName, err)
+	}
+	if runningStatus, _ := utils.GetValue[string](migration, "RUNNINGSTATUS"); runningStatus ==
+		lunMigrationRunningStatusCompleted {
+		return fmt.Errorf("%w: migration of lun %s is completed", constants.ErrMigrationCutOver, lunName)
+	}
+	if migration != nil {
+		if err = p.cli.DeleteLunMigration(ctx, lunID); err != nil {
+			return fmt.Errorf("delete migration of lun %s error: %w", lunName, err)
+		}
+	} else if poolName, _ := utils.GetValue[string](lun, "PARENTNAME"); poolName == targetPool {
+		return fmt.Errorf("%w: lun %s is in pool %s", constants.ErrMigrationCutOver, lunName, targetPool)
+	}
+
+	if err = p.deleteMigrationTargetLun(ctx, getMigrationTargetLunName(p, lunName)); err != nil {
+		return err
+	}
+
+	log.AddContext(ctx).Infof("Migration of lun %s to pool %s is rolled back", lunName, targetPool)
+	return nil
+}
+
+// RollbackMigrationToBackend deletes the luncopy, the snapshot and the target lun created by the migration which is
+// not cut over, so the lun is kept on the source backend. It fails with constants.ErrMigrationCutOver if the source
+// lun has been deleted by the cutover, because the target lun holds the only copy of the data since then.
+func (p *SAN) RollbackMigrationToBackend(ctx context.Context, name string, target *SAN, targetPool string) error {
+	lunName := p.cli.MakeLunName(name)
+	targetLunName := getMigrationTargetLunName(target, lunName)
+	lun, err := p.cli.GetLunByName(ctx, lunName)
+	if err != nil {
+		return fmt.Errorf("get lun by name %s error: %w", lunName, err)
+	}
+	if poolName, _ := utils.GetValue[string](lun, "PARENTNAME"); lun == nil || poolName == targetPool {
+		targetLun, err := target.cli.GetLunByName(ctx, targetLunName)
+		if err != nil {
+			return fmt.Errorf("get lun by name %s error: %w", targetLunName, err)
+		}
+		if lun == nil && targetLun == nil {
+			log.AddContext(ctx).Infof("Lun %s does not exist, no migration to roll back", lunName)
+			return nil
+		}
+
+		return fmt.Errorf("%w: lun %s is cut over to pool %s", constants.ErrMigrationCutOver, lunName, targetPool)
+	}
+
+	lunID, _ := utils.GetValue[string](lun, "ID")
+	lunCopyName := getMigrationLunCopyName(lunID)
+	if err = p.deleteLunCopy(ctx, lunCopyName, false); err != nil {
+		return fmt.Errorf("delete luncopy %s error: %w", lunCopyName, err)
+	}
+
+	// the snapshot is deleted separately, because it is left if the luncopy failed to be created from it
+	snapshotName := getMigrationSnapshotName(lunID)
+	snapshot, err := p.cli.GetLunSnapshotByName(ctx, snapshotName)
+	if err != nil {
+		return fmt.Errorf("get lun snapshot by name %s error: %w", snapshotName, err)
+	}
+	if snapshotID, ok := utils.GetValue[string](snapshot, "ID"); ok {
+		if err = smartx.NewSmartX(p.cli).DeleteLunSnapshot(ctx, snapshotID); err != nil {
+			return fmt.Errorf("delete lun snapshot %s error: %w", snapshotName, err)
+		}
+	}
+
+	if err = target.deleteMigrationTargetLun(ctx, targetLunName); err != nil {
+		return err
+	}
+
+	log.AddContext(ctx).Infof("Migration of lun %s to pool %s is rolled back", lunName, targetPool)
+	return nil
+}
+
+// RollbackMigrationToDevice deletes the replication pair and the target lun created by the migration which is not
+// cut over, so the lun is kept on the source storage. It fails with constants.ErrMigrationCutOver if the source
+// lun has been deleted by the cutover, because the target lun holds the only copy of the data since then.
+func (p *SAN) RollbackMigrationToDevice(ctx context.Context, name string, target *SAN, targetPool string) error {
+	lunName := p.cli.MakeLunName(name)
+	targetLunName := getMigrationTargetLunName(target, lunName)
+	lun, err := p.cli.GetLunByName(ctx, lunName)
+	if err != nil {
+		return fmt.Errorf("get lun by name %s error: %w", lunName, err)
+	}
+	if lun == nil {
+		targetLun, err := target.cli.GetLunByName(ctx, targetLunName)
+		if err != nil {
+			return fmt.Errorf("get lun by name %s error: %w", targetLunName, err)
+		}
+		if targetLun == nil {
+			log.AddContext(ctx).Infof("Lun %s does not exist, no migration to roll back", lunName)
+			return nil
+		}
+
+		return fmt.Errorf("%w: lun %s is cut over to pool %s", constants.ErrMigrationCutOver, lunName, targetPool)
+	}
+
+	lunID, _ := utils.GetValue[string](lun, "ID")
+	pairID, err := p.getMigrationReplicationPairID(ctx, lunID, target, targetLunName)
+	if err != nil {
+		return err
+	}
+	if pairID != "" {
+		if err = creator.RevertReplicationPair(ctx, p.cli, pairID); err != nil {
+			return fmt.Errorf("delete replication pair %s error: %w", pairID, err)
+		}
+	}
+
+	if err = target.deleteMigrationTargetLun(ctx, targetLunName); err != nil {
+		return err
+	}
+
+	log.AddContext(ctx).Infof("Migration of lun %s to pool %s is rolled back", lunName, targetPool)
+	return nil
+}
+
+func (p *SAN) deleteMigrationTargetLun(ctx context.Context, targetLunName string) error {
+	targetLun, err := p.cli.GetLunByName(ctx, targetLunName)
+	if err != nil {
+		return fmt.Errorf("get lun by name %s error: %w", targetLunName, err)
+	}
+	targetLunID, ok := utils.GetValue[string](targetLun, "ID")
+	if !ok {
+		return nil
+	}
+
+	if err = p.cli.DeleteLun(ctx, targetLunID); err != nil {
+		return fmt.Errorf("delete migration target lun %s error: %w", targetLunName, err)
+	}
+
+	return nil
+}
+
+func (p *SAN) checkLunMigration(ctx context.Context, lunName, lunID string,
+	migration map[string]interface{}) (int, bool, error) {
+	runningStatus, _ := utils.GetValue[string](migration, "RUNNINGSTATUS")
+	switch runningStatus {
+	case lunMigrationRunningStatusFault:
+		return 0, false, fmt.Errorf("%w: migration of lun %s is at fault status", constants.ErrMigrationFailed,
+			lunName)
+	case lunMigrationRunningStatusCompleted:
+		if err := p.cli.DeleteLunMigration(ctx, lunID); err != nil {
+			return 0, false, fmt.Errorf("delete migration of lun %s error: %w", lunName, err)
+		}
+
+		log.AddContext(ctx).Infof("Migration of lun %s finished", lunName)
+		return migrationProgressFinished, true, nil
+	default:
+		return 0, false, nil
+	}
+}
+
+func (p *SAN) startMigrationLunCopy(ctx context.Context, lun map[string]interface{}, target *SAN,
+	targetPool, targetLunName, lunCopyName string) error {
+	lunID, _ := utils.GetValue[string](lun, "ID")
+	lunName, _ := utils.GetValue[string](lun, "NAME")
+	targetLunID, err := target.createMigrationTargetLun(ctx, lun, targetPool, targetLunName)
+	if err != nil {
+		return err
+	}
+
+	snapshotName := getMigrationSnapshotName(lunID)
+	snapshot, err := p.cli.GetLunSnapshotByName(ctx, snapshotName)
+	if err != nil {
+		return fmt.Errorf("get lun snapshot by name %s error: %w", snapshotName, err)
+	}
+	if snapshot == nil {
+		snapshot, err = smartx.NewSmartX(p.cli).CreateLunSnapshot(ctx, snapshotName, lunID)
+		if err != nil {
+			return fmt.Errorf("create snapshot %s of lun %s error: %w", snapshotName, lunName, err)
+		}
+	}
+
+	snapshotID, _ := utils.GetValue[string](snapshot, "ID")
+	lunCopy, err := p.cli.CreateLunCopy(ctx, lunCopyName, snapshotID, targetLunID, migrationSpeedMedium)
+	if err != nil {
+		return fmt.Errorf("create luncopy from lun %s to lun %s error: %w", lunName, targetLunName, err)
+	}
+
+	lunCopyID, _ := utils.GetValue[string](lunCopy, "ID")
+	if err = p.cli.StartLunCopy(ctx, lunCopyID); err != nil {
+		return fmt.Errorf("start luncopy %s error: %w", lunCopyName, err)
+	}
+
+	log.AddContext(ctx).Infof("Start to migrate lun %s to lun %s by luncopy %s", lunName, targetLunName, lunCopyName)
+	return nil
+}
+
+// startMigrationReplication creates the replication pair from the lun to the lun created in the target pool
+// of the target storage, and starts the first synchronization of it.
+func (p *SAN) startMigrationReplication(ctx context.Context, lun map[string]interface{}, target *SAN,
+	targetPool, targetLunName string) error {
+	lunID, _ := utils.GetValue[string](lun, "ID")
+	lunName, _ := utils.GetValue[string](lun, "NAME")
+	targetLunID, err := target.createMigrationTargetLun(ctx, lun, targetPool, targetLunName)
+	if err != nil {
+		return err
+	}
+
+	remoteDeviceID, err := p.getRemoteDeviceID(ctx, target.cli.GetDeviceSN())
+	if err != nil {
+		return fmt.Errorf("get remote device of storage %s error: %w", target.cli.GetDeviceSN(), err)
+	}
+
+	_, err = creator.CreateReplicationPair(ctx, p.cli, &creator.ReplicationPairParam{
+		LocalResID:     lunID,
+		RemoteResID:    targetLunID,
+		RemoteDeviceID: remoteDeviceID,
+		ResType:        creator.ReplicationLunResType,
+		SyncPeriod:     defaultReplicationSyncPeriod,
+	})
+	if err != nil {
+		return fmt.Errorf("create replication pair from lun %s to lun %s error: %w", lunName, targetLunName, err)
+	}
+
+	log.AddContext(ctx).Infof("Start to migrate lun %s to lun %s of storage %s by remote replication",
+		lunName, targetLunName, target.cli.GetDeviceSN())
+	return nil
+}
+
+// getMigrationReplicationPairID returns the id of the replication pair from the lun to the migration target lun,
+// it is empty if either the target lun or the pair does not exist.
+func (p *SAN) getMigrationReplicationPairID(ctx context.Context, lunID string, target *SAN,
+	targetLunName string) (string, error) {
+	targetLun, err := target.cli.GetLunByName(ctx, targetLunName)
+	if err != nil {
+		return "", fmt.Errorf("get lun by name %s error: %w", targetLunName, err)
+	}
+	targetLunID, ok := utils.GetValue[string](targetLun, "ID")
+	if !ok {
+		return "", nil
+	}
+
+	pairID, err := creator.GetReplicationPairID(ctx, p.cli, lunID, targetLunID, creator.ReplicationLunResType)
+	if err != nil {
+		return "", fmt.Errorf("get replication pair of lun %s error: %w", lunID, err)
+	}
+
+	return pairID, nil
+}
+
+// getMigrationReplicationProgress returns the progress of the first synchronization of the replication pair,
+// the pair which is split or interrupted is synchronized again.
+func (p *SAN) getMigrationReplicationProgress(ctx context.Context, pairID string,
+	pair map[string]interface{}) (int, bool, error) {
+	if healthStatus, _ := utils.GetValue[string](pair, "HEALTHSTATUS"); healthStatus ==
+		replicationPairHealthStatusFault {
+		return 0, false, fmt.Errorf("%w: replication pair %s is at fault status", constants.ErrMigrationFailed,
+			pairID)
+	}
+
+	runningStatus, _ := utils.GetValue[string](pair, "RUNNINGSTATUS")
+	switch runningStatus {
+	case creator.ReplicationPairRunningStatusSync:
+		progressStr, _ := utils.GetValue[string](pair, "REPLICATIONPROGRESS")
+		progress, err := strconv.Atoi(progressStr)
+		if err != nil || progress < 0 {
+			progress = 0
+		}
+		return min(progress, migrationProgressFinished-1), false, nil
+	case creator.ReplicationPairRunningStatusNormal:
+		return migrationProgressFinished, true, nil
+	default:
+		if err := p.cli.SyncReplicationPair(ctx, pairID); err != nil {
+			return 0, false, fmt.Errorf("sync replication pair %s error: %w", pairID, err)
+		}
+
+		log.AddContext(ctx).Infof("Replication pair %s is at running status %s, synchronize it again",
+			pairID, runningStatus)
+		return 0, false, nil
+	}
+}
+
+// cutoverMigration deletes the source lun, and renames the copied lun to the name of the source lun,
+// so the volume keeps its name and description on the target backend.
+func (p *SAN) cutoverMigration(ctx context.Context, name, description string, target *SAN,
+	targetLunName string) (int, bool, error) {
+	lunName := p.cli.MakeLunName(name)
+	targetLunID, err := target.getCopiedMigrationTargetLun(ctx, lunName, targetLunName)
+	if err != nil {
+		return 0, false, err
+	}
+
+	if err = p.Delete(ctx, name); err != nil {
+		return 0, false, fmt.Errorf("delete migration source lun %s error: %w", lunName, err)
+	}
+
+	err = target.cli.UpdateLun(ctx, targetLunID, map[string]interface{}{"NAME": lunName, "DESCRIPTION": description})
+	if err != nil {
+		return 0, false, fmt.Errorf("rename lun %s to %s error: %w", targetLunName, lunName, err)
+	}
+
+	log.AddContext(ctx).Infof("Migration of lun %s finished, lun %s is renamed to %s",
+		lunName, targetLunName, lunName)
+	return migrationProgressFinished, true, nil
+}
+
+// checkMigratedLun checks whether the migration is finished after the source lun is deleted, the rename of the
+// copied lun is retried if it has not been done.
+func (p *SAN) checkMigratedLun(ctx context.Context, lunName, targetLunName, targetPool string) (int, bool, error) {
+	lun, err := p.cli.GetLunByName(ctx, lunName)
+	if err != nil {
+		return 0, false, fmt.Errorf("get lun by name %s error: %w", lunName, err)
+	}
+	if poolName, _ := utils.GetValue[string](lun, "PARENTNAME"); lun != nil && poolName == targetPool {
+		log.AddContext(ctx).Infof("Lun %s is in pool %s, migration finished", lunName, targetPool)
+		return migrationProgressFinished, true, nil
+	}
+
+	if lun != nil {
+		return 0, false, fmt.Errorf("lun %s to migrate is not in pool %s", lunName, targetPool)
+	}
+
+	targetLunID, err := p.getCopiedMigrationTargetLun(ctx, lunName, targetLunName)
+	if err != nil {
+		return 0, false, err
+	}
+
+	// the description of the deleted source lun is lost, so the default one is used
+	err = p.cli.UpdateLun(ctx, targetLunID,
+		map[string]interface{}{"NAME": lunName, "DESCRIPTION": constants.DefaultDescription})
+	if err != nil {
+		return 0, false, fmt.Errorf("rename lun %s to %s error: %w", targetLunName, lunName, err)
+	}
+
+	return migrationProgressFinished, true, nil
+}
+
+// markMigrationCopied records the completion of the luncopy on the migration target lun
+func (p *SAN) markMigrationCopied(ctx context.Context, targetLunName string) error {
+	targetLun, err := p.cli.GetLunByName(ctx, targetLunName)
+	if err != nil {
+		return fmt.Errorf("get lun by name %s error: %w", targetLunName, err)
+	}
+	targetLunID, ok := utils.GetValue[string](targetLun, "ID")
+	if !ok {
+		return fmt.Errorf("migration target lun %s does not exist", targetLunName)
+	}
+
+	err = p.cli.UpdateLun(ctx, targetLunID, map[string]interface{}{"DESCRIPTION": migrationCopiedDescription})
+	if err != nil {
+		return fmt.Errorf("record copy completion on lun %s error: %w", targetLunName, err)
+	}
+
+	return nil
+}
+
+// getCopiedMigrationTargetLun returns the id of the migration target lun, it fails if the completion of the luncopy
+// is not recorded on the lun, because the data of the lun may be incomplete
+func (p *SAN) getCopiedMigrationTargetLun(ctx context.Context, lunName, targetLunName string) (string, error) {
+	targetLun, err := p.cli.GetLunByName(ctx, targetLunName)
+	if err != nil {
+		return "", fmt.Errorf("get lun by name %s error: %w", targetLunName, err)
+	}
+	targetLunID, ok := utils.GetValue[string](targetLun, "ID")
+	if !ok {
+		return "", fmt.Errorf("migration target lun %s of lun %s does not exist", targetLunName, lunName)
+	}
+
+	if description, _ := utils.GetValue[string](targetLun, "DESCRIPTION"); description != migrationCopiedDescription {
+		return "", fmt.Errorf("%w: copy from lun %s to lun %s is not recorded as completed, "+
+			"the migration can not be cut over", constants.ErrMigrationFailed, lunName, targetLunName)
+	}
+
+	return targetLunID, nil
+}
+
+// createMigrationTargetLun creates the lun in the target pool with the capacity and allocation type of the source
+// lun, the lun created by the previous attempt is reused.
+func (p *SAN) createMigrationTargetLun(ctx context.Context, lun map[string]interface{},
+	targetPool, targetLunName string) (string, error) {
+	targetLun, err := p.cli.GetLunByName(ctx, targetLunName)
+	if err != nil {
+		return "", fmt.Errorf("get lun by name %s error: %w", targetLunName, err)
+	}
+	if targetLunID, ok := utils.GetValue[string](targetLun, "ID"); ok {
+		return targetLunID, nil
+	}
+
+	pool, err := p.cli.GetPoolByName(ctx, targetPool)
+	if err != nil {
+		return "", fmt.Errorf("get pool by name %s error: %w", targetPool, err)
+	}
+	poolID, ok := utils.GetValue[string](pool, "ID")
+	if !ok {
+		return "", fmt.Errorf("target pool %s does not exist", targetPool)
+	}
+
+	capacityStr, _ := utils.GetValue[string](lun, "CAPACITY")
+	capacity, err := strconv.ParseInt(capacityStr, 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("parse capacity %s of lun error: %w", capacityStr, err)
+	}
+	allocTypeStr, _ := utils.GetValue[string](lun, "ALLOCTYPE")
+	allocType, err := strconv.Atoi(allocTypeStr)
+	if err != nil {
+		return "", fmt.Errorf("parse alloc type %s of lun error: %w", allocTypeStr, err)
+	}
+
+	params := map[string]interface{}{
+		"name":      targetLunName,
+		"parentid":  poolID,
+		"capacity":  capacity,
+		"alloctype": allocType,
+	}
+	if workloadTypeID, _ := utils.GetValue[string](lun, "WORKLOADTYPEID"); workloadTypeID != "" {
+		params["workloadTypeID"] = workloadTypeID
+	}
+
+	targetLun, err = p.cli.CreateLun(ctx, params)
+	if err != nil {
+		return "", fmt.Errorf("create migration target lun %s in pool %s error: %w", targetLunName, targetPool, err)
+	}
+
+	targetLunID, _ := utils.GetValue[string](targetLun, "ID")
+	return targetLunID, nil
+}
+
+// checkMigrationSourceLun checks the lun is not a member of hypermetro or replication pairs,
+// which would be broken by the migration
+func checkMigrationSourceLun(lunName string, lun map[string]interface{}) error {
+	rssStr, _ := utils.GetValue[string](lun, "HASRSSOBJECT")
+	var rss map[string]string
+	if rssStr != "" {
+		if err := json.Unmarshal([]byte(rssStr), &rss); err != nil {
+			return fmt.Errorf("unmarshal HASRSSOBJECT %s of lun %s error: %w", rssStr, lunName, err)
+		}
+	}
+
+	if rss["HyperMetro"] == "TRUE" || rss["RemoteReplication"] == "TRUE" {
+		return fmt.Errorf("lun %s is a hypermetro or replication lun, it can not be migrated", lunName)
+	}
+
+	return nil
+}
+
+func getLunCopyProgress(lunCopyName string, lunCopy map[string]interface{}) (int, bool, error) {
+	if healthStatus, _ := utils.GetValue[string](lunCopy, "HEALTHSTATUS"); healthStatus == lunCopyHealthStatusFault {
+		return 0, false, fmt.Errorf("%w: luncopy %s is at fault status", constants.ErrMigrationFailed, lunCopyName)
+	}
+
+	runningStatus, _ := utils.GetValue[string](lunCopy, "RUNNINGSTATUS")
+	switch runningStatus {
+	case lunCopyRunningStatusQueuing, lunCopyRunningStatusCopying:
+		progressStr, _ := utils.GetValue[string](lunCopy, "COPYPROGRESS")
+		progress, err := strconv.Atoi(progressStr)
+		if err != nil || progress < 0 {
+			progress = 0
+		}
+		return min(progress, migrationProgressFinished), false, nil
+	case lunCopyRunningStatusStop, lunCopyRunningStatusPaused:
+		return 0, false, fmt.Errorf("luncopy %s is stopped", lunCopyName)
+	case lunCopyRunningStatusCompleted:
+		return migrationProgressFinished, true, nil
+	default:
+		return 0, false, fmt.Errorf("luncopy %s is at unexpected running status %s", lunCopyName, runningStatus)
+	}
+}
+
+func getMigrationTargetLunName(p *SAN, lunName string) string {
+	return p.cli.MakeLunName("k8s_mig_" + lunName)
+}
+
+func getMigrationLunCopyName(lunID string) string {
+	return fmt.Sprintf("k8s_mig_%s_copy", lunID)
+}
+
+func getMigrationSnapshotName(lunID string) string {
+	return fmt.Sprintf("k8s_mig_%s_snap", lunID)
+}
@@ -0,0 +1,700 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package volume
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/oceanstor/volume/creator"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/test/mocks/mock_client"
+)
+
+func newMigrationSourceLun(pool, rss string) map[string]interface{} {
+	return map[string]interface{}{"ID": "10", "NAME": "pvc-lun", "PARENTNAME": pool, "CAPACITY": "2097152",
+		"ALLOCTYPE": "1", "WORKLOADTYPEID": "", "HASRSSOBJECT": rss}
+}
+
+func TestSAN_MigrateToPool_Start(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	san := NewSAN(cli, nil, nil, constants.OceanStorDoradoV6)
+	wantParams := map[string]interface{}{"name": "k8s_mig_pvc-lun", "parentid": "2", "capacity": int64(2097152),
+		"alloctype": 1}
+
+	// mock
+	cli.EXPECT().MakeLunName(gomock.Any()).DoAndReturn(func(name string) string { return name }).AnyTimes()
+	cli.EXPECT().GetLunByName(ctx, "pvc-lun").Return(newMigrationSourceLun("pool1", `{"LunCopy":"FALSE"}`), nil)
+	cli.EXPECT().GetLunMigration(ctx, "10").Return(nil, nil)
+	cli.EXPECT().GetLunByName(ctx, "k8s_mig_pvc-lun").Return(nil, nil)
+	cli.EXPECT().GetPoolByName(ctx, "pool2").Return(map[string]interface{}{"ID": "2"}, nil)
+	cli.EXPECT().CreateLun(ctx, wantParams).Return(map[string]interface{}{"ID": "20"}, nil)
+	cli.EXPECT().CreateLunMigration(ctx, "10", "20", migrationSpeedMedium).Return(map[string]interface{}{}, nil)
+
+	// action
+	progress, finished, err := san.MigrateToPool(ctx, "pvc-lun", "pool2", false)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, 0, progress)
+	assert.False(t, finished)
+}
+
+func TestSAN_MigrateToPool_CreateMigrationFailed(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	san := NewSAN(cli, nil, nil, constants.OceanStorDoradoV6)
+
+	// mock
+	cli.EXPECT().MakeLunName(gomock.Any()).DoAndReturn(func(name string) string { return name }).AnyTimes()
+	cli.EXPECT().GetLunByName(ctx, "pvc-lun").Return(newMigrationSourceLun("pool1", ""), nil)
+	cli.EXPECT().GetLunMigration(ctx, "10").Return(nil, nil)
+	cli.EXPECT().GetLunByName(ctx, "k8s_mig_pvc-lun").Return(map[string]interface{}{"ID": "20"}, nil)
+	cli.EXPECT().CreateLunMigration(ctx, "10", "20", migrationSpeedMedium).Return(nil, errors.New("mock error"))
+	cli.EXPECT().DeleteLun(ctx, "20").Return(nil)
+
+	// action
+	_, _, err := san.MigrateToPool(ctx, "pvc-lun", "pool2", false)
+
+	// assert
+	assert.ErrorContains(t, err, "mock error")
+}
+
+func TestSAN_MigrateToPool_Finished(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	san := NewSAN(cli, nil, nil, constants.OceanStorDoradoV6)
+	running := map[string]interface{}{"RUNNINGSTATUS": "75"}
+	completed := map[string]interface{}{"RUNNINGSTATUS": lunMigrationRunningStatusCompleted}
+
+	// mock
+	cli.EXPECT().MakeLunName(gomock.Any()).DoAndReturn(func(name string) string { return name }).AnyTimes()
+	cli.EXPECT().GetLunByName(ctx, "pvc-lun").Return(newMigrationSourceLun("pool1", ""), nil).Times(2)
+	cli.EXPECT().GetLunMigration(ctx, "10").Return(running, nil)
+	cli.EXPECT().GetLunMigration(ctx, "10").Return(completed, nil)
+	cli.EXPECT().DeleteLunMigration(ctx, "10").Return(nil)
+
+	// action
+	_, runningFinished, runningErr := san.MigrateToPool(ctx, "pvc-lun", "pool2", true)
+	progress, finished, err := san.MigrateToPool(ctx, "pvc-lun", "pool2", true)
+
+	// assert
+	assert.NoError(t, runningErr)
+	assert.False(t, runningFinished)
+	assert.NoError(t, err)
+	assert.Equal(t, 100, progress)
+	assert.True(t, finished)
+}
+
+func TestSAN_MigrateToPool_Fault(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	san := NewSAN(cli, nil, nil, constants.OceanStorDoradoV6)
+	fault := map[string]interface{}{"RUNNINGSTATUS": lunMigrationRunningStatusFault}
+
+	// mock
+	cli.EXPECT().MakeLunName(gomock.Any()).DoAndReturn(func(name string) string { return name }).AnyTimes()
+	cli.EXPECT().GetLunByName(ctx, "pvc-lun").Return(newMigrationSourceLun("pool1", ""), nil)
+	cli.EXPECT().GetLunMigration(ctx, "10").Return(fault, nil)
+
+	// action
+	_, finished, err := san.MigrateToPool(ctx, "pvc-lun", "pool2", true)
+
+	// assert
+	assert.ErrorIs(t, err, constants.ErrMigrationFailed)
+	assert.False(t, finished)
+}
+
+func TestSAN_MigrateToPool_HyperMetroLun(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	san := NewSAN(cli, nil, nil, constants.OceanStorDoradoV6)
+
+	// mock
+	cli.EXPECT().MakeLunName(gomock.Any()).DoAndReturn(func(name string) string { return name }).AnyTimes()
+	cli.EXPECT().GetLunByName(ctx, "pvc-lun").Return(newMigrationSourceLun("pool1", `{"HyperMetro":"TRUE"}`), nil)
+	cli.EXPECT().GetLunMigration(ctx, "10").Return(nil, nil)
+
+	// action
+	_, _, err := san.MigrateToPool(ctx, "pvc-lun", "pool2", false)
+
+	// assert
+	assert.ErrorContains(t, err, "can not be migrated")
+}
+
+func TestSAN_MigrateToBackend_Progress(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	san := NewSAN(cli, nil, nil, constants.OceanStorDoradoV6)
+	lunCopy := map[string]interface{}{"ID": "5", "HEALTHSTATUS": "1", "RUNNINGSTATUS": lunCopyRunningStatusCopying,
+		"COPYPROGRESS": "60"}
+
+	// mock
+	cli.EXPECT().MakeLunName(gomock.Any()).DoAndReturn(func(name string) string { return name }).AnyTimes()
+	cli.EXPECT().GetLunByName(ctx, "pvc-lun").Return(newMigrationSourceLun("pool1", ""), nil)
+	cli.EXPECT().GetLunCopyByName(ctx, "k8s_mig_10_copy").Return(map[string]interface{}{"ID": "5"}, nil)
+	cli.EXPECT().GetLunCopyByID(ctx, "5").Return(lunCopy, nil)
+
+	// action
+	progress, finished, err := san.MigrateToBackend(ctx, "pvc-lun", san, "pool2", true)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, 60, progress)
+	assert.False(t, finished)
+}
+
+func TestSAN_MigrateToBackend_RenameAfterSourceDeleted(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	san := NewSAN(cli, nil, nil, constants.OceanStorDoradoV6)
+
+	// mock
+	cli.EXPECT().MakeLunName(gomock.Any()).DoAndReturn(func(name string) string { return name }).AnyTimes()
+	cli.EXPECT().GetLunByName(ctx, "pvc-lun").Return(nil, nil).Times(2)
+	cli.EXPECT().GetLunByName(ctx, "k8s_mig_pvc-lun").
+		Return(map[string]interface{}{"ID": "20", "DESCRIPTION": migrationCopiedDescription}, nil)
+	cli.EXPECT().UpdateLun(ctx, "20",
+		map[string]interface{}{"NAME": "pvc-lun", "DESCRIPTION": constants.DefaultDescription}).Return(nil)
+
+	// action
+	progress, finished, err := san.MigrateToBackend(ctx, "pvc-lun", san, "pool2", true)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, 100, progress)
+	assert.True(t, finished)
+}
+
+func TestSAN_MigrateToBackend_CutoverAfterCopyRecorded(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	san := NewSAN(cli, nil, nil, constants.OceanStorDoradoV6)
+	sourceLun := newMigrationSourceLun("pool1", "")
+	sourceLun["DESCRIPTION"] = "source description"
+	lunCopy := map[string]interface{}{"ID": "5", "HEALTHSTATUS": "1", "RUNNINGSTATUS": lunCopyRunningStatusCompleted}
+	var deleted bool
+
+	// mock
+	patches := gomonkey.ApplyMethodFunc(san, "Delete", func(_ context.Context, name string) error {
+		deleted = name == "pvc-lun"
+		return nil
+	})
+	defer patches.Reset()
+	cli.EXPECT().MakeLunName(gomock.Any()).DoAndReturn(func(name string) string { return name }).AnyTimes()
+	cli.EXPECT().GetLunByName(ctx, "pvc-lun").Return(sourceLun, nil)
+	cli.EXPECT().GetLunCopyByName(ctx, "k8s_mig_10_copy").Return(map[string]interface{}{"ID": "5"}, nil)
+	cli.EXPECT().GetLunCopyByID(ctx, "5").Return(lunCopy, nil)
+	gomock.InOrder(
+		cli.EXPECT().GetLunByName(ctx, "k8s_mig_pvc-lun").Return(map[string]interface{}{"ID": "20"}, nil),
+		cli.EXPECT().UpdateLun(ctx, "20", map[string]interface{}{"DESCRIPTION": migrationCopiedDescription}).
+			Return(nil),
+		cli.EXPECT().GetLunCopyByName(ctx, "k8s_mig_10_copy").Return(map[string]interface{}{"ID": "5",
+			"RUNNINGSTATUS": lunCopyRunningStatusCompleted, "SOURCELUNNAME": "k8s_mig_10_snap"}, nil),
+		cli.EXPECT().DeleteLunCopy(ctx, "5").Return(nil),
+		cli.EXPECT().GetLunSnapshotByName(ctx, "k8s_mig_10_snap").Return(nil, nil),
+		cli.EXPECT().GetLunByName(ctx, "k8s_mig_pvc-lun").
+			Return(map[string]interface{}{"ID": "20", "DESCRIPTION": migrationCopiedDescription}, nil),
+		cli.EXPECT().UpdateLun(ctx, "20",
+			map[string]interface{}{"NAME": "pvc-lun", "DESCRIPTION": "source description"}).Return(nil),
+	)
+
+	// action
+	progress, finished, err := san.MigrateToBackend(ctx, "pvc-lun", san, "pool2", true)
+
+	// assert
+	assert.NoError(t, err)
+	assert.True(t, deleted)
+	assert.Equal(t, 100, progress)
+	assert.True(t, finished)
+}
+
+func TestSAN_MigrateToBackend_LunCopyNotFoundWithoutCopyRecord(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	san := NewSAN(cli, nil, nil, constants.OceanStorDoradoV6)
+
+	// mock
+	cli.EXPECT().MakeLunName(gomock.Any()).DoAndReturn(func(name string) string { return name }).AnyTimes()
+	cli.EXPECT().GetLunByName(ctx, "pvc-lun").Return(newMigrationSourceLun("pool1", ""), nil)
+	cli.EXPECT().GetLunCopyByName(ctx, "k8s_mig_10_copy").Return(nil, nil)
+	cli.EXPECT().GetLunByName(ctx, "k8s_mig_pvc-lun").
+		Return(map[string]interface{}{"ID": "20", "DESCRIPTION": constants.DefaultDescription}, nil)
+
+	// action
+	_, finished, err := san.MigrateToBackend(ctx, "pvc-lun", san, "pool2", true)
+
+	// assert
+	assert.ErrorContains(t, err, "is not recorded as completed")
+	assert.ErrorIs(t, err, constants.ErrMigrationFailed)
+	assert.False(t, finished)
+}
+
+func TestSAN_MigrateToBackend_RestartAfterStartLunCopyFailed(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	san := NewSAN(cli, nil, nil, constants.OceanStorDoradoV6)
+	lunCopy := map[string]interface{}{"ID": "5", "HEALTHSTATUS": "1", "RUNNINGSTATUS": lunCopyRunningStatusNormal}
+
+	// mock
+	cli.EXPECT().MakeLunName(gomock.Any()).DoAndReturn(func(name string) string { return name }).AnyTimes()
+	cli.EXPECT().GetLunByName(ctx, "pvc-lun").Return(newMigrationSourceLun("pool1", ""), nil).Times(2)
+	cli.EXPECT().GetLunByName(ctx, "k8s_mig_pvc-lun").Return(map[string]interface{}{"ID": "20"}, nil)
+	cli.EXPECT().GetLunSnapshotByName(ctx, "k8s_mig_10_snap").Return(map[string]interface{}{"ID": "30"}, nil)
+	cli.EXPECT().CreateLunCopy(ctx, "k8s_mig_10_copy", "30", "20", migrationSpeedMedium).
+		Return(map[string]interface{}{"ID": "5"}, nil)
+	cli.EXPECT().GetLunCopyByName(ctx, "k8s_mig_10_copy").Return(nil, nil)
+	cli.EXPECT().GetLunCopyByName(ctx, "k8s_mig_10_copy").Return(map[string]interface{}{"ID": "5"}, nil)
+	cli.EXPECT().GetLunCopyByID(ctx, "5").Return(lunCopy, nil)
+	cli.EXPECT().StartLunCopy(ctx, "5").Return(errors.New("start luncopy error"))
+	cli.EXPECT().StartLunCopy(ctx, "5").Return(nil)
+
+	// action
+	_, _, startErr := san.MigrateToBackend(ctx, "pvc-lun", san, "pool2", false)
+	progress, finished, err := san.MigrateToBackend(ctx, "pvc-lun", san, "pool2", false)
+
+	// assert
+	assert.ErrorContains(t, startErr, "start luncopy")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, progress)
+	assert.False(t, finished)
+}
+
+func TestSAN_MigrateToBackend_CompletedWithoutStartedRecord(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	san := NewSAN(cli, nil, nil, constants.OceanStorDoradoV6)
+	lunCopy := map[string]interface{}{"ID": "5", "HEALTHSTATUS": "1", "RUNNINGSTATUS": lunCopyRunningStatusCompleted}
+
+	// mock
+	cli.EXPECT().MakeLunName(gomock.Any()).DoAndReturn(func(name string) string { return name }).AnyTimes()
+	cli.EXPECT().GetLunByName(ctx, "pvc-lun").Return(newMigrationSourceLun("pool1", ""), nil)
+	cli.EXPECT().GetLunCopyByName(ctx, "k8s_mig_10_copy").Return(map[string]interface{}{"ID": "5"}, nil)
+	cli.EXPECT().GetLunCopyByID(ctx, "5").Return(lunCopy, nil)
+
+	// action
+	progress, finished, err := san.MigrateToBackend(ctx, "pvc-lun", san, "pool2", false)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, 100, progress)
+	assert.False(t, finished)
+}
+
+func TestSAN_MigrateToBackend_SourceNotExistWithoutStartedRecord(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	san := NewSAN(cli, nil, nil, constants.OceanStorDoradoV6)
+
+	// mock
+	cli.EXPECT().MakeLunName(gomock.Any()).DoAndReturn(func(name string) string { return name }).AnyTimes()
+	cli.EXPECT().GetLunByName(ctx, "pvc-lun").Return(nil, nil)
+
+	// action
+	_, finished, err := san.MigrateToBackend(ctx, "pvc-lun", san, "pool2", false)
+
+	// assert
+	assert.ErrorContains(t, err, "does not exist")
+	assert.False(t, finished)
+}
+
+func newMigrationDeviceSANs(t *testing.T) (*SAN, *mock_client.MockOceanstorClientInterface, *SAN,
+	*mock_client.MockOceanstorClientInterface) {
+	mockCtrl := gomock.NewController(t)
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	targetCli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	cli.EXPECT().MakeLunName(gomock.Any()).DoAndReturn(func(name string) string { return name }).AnyTimes()
+	targetCli.EXPECT().MakeLunName(gomock.Any()).DoAndReturn(func(name string) string { return name }).AnyTimes()
+	targetCli.EXPECT().GetDeviceSN().Return("sn-2").AnyTimes()
+	return NewSAN(cli, nil, nil, constants.OceanStorDoradoV6), cli,
+		NewSAN(targetCli, nil, nil, constants.OceanStorDoradoV6), targetCli
+}
+
+func TestSAN_MigrateToDevice_Start(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	san, cli, target, targetCli := newMigrationDeviceSANs(t)
+	wantLunParams := map[string]interface{}{"name": "k8s_mig_pvc-lun", "parentid": "2", "capacity": int64(2097152),
+		"alloctype": 1}
+	wantPairParams := map[string]interface{}{"LOCALRESID": "10", "LOCALRESTYPE": creator.ReplicationLunResType,
+		"REMOTEDEVICEID": "3", "REMOTERESID": "20", "REPLICATIONMODEL": 2, "SYNCHRONIZETYPE": 2,
+		"TIMINGVAL": defaultReplicationSyncPeriod, "SPEED": 4}
+
+	// mock
+	cli.EXPECT().GetLunByName(ctx, "pvc-lun").Return(newMigrationSourceLun("pool1", ""), nil)
+	gomock.InOrder(
+		targetCli.EXPECT().GetLunByName(ctx, "k8s_mig_pvc-lun").Return(nil, nil),
+		targetCli.EXPECT().GetLunByName(ctx, "k8s_mig_pvc-lun").Return(nil, nil),
+		targetCli.EXPECT().GetPoolByName(ctx, "pool1").Return(map[string]interface{}{"ID": "2"}, nil),
+		targetCli.EXPECT().CreateLun(ctx, wantLunParams).Return(map[string]interface{}{"ID": "20"}, nil),
+	)
+	gomock.InOrder(
+		cli.EXPECT().GetRemoteDeviceBySN(ctx, "sn-2").Return(map[string]interface{}{"ID": "3",
+			"HEALTHSTATUS": remoteDeviceHealthStatus, "RUNNINGSTATUS": remoteDeviceRunningStatusLinkUp}, nil),
+		cli.EXPECT().CreateReplicationPair(ctx, wantPairParams).Return(map[string]interface{}{"ID": "30"}, nil),
+		cli.EXPECT().SyncReplicationPair(ctx, "30").Return(nil),
+	)
+
+	// action
+	progress, finished, err := san.MigrateToDevice(ctx, "pvc-lun", target, "pool1", false)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, 0, progress)
+	assert.False(t, finished)
+}
+
+func TestSAN_MigrateToDevice_Progress(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	san, cli, target, targetCli := newMigrationDeviceSANs(t)
+	pair := map[string]interface{}{"ID": "30", "REMOTERESID": "20", "HEALTHSTATUS": "1",
+		"RUNNINGSTATUS": creator.ReplicationPairRunningStatusSync, "REPLICATIONPROGRESS": "40"}
+
+	// mock
+	cli.EXPECT().GetLunByName(ctx, "pvc-lun").Return(newMigrationSourceLun("pool1", ""), nil)
+	targetCli.EXPECT().GetLunByName(ctx, "k8s_mig_pvc-lun").Return(map[string]interface{}{"ID": "20"}, nil)
+	cli.EXPECT().GetReplicationPairByResID(ctx, "10", creator.ReplicationLunResType).
+		Return([]map[string]interface{}{pair}, nil)
+	cli.EXPECT().GetReplicationPairByID(ctx, "30").Return(pair, nil)
+
+	// action
+	progress, finished, err := san.MigrateToDevice(ctx, "pvc-lun", target, "pool1", true)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, 40, progress)
+	assert.False(t, finished)
+}
+
+func TestSAN_MigrateToDevice_ResyncSplitPair(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	san, cli, target, targetCli := newMigrationDeviceSANs(t)
+	pair := map[string]interface{}{"ID": "30", "REMOTERESID": "20", "HEALTHSTATUS": "1", "RUNNINGSTATUS": "26"}
+
+	// mock
+	cli.EXPECT().GetLunByName(ctx, "pvc-lun").Return(newMigrationSourceLun("pool1", ""), nil)
+	targetCli.EXPECT().GetLunByName(ctx, "k8s_mig_pvc-lun").Return(map[string]interface{}{"ID": "20"}, nil)
+	cli.EXPECT().GetReplicationPairByResID(ctx, "10", creator.ReplicationLunResType).
+		Return([]map[string]interface{}{pair}, nil)
+	cli.EXPECT().GetReplicationPairByID(ctx, "30").Return(pair, nil)
+	cli.EXPECT().SyncReplicationPair(ctx, "30").Return(nil)
+
+	// action
+	progress, finished, err := san.MigrateToDevice(ctx, "pvc-lun", target, "pool1", true)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, 0, progress)
+	assert.False(t, finished)
+}
+
+func TestSAN_MigrateToDevice_FaultPair(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	san, cli, target, targetCli := newMigrationDeviceSANs(t)
+	pair := map[string]interface{}{"ID": "30", "REMOTERESID": "20", "HEALTHSTATUS": replicationPairHealthStatusFault}
+
+	// mock
+	cli.EXPECT().GetLunByName(ctx, "pvc-lun").Return(newMigrationSourceLun("pool1", ""), nil)
+	targetCli.EXPECT().GetLunByName(ctx, "k8s_mig_pvc-lun").Return(map[string]interface{}{"ID": "20"}, nil)
+	cli.EXPECT().GetReplicationPairByResID(ctx, "10", creator.ReplicationLunResType).
+		Return([]map[string]interface{}{pair}, nil)
+	cli.EXPECT().GetReplicationPairByID(ctx, "30").Return(pair, nil)
+
+	// action
+	_, _, err := san.MigrateToDevice(ctx, "pvc-lun", target, "pool1", true)
+
+	// assert
+	assert.ErrorIs(t, err, constants.ErrMigrationFailed)
+}
+
+func TestSAN_MigrateToDevice_CutoverAfterSynchronized(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	san, cli, target, targetCli := newMigrationDeviceSANs(t)
+	sourceLun := newMigrationSourceLun("pool1", "")
+	sourceLun["DESCRIPTION"] = "source description"
+	pair := map[string]interface{}{"ID": "30", "REMOTERESID": "20", "HEALTHSTATUS": "1",
+		"RUNNINGSTATUS": creator.ReplicationPairRunningStatusNormal}
+	var deleted bool
+
+	// mock
+	patches := gomonkey.ApplyMethodFunc(san, "Delete", func(_ context.Context, name string) error {
+		deleted = name == "pvc-lun"
+		return nil
+	})
+	defer patches.Reset()
+	cli.EXPECT().GetLunByName(ctx, "pvc-lun").Return(sourceLun, nil)
+	cli.EXPECT().GetReplicationPairByResID(ctx, "10", creator.ReplicationLunResType).
+		Return([]map[string]interface{}{pair}, nil)
+	gomock.InOrder(
+		targetCli.EXPECT().GetLunByName(ctx, "k8s_mig_pvc-lun").Return(map[string]interface{}{"ID": "20"}, nil),
+		targetCli.EXPECT().GetLunByName(ctx, "k8s_mig_pvc-lun").Return(map[string]interface{}{"ID": "20"}, nil),
+		targetCli.EXPECT().UpdateLun(ctx, "20", map[string]interface{}{"DESCRIPTION": migrationCopiedDescription}).
+			Return(nil),
+		targetCli.EXPECT().GetLunByName(ctx, "k8s_mig_pvc-lun").
+			Return(map[string]interface{}{"ID": "20", "DESCRIPTION": migrationCopiedDescription}, nil),
+		targetCli.EXPECT().UpdateLun(ctx, "20",
+			map[string]interface{}{"NAME": "pvc-lun", "DESCRIPTION": "source description"}).Return(nil),
+	)
+	gomock.InOrder(
+		cli.EXPECT().GetReplicationPairByID(ctx, "30").Return(pair, nil),
+		cli.EXPECT().GetReplicationPairByID(ctx, "30").Return(pair, nil),
+		cli.EXPECT().SplitReplicationPair(ctx, "30").Return(nil),
+		cli.EXPECT().DeleteReplicationPair(ctx, "30").Return(nil),
+	)
+
+	// action
+	progress, finished, err := san.MigrateToDevice(ctx, "pvc-lun", target, "pool1", true)
+
+	// assert
+	assert.NoError(t, err)
+	assert.True(t, deleted)
+	assert.Equal(t, 100, progress)
+	assert.True(t, finished)
+}
+
+func TestSAN_MigrateToDevice_SynchronizedWithoutStartedRecord(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	san, cli, target, targetCli := newMigrationDeviceSANs(t)
+	pair := map[string]interface{}{"ID": "30", "REMOTERESID": "20", "HEALTHSTATUS": "1",
+		"RUNNINGSTATUS": creator.ReplicationPairRunningStatusNormal}
+
+	// mock
+	cli.EXPECT().GetLunByName(ctx, "pvc-lun").Return(newMigrationSourceLun("pool1", ""), nil)
+	targetCli.EXPECT().GetLunByName(ctx, "k8s_mig_pvc-lun").Return(map[string]interface{}{"ID": "20"}, nil)
+	cli.EXPECT().GetReplicationPairByResID(ctx, "10", creator.ReplicationLunResType).
+		Return([]map[string]interface{}{pair}, nil)
+	cli.EXPECT().GetReplicationPairByID(ctx, "30").Return(pair, nil)
+
+	// action
+	progress, finished, err := san.MigrateToDevice(ctx, "pvc-lun", target, "pool1", false)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, 100, progress)
+	assert.False(t, finished)
+}
+
+func TestSAN_RollbackMigrationToPool_Running(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	san := NewSAN(cli, nil, nil, constants.OceanStorDoradoV6)
+	running := map[string]interface{}{"RUNNINGSTATUS": "75"}
+
+	// mock
+	cli.EXPECT().MakeLunName(gomock.Any()).DoAndReturn(func(name string) string { return name }).AnyTimes()
+	cli.EXPECT().GetLunByName(ctx, "pvc-lun").Return(newMigrationSourceLun("pool1", ""), nil)
+	gomock.InOrder(
+		cli.EXPECT().GetLunMigration(ctx, "10").Return(running, nil),
+		cli.EXPECT().DeleteLunMigration(ctx, "10").Return(nil),
+		cli.EXPECT().GetLunByName(ctx, "k8s_mig_pvc-lun").Return(map[string]interface{}{"ID": "20"}, nil),
+		cli.EXPECT().DeleteLun(ctx, "20").Return(nil),
+	)
+
+	// action
+	err := san.RollbackMigrationToPool(ctx, "pvc-lun", "pool2")
+
+	// assert
+	assert.NoError(t, err)
+}
+
+func TestSAN_RollbackMigrationToPool_Completed(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	san := NewSAN(cli, nil, nil, constants.OceanStorDoradoV6)
+	completed := map[string]interface{}{"RUNNINGSTATUS": lunMigrationRunningStatusCompleted}
+
+	// mock
+	cli.EXPECT().MakeLunName(gomock.Any()).DoAndReturn(func(name string) string { return name }).AnyTimes()
+	cli.EXPECT().GetLunByName(ctx, "pvc-lun").Return(newMigrationSourceLun("pool1", ""), nil)
+	cli.EXPECT().GetLunMigration(ctx, "10").Return(completed, nil)
+
+	// action
+	err := san.RollbackMigrationToPool(ctx, "pvc-lun", "pool2")
+
+	// assert
+	assert.ErrorIs(t, err, constants.ErrMigrationCutOver)
+}
+
+func TestSAN_RollbackMigrationToBackend_Copying(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	san := NewSAN(cli, nil, nil, constants.OceanStorDoradoV6)
+	lunCopy := map[string]interface{}{"ID": "5", "RUNNINGSTATUS": lunCopyRunningStatusCopying,
+		"SOURCELUNNAME": "k8s_mig_10_snap"}
+
+	// mock
+	cli.EXPECT().MakeLunName(gomock.Any()).DoAndReturn(func(name string) string { return name }).AnyTimes()
+	cli.EXPECT().GetLunByName(ctx, "pvc-lun").Return(newMigrationSourceLun("pool1", ""), nil)
+	gomock.InOrder(
+		cli.EXPECT().GetLunCopyByName(ctx, "k8s_mig_10_copy").Return(lunCopy, nil),
+		cli.EXPECT().StopLunCopy(ctx, "5").Return(nil),
+		cli.EXPECT().DeleteLunCopy(ctx, "5").Return(nil),
+		cli.EXPECT().GetLunSnapshotByName(ctx, "k8s_mig_10_snap").Return(map[string]interface{}{"ID": "7"}, nil),
+		cli.EXPECT().GetLunSnapshotByName(ctx, "k8s_mig_10_snap").Return(map[string]interface{}{"ID": "7"}, nil),
+		cli.EXPECT().DeactivateLunSnapshot(ctx, "7").Return(nil),
+		cli.EXPECT().DeleteLunSnapshot(ctx, "7").Return(nil),
+		cli.EXPECT().GetLunByName(ctx, "k8s_mig_pvc-lun").Return(map[string]interface{}{"ID": "20"}, nil),
+		cli.EXPECT().DeleteLun(ctx, "20").Return(nil),
+	)
+
+	// action
+	err := san.RollbackMigrationToBackend(ctx, "pvc-lun", san, "pool2")
+
+	// assert
+	assert.NoError(t, err)
+}
+
+func TestSAN_RollbackMigrationToBackend_SourceDeleted(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	san := NewSAN(cli, nil, nil, constants.OceanStorDoradoV6)
+
+	// mock
+	cli.EXPECT().MakeLunName(gomock.Any()).DoAndReturn(func(name string) string { return name }).AnyTimes()
+	cli.EXPECT().GetLunByName(ctx, "pvc-lun").Return(nil, nil)
+	cli.EXPECT().GetLunByName(ctx, "k8s_mig_pvc-lun").
+		Return(map[string]interface{}{"ID": "20", "DESCRIPTION": migrationCopiedDescription}, nil)
+
+	// action
+	err := san.RollbackMigrationToBackend(ctx, "pvc-lun", san, "pool2")
+
+	// assert
+	assert.ErrorIs(t, err, constants.ErrMigrationCutOver)
+}
+
+func TestSAN_RollbackMigrationToDevice_Synchronizing(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	san, cli, target, targetCli := newMigrationDeviceSANs(t)
+	pair := map[string]interface{}{"ID": "30", "REMOTERESID": "20",
+		"RUNNINGSTATUS": creator.ReplicationPairRunningStatusSync}
+
+	// mock
+	cli.EXPECT().GetLunByName(ctx, "pvc-lun").Return(newMigrationSourceLun("pool1", ""), nil)
+	cli.EXPECT().GetReplicationPairByResID(ctx, "10", creator.ReplicationLunResType).
+		Return([]map[string]interface{}{pair}, nil)
+	gomock.InOrder(
+		cli.EXPECT().GetReplicationPairByID(ctx, "30").Return(pair, nil),
+		cli.EXPECT().SplitReplicationPair(ctx, "30").Return(nil),
+		cli.EXPECT().DeleteReplicationPair(ctx, "30").Return(nil),
+	)
+	gomock.InOrder(
+		targetCli.EXPECT().GetLunByName(ctx, "k8s_mig_pvc-lun").Return(map[string]interface{}{"ID": "20"}, nil),
+		targetCli.EXPECT().GetLunByName(ctx, "k8s_mig_pvc-lun").Return(map[string]interface{}{"ID": "20"}, nil),
+		targetCli.EXPECT().DeleteLun(ctx, "20").Return(nil),
+	)
+
+	// action
+	err := san.RollbackMigrationToDevice(ctx, "pvc-lun", target, "pool1")
+
+	// assert
+	assert.NoError(t, err)
+}
+
+func TestSAN_RollbackMigrationToDevice_SourceDeleted(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	san, cli, target, targetCli := newMigrationDeviceSANs(t)
+
+	// mock
+	cli.EXPECT().GetLunByName(ctx, "pvc-lun").Return(nil, nil)
+	targetCli.EXPECT().GetLunByName(ctx, "k8s_mig_pvc-lun").
+		Return(map[string]interface{}{"ID": "20", "DESCRIPTION": migrationCopiedDescription}, nil)
+
+	// action
+	err := san.RollbackMigrationToDevice(ctx, "pvc-lun", target, "pool1")
+
+	// assert
+	assert.ErrorIs(t, err, constants.ErrMigrationCutOver)
+}
+
+func TestGetLunCopyProgress(t *testing.T) {
+	// arrange
+	tests := []struct {
+		name         string
+		lunCopy      map[string]interface{}
+		wantProgress int
+		wantFinished bool
+		wantErr      bool
+	}{
+		{name: "copying", lunCopy: map[string]interface{}{"RUNNINGSTATUS": lunCopyRunningStatusCopying,
+			"COPYPROGRESS": "30"}, wantProgress: 30},
+		{name: "queuing", lunCopy: map[string]interface{}{"RUNNINGSTATUS": lunCopyRunningStatusQueuing}},
+		{name: "fault", lunCopy: map[string]interface{}{"HEALTHSTATUS": lunCopyHealthStatusFault}, wantErr: true},
+		{name: "stopped", lunCopy: map[string]interface{}{"RUNNINGSTATUS": lunCopyRunningStatusStop}, wantErr: true},
+		{name: "completed", lunCopy: map[string]interface{}{"RUNNINGSTATUS": lunCopyRunningStatusCompleted},
+			wantProgress: 100, wantFinished: true},
+		{name: "not started", lunCopy: map[string]interface{}{"RUNNINGSTATUS": lunCopyRunningStatusNormal},
+			wantErr: true},
+		{name: "unknown", lunCopy: map[string]interface{}{}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// action
+			progress, finished, err := getLunCopyProgress("luncopy", tt.lunCopy)
+
+			// assert
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.wantProgress, progress)
+			assert.Equal(t, tt.wantFinished, finished)
+		})
+	}
+}
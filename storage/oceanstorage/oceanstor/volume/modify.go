@@ -0,0 +1,695 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package volume
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/oceanstor/client"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/oceanstor/smartx"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
+)
+
+const (
+	// ModifyQoSKey is the mutable parameter key of the qos of the volume
+	ModifyQoSKey = "qos"
+	// ModifyDescriptionKey is the mutable parameter key of the description of the volume
+	ModifyDescriptionKey = "description"
+	// ModifyAllocTypeKey is the mutable parameter key of the allocation type of the filesystem
+	ModifyAllocTypeKey = "allocType"
+	// ModifyReservedSnapshotSpaceRatioKey is the mutable parameter key of the snapshot reserve of the filesystem
+	ModifyReservedSnapshotSpaceRatioKey = "reservedSnapshotSpaceRatio"
+	// ModifyAuthClientKey is the mutable parameter key of the auth clients of the nfs share, separated by ";"
+	ModifyAuthClientKey = constants.ModifyAuthClientKey
+	// ModifyAllSquashKey is the mutable parameter key of the all squash of the nfs share auth clients
+	ModifyAllSquashKey = constants.ModifyAllSquashKey
+	// ModifyRootSquashKey is the mutable parameter key of the root squash of the nfs share auth clients
+	ModifyRootSquashKey = constants.ModifyRootSquashKey
+	// ModifySnapshotDirectoryVisibilityKey is the mutable parameter key of the snapshot directory visibility
+	ModifySnapshotDirectoryVisibilityKey = constants.ModifySnapshotDirectoryVisibilityKey
+	// ModifySmartTierInitialPolicyKey is the mutable parameter key of the SmartTier initial policy of the lun
+	ModifySmartTierInitialPolicyKey = constants.SmartTierInitialPolicyKey
+	// ModifySmartTierRelocationPolicyKey is the mutable parameter key of the SmartTier relocation policy of the lun
+	ModifySmartTierRelocationPolicyKey = constants.SmartTierRelocationPolicyKey
+
+	lunObjectType = "lun"
+	fsObjectType  = "fs"
+
+	thickAllocType = "thick"
+	thinAllocType  = "thin"
+
+	allSquashField  = "ALLSQUASH"
+	rootSquashField = "ROOTSQUASH"
+)
+
+var (
+	sanModifiableKeys = []string{ModifyQoSKey, ModifyDescriptionKey, ModifySmartTierInitialPolicyKey,
+		ModifySmartTierRelocationPolicyKey}
+	nasModifiableKeys = []string{ModifyQoSKey, ModifyDescriptionKey, ModifyAllocTypeKey,
+		ModifyReservedSnapshotSpaceRatioKey, ModifyAuthClientKey, ModifyAllSquashKey, ModifyRootSquashKey,
+		ModifySnapshotDirectoryVisibilityKey}
+	dtreeModifiableKeys = []string{ModifyAuthClientKey, ModifyAllSquashKey, ModifyRootSquashKey}
+)
+
+// CheckSANModifyParameters checks the mutable parameters can be modified on the lun, the value of qos is not checked
+func CheckSANModifyParameters(params map[string]string) error {
+	if err := checkModifyKeys(params, sanModifiableKeys...); err != nil {
+		return err
+	}
+
+	_, err := getModifyLunData(params)
+	return err
+}
+
+// CheckNASModifyParameters checks the mutable parameters can be modified on the filesystem,
+// the value of qos is not checked
+func CheckNASModifyParameters(params map[string]string) error {
+	if err := checkModifyKeys(params, nasModifiableKeys...); err != nil {
+		return err
+	}
+
+	_, err := getModifyFileSystemData(params)
+	return err
+}
+
+// CheckDTreeModifyParameters checks the mutable parameters can be modified on the dtree
+func CheckDTreeModifyParameters(params map[string]string) error {
+	return checkModifyKeys(params, dtreeModifiableKeys...)
+}
+
+// ModifyAttributes used to change the mutable attributes of the lun in place, the qos is changed on the hypermetro
+// remote lun too, other attributes, such as the description and the SmartTier policies, are only changed on the
+// local storage.
+func (p *SAN) ModifyAttributes(ctx context.Context, name string, params map[string]string) error {
+	if err := CheckSANModifyParameters(params); err != nil {
+		return err
+	}
+
+	lunName := p.cli.MakeLunName(name)
+	lun, err := p.cli.GetLunByName(ctx, lunName)
+	if err != nil {
+		log.AddContext(ctx).Errorf("Get lun %s error: %v", lunName, err)
+		return err
+	}
+	if lun == nil {
+		return fmt.Errorf("lun %s does not exist", lunName)
+	}
+
+	lunID, ok := utils.GetValue[string](lun, "ID")
+	if !ok {
+		return fmt.Errorf("convert lunID to string failed, data: %v", lun["ID"])
+	}
+
+	data, err := getModifyLunData(params)
+	if err != nil {
+		return err
+	}
+	if len(data) != 0 {
+		if err = p.cli.UpdateLun(ctx, lunID, data); err != nil {
+			log.AddContext(ctx).Errorf("Update lun %s by %v error: %v", lunID, data, err)
+			return err
+		}
+	}
+
+	if qos, exist := params[ModifyQoSKey]; exist {
+		if err = p.modifyLunQoS(ctx, lun, lunName, qos); err != nil {
+			return err
+		}
+	}
+
+	log.AddContext(ctx).Infof("Modify attributes %v of lun %s success", params, lunName)
+	return nil
+}
+
+// ModifyAttributes used to change the mutable attributes of the filesystem in place,
+// the qos is changed on the hypermetro remote filesystem too, other attributes are only changed on the local storage.
+func (p *NAS) ModifyAttributes(ctx context.Context, name string, params map[string]string) error {
+	if err := CheckNASModifyParameters(params); err != nil {
+		return err
+	}
+
+	fs, err := p.cli.GetFileSystemByName(ctx, name)
+	if err != nil {
+		log.AddContext(ctx).Errorf("Get filesystem %s error: %v", name, err)
+		return err
+	}
+	if fs == nil {
+		return fmt.Errorf("filesystem %s does not exist", name)
+	}
+
+	fsID, ok := utils.GetValue[string](fs, "ID")
+	if !ok {
+		return fmt.Errorf("convert fsID to string failed, data: %v", fs["ID"])
+	}
+
+	data, err := getModifyFileSystemData(params)
+	if err != nil {
+		return err
+	}
+	if len(data) != 0 {
+		if err = p.cli.UpdateFileSystem(ctx, fsID, data); err != nil {
+			log.AddContext(ctx).Errorf("Update filesystem %s by %v error: %v", fsID, data, err)
+			return err
+		}
+	}
+
+	if qos, exist := params[ModifyQoSKey]; exist {
+		if err = p.modifyFileSystemQoS(ctx, fs, name, qos); err != nil {
+			return err
+		}
+	}
+
+	if err = p.modifyShareOptions(ctx, name, params); err != nil {
+		return err
+	}
+
+	log.AddContext(ctx).Infof("Modify attributes %v of filesystem %s success", params, name)
+	return nil
+}
+
+// ModifyAttributes used to change the export options of the dtree nfs share in place,
+// such as auth clients, all squash and root squash.
+func (p *DTree) ModifyAttributes(ctx context.Context, name, parentName string, params map[string]string) error {
+	if err := CheckDTreeModifyParameters(params); err != nil {
+		return err
+	}
+
+	if err := p.modifyShareOptions(ctx, parentName+"/"+name, params); err != nil {
+		return err
+	}
+
+	log.AddContext(ctx).Infof("Modify attributes %v of dtree %s/%s success", params, parentName, name)
+	return nil
+}
+
+// GetQoS returns the qos config of the lun, it is empty if the lun has no qos
+func (p *SAN) GetQoS(ctx context.Context, name string) (string, error) {
+	lunName := p.cli.MakeLunName(name)
+	lun, err := p.cli.GetLunByName(ctx, lunName)
+	if err != nil {
+		log.AddContext(ctx).Errorf("Get lun %s error: %v", lunName, err)
+		return "", err
+	}
+	if lun == nil {
+		return "", fmt.Errorf("lun %s does not exist", lunName)
+	}
+
+	return p.getQoSConfig(ctx, lun, "")
+}
+
+// GetQoS returns the qos config of the filesystem, it is empty if the filesystem has no qos
+func (p *NAS) GetQoS(ctx context.Context, name string) (string, error) {
+	fs, err := p.cli.GetFileSystemByName(ctx, name)
+	if err != nil {
+		log.AddContext(ctx).Errorf("Get filesystem %s error: %v", name, err)
+		return "", err
+	}
+	if fs == nil {
+		return "", fmt.Errorf("filesystem %s does not exist", name)
+	}
+
+	vStoreID, _ := utils.GetValue[string](fs, "vstoreId")
+	return p.getQoSConfig(ctx, fs, vStoreID)
+}
+
+// GetExportOptions returns the export options of the filesystem in the format of the mutable parameters,
+// such as auth clients, all squash, root squash and snapshot directory visibility
+func (p *NAS) GetExportOptions(ctx context.Context, name string) (map[string]string, error) {
+	fs, err := p.cli.GetFileSystemByName(ctx, name)
+	if err != nil {
+		log.AddContext(ctx).Errorf("Get filesystem %s error: %v", name, err)
+		return nil, err
+	}
+	if fs == nil {
+		return nil, fmt.Errorf("filesystem %s does not exist", name)
+	}
+
+	options, err := p.getShareOptions(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	options[ModifySnapshotDirectoryVisibilityKey] = invisibleString
+	if isShowSnapDir, _ := utils.ToStringWithFlag(fs["ISSHOWSNAPDIR"]); strings.EqualFold(isShowSnapDir, "true") {
+		options[ModifySnapshotDirectoryVisibilityKey] = visibleString
+	}
+	return options, nil
+}
+
+// GetExportOptions returns the export options of the dtree in the format of the mutable parameters,
+// such as auth clients, all squash and root squash
+func (p *DTree) GetExportOptions(ctx context.Context, name, parentName string) (map[string]string, error) {
+	return p.getShareOptions(ctx, parentName+"/"+name)
+}
+
+func (p *Base) getShareOptions(ctx context.Context, shareName string) (map[string]string, error) {
+	sharePath := utils.GetOriginSharePath(shareName)
+	vStoreID := p.cli.GetvStoreID()
+	share, err := p.cli.GetNfsShareByPath(ctx, sharePath, vStoreID)
+	if err != nil {
+		return nil, fmt.Errorf("get nfs share by path %s error: %w", sharePath, err)
+	}
+	shareID, _ := utils.GetValue[string](share, "ID")
+	if shareID == "" {
+		return nil, fmt.Errorf("nfs share %s does not exist", sharePath)
+	}
+
+	accesses, err := p.getCurrentShareAccess(ctx, shareID, vStoreID, p.cli)
+	if err != nil {
+		return nil, fmt.Errorf("get auth clients of share %s error: %w", sharePath, err)
+	}
+
+	clients := make([]string, 0, len(accesses))
+	for name := range accesses {
+		clients = append(clients, name)
+	}
+	sort.Strings(clients)
+
+	// the auth clients of the share are modified together, so the squash of any of them is the squash of the share
+	currentAllSquash, currentRootSquash := noAllSquash, noRootSquash
+	if len(clients) != 0 {
+		access, _ := accesses[clients[0]].(map[string]interface{})
+		if currentAllSquash, currentRootSquash, err = getAuthClientSquash(access); err != nil {
+			return nil, err
+		}
+	}
+
+	return utils.GetShareOptions(clients, currentAllSquash, currentRootSquash), nil
+}
+
+func (p *Base) getQoSConfig(ctx context.Context, obj map[string]interface{}, vStoreID string) (string, error) {
+	qosID, _ := utils.GetValue[string](obj, "IOCLASSID")
+	if qosID == "" {
+		return "", nil
+	}
+
+	return smartx.NewSmartX(p.cli).GetQosConfig(ctx, qosID, vStoreID, p.product)
+}
+
+// modifyLunQoS modifies the qos of the lun and its hypermetro remote lun. The remote lun is looked up before
+// the local qos is modified, and the local qos is restored if the remote one fails to be modified, so that
+// the two ends of the hypermetro pair keep the same qos.
+func (p *SAN) modifyLunQoS(ctx context.Context, lun map[string]interface{}, lunName, qos string) error {
+	remoteLun, err := p.getHyperMetroRemoteLun(ctx, lun, lunName)
+	if err != nil {
+		return err
+	}
+	if remoteLun == nil {
+		return p.modifyQoS(ctx, p.cli, lun, lunObjectType, "", qos)
+	}
+
+	originQoS, err := p.getQoSConfig(ctx, lun, "")
+	if err != nil {
+		return err
+	}
+	if err = p.modifyQoS(ctx, p.cli, lun, lunObjectType, "", qos); err != nil {
+		return err
+	}
+	if err = p.modifyQoS(ctx, p.metroRemoteCli, remoteLun, lunObjectType, "", qos); err != nil {
+		if restoreErr := p.restoreLunQoS(ctx, lunName, originQoS); restoreErr != nil {
+			log.AddContext(ctx).Errorf("Restore qos of lun %s to %q error: %v", lunName, originQoS, restoreErr)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// getHyperMetroRemoteLun returns the hypermetro remote lun of the lun, it is nil if the lun is not hypermetro
+func (p *SAN) getHyperMetroRemoteLun(ctx context.Context, lun map[string]interface{},
+	lunName string) (map[string]interface{}, error) {
+	rssStr, _ := utils.GetValue[string](lun, "HASRSSOBJECT")
+	var rss map[string]string
+	if rssStr != "" {
+		if err := json.Unmarshal([]byte(rssStr), &rss); err != nil {
+			return nil, fmt.Errorf("unmarshal san HASRSSOBJECT failed, data: %v, err: %w", rssStr, err)
+		}
+	}
+	if rss["HyperMetro"] != "TRUE" {
+		return nil, nil
+	}
+
+	if p.metroRemoteCli == nil {
+		return nil, fmt.Errorf("lun %s is hypermetro, but hypermetro backend is not configured", lunName)
+	}
+	remoteLun, err := p.metroRemoteCli.GetLunByName(ctx, lunName)
+	if err != nil {
+		log.AddContext(ctx).Errorf("Get hypermetro remote lun %s error: %v", lunName, err)
+		return nil, err
+	}
+	if remoteLun == nil {
+		return nil, fmt.Errorf("hypermetro remote lun %s does not exist", lunName)
+	}
+
+	return remoteLun, nil
+}
+
+func (p *SAN) restoreLunQoS(ctx context.Context, lunName, qos string) error {
+	lun, err := p.cli.GetLunByName(ctx, lunName)
+	if err != nil {
+		return err
+	}
+	if lun == nil {
+		return fmt.Errorf("lun %s does not exist", lunName)
+	}
+
+	return p.modifyQoS(ctx, p.cli, lun, lunObjectType, "", qos)
+}
+
+// modifyFileSystemQoS modifies the qos of the filesystem and its hypermetro remote filesystem,
+// the local qos is restored if the remote one fails to be modified, the same as modifyLunQoS.
+func (p *NAS) modifyFileSystemQoS(ctx context.Context, fs map[string]interface{}, fsName, qos string) error {
+	remoteFs, err := p.getHyperMetroRemoteFileSystem(ctx, fs, fsName)
+	if err != nil {
+		return err
+	}
+
+	vStoreID, _ := utils.GetValue[string](fs, "vstoreId")
+	if remoteFs == nil {
+		return p.modifyQoS(ctx, p.cli, fs, fsObjectType, vStoreID, qos)
+	}
+
+	originQoS, err := p.getQoSConfig(ctx, fs, vStoreID)
+	if err != nil {
+		return err
+	}
+	if err = p.modifyQoS(ctx, p.cli, fs, fsObjectType, vStoreID, qos); err != nil {
+		return err
+	}
+	remoteVStoreID, _ := utils.GetValue[string](remoteFs, "vstoreId")
+	if err = p.modifyQoS(ctx, p.metroRemoteCli, remoteFs, fsObjectType, remoteVStoreID, qos); err != nil {
+		if restoreErr := p.restoreFileSystemQoS(ctx, fsName, originQoS); restoreErr != nil {
+			log.AddContext(ctx).Errorf("Restore qos of filesystem %s to %q error: %v",
+				fsName, originQoS, restoreErr)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// getHyperMetroRemoteFileSystem returns the hypermetro remote filesystem of the filesystem,
+// it is nil if the filesystem is not hypermetro
+func (p *NAS) getHyperMetroRemoteFileSystem(ctx context.Context, fs map[string]interface{},
+	fsName string) (map[string]interface{}, error) {
+	hyperMetroIDs, err := p.parseHyperMetroPairs(fs)
+	if err != nil {
+		return nil, err
+	}
+	if len(hyperMetroIDs) == 0 {
+		return nil, nil
+	}
+
+	if p.metroRemoteCli == nil {
+		return nil, fmt.Errorf("filesystem %s is hypermetro, but hypermetro backend is not configured", fsName)
+	}
+	remoteFs, err := p.metroRemoteCli.GetFileSystemByName(ctx, fsName)
+	if err != nil {
+		log.AddContext(ctx).Errorf("Get hypermetro remote filesystem %s error: %v", fsName, err)
+		return nil, err
+	}
+	if remoteFs == nil {
+		return nil, fmt.Errorf("hypermetro remote filesystem %s does not exist", fsName)
+	}
+
+	return remoteFs, nil
+}
+
+func (p *NAS) restoreFileSystemQoS(ctx context.Context, fsName, qos string) error {
+	fs, err := p.cli.GetFileSystemByName(ctx, fsName)
+	if err != nil {
+		return err
+	}
+	if fs == nil {
+		return fmt.Errorf("filesystem %s does not exist", fsName)
+	}
+
+	vStoreID, _ := utils.GetValue[string](fs, "vstoreId")
+	return p.modifyQoS(ctx, p.cli, fs, fsObjectType, vStoreID, qos)
+}
+
+// modifyQoS replaces the qos of the object with a new one which is built from the qos config,
+// an empty qos config means the qos of the object is removed.
+func (p *Base) modifyQoS(ctx context.Context, cli client.OceanstorClientInterface, obj map[string]interface{},
+	objType, vStoreID, qosConfig string) error {
+	params := map[string]interface{}{ModifyQoSKey: qosConfig}
+	if err := p.getQoS(ctx, params); err != nil {
+		return err
+	}
+	qos, _ := params[ModifyQoSKey].(map[string]int)
+
+	objID, _ := utils.GetValue[string](obj, "ID")
+	smartX := smartx.NewSmartX(cli)
+	if qosID, _ := utils.GetValue[string](obj, "IOCLASSID"); qosID != "" {
+		if len(qos) != 0 {
+			if err := smartX.ModifyQos(ctx, qosID, objID, objType, vStoreID, qos); err != nil {
+				log.AddContext(ctx).Errorf("Modify qos %s of %s %s to %v error: %v", qosID, objType, objID, qos, err)
+				return err
+			}
+
+			return nil
+		}
+
+		if err := smartX.DeleteQos(ctx, qosID, objID, objType, vStoreID); err != nil {
+			log.AddContext(ctx).Errorf("Remove %s %s from qos %s error: %v", objType, objID, qosID, err)
+			return err
+		}
+	}
+
+	if len(qos) == 0 {
+		return nil
+	}
+
+	if _, err := smartX.CreateQos(ctx, objID, objType, vStoreID, qos); err != nil {
+		log.AddContext(ctx).Errorf("Create qos %v for %s %s error: %v", qos, objType, objID, err)
+		return err
+	}
+
+	return nil
+}
+
+// modifyShareOptions used to change the auth clients and squash of the nfs share in place.
+// The auth clients which are not in the new list are removed, and the new ones inherit the squash of the share.
+func (p *Base) modifyShareOptions(ctx context.Context, shareName string, params map[string]string) error {
+	squash, err := utils.GetModifySquash(params)
+	if err != nil {
+		return err
+	}
+	authClient, modifyAuthClient := params[ModifyAuthClientKey]
+	if !modifyAuthClient && squash.IsEmpty() {
+		return nil
+	}
+
+	sharePath := utils.GetOriginSharePath(shareName)
+	vStoreID := p.cli.GetvStoreID()
+	share, err := p.cli.GetNfsShareByPath(ctx, sharePath, vStoreID)
+	if err != nil {
+		return fmt.Errorf("get nfs share by path %s error: %w", sharePath, err)
+	}
+	shareID, _ := utils.GetValue[string](share, "ID")
+	if shareID == "" {
+		return fmt.Errorf("nfs share %s does not exist", sharePath)
+	}
+
+	if modifyAuthClient {
+		if err = p.modifyAuthClients(ctx, shareName, shareID, vStoreID, authClient); err != nil {
+			return fmt.Errorf("modify auth clients of share %s error: %w", sharePath, err)
+		}
+	}
+
+	if squash.IsEmpty() {
+		return nil
+	}
+
+	accesses, err := p.getCurrentShareAccess(ctx, shareID, vStoreID, p.cli)
+	if err != nil {
+		return fmt.Errorf("get auth clients of share %s error: %w", sharePath, err)
+	}
+	for name, access := range accesses {
+		if err = p.modifyAuthClientSquash(ctx, access, vStoreID, squash); err != nil {
+			return fmt.Errorf("modify squash of auth client %s of share %s error: %w", name, sharePath, err)
+		}
+	}
+
+	return nil
+}
+
+func (p *Base) modifyAuthClients(ctx context.Context, shareName, shareID, vStoreID, authClient string) error {
+	clients := utils.SplitAuthClients(authClient)
+	if len(clients) == 0 {
+		return fmt.Errorf("%s can not be empty", ModifyAuthClientKey)
+	}
+
+	accesses, err := p.getCurrentShareAccess(ctx, shareID, vStoreID, p.cli)
+	if err != nil {
+		return err
+	}
+
+	var added []string
+	for _, name := range clients {
+		if _, exist := accesses[name]; !exist {
+			added = append(added, name)
+		}
+	}
+
+	// the new auth clients are added before the stale ones are removed, so that they can copy the squash of them
+	if len(added) != 0 {
+		if err = p.autoManageAuthClient(ctx, shareName, added, constants.AuthClientReadWrite); err != nil {
+			return err
+		}
+	}
+
+	for name, access := range accesses {
+		if utils.Contains(clients, name) {
+			continue
+		}
+
+		accessMap, _ := access.(map[string]interface{})
+		accessID, _ := utils.GetValue[string](accessMap, "ID")
+		if err = p.cli.DeleteNfsShareAccess(ctx, accessID, vStoreID); err != nil {
+			return fmt.Errorf("delete auth client %s error: %w", name, err)
+		}
+	}
+
+	log.AddContext(ctx).Infof("Modify auth clients of share %s to %v success", shareName, clients)
+	return nil
+}
+
+func (p *Base) modifyAuthClientSquash(ctx context.Context, access interface{}, vStoreID string,
+	squash utils.ShareSquash) error {
+	authClient, ok := access.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("convert auth client %v to map[string]interface{} failed", access)
+	}
+
+	currentAllSquash, currentRootSquash, err := getAuthClientSquash(authClient)
+	if err != nil {
+		return err
+	}
+	if squash.AllSquash != nil {
+		currentAllSquash = *squash.AllSquash
+	}
+	if squash.RootSquash != nil {
+		currentRootSquash = *squash.RootSquash
+	}
+
+	accessID, _ := utils.GetValue[string](authClient, "ID")
+	return p.cli.ModifyNfsShareAccessSquash(ctx, accessID, vStoreID, currentAllSquash, currentRootSquash)
+}
+
+func getAuthClientSquash(authClient map[string]interface{}) (int, int, error) {
+	values := make(map[string]int, 2)
+	for _, field := range []string{allSquashField, rootSquashField} {
+		current, _ := utils.GetValue[string](authClient, field)
+		value, err := strconv.Atoi(current)
+		if err != nil {
+			return 0, 0, fmt.Errorf("convert %s %v to int failed", field, current)
+		}
+		values[field] = value
+	}
+
+	return values[allSquashField], values[rootSquashField], nil
+}
+
+func getModifyLunData(params map[string]string) (map[string]interface{}, error) {
+	data := make(map[string]interface{})
+	if description, exist := params[ModifyDescriptionKey]; exist {
+		data["DESCRIPTION"] = description
+	}
+
+	for key, policy := range map[string]struct {
+		field    string
+		policies map[string]int
+	}{
+		ModifySmartTierInitialPolicyKey:    {field: "INITIALDISTRIBUTEPOLICY", policies: client.SmartTierInitialPolicies},
+		ModifySmartTierRelocationPolicyKey: {field: "DATATRANSFERPOLICY", policies: client.SmartTierRelocationPolicies},
+	} {
+		value, exist := params[key]
+		if !exist {
+			continue
+		}
+
+		storageValue, ok := policy.policies[value]
+		if !ok {
+			return nil, fmt.Errorf("%s [%s] is invalid, it must be one of %v", key, value,
+				slices.Sorted(maps.Keys(policy.policies)))
+		}
+		data[policy.field] = storageValue
+	}
+
+	return data, nil
+}
+
+func getModifyFileSystemData(params map[string]string) (map[string]interface{}, error) {
+	data := make(map[string]interface{})
+	if description, exist := params[ModifyDescriptionKey]; exist {
+		data["DESCRIPTION"] = description
+	}
+
+	if allocType, exist := params[ModifyAllocTypeKey]; exist {
+		switch allocType {
+		case thickAllocType:
+			data["ALLOCTYPE"] = 0
+		case thinAllocType:
+			data["ALLOCTYPE"] = 1
+		default:
+			return nil, fmt.Errorf("%s must be %q or %q, %q is invalid",
+				ModifyAllocTypeKey, thickAllocType, thinAllocType, allocType)
+		}
+	}
+
+	if ratio, exist := params[ModifyReservedSnapshotSpaceRatioKey]; exist {
+		value, err := strconv.Atoi(ratio)
+		if err != nil {
+			return nil, fmt.Errorf("convert %s [%s] to int failed: %w", ModifyReservedSnapshotSpaceRatioKey, ratio, err)
+		}
+		data["SNAPSHOTRESERVEPER"] = value
+	}
+
+	if visibility, exist := params[ModifySnapshotDirectoryVisibilityKey]; exist {
+		switch {
+		case strings.EqualFold(visibility, visibleString):
+			data["ISSHOWSNAPDIR"] = true
+		case strings.EqualFold(visibility, invisibleString):
+			data["ISSHOWSNAPDIR"] = false
+		default:
+			return nil, fmt.Errorf("%s must be %q or %q, %q is invalid",
+				ModifySnapshotDirectoryVisibilityKey, visibleString, invisibleString, visibility)
+		}
+	}
+
+	return data, nil
+}
+
+func checkModifyKeys(params map[string]string, supportedKeys ...string) error {
+	for key := range params {
+		if !utils.Contains(supportedKeys, key) {
+			return fmt.Errorf("parameter %s can not be modified, supported parameters: %v", key, supportedKeys)
+		}
+	}
+
+	return nil
+}
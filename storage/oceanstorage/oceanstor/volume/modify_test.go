@@ -0,0 +1,511 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package volume
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/base"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/test/mocks/mock_client"
+)
+
+func TestSAN_ModifyAttributes_UpdateQoSInPlace(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	san := NewSAN(cli, nil, nil, constants.OceanStorDoradoV6)
+	lun := map[string]interface{}{"ID": "lun-1", "IOCLASSID": "qos-1"}
+	oldQoS := map[string]interface{}{"ID": "qos-1", "LUNLIST": `["lun-1"]`, "MAXIOPS": "1000", "MAXBANDWIDTH": "0"}
+
+	// mock
+	cli.EXPECT().MakeLunName("test-lun").Return("test-lun")
+	cli.EXPECT().GetLunByName(ctx, "test-lun").Return(lun, nil)
+	cli.EXPECT().UpdateLun(ctx, "lun-1", map[string]interface{}{"DESCRIPTION": "gold"}).Return(nil)
+	cli.EXPECT().GetQosByID(ctx, "qos-1", "").Return(oldQoS, nil)
+	cli.EXPECT().UpdateQos(ctx, "qos-1", "", map[string]interface{}{"MAXIOPS": 2000}).Return(nil)
+
+	// action
+	err := san.ModifyAttributes(ctx, "test-lun",
+		map[string]string{"qos": `{"MAXIOPS": 2000}`, "description": "gold"})
+
+	// assert
+	assert.NoError(t, err)
+}
+
+func TestSAN_ModifyAttributes_ReplaceQoS(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	san := NewSAN(cli, nil, nil, constants.OceanStorDoradoV6)
+	lun := map[string]interface{}{"ID": "lun-1", "IOCLASSID": "qos-1"}
+	oldQoS := map[string]interface{}{"ID": "qos-1", "LUNLIST": `["lun-1"]`, "MINIOPS": "500"}
+	var gotArgs base.CreateQoSArgs
+
+	// mock
+	cli.EXPECT().MakeLunName("test-lun").Return("test-lun")
+	cli.EXPECT().GetLunByName(ctx, "test-lun").Return(lun, nil)
+	cli.EXPECT().GetQosByID(ctx, "qos-1", "").Return(oldQoS, nil).Times(2)
+	cli.EXPECT().DeactivateQos(ctx, "qos-1", "").Return(nil)
+	cli.EXPECT().DeleteQos(ctx, "qos-1", "").Return(nil)
+	cli.EXPECT().CreateQos(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, args base.CreateQoSArgs) (map[string]interface{}, error) {
+			gotArgs = args
+			return map[string]interface{}{"ID": "qos-2", "ENABLESTATUS": "true"}, nil
+		})
+
+	// action
+	err := san.ModifyAttributes(ctx, "test-lun", map[string]string{"qos": `{"MAXIOPS": 2000}`})
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, "lun-1", gotArgs.ObjID)
+	assert.Equal(t, map[string]int{"MAXIOPS": 2000}, gotArgs.Params)
+}
+
+func TestSAN_ModifyAttributes_RestoreExclusiveQoSWhenCreateFailed(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	san := NewSAN(cli, nil, nil, constants.OceanStorDoradoV6)
+	lun := map[string]interface{}{"ID": "lun-1", "IOCLASSID": "qos-1"}
+	oldQoS := map[string]interface{}{"ID": "qos-1", "LUNLIST": `["lun-1"]`, "MAXIOPS": "1000", "IOTYPE": "2"}
+	var restoreArgs base.CreateQoSArgs
+
+	// mock
+	cli.EXPECT().MakeLunName("test-lun").Return("test-lun")
+	cli.EXPECT().GetLunByName(ctx, "test-lun").Return(lun, nil)
+	cli.EXPECT().GetQosByID(ctx, "qos-1", "").Return(oldQoS, nil).Times(2)
+	cli.EXPECT().DeactivateQos(ctx, "qos-1", "").Return(nil)
+	cli.EXPECT().DeleteQos(ctx, "qos-1", "").Return(nil)
+	cli.EXPECT().CreateQos(ctx, gomock.Any()).Return(nil, errors.New("create qos error"))
+	cli.EXPECT().CreateQos(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, args base.CreateQoSArgs) (map[string]interface{}, error) {
+			restoreArgs = args
+			return map[string]interface{}{"ID": "qos-3", "ENABLESTATUS": "true"}, nil
+		})
+
+	// action
+	err := san.ModifyAttributes(ctx, "test-lun", map[string]string{"qos": `{"MAXBANDWIDTH": 100}`})
+
+	// assert
+	assert.ErrorContains(t, err, "create qos error")
+	assert.Equal(t, "lun-1", restoreArgs.ObjID)
+	assert.Equal(t, map[string]int{"MAXIOPS": 1000, "IOTYPE": 2}, restoreArgs.Params)
+}
+
+func TestSAN_ModifyAttributes_RestoreSharedQoSWhenCreateFailed(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	san := NewSAN(cli, nil, nil, constants.OceanStorDoradoV6)
+	lun := map[string]interface{}{"ID": "lun-1", "IOCLASSID": "qos-1"}
+	oldQoS := map[string]interface{}{"ID": "qos-1", "LUNLIST": `["lun-1","lun-2"]`, "MAXIOPS": "1000"}
+
+	// mock
+	cli.EXPECT().MakeLunName("test-lun").Return("test-lun")
+	cli.EXPECT().GetLunByName(ctx, "test-lun").Return(lun, nil)
+	cli.EXPECT().GetQosByID(ctx, "qos-1", "").Return(oldQoS, nil).Times(2)
+	cli.EXPECT().UpdateQos(ctx, "qos-1", "", map[string]interface{}{"LUNLIST": []string{"lun-2"}}).Return(nil)
+	cli.EXPECT().CreateQos(ctx, gomock.Any()).Return(nil, errors.New("create qos error"))
+	cli.EXPECT().UpdateQos(ctx, "qos-1", "",
+		map[string]interface{}{"LUNLIST": []string{"lun-1", "lun-2"}}).Return(nil)
+
+	// action
+	err := san.ModifyAttributes(ctx, "test-lun", map[string]string{"qos": `{"MAXIOPS": 2000}`})
+
+	// assert
+	assert.ErrorContains(t, err, "create qos error")
+}
+
+func TestSAN_GetQoS(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	san := NewSAN(cli, nil, nil, constants.OceanStorDoradoV6)
+	lun := map[string]interface{}{"ID": "lun-1", "IOCLASSID": "qos-1"}
+	qos := map[string]interface{}{"ID": "qos-1", "MAXIOPS": "1000", "MINIOPS": "0", "LATENCY": "2000", "IOTYPE": "2"}
+
+	// mock
+	cli.EXPECT().MakeLunName("test-lun").Return("test-lun")
+	cli.EXPECT().GetLunByName(ctx, "test-lun").Return(lun, nil)
+	cli.EXPECT().GetQosByID(ctx, "qos-1", "").Return(qos, nil)
+
+	// action
+	config, err := san.GetQoS(ctx, "test-lun")
+
+	// assert
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"MAXIOPS": 1000, "LATENCY": 2, "IOTYPE": 2}`, config)
+}
+
+func TestNAS_GetQoS_WithoutQoS(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	nas := NewNAS(cli, nil, nil, constants.OceanStorDoradoV6, NASHyperMetro{}, false)
+
+	// mock
+	cli.EXPECT().GetFileSystemByName(ctx, "test-fs").Return(map[string]interface{}{"ID": "fs-1"}, nil)
+
+	// action
+	config, err := nas.GetQoS(ctx, "test-fs")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Empty(t, config)
+}
+
+func TestSAN_ModifyAttributes_UnsupportedKey(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	san := NewSAN(cli, nil, nil, constants.OceanStorDoradoV6)
+
+	// action
+	err := san.ModifyAttributes(ctx, "test-lun", map[string]string{"allocType": "thick"})
+
+	// assert
+	assert.ErrorContains(t, err, "parameter allocType can not be modified")
+}
+
+func TestSAN_ModifyAttributes_SmartTierPolicies(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	san := NewSAN(cli, nil, nil, constants.OceanStorDoradoV6)
+
+	// mock
+	cli.EXPECT().MakeLunName("test-lun").Return("test-lun")
+	cli.EXPECT().GetLunByName(ctx, "test-lun").Return(map[string]interface{}{"ID": "lun-1"}, nil)
+	cli.EXPECT().UpdateLun(ctx, "lun-1", map[string]interface{}{
+		"INITIALDISTRIBUTEPOLICY": 1,
+		"DATATRANSFERPOLICY":      3,
+	}).Return(nil)
+
+	// action
+	err := san.ModifyAttributes(ctx, "test-lun",
+		map[string]string{"smartTierInitialPolicy": "highest", "smartTierRelocationPolicy": "lowest"})
+
+	// assert
+	assert.NoError(t, err)
+}
+
+func TestCheckSANModifyParameters_InvalidSmartTierPolicy(t *testing.T) {
+	// arrange
+	params := map[string]string{"smartTierRelocationPolicy": "fastest"}
+
+	// action
+	err := CheckSANModifyParameters(params)
+
+	// assert
+	assert.ErrorContains(t, err, "smartTierRelocationPolicy [fastest] is invalid")
+}
+
+func TestNAS_ModifyAttributes_UpdateFileSystemAndRemoveQoS(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	nas := NewNAS(cli, nil, nil, constants.OceanStorDoradoV6, NASHyperMetro{}, true)
+	fs := map[string]interface{}{"ID": "fs-1", "IOCLASSID": "qos-1", "vstoreId": "1"}
+
+	// mock
+	cli.EXPECT().GetFileSystemByName(ctx, "test-fs").Return(fs, nil)
+	cli.EXPECT().UpdateFileSystem(ctx, "fs-1", map[string]interface{}{
+		"ALLOCTYPE":          0,
+		"SNAPSHOTRESERVEPER": 20,
+	}).Return(nil)
+	cli.EXPECT().GetQosByID(ctx, "qos-1", "1").Return(map[string]interface{}{"FSLIST": `["fs-1"]`}, nil)
+	cli.EXPECT().DeactivateQos(ctx, "qos-1", "1").Return(nil)
+	cli.EXPECT().DeleteQos(ctx, "qos-1", "1").Return(nil)
+
+	// action
+	err := nas.ModifyAttributes(ctx, "test-fs",
+		map[string]string{"qos": "", "allocType": "thick", "reservedSnapshotSpaceRatio": "20"})
+
+	// assert
+	assert.NoError(t, err)
+}
+
+func TestNAS_ModifyAttributes_InvalidAllocType(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	nas := NewNAS(cli, nil, nil, constants.OceanStorDoradoV6, NASHyperMetro{}, true)
+
+	// action
+	err := nas.ModifyAttributes(ctx, "test-fs", map[string]string{"allocType": "auto"})
+
+	// assert
+	assert.ErrorContains(t, err, "allocType must be")
+}
+
+func TestSAN_ModifyAttributes_HyperMetroQoS(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	remoteCli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	san := NewSAN(cli, remoteCli, nil, constants.OceanStorDoradoV6)
+	lun := map[string]interface{}{"ID": "lun-1", "HASRSSOBJECT": `{"HyperMetro":"TRUE"}`}
+	remoteLun := map[string]interface{}{"ID": "lun-2"}
+	qosCreated := map[string]interface{}{"ID": "qos-1", "ENABLESTATUS": "true"}
+
+	// mock
+	cli.EXPECT().MakeLunName("test-lun").Return("test-lun")
+	cli.EXPECT().GetLunByName(ctx, "test-lun").Return(lun, nil)
+	cli.EXPECT().CreateQos(ctx, gomock.Any()).Return(qosCreated, nil)
+	remoteCli.EXPECT().GetLunByName(ctx, "test-lun").Return(remoteLun, nil)
+	remoteCli.EXPECT().CreateQos(ctx, gomock.Any()).Return(qosCreated, nil)
+
+	// action
+	err := san.ModifyAttributes(ctx, "test-lun", map[string]string{"qos": `{"MAXBANDWIDTH": 100}`})
+
+	// assert
+	assert.NoError(t, err)
+}
+
+func TestSAN_ModifyAttributes_HyperMetroRemoteLunNotExist(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	remoteCli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	san := NewSAN(cli, remoteCli, nil, constants.OceanStorDoradoV6)
+	lun := map[string]interface{}{"ID": "lun-1", "HASRSSOBJECT": `{"HyperMetro":"TRUE"}`}
+
+	// mock
+	cli.EXPECT().MakeLunName("test-lun").Return("test-lun")
+	cli.EXPECT().GetLunByName(ctx, "test-lun").Return(lun, nil)
+	remoteCli.EXPECT().GetLunByName(ctx, "test-lun").Return(nil, nil)
+
+	// action
+	err := san.ModifyAttributes(ctx, "test-lun", map[string]string{"qos": `{"MAXBANDWIDTH": 100}`})
+
+	// assert
+	assert.ErrorContains(t, err, "hypermetro remote lun test-lun does not exist")
+}
+
+func TestSAN_ModifyAttributes_RestoreLocalQoSWhenRemoteFailed(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	remoteCli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	san := NewSAN(cli, remoteCli, nil, constants.OceanStorDoradoV6)
+	lun := map[string]interface{}{"ID": "lun-1", "HASRSSOBJECT": `{"HyperMetro":"TRUE"}`}
+	modifiedLun := map[string]interface{}{"ID": "lun-1", "IOCLASSID": "qos-1"}
+	remoteLun := map[string]interface{}{"ID": "lun-2"}
+
+	// mock
+	cli.EXPECT().MakeLunName("test-lun").Return("test-lun")
+	cli.EXPECT().GetLunByName(ctx, "test-lun").Return(lun, nil)
+	remoteCli.EXPECT().GetLunByName(ctx, "test-lun").Return(remoteLun, nil)
+	cli.EXPECT().CreateQos(ctx, gomock.Any()).Return(map[string]interface{}{"ID": "qos-1", "ENABLESTATUS": "true"}, nil)
+	remoteCli.EXPECT().CreateQos(ctx, gomock.Any()).Return(nil, errors.New("create remote qos error"))
+	cli.EXPECT().GetLunByName(ctx, "test-lun").Return(modifiedLun, nil)
+	cli.EXPECT().GetQosByID(ctx, "qos-1", "").Return(map[string]interface{}{"LUNLIST": `["lun-1"]`}, nil)
+	cli.EXPECT().DeactivateQos(ctx, "qos-1", "").Return(nil)
+	cli.EXPECT().DeleteQos(ctx, "qos-1", "").Return(nil)
+
+	// action
+	err := san.ModifyAttributes(ctx, "test-lun", map[string]string{"qos": `{"MAXBANDWIDTH": 100}`})
+
+	// assert
+	assert.ErrorContains(t, err, "create remote qos error")
+}
+
+func TestNAS_ModifyAttributes_HyperMetroRemoteNotConfigured(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	nas := NewNAS(cli, nil, nil, constants.OceanStorDoradoV6, NASHyperMetro{}, true)
+	fs := map[string]interface{}{"ID": "fs-1", "IOCLASSID": "qos-1", "HYPERMETROPAIRIDS": `["metro-1"]`}
+
+	// mock
+	cli.EXPECT().GetFileSystemByName(ctx, "test-fs").Return(fs, nil)
+
+	// action
+	err := nas.ModifyAttributes(ctx, "test-fs", map[string]string{"qos": `{"MAXIOPS": 2000}`})
+
+	// assert
+	assert.ErrorContains(t, err, "hypermetro backend is not configured")
+}
+
+func TestNAS_ModifyAttributes_ShareOptions(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	nas := NewNAS(cli, nil, nil, constants.OceanStorDoradoV6, NASHyperMetro{}, true)
+	share := map[string]interface{}{"ID": "share-1"}
+	clients := []any{
+		map[string]interface{}{"ID": "access-1", "NAME": "192.168.1.1", "ALLSQUASH": "1", "ROOTSQUASH": "0",
+			"ACCESSKRB5": "5", "ACCESSKRB5I": "5", "ACCESSKRB5P": "5"},
+		map[string]interface{}{"ID": "access-3", "NAME": "192.168.1.3", "ALLSQUASH": "1", "ROOTSQUASH": "0",
+			"ACCESSKRB5": "5", "ACCESSKRB5I": "5", "ACCESSKRB5P": "5"},
+	}
+	var gotReq *base.AllowNfsShareAccessRequest
+
+	// mock
+	cli.EXPECT().GetvStoreID().Return("").AnyTimes()
+	cli.EXPECT().GetFileSystemByName(ctx, "test-fs").Return(map[string]interface{}{"ID": "fs-1"}, nil)
+	cli.EXPECT().UpdateFileSystem(ctx, "fs-1", map[string]interface{}{"ISSHOWSNAPDIR": false}).Return(nil)
+	cli.EXPECT().GetNfsShareByPath(ctx, "/test-fs/", "").Return(share, nil).Times(2)
+	cli.EXPECT().GetNfsShareAccessCount(ctx, "share-1", "").Return(int64(2), nil)
+	cli.EXPECT().GetNfsShareAccessRange(ctx, "share-1", "", gomock.Any(), gomock.Any()).Return(clients, nil).Times(2)
+	cli.EXPECT().GetNfsShareAccess(ctx, "share-1", "192.168.1.2", "").Return(nil, nil)
+	cli.EXPECT().AllowNfsShareAccess(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *base.AllowNfsShareAccessRequest) error {
+			gotReq = req
+			return nil
+		})
+	cli.EXPECT().DeleteNfsShareAccess(ctx, "access-3", "").Return(nil)
+
+	// action
+	err := nas.ModifyAttributes(ctx, "test-fs", map[string]string{
+		"authClient": "192.168.1.1;192.168.1.2", "snapshotDirectoryVisibility": "invisible"})
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, "192.168.1.2", gotReq.Name)
+	assert.Equal(t, constants.NoAllSquashValue, gotReq.AllSquash)
+	assert.Equal(t, constants.RootSquashValue, gotReq.RootSquash)
+}
+
+func TestDTree_ModifyAttributes_Squash(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	dtree := NewDTree(cli)
+	clients := []any{map[string]interface{}{"ID": "access-1", "NAME": "*", "ALLSQUASH": "1", "ROOTSQUASH": "1"}}
+
+	// mock
+	cli.EXPECT().GetvStoreID().Return("1").AnyTimes()
+	cli.EXPECT().GetNfsShareByPath(ctx, "/parent/test-dtree/", "1").
+		Return(map[string]interface{}{"ID": "share-1"}, nil)
+	cli.EXPECT().GetNfsShareAccessCount(ctx, "share-1", "1").Return(int64(1), nil)
+	cli.EXPECT().GetNfsShareAccessRange(ctx, "share-1", "1", int64(0), int64(100)).Return(clients, nil)
+	cli.EXPECT().ModifyNfsShareAccessSquash(ctx, "access-1", "1", constants.AllSquashValue,
+		constants.NoRootSquashValue).Return(nil)
+
+	// action
+	err := dtree.ModifyAttributes(ctx, "test-dtree", "parent", map[string]string{"allSquash": "all_squash"})
+
+	// assert
+	assert.NoError(t, err)
+}
+
+func TestDTree_ModifyAttributes_UnsupportedKey(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	dtree := NewDTree(mock_client.NewMockOceanstorClientInterface(mockCtrl))
+
+	// action
+	err := dtree.ModifyAttributes(ctx, "test-dtree", "parent",
+		map[string]string{"snapshotDirectoryVisibility": "visible"})
+
+	// assert
+	assert.ErrorContains(t, err, "can not be modified")
+}
+
+func TestNAS_GetExportOptions(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	nas := NewNAS(cli, nil, nil, constants.OceanStorDoradoV6, NASHyperMetro{}, true)
+	clients := []any{
+		map[string]interface{}{"ID": "access-3", "NAME": "192.168.1.3", "ALLSQUASH": "1", "ROOTSQUASH": "0"},
+		map[string]interface{}{"ID": "access-1", "NAME": "192.168.1.1", "ALLSQUASH": "1", "ROOTSQUASH": "0"},
+	}
+
+	// mock
+	cli.EXPECT().GetvStoreID().Return("").AnyTimes()
+	cli.EXPECT().GetFileSystemByName(ctx, "test-fs").
+		Return(map[string]interface{}{"ID": "fs-1", "ISSHOWSNAPDIR": "true"}, nil)
+	cli.EXPECT().GetNfsShareByPath(ctx, "/test-fs/", "").Return(map[string]interface{}{"ID": "share-1"}, nil)
+	cli.EXPECT().GetNfsShareAccessCount(ctx, "share-1", "").Return(int64(2), nil)
+	cli.EXPECT().GetNfsShareAccessRange(ctx, "share-1", "", int64(0), int64(100)).Return(clients, nil)
+
+	// action
+	got, err := nas.GetExportOptions(ctx, "test-fs")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"authClient": "192.168.1.1;192.168.1.3", "allSquash": "no_all_squash",
+		"rootSquash": "root_squash", "snapshotDirectoryVisibility": "visible"}, got)
+}
+
+func TestDTree_GetExportOptions_WithoutAuthClient(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	dtree := NewDTree(cli)
+
+	// mock
+	cli.EXPECT().GetvStoreID().Return("1").AnyTimes()
+	cli.EXPECT().GetNfsShareByPath(ctx, "/parent/test-dtree/", "1").
+		Return(map[string]interface{}{"ID": "share-1"}, nil)
+	cli.EXPECT().GetNfsShareAccessCount(ctx, "share-1", "1").Return(int64(0), nil)
+
+	// action
+	got, err := dtree.GetExportOptions(ctx, "test-dtree", "parent")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"authClient": "", "allSquash": "no_all_squash",
+		"rootSquash": "no_root_squash"}, got)
+}
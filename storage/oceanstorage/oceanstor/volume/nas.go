@@ -72,14 +72,15 @@ type NAS struct {
 }
 
 // NewNAS inits a new nas client
-func NewNAS(cli, metroRemoteCli client.OceanstorClientInterface, product constants.OceanstorVersion,
-	nasHyperMetro NASHyperMetro, isRunningOnOwnSite bool) *NAS {
+func NewNAS(cli, metroRemoteCli, replicaRemoteCli client.OceanstorClientInterface,
+	product constants.OceanstorVersion, nasHyperMetro NASHyperMetro, isRunningOnOwnSite bool) *NAS {
 
 	return &NAS{
 		Base: Base{
-			cli:            cli,
-			metroRemoteCli: metroRemoteCli,
-			product:        product,
+			cli:              cli,
+			metroRemoteCli:   metroRemoteCli,
+			replicaRemoteCli: replicaRemoteCli,
+			product:          product,
 		},
 		NASHyperMetro:      nasHyperMetro,
 		isRunningOnOwnSite: isRunningOnOwnSite,
@@ -327,6 +328,13 @@ func (p *NAS) create(ctx context.Context, params map[string]interface{}) (utils.
 		return nil, ErrLogicPortFailOver
 	}
 
+	if isReplicationFromParams(params) {
+		if err := p.setReplicationParams(ctx, params); err != nil {
+			return nil, err
+		}
+		standbyCli = p.replicaRemoteCli
+	}
+
 	volumeCreator, err := creator.NewFromParameters(ctx, params, activeCli, standbyCli)
 	if err != nil {
 		return nil, err
@@ -335,6 +343,47 @@ func (p *NAS) create(ctx context.Context, params map[string]interface{}) (utils.
 	return volumeCreator.CreateVolume(ctx)
 }
 
+func (p *NAS) setReplicationParams(ctx context.Context, params map[string]interface{}) error {
+	remoteDeviceID, err := p.getReplicaRemoteDeviceID(ctx)
+	if err != nil {
+		return err
+	}
+
+	remotePoolID, err := p.getRemotePoolID(ctx, params, p.replicaRemoteCli)
+	if err != nil {
+		return err
+	}
+
+	params[creator.RemoteDeviceIdKey] = remoteDeviceID
+	params[creator.RemotePoolIdKey] = remotePoolID
+
+	vStoreID := p.cli.GetvStoreID()
+	if vStoreID == "" || vStoreID == systemVStore {
+		return nil
+	}
+
+	vStorePair, err := p.cli.GetReplicationvStorePairByvStore(ctx, vStoreID)
+	if err != nil {
+		return err
+	}
+	if vStorePair == nil {
+		return fmt.Errorf("replication vstore pair of vstore %s does not exist", vStoreID)
+	}
+
+	status, _ := utils.GetValue[string](vStorePair, "RUNNINGSTATUS")
+	if status != replicationVStorePairRunningStatusNormal && status != replicationVStorePairRunningStatusSync {
+		return fmt.Errorf("replication vstore pair of vstore %s status %s is not normal", vStoreID, status)
+	}
+
+	role, _ := utils.GetValue[string](vStorePair, "ROLE")
+	if role != replicationRolePrimary {
+		return fmt.Errorf("vstore %s is not the primary end of the replication vstore pair", vStoreID)
+	}
+
+	params[creator.ReplicationVStorePairIdKey] = vStorePair["ID"]
+	return nil
+}
+
 func (p *NAS) validateManage(ctx context.Context, params, fs map[string]interface{}) error {
 	return p.validateManageWorkLoadType(ctx, params, fs)
 }
@@ -388,7 +437,7 @@ func (p *NAS) Query(ctx context.Context, fsName string, params map[string]interf
 	}
 
 	if fs == nil {
-		return nil, utils.Errorf(ctx, "Filesystem [%s] to query does not exist", fsName)
+		return nil, fmt.Errorf("%w: filesystem [%s] to query does not exist", constants.ErrVolumeNotFound, fsName)
 	}
 
 	if err = p.validateManage(ctx, params, fs); err != nil {
@@ -438,6 +487,10 @@ func (p *NAS) Delete(ctx context.Context, fsName string) error {
 	if err != nil {
 		return err
 	}
+	replicationIDs, err := p.parseReplicationPairs(fs)
+	if err != nil {
+		return err
+	}
 	taskflow := flow.NewTaskFlow(ctx, "Delete-FileSystem-Volume")
 	if len(hyperMetroIDs) > 0 {
 		if p.metroRemoteCli == nil {
@@ -454,12 +507,17 @@ func (p *NAS) Delete(ctx context.Context, fsName string) error {
 			return ErrLogicPortFailOver
 		}
 
+		if len(replicationIDs) > 0 {
+			taskflow.AddTask("Delete-Replication-Pair", p.deleteReplication, nil)
+			taskflow.AddTask("Delete-Replication-Remote-FileSystem", p.deleteReplicationRemoteFS, nil)
+		}
 		taskflow.AddTask("Delete-Local-FileSystem", p.deleteLocalFS, nil)
 	}
 
 	vStoreID, _ := fs["vstoreId"].(string)
 	params := map[string]interface{}{
 		"name":           fsName,
+		"localFSID":      fsID,
 		"hypermetroIDs":  hyperMetroIDs,
 		"localVStoreID":  vStoreID,
 		"remoteVStoreID": p.RmtVStoreID,
@@ -492,6 +550,10 @@ func (p *NAS) Expand(ctx context.Context, fsName string, newSize int64) error {
 	if err != nil {
 		return err
 	}
+	replicationIDs, err := p.parseReplicationPairs(fs)
+	if err != nil {
+		return err
+	}
 	expandTask := flow.NewTaskFlow(ctx, "Expand-FileSystem-Volume")
 	expandTask.AddTask("Expand-PreCheck-Capacity", p.preExpandCheckCapacity, nil)
 
@@ -514,9 +576,19 @@ func (p *NAS) Expand(ctx context.Context, fsName string, newSize int64) error {
 		}
 	} else if !p.isRunningOnOwnSite {
 		return ErrLogicPortFailOver
+	} else if len(replicationIDs) > 0 {
+		if p.replicaRemoteCli == nil {
+			return errors.New("replication backend is not configured")
+		}
+		expandTask.AddTask("Expand-Remote-PreCheck-Capacity", p.preExpandCheckRemoteCapacity, nil)
+		expandTask.AddTask("Split-Replication", p.splitReplication, nil)
+		expandTask.AddTask("Expand-Replication-Remote-FileSystem", p.expandReplicationRemoteFS, nil)
 	}
 
 	expandTask.AddTask("Expand-Local-FileSystem", p.expandLocalFS, nil)
+	if len(replicationIDs) > 0 {
+		expandTask.AddTask("Sync-Replication", p.syncReplication, nil)
+	}
 	params := map[string]interface{}{
 		"name":            fsName,
 		"size":            newSize,
@@ -524,6 +596,7 @@ func (p *NAS) Expand(ctx context.Context, fsName string, newSize int64) error {
 		"localFSID":       fs["ID"].(string),
 		"localParentName": fs["PARENTNAME"].(string),
 		"hyperMetroIDs":   hyperMetroIDs,
+		"replicationIDs":  replicationIDs,
 	}
 	_, err = expandTask.Run(params)
 	return err
@@ -880,7 +953,7 @@ func (p *NAS) preExpandCheckRemoteCapacity(ctx context.Context,
 	params, taskResult map[string]interface{}) (map[string]interface{}, error) {
 	// define the client
 	var cli client.OceanstorClientInterface
-	if p.replicaRemoteCli != nil {
+	if replicationIDs, _ := params["replicationIDs"].([]string); len(replicationIDs) > 0 && p.replicaRemoteCli != nil {
 		cli = p.replicaRemoteCli
 	} else if p.metroRemoteCli != nil {
 		cli = p.metroRemoteCli
@@ -963,6 +1036,84 @@ func (p *NAS) expandHyperMetroRemoteFS(ctx context.Context,
 	return nil, err
 }
 
+func (p *NAS) splitReplication(ctx context.Context,
+	params, taskResult map[string]interface{}) (map[string]interface{}, error) {
+	fsID, ok := params["localFSID"].(string)
+	if !ok {
+		return nil, pkgUtils.Errorf(ctx, "convert fsID to string failed, data: %v", params["localFSID"])
+	}
+
+	pairIDs, err := p.splitReplicationPairs(ctx, fsID, creator.ReplicationFsResType)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"replicationPairIDs": pairIDs,
+	}, nil
+}
+
+func (p *NAS) expandReplicationRemoteFS(ctx context.Context,
+	params, taskResult map[string]interface{}) (map[string]interface{}, error) {
+	fsID, ok := taskResult["remoteFSID"].(string)
+	if !ok {
+		return nil, pkgUtils.Errorf(ctx, "convert fsID to string failed, data: %v", taskResult["remoteFSID"])
+	}
+	newSize, ok := params["size"].(int64)
+	if !ok {
+		return nil, pkgUtils.Errorf(ctx, "convert newSize to int64 failed, data: %v", params["size"])
+	}
+	err := p.expandFS(ctx, fsID, newSize, p.replicaRemoteCli)
+	if err != nil {
+		log.AddContext(ctx).Errorf("Expand replication remote filesystem %s error: %v", fsID, err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+func (p *NAS) syncReplication(ctx context.Context,
+	params, taskResult map[string]interface{}) (map[string]interface{}, error) {
+	pairIDs, _ := taskResult["replicationPairIDs"].([]string)
+	return nil, p.syncReplicationPairs(ctx, pairIDs)
+}
+
+func (p *NAS) deleteReplication(ctx context.Context,
+	params, taskResult map[string]interface{}) (map[string]interface{}, error) {
+	fsID, ok := params["localFSID"].(string)
+	if !ok {
+		return nil, pkgUtils.Errorf(ctx, "convert fsID to string failed, data: %v", params["localFSID"])
+	}
+
+	deletedNum, err := p.deleteReplicationPairs(ctx, fsID, creator.ReplicationFsResType)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"deletedReplicationPairNum": deletedNum,
+	}, nil
+}
+
+func (p *NAS) deleteReplicationRemoteFS(ctx context.Context,
+	params, taskResult map[string]interface{}) (map[string]interface{}, error) {
+	if deletedNum, _ := taskResult["deletedReplicationPairNum"].(int); deletedNum == 0 {
+		// the remote filesystem is the primary end or has no pair with the local one, it must not be deleted here.
+		return nil, nil
+	}
+
+	if p.replicaRemoteCli == nil {
+		log.AddContext(ctx).Warningln("Replication remote cli is nil, the remote filesystem will be leftover")
+		return nil, nil
+	}
+
+	name, ok := params["name"].(string)
+	if !ok {
+		return nil, pkgUtils.Errorf(ctx, "convert fsName to string failed, data: %v", params["name"])
+	}
+	return nil, p.DeleteFS(ctx, name, p.replicaRemoteCli)
+}
+
 func (p *NAS) expandLocalFS(ctx context.Context,
 	params, taskResult map[string]interface{}) (map[string]interface{}, error) {
 	newSize, ok := params["size"].(int64)
@@ -1254,6 +1405,25 @@ func (p *NAS) parseHyperMetroPairs(fsMap map[string]any) ([]string, error) {
 	return hyperMetroIds, nil
 }
 
+func (p *NAS) parseReplicationPairs(fsMap map[string]any) ([]string, error) {
+	var replicationIds []string
+	rawPairIds, exists := fsMap["REMOTEREPLICATIONIDS"]
+	if !exists {
+		return replicationIds, nil
+	}
+
+	pairIdStr, ok := rawPairIds.(string)
+	if !ok {
+		return nil, fmt.Errorf("remoteReplicationIds is not a string, data: %+v", rawPairIds)
+	}
+
+	if err := json.Unmarshal([]byte(pairIdStr), &replicationIds); err != nil {
+		return nil, fmt.Errorf("unmarshal remoteReplicationIds failed, error: %w", err)
+	}
+
+	return replicationIds, nil
+}
+
 func (p *NAS) assertExpandSize(ctx context.Context, fsName string, curSize, newSize int64) error {
 	if newSize == curSize {
 		log.AddContext(ctx).Infof("the size of filesystem %s has not changed and the current size is %d",
@@ -27,6 +27,7 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/oceanstor/volume/creator"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/test/mocks/mock_client"
 )
 
@@ -58,7 +59,7 @@ func TestNAS_Expand_FilesystemNotFound(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
 	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
-	nas := NewNAS(cli, nil, constants.OceanStorDoradoV6, NASHyperMetro{}, false)
+	nas := NewNAS(cli, nil, nil, constants.OceanStorDoradoV6, NASHyperMetro{}, false)
 
 	fsName := "non-existent-fs"
 	newSize := int64(1073741824)
@@ -81,7 +82,7 @@ func TestNAS_Expand_GetFileSystemError(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
 	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
-	nas := NewNAS(cli, nil, constants.OceanStorDoradoV6, NASHyperMetro{}, false)
+	nas := NewNAS(cli, nil, nil, constants.OceanStorDoradoV6, NASHyperMetro{}, false)
 
 	fsName := "test-fs"
 	newSize := int64(1073741824)
@@ -103,7 +104,7 @@ func TestNAS_CreateSnapshot_FilesystemNotFound(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
 	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
-	nas := NewNAS(cli, nil, constants.OceanStorDoradoV6, NASHyperMetro{}, false)
+	nas := NewNAS(cli, nil, nil, constants.OceanStorDoradoV6, NASHyperMetro{}, false)
 
 	fsName := "non-existent-fs"
 	snapshotName := "test-snapshot"
@@ -126,7 +127,7 @@ func TestNAS_CreateSnapshot_GetFileSystemError(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
 	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
-	nas := NewNAS(cli, nil, constants.OceanStorDoradoV6, NASHyperMetro{}, false)
+	nas := NewNAS(cli, nil, nil, constants.OceanStorDoradoV6, NASHyperMetro{}, false)
 
 	fsName := "test-fs"
 	snapshotName := "test-snapshot"
@@ -141,3 +142,108 @@ func TestNAS_CreateSnapshot_GetFileSystemError(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "get fs error")
 }
+
+func TestNAS_Delete_WithReplication_Success(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	replicaCli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	nas := NewNAS(cli, nil, replicaCli, constants.OceanStorDoradoV6, NASHyperMetro{}, true)
+	fsName := "test-fs"
+	fs := map[string]interface{}{"ID": "fs-1", "REMOTEREPLICATIONIDS": `["pair-1"]`}
+	remoteFs := map[string]interface{}{"ID": "remote-fs-1"}
+	pair := map[string]interface{}{
+		"ID":            "pair-1",
+		"ISPRIMARY":     "true",
+		"RUNNINGSTATUS": creator.ReplicationPairRunningStatusSync,
+	}
+
+	// mock
+	cli.EXPECT().GetFileSystemByName(ctx, fsName).Return(fs, nil).Times(2)
+	cli.EXPECT().GetFSSnapshotCountByParentId(ctx, "fs-1").Return(0, nil)
+	cli.EXPECT().GetReplicationPairByResID(ctx, "fs-1", creator.ReplicationFsResType).
+		Return([]map[string]interface{}{pair}, nil)
+	cli.EXPECT().SplitReplicationPair(ctx, "pair-1").Return(nil)
+	cli.EXPECT().DeleteReplicationPair(ctx, "pair-1").Return(nil)
+	replicaCli.EXPECT().GetFileSystemByName(ctx, fsName).Return(remoteFs, nil)
+	replicaCli.EXPECT().DeleteFileSystem(ctx, map[string]interface{}{"ID": "remote-fs-1"}).Return(nil)
+	cli.EXPECT().GetNfsShareByPath(ctx, gomock.Any(), "").Return(nil, nil)
+	cli.EXPECT().DeleteFileSystem(ctx, map[string]interface{}{"ID": "fs-1"}).Return(nil)
+
+	// action
+	err := nas.Delete(ctx, fsName)
+
+	// assert
+	assert.NoError(t, err)
+}
+
+func TestNAS_Delete_WithSecondaryReplication_KeepRemoteFS(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	replicaCli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	nas := NewNAS(cli, nil, replicaCli, constants.OceanStorDoradoV6, NASHyperMetro{}, true)
+	fsName := "test-fs"
+	fs := map[string]interface{}{"ID": "fs-1", "REMOTEREPLICATIONIDS": `["pair-1"]`}
+	pair := map[string]interface{}{"ID": "pair-1", "ISPRIMARY": "false"}
+
+	// mock
+	cli.EXPECT().GetFileSystemByName(ctx, fsName).Return(fs, nil).Times(2)
+	cli.EXPECT().GetFSSnapshotCountByParentId(ctx, "fs-1").Return(0, nil)
+	cli.EXPECT().GetReplicationPairByResID(ctx, "fs-1", creator.ReplicationFsResType).
+		Return([]map[string]interface{}{pair}, nil)
+	cli.EXPECT().GetNfsShareByPath(ctx, gomock.Any(), "").Return(nil, nil)
+	cli.EXPECT().DeleteFileSystem(ctx, map[string]interface{}{"ID": "fs-1"}).Return(nil)
+
+	// action
+	err := nas.Delete(ctx, fsName)
+
+	// assert
+	assert.NoError(t, err)
+}
+
+func TestNAS_Expand_WithReplication_Success(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	replicaCli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	nas := NewNAS(cli, nil, replicaCli, constants.OceanStorDoradoV6, NASHyperMetro{}, true)
+	fsName := "test-fs"
+	newSize := int64(2097152)
+	fs := map[string]interface{}{
+		"ID":                   "fs-1",
+		"CAPACITY":             "1048576",
+		"PARENTNAME":           "test-pool",
+		"REMOTEREPLICATIONIDS": `["pair-1"]`,
+	}
+	remoteFs := map[string]interface{}{"ID": "remote-fs-1", "CAPACITY": "1048576"}
+	pair := map[string]interface{}{
+		"ID":            "pair-1",
+		"ISPRIMARY":     "true",
+		"RUNNINGSTATUS": creator.ReplicationPairRunningStatusNormal,
+	}
+
+	// mock
+	cli.EXPECT().GetFileSystemByName(ctx, fsName).Return(fs, nil)
+	cli.EXPECT().GetPoolByName(ctx, "test-pool").Return(map[string]interface{}{"ID": "pool-1"}, nil)
+	replicaCli.EXPECT().GetFileSystemByName(ctx, fsName).Return(remoteFs, nil)
+	cli.EXPECT().GetReplicationPairByResID(ctx, "fs-1", creator.ReplicationFsResType).
+		Return([]map[string]interface{}{pair}, nil)
+	cli.EXPECT().SplitReplicationPair(ctx, "pair-1").Return(nil)
+	replicaCli.EXPECT().UpdateFileSystem(ctx, "remote-fs-1", map[string]interface{}{"CAPACITY": newSize}).
+		Return(nil)
+	cli.EXPECT().UpdateFileSystem(ctx, "fs-1", map[string]interface{}{"CAPACITY": newSize}).Return(nil)
+	cli.EXPECT().SyncReplicationPair(ctx, "pair-1").Return(nil)
+
+	// action
+	err := nas.Expand(ctx, fsName, newSize)
+
+	// assert
+	assert.NoError(t, err)
+}
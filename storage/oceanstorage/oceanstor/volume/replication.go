@@ -0,0 +1,134 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package volume
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/oceanstor/volume/creator"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
+)
+
+const (
+	defaultReplicationSyncPeriod = 3600
+	minReplicationSyncPeriod     = 60
+	maxReplicationSyncPeriod     = 86400
+)
+
+func isReplicationFromParams(params map[string]interface{}) bool {
+	replication, ok := params["replication"].(bool)
+	return ok && replication
+}
+
+func (p *Base) getReplicationSyncPeriod(_ context.Context, params map[string]interface{}) error {
+	if !isReplicationFromParams(params) {
+		return nil
+	}
+
+	v, exist := params["replicationsyncperiod"].(string)
+	if !exist || v == "" {
+		params["replicationsyncperiod"] = defaultReplicationSyncPeriod
+		return nil
+	}
+
+	period, err := strconv.Atoi(v)
+	if err != nil || period < minReplicationSyncPeriod || period > maxReplicationSyncPeriod {
+		return fmt.Errorf("error config %s for replicationSyncPeriod, it must be an integer in [%d, %d]",
+			v, minReplicationSyncPeriod, maxReplicationSyncPeriod)
+	}
+	params["replicationsyncperiod"] = period
+
+	return nil
+}
+
+func (p *Base) getReplicaRemoteDeviceID(ctx context.Context) (string, error) {
+	if p.replicaRemoteCli == nil {
+		msg := "replication backend is not configured"
+		log.AddContext(ctx).Errorln(msg)
+		return "", errors.New(msg)
+	}
+
+	return p.getRemoteDeviceID(ctx, p.replicaRemoteCli.GetDeviceSN())
+}
+
+// deleteReplicationPairs deletes the primary replication pairs of the resource and returns the deleted number
+func (p *Base) deleteReplicationPairs(ctx context.Context, resID string, resType int) (int, error) {
+	pairs, err := creator.GetPrimaryReplicationPairs(ctx, p.cli, resID, resType)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, pair := range pairs {
+		pairID, ok := pair["ID"].(string)
+		if !ok {
+			return 0, fmt.Errorf("convert replication pairID to string failed, data: %v", pair["ID"])
+		}
+
+		if creator.IsReplicationPairRunning(pair) {
+			if err := p.cli.SplitReplicationPair(ctx, pairID); err != nil {
+				log.AddContext(ctx).Errorf("Split replication pair %s error: %v", pairID, err)
+				return 0, err
+			}
+		}
+
+		if err := p.cli.DeleteReplicationPair(ctx, pairID); err != nil {
+			log.AddContext(ctx).Errorf("Delete replication pair %s error: %v", pairID, err)
+			return 0, err
+		}
+	}
+
+	return len(pairs), nil
+}
+
+func (p *Base) splitReplicationPairs(ctx context.Context, resID string, resType int) ([]string, error) {
+	pairs, err := creator.GetPrimaryReplicationPairs(ctx, p.cli, resID, resType)
+	if err != nil {
+		return nil, err
+	}
+
+	var pairIDs []string
+	for _, pair := range pairs {
+		pairID, ok := pair["ID"].(string)
+		if !ok {
+			return nil, fmt.Errorf("convert replication pairID to string failed, data: %v", pair["ID"])
+		}
+
+		if creator.IsReplicationPairRunning(pair) {
+			if err := p.cli.SplitReplicationPair(ctx, pairID); err != nil {
+				log.AddContext(ctx).Errorf("Split replication pair %s error: %v", pairID, err)
+				return nil, err
+			}
+		}
+		pairIDs = append(pairIDs, pairID)
+	}
+
+	return pairIDs, nil
+}
+
+func (p *Base) syncReplicationPairs(ctx context.Context, pairIDs []string) error {
+	for _, pairID := range pairIDs {
+		if err := p.cli.SyncReplicationPair(ctx, pairID); err != nil {
+			log.AddContext(ctx).Errorf("Sync replication pair %s error: %v", pairID, err)
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package volume
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBase_getReplicationSyncPeriod(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  map[string]interface{}
+		want    interface{}
+		wantErr bool
+	}{
+		{name: "not replication", params: map[string]interface{}{"replicationsyncperiod": "10"}, want: "10"},
+		{name: "default period", params: map[string]interface{}{"replication": true},
+			want: defaultReplicationSyncPeriod},
+		{name: "valid period", params: map[string]interface{}{"replication": true, "replicationsyncperiod": "600"},
+			want: 600},
+		{name: "too small period",
+			params: map[string]interface{}{"replication": true, "replicationsyncperiod": "10"}, wantErr: true},
+		{name: "invalid period",
+			params: map[string]interface{}{"replication": true, "replicationsyncperiod": "abc"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// action
+			err := (&Base{}).getReplicationSyncPeriod(context.Background(), tt.params)
+
+			// assert
+			if tt.wantErr {
+				require.ErrorContains(t, err, "replicationSyncPeriod")
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, tt.params["replicationsyncperiod"])
+		})
+	}
+}
@@ -0,0 +1,188 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package volume
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
+)
+
+const (
+	snapshotRunningStatusRollingBack = "44"
+	snapshotHealthStatusFault        = "2"
+
+	rollbackProgressFinished = 100
+	rollbackTimeUnset        = "-1"
+)
+
+// RollbackSnapshot rolls back the lun to its snapshot in place. The rollback is started when started is false,
+// otherwise the progress of the running rollback is returned, along with whether the rollback is finished.
+// The error wraps constants.ErrRollbackFailed if the rollback can never be finished.
+func (p *SAN) RollbackSnapshot(ctx context.Context, lunName, snapshotName string, started bool) (int, bool, error) {
+	lun, err := p.cli.GetLunByName(ctx, lunName)
+	if err != nil {
+		return 0, false, fmt.Errorf("get lun by name %s error: %w", lunName, err)
+	}
+	lunID, ok := utils.GetValue[string](lun, "ID")
+	if !ok {
+		return 0, false, fmt.Errorf("lun %s to roll back does not exist", lunName)
+	}
+	if err = checkRollbackLun(lunName, lun); err != nil {
+		return 0, false, err
+	}
+
+	snapshot, err := p.cli.GetLunSnapshotByName(ctx, snapshotName)
+	if err != nil {
+		return 0, false, fmt.Errorf("get lun snapshot by name %s error: %w", snapshotName, err)
+	}
+	if snapshot == nil {
+		return 0, false, fmt.Errorf("lun snapshot %s does not exist", snapshotName)
+	}
+	if parentID, _ := utils.GetValue[string](snapshot, "PARENTID"); parentID != lunID {
+		return 0, false, fmt.Errorf("%w: snapshot %s does not belong to lun %s", constants.ErrRollbackFailed,
+			snapshotName, lunName)
+	}
+	if err = checkRollbackSnapshotHealth(snapshotName, snapshot); err != nil {
+		return 0, false, err
+	}
+
+	snapshotID, _ := utils.GetValue[string](snapshot, "ID")
+	runningStatus, _ := utils.GetValue[string](snapshot, "RUNNINGSTATUS")
+	progress := getRollbackProgress(snapshot)
+	if runningStatus == snapshotRunningStatusRollingBack {
+		return progress, false, nil
+	}
+
+	if started {
+		log.AddContext(ctx).Infof("Rollback lun %s to snapshot %s finished", lunName, snapshotName)
+		return rollbackProgressFinished, true, nil
+	}
+
+	if runningStatus != snapshotRunningStatusActive {
+		return 0, false, fmt.Errorf("snapshot %s is not activated, running status: %s", snapshotName, runningStatus)
+	}
+
+	if err = p.cli.RollbackLunSnapshot(ctx, snapshotID); err != nil {
+		return 0, false, fmt.Errorf("rollback lun %s to snapshot %s error: %w", lunName, snapshotName, err)
+	}
+
+	log.AddContext(ctx).Infof("Start to rollback lun %s to snapshot %s", lunName, snapshotName)
+	return 0, false, nil
+}
+
+// RollbackSnapshot rolls back the filesystem to its snapshot in place. The rollback is started when started is
+// false, otherwise the progress of the running rollback is returned, along with whether the rollback is finished.
+// The error wraps constants.ErrRollbackFailed if the rollback can never be finished.
+func (p *NAS) RollbackSnapshot(ctx context.Context, fsName, snapshotParentID, snapshotName string,
+	started bool) (int, bool, error) {
+	fs, err := p.getFilesystemByName(ctx, p.cli, fsName)
+	if err != nil {
+		return 0, false, err
+	}
+	if len(fs.HyperMetroPairIds) != 0 {
+		return 0, false, fmt.Errorf("%w: filesystem %s is a hypermetro filesystem, it can not be rolled back",
+			constants.ErrRollbackFailed, fsName)
+	}
+	if fs.ID != snapshotParentID {
+		return 0, false, fmt.Errorf("%w: snapshot %s does not belong to filesystem %s", constants.ErrRollbackFailed,
+			snapshotName, fsName)
+	}
+
+	snapshot, err := p.cli.GetFSSnapshotByName(ctx, fs.ID, snapshotName)
+	if err != nil {
+		return 0, false, fmt.Errorf("get filesystem snapshot by name %s error: %w", snapshotName, err)
+	}
+	if snapshot == nil {
+		return 0, false, fmt.Errorf("filesystem snapshot %s does not exist", snapshotName)
+	}
+	if err = checkRollbackSnapshotHealth(snapshotName, snapshot); err != nil {
+		return 0, false, err
+	}
+
+	// the rollback may be started before the started state is recorded, so it must not be started again
+	progress := getRollbackProgress(snapshot)
+	if isFSSnapshotRollingBack(snapshot) {
+		return progress, false, nil
+	}
+
+	if !started {
+		snapshotID, _ := utils.GetValue[string](snapshot, "ID")
+		if err = p.cli.RollbackFSSnapshot(ctx, snapshotID); err != nil {
+			return 0, false, fmt.Errorf("rollback filesystem %s to snapshot %s error: %w", fsName, snapshotName, err)
+		}
+
+		log.AddContext(ctx).Infof("Start to rollback filesystem %s to snapshot %s", fsName, snapshotName)
+		return 0, false, nil
+	}
+
+	endTime, _ := utils.GetValue[string](snapshot, "ROLLBACKENDTIME")
+	if endTime == rollbackTimeUnset && progress < rollbackProgressFinished {
+		return progress, false, nil
+	}
+
+	log.AddContext(ctx).Infof("Rollback filesystem %s to snapshot %s finished", fsName, snapshotName)
+	return rollbackProgressFinished, true, nil
+}
+
+// checkRollbackLun checks the lun is not a member of hypermetro pair, whose data can not be rolled back on one site
+func checkRollbackLun(lunName string, lun map[string]interface{}) error {
+	rssStr, _ := utils.GetValue[string](lun, "HASRSSOBJECT")
+	var rss map[string]string
+	if rssStr != "" {
+		if err := json.Unmarshal([]byte(rssStr), &rss); err != nil {
+			return fmt.Errorf("unmarshal HASRSSOBJECT %s of lun %s error: %w", rssStr, lunName, err)
+		}
+	}
+
+	if rss["HyperMetro"] == "TRUE" {
+		return fmt.Errorf("%w: lun %s is a hypermetro lun, it can not be rolled back", constants.ErrRollbackFailed,
+			lunName)
+	}
+
+	return nil
+}
+
+// checkRollbackSnapshotHealth checks the snapshot is not at fault, the rollback from or to which can never finish
+func checkRollbackSnapshotHealth(snapshotName string, snapshot map[string]interface{}) error {
+	if healthStatus, _ := utils.GetValue[string](snapshot, "HEALTHSTATUS"); healthStatus == snapshotHealthStatusFault {
+		return fmt.Errorf("%w: snapshot %s is at fault status", constants.ErrRollbackFailed, snapshotName)
+	}
+
+	return nil
+}
+
+func isFSSnapshotRollingBack(snapshot map[string]interface{}) bool {
+	startTime, _ := utils.GetValue[string](snapshot, "ROLLBACKSTARTTIME")
+	endTime, _ := utils.GetValue[string](snapshot, "ROLLBACKENDTIME")
+	return startTime != "" && startTime != rollbackTimeUnset && endTime == rollbackTimeUnset
+}
+
+func getRollbackProgress(snapshot map[string]interface{}) int {
+	rate, _ := utils.GetValue[string](snapshot, "ROLLBACKRATE")
+	progress, err := strconv.Atoi(rate)
+	if err != nil || progress < 0 {
+		return 0
+	}
+
+	return min(progress, rollbackProgressFinished)
+}
@@ -0,0 +1,185 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package volume
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/test/mocks/mock_client"
+)
+
+func TestSAN_RollbackSnapshot_Start(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	san := NewSAN(cli, nil, nil, constants.OceanStorDoradoV6)
+	snapshot := map[string]interface{}{"ID": "1", "PARENTID": "10", "RUNNINGSTATUS": "43", "ROLLBACKRATE": "-1"}
+
+	// mock
+	cli.EXPECT().GetLunByName(ctx, "pvc-lun").Return(map[string]interface{}{"ID": "10"}, nil)
+	cli.EXPECT().GetLunSnapshotByName(ctx, "snapshot-1").Return(snapshot, nil)
+	cli.EXPECT().RollbackLunSnapshot(ctx, "1").Return(nil)
+
+	// action
+	progress, finished, err := san.RollbackSnapshot(ctx, "pvc-lun", "snapshot-1", false)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, 0, progress)
+	assert.False(t, finished)
+}
+
+func TestSAN_RollbackSnapshot_Progress(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	san := NewSAN(cli, nil, nil, constants.OceanStorDoradoV6)
+	rollingBack := map[string]interface{}{"ID": "1", "PARENTID": "10", "RUNNINGSTATUS": "44", "ROLLBACKRATE": "45"}
+	active := map[string]interface{}{"ID": "1", "PARENTID": "10", "RUNNINGSTATUS": "43", "ROLLBACKRATE": "100"}
+
+	// mock
+	cli.EXPECT().GetLunByName(ctx, "pvc-lun").Return(map[string]interface{}{"ID": "10"}, nil).Times(2)
+	cli.EXPECT().GetLunSnapshotByName(ctx, "snapshot-1").Return(rollingBack, nil)
+	cli.EXPECT().GetLunSnapshotByName(ctx, "snapshot-1").Return(active, nil)
+
+	// action
+	runningProgress, runningFinished, runningErr := san.RollbackSnapshot(ctx, "pvc-lun", "snapshot-1", true)
+	progress, finished, err := san.RollbackSnapshot(ctx, "pvc-lun", "snapshot-1", true)
+
+	// assert
+	assert.NoError(t, runningErr)
+	assert.Equal(t, 45, runningProgress)
+	assert.False(t, runningFinished)
+	assert.NoError(t, err)
+	assert.Equal(t, 100, progress)
+	assert.True(t, finished)
+}
+
+func TestSAN_RollbackSnapshot_ParentMismatch(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	san := NewSAN(cli, nil, nil, constants.OceanStorDoradoV6)
+	snapshot := map[string]interface{}{"ID": "1", "PARENTID": "20", "RUNNINGSTATUS": "43"}
+
+	// mock
+	cli.EXPECT().GetLunByName(ctx, "pvc-lun").Return(map[string]interface{}{"ID": "10"}, nil)
+	cli.EXPECT().GetLunSnapshotByName(ctx, "snapshot-1").Return(snapshot, nil)
+
+	// action
+	_, _, err := san.RollbackSnapshot(ctx, "pvc-lun", "snapshot-1", false)
+
+	// assert
+	assert.ErrorContains(t, err, "does not belong to lun pvc-lun")
+}
+
+func TestNAS_RollbackSnapshot_StartAndFinish(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	nas := NewNAS(cli, nil, nil, constants.OceanStorDoradoV6, NASHyperMetro{}, true)
+	fs := map[string]interface{}{"ID": "10", "NAME": "pvc-fs", "CAPACITY": "2097152"}
+	snapshot := map[string]interface{}{"ID": "1", "PARENTID": "10", "ROLLBACKRATE": "100",
+		"ROLLBACKENDTIME": "1700000000"}
+
+	// mock
+	cli.EXPECT().GetFileSystemByName(ctx, "pvc-fs").Return(fs, nil).Times(2)
+	cli.EXPECT().GetFSSnapshotByName(ctx, "10", "snapshot-1").Return(snapshot, nil).Times(2)
+	cli.EXPECT().RollbackFSSnapshot(ctx, "1").Return(nil)
+
+	// action
+	_, startFinished, startErr := nas.RollbackSnapshot(ctx, "pvc-fs", "10", "snapshot-1", false)
+	progress, finished, err := nas.RollbackSnapshot(ctx, "pvc-fs", "10", "snapshot-1", true)
+
+	// assert
+	assert.NoError(t, startErr)
+	assert.False(t, startFinished)
+	assert.NoError(t, err)
+	assert.Equal(t, 100, progress)
+	assert.True(t, finished)
+}
+
+func TestSAN_RollbackSnapshot_HyperMetroLun(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	san := NewSAN(cli, nil, nil, constants.OceanStorDoradoV6)
+	lun := map[string]interface{}{"ID": "10", "HASRSSOBJECT": `{"HyperMetro":"TRUE"}`}
+
+	// mock
+	cli.EXPECT().GetLunByName(ctx, "pvc-lun").Return(lun, nil)
+
+	// action
+	_, _, err := san.RollbackSnapshot(ctx, "pvc-lun", "snapshot-1", false)
+
+	// assert
+	assert.ErrorContains(t, err, "is a hypermetro lun")
+	assert.ErrorIs(t, err, constants.ErrRollbackFailed)
+}
+
+func TestSAN_RollbackSnapshot_FaultSnapshot(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	san := NewSAN(cli, nil, nil, constants.OceanStorDoradoV6)
+	snapshot := map[string]interface{}{"ID": "1", "PARENTID": "10", "RUNNINGSTATUS": "44", "HEALTHSTATUS": "2"}
+
+	// mock
+	cli.EXPECT().GetLunByName(ctx, "pvc-lun").Return(map[string]interface{}{"ID": "10"}, nil)
+	cli.EXPECT().GetLunSnapshotByName(ctx, "snapshot-1").Return(snapshot, nil)
+
+	// action
+	_, _, err := san.RollbackSnapshot(ctx, "pvc-lun", "snapshot-1", true)
+
+	// assert
+	assert.ErrorContains(t, err, "snapshot snapshot-1 is at fault status")
+	assert.ErrorIs(t, err, constants.ErrRollbackFailed)
+}
+
+func TestNAS_RollbackSnapshot_RunningBeforeStartedRecorded(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	nas := NewNAS(cli, nil, nil, constants.OceanStorDoradoV6, NASHyperMetro{}, true)
+	fs := map[string]interface{}{"ID": "10", "NAME": "pvc-fs", "CAPACITY": "2097152"}
+	snapshot := map[string]interface{}{"ID": "1", "PARENTID": "10", "ROLLBACKRATE": "30",
+		"ROLLBACKSTARTTIME": "1700000000", "ROLLBACKENDTIME": "-1"}
+
+	// mock
+	cli.EXPECT().GetFileSystemByName(ctx, "pvc-fs").Return(fs, nil)
+	cli.EXPECT().GetFSSnapshotByName(ctx, "10", "snapshot-1").Return(snapshot, nil)
+
+	// action
+	progress, finished, err := nas.RollbackSnapshot(ctx, "pvc-fs", "10", "snapshot-1", false)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, 30, progress)
+	assert.False(t, finished)
+}
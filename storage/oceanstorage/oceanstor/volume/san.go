@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2020-2025. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2020-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -31,6 +31,7 @@ import (
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/base"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/oceanstor/client"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/oceanstor/smartx"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/oceanstor/volume/creator"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/flow"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
@@ -95,6 +96,27 @@ func (p *SAN) preCreate(ctx context.Context, params map[string]interface{}) erro
 		return err
 	}
 
+	return p.getSmartTierPolicy(ctx, params)
+}
+
+// getSmartTierPolicy converts the SmartTier policies of the lun to the values of the storage
+func (p *SAN) getSmartTierPolicy(_ context.Context, params map[string]interface{}) error {
+	for key, policies := range map[string]map[string]int{
+		"smarttierinitialpolicy":    client.SmartTierInitialPolicies,
+		"smarttierrelocationpolicy": client.SmartTierRelocationPolicies,
+	} {
+		v, exist := params[key].(string)
+		if !exist || v == "" {
+			continue
+		}
+
+		policy, ok := policies[v]
+		if !ok {
+			return fmt.Errorf("error config %s for %s", v, key)
+		}
+		params[key] = policy
+	}
+
 	return nil
 }
 
@@ -108,8 +130,11 @@ func (p *SAN) Create(ctx context.Context, params map[string]interface{}) (utils.
 	taskflow := flow.NewTaskFlow(ctx, "Create-LUN-Volume")
 
 	hyperMetro, hyperMetroOK := params["hypermetro"].(bool)
+	replication := isReplicationFromParams(params)
 	if hyperMetroOK && hyperMetro {
 		taskflow.AddTask("Get-HyperMetro-Params", p.getHyperMetroParams, nil)
+	} else if replication {
+		taskflow.AddTask("Get-Replication-Params", p.getReplicationParams, nil)
 	}
 
 	taskflow.AddTask("Create-Local-LUN", p.createLocalLun, p.revertLocalLun)
@@ -119,6 +144,10 @@ func (p *SAN) Create(ctx context.Context, params map[string]interface{}) (utils.
 		taskflow.AddTask("Create-Remote-LUN", p.createRemoteLun, p.revertRemoteLun)
 		taskflow.AddTask("Create-Remote-QoS", p.createRemoteQoS, p.revertRemoteQoS)
 		taskflow.AddTask("Create-HyperMetro", p.createHyperMetro, p.revertHyperMetro)
+	} else if replication {
+		taskflow.AddTask("Create-Remote-LUN", p.createRemoteLun, p.revertRemoteLun)
+		taskflow.AddTask("Create-Remote-QoS", p.createRemoteQoS, p.revertRemoteQoS)
+		taskflow.AddTask("Create-Replication-Pair", p.createReplication, p.revertReplication)
 	}
 
 	res, err := taskflow.Run(params)
@@ -139,7 +168,7 @@ func (p *SAN) Query(ctx context.Context, name string, params map[string]interfac
 	}
 
 	if lun == nil {
-		return nil, utils.Errorf(ctx, "lun [%s] to query does not exist", name)
+		return nil, fmt.Errorf("%w: lun [%s] to query does not exist", constants.ErrVolumeNotFound, name)
 	}
 
 	if err = p.validateManageWorkLoadType(ctx, params, lun); err != nil {
@@ -211,6 +240,11 @@ func (p *SAN) Delete(ctx context.Context, name string) error {
 		taskflow.AddTask("Delete-HyperMetro-Remote-LUN", p.deleteHyperMetroRemoteLun, nil)
 	}
 
+	if replication, ok := rss["RemoteReplication"]; ok && replication == "TRUE" {
+		taskflow.AddTask("Delete-Replication-Pair", p.deleteReplication, nil)
+		taskflow.AddTask("Delete-Replication-Remote-LUN", p.deleteReplicationRemoteLun, nil)
+	}
+
 	if lunCopy, ok := rss["LunCopy"]; ok && lunCopy == "TRUE" {
 		taskflow.AddTask("Delete-Local-LunCopy", p.deleteLocalLunCopy, nil)
 	}
@@ -272,12 +306,23 @@ func (p *SAN) Expand(ctx context.Context, name string, newSize int64) (bool, err
 		expandTask.AddTask("Expand-HyperMetro-Remote-LUN", p.expandHyperMetroRemoteLun, nil)
 	}
 
+	if replication, ok := rss["RemoteReplication"]; ok && replication == "TRUE" {
+		expandTask.AddTask("Expand-Replication-Remote-PreCheck-Capacity",
+			p.preExpandReplicationCheckRemoteCapacity, nil)
+		expandTask.AddTask("Split-Replication", p.splitReplication, nil)
+		expandTask.AddTask("Expand-Replication-Remote-LUN", p.expandReplicationRemoteLun, nil)
+	}
+
 	expandTask.AddTask("Expand-Local-Lun", p.expandLocalLun, nil)
 
 	if hyperMetro, ok := rss["HyperMetro"]; ok && hyperMetro == "TRUE" {
 		expandTask.AddTask("Sync-HyperMetro", p.syncHyperMetro, nil)
 	}
 
+	if replication, ok := rss["RemoteReplication"]; ok && replication == "TRUE" {
+		expandTask.AddTask("Sync-Replication", p.syncReplication, nil)
+	}
+
 	params := map[string]interface{}{
 		"name":            name,
 		"size":            newSize,
@@ -1329,6 +1374,111 @@ func (p *SAN) getHyperMetroParams(ctx context.Context,
 	}, nil
 }
 
+func (p *SAN) getReplicationParams(ctx context.Context,
+	params, taskResult map[string]interface{}) (map[string]interface{}, error) {
+	remoteDeviceID, err := p.getReplicaRemoteDeviceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	remotePoolID, err := p.getRemotePoolID(ctx, params, p.replicaRemoteCli)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"remotePoolID":   remotePoolID,
+		"remoteCli":      p.replicaRemoteCli,
+		"remoteDeviceID": remoteDeviceID,
+	}, nil
+}
+
+func (p *SAN) createReplication(ctx context.Context,
+	params, taskResult map[string]interface{}) (map[string]interface{}, error) {
+	localLunID, ok := taskResult["localLunID"].(string)
+	if !ok {
+		return nil, pkgUtils.Errorf(ctx, "convert localLunID to string failed, data: %v", taskResult["localLunID"])
+	}
+	remoteLunID, ok := taskResult["remoteLunID"].(string)
+	if !ok {
+		return nil, pkgUtils.Errorf(ctx, "convert remoteLunID to string failed, data: %v", taskResult["remoteLunID"])
+	}
+	remoteDeviceID, ok := taskResult["remoteDeviceID"].(string)
+	if !ok {
+		return nil, pkgUtils.Errorf(ctx, "convert remoteDeviceID to string failed, data: %v",
+			taskResult["remoteDeviceID"])
+	}
+
+	pairID, err := creator.GetReplicationPairID(ctx, p.cli, localLunID, remoteLunID, creator.ReplicationLunResType)
+	if err != nil {
+		return nil, err
+	}
+
+	if pairID == "" {
+		syncPeriod, _ := params["replicationsyncperiod"].(int)
+		pairID, err = creator.CreateReplicationPair(ctx, p.cli, &creator.ReplicationPairParam{
+			LocalResID:     localLunID,
+			RemoteResID:    remoteLunID,
+			RemoteDeviceID: remoteDeviceID,
+			ResType:        creator.ReplicationLunResType,
+			SyncPeriod:     syncPeriod,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return map[string]interface{}{
+		"replicationPairID": pairID,
+	}, nil
+}
+
+func (p *SAN) revertReplication(ctx context.Context, taskResult map[string]interface{}) error {
+	pairID, exist := taskResult["replicationPairID"].(string)
+	if !exist || pairID == "" {
+		return nil
+	}
+
+	return creator.RevertReplicationPair(ctx, p.cli, pairID)
+}
+
+func (p *SAN) deleteReplication(ctx context.Context,
+	params, taskResult map[string]interface{}) (map[string]interface{}, error) {
+	lunID, ok := params["lunID"].(string)
+	if !ok {
+		return nil, pkgUtils.Errorf(ctx, "format lunID to string failed, data: %v", params["lunID"])
+	}
+
+	deletedNum, err := p.deleteReplicationPairs(ctx, lunID, creator.ReplicationLunResType)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"deletedReplicationPairNum": deletedNum,
+	}, nil
+}
+
+func (p *SAN) deleteReplicationRemoteLun(ctx context.Context,
+	params, taskResult map[string]interface{}) (map[string]interface{}, error) {
+	if deletedNum, _ := taskResult["deletedReplicationPairNum"].(int); deletedNum == 0 {
+		// the remote lun is the primary end or has no pair with the local lun, it must not be deleted here.
+		return nil, nil
+	}
+
+	if p.replicaRemoteCli == nil {
+		log.AddContext(ctx).Warningln("Replication remote cli is nil, the remote lun will be leftover")
+		return nil, nil
+	}
+
+	lunName, ok := params["lunName"].(string)
+	if !ok {
+		return nil, pkgUtils.Errorf(ctx, "format lunName to string failed, data: %v", params["lunName"])
+	}
+	err := p.deleteLun(ctx, lunName, p.replicaRemoteCli)
+	return nil, err
+}
+
 func (p *SAN) deleteLocalLunCopy(ctx context.Context,
 	params, taskResult map[string]interface{}) (map[string]interface{}, error) {
 	lunID, ok := params["lunID"].(string)
@@ -1576,6 +1726,64 @@ func (p *SAN) syncHyperMetro(ctx context.Context,
 	return nil, nil
 }
 
+func (p *SAN) preExpandReplicationCheckRemoteCapacity(ctx context.Context,
+	params, taskResult map[string]interface{}) (map[string]interface{}, error) {
+	if p.replicaRemoteCli == nil {
+		return nil, errors.New("replication backend is not configured")
+	}
+
+	remoteLunID, err := p.preExpandCheckRemoteCapacity(ctx, params, p.replicaRemoteCli)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"remoteLunID": remoteLunID,
+	}, nil
+}
+
+func (p *SAN) splitReplication(ctx context.Context,
+	params, taskResult map[string]interface{}) (map[string]interface{}, error) {
+	lunID, ok := params["lunID"].(string)
+	if !ok {
+		return nil, pkgUtils.Errorf(ctx, "format lunID to string failed, data: %v", params["lunID"])
+	}
+
+	pairIDs, err := p.splitReplicationPairs(ctx, lunID, creator.ReplicationLunResType)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"replicationPairIDs": pairIDs,
+	}, nil
+}
+
+func (p *SAN) expandReplicationRemoteLun(ctx context.Context,
+	params, taskResult map[string]interface{}) (map[string]interface{}, error) {
+	remoteLunID, ok := taskResult["remoteLunID"].(string)
+	if !ok {
+		return nil, pkgUtils.Errorf(ctx, "convert remoteLunID to string failed, data: %v", taskResult["remoteLunID"])
+	}
+	newSize, ok := params["size"].(int64)
+	if !ok {
+		return nil, pkgUtils.Errorf(ctx, "format newSize to int64 failed, data: %v", params["size"])
+	}
+	err := p.replicaRemoteCli.ExtendLun(ctx, remoteLunID, newSize)
+	if err != nil {
+		log.AddContext(ctx).Errorf("Extend replication remote lun %s error: %v", remoteLunID, err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+func (p *SAN) syncReplication(ctx context.Context,
+	params, taskResult map[string]interface{}) (map[string]interface{}, error) {
+	pairIDs, _ := taskResult["replicationPairIDs"].([]string)
+	return nil, p.syncReplicationPairs(ctx, pairIDs)
+}
+
 func (p *SAN) expandLocalLun(ctx context.Context,
 	params, taskResult map[string]interface{}) (map[string]interface{}, error) {
 
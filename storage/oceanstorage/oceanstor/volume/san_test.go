@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2025-2025. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2025-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -29,6 +29,7 @@ import (
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/base"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/oceanstor/client"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/storage/oceanstorage/oceanstor/volume/creator"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/test/mocks/mock_client"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils"
 )
@@ -366,6 +367,65 @@ func TestSAN_Create_PreCreateFailed(t *testing.T) {
 	assert.ErrorContains(t, err, "must specify storage pool")
 }
 
+func TestSAN_Create_WithSmartTierPolicy(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	san := NewSAN(cli, nil, nil, constants.OceanStorV5)
+
+	params := map[string]interface{}{
+		"name":                      "test-lun",
+		"storagepool":               "test-pool",
+		"capacity":                  int64(1073741824),
+		"smarttierinitialpolicy":    constants.SmartTierPolicyHighest,
+		"smarttierrelocationpolicy": constants.SmartTierPolicyNone,
+	}
+	lun := map[string]interface{}{"ID": "lun-123", "WWN": "wwn-123456", "CAPACITY": "1073741824"}
+
+	// mock
+	cli.EXPECT().GetPoolByName(ctx, "test-pool").Return(map[string]interface{}{"ID": "pool-123"}, nil)
+	cli.EXPECT().MakeLunName("test-lun").Return("k8s_test-lun")
+	cli.EXPECT().GetLunByName(ctx, "k8s_test-lun").Return(nil, nil)
+	cli.EXPECT().CreateLun(ctx, gomock.Any()).Return(lun, nil)
+
+	// action
+	_, err := san.Create(ctx, params)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, params["smarttierinitialpolicy"])
+	assert.Equal(t, 0, params["smarttierrelocationpolicy"])
+}
+
+func TestSAN_Create_InvalidSmartTierPolicy(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	san := NewSAN(cli, nil, nil, constants.OceanStorV5)
+
+	params := map[string]interface{}{
+		"name":                   "test-lun",
+		"storagepool":            "test-pool",
+		"capacity":               int64(1073741824),
+		"smarttierinitialpolicy": constants.SmartTierPolicyNone,
+	}
+
+	// mock
+	cli.EXPECT().GetPoolByName(ctx, "test-pool").Return(map[string]interface{}{"ID": "pool-123"}, nil)
+	cli.EXPECT().MakeLunName("test-lun").Return("k8s_test-lun")
+
+	// action
+	vol, err := san.Create(ctx, params)
+
+	// assert
+	assert.Nil(t, vol)
+	assert.ErrorContains(t, err, "error config none for smarttierinitialpolicy")
+}
+
 func TestSAN_Create_LunAlreadyExistsWithInsufficientCapacity(t *testing.T) {
 	// arrange
 	ctx := context.Background()
@@ -964,3 +1024,189 @@ func TestSAN_Expand_LunNotFound(t *testing.T) {
 	assert.Contains(t, err.Error(), "does not exist")
 	assert.False(t, isAttached)
 }
+
+func TestSAN_Create_WithReplication_Success(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	replicaCli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	san := NewSAN(cli, nil, replicaCli, constants.OceanStorDoradoV6)
+	params := map[string]interface{}{
+		"name":                  "test-lun",
+		"storagepool":           "test-pool",
+		"remotestoragepool":     "test-remote-pool",
+		"capacity":              int64(1073741824),
+		"replication":           true,
+		"replicationsyncperiod": "600",
+	}
+	lun := map[string]interface{}{"ID": "lun-123", "WWN": "wwn-123", "CAPACITY": "1073741824"}
+	remoteLun := map[string]interface{}{"ID": "remote-lun-456", "WWN": "wwn-456", "CAPACITY": "1073741824"}
+	remoteDevice := map[string]interface{}{
+		"ID":            "device-1",
+		"HEALTHSTATUS":  remoteDeviceHealthStatus,
+		"RUNNINGSTATUS": remoteDeviceRunningStatusLinkUp,
+	}
+
+	// mock
+	cli.EXPECT().GetPoolByName(ctx, "test-pool").Return(map[string]interface{}{"ID": "pool-123"}, nil)
+	cli.EXPECT().MakeLunName("test-lun").Return("k8s_test-lun")
+	replicaCli.EXPECT().GetDeviceSN().Return("remote-sn")
+	cli.EXPECT().GetRemoteDeviceBySN(ctx, "remote-sn").Return(remoteDevice, nil)
+	replicaCli.EXPECT().GetPoolByName(ctx, "test-remote-pool").
+		Return(map[string]interface{}{"ID": "remote-pool-456"}, nil)
+	cli.EXPECT().GetLunByName(ctx, "k8s_test-lun").Return(nil, nil)
+	cli.EXPECT().CreateLun(ctx, gomock.Any()).Return(lun, nil)
+	replicaCli.EXPECT().GetLunByName(ctx, "k8s_test-lun").Return(nil, nil)
+	replicaCli.EXPECT().CreateLun(ctx, gomock.Any()).Return(remoteLun, nil)
+	cli.EXPECT().GetReplicationPairByResID(ctx, "lun-123", creator.ReplicationLunResType).Return(nil, nil)
+	cli.EXPECT().CreateReplicationPair(ctx, map[string]interface{}{
+		"LOCALRESID":       "lun-123",
+		"LOCALRESTYPE":     creator.ReplicationLunResType,
+		"REMOTEDEVICEID":   "device-1",
+		"REMOTERESID":      "remote-lun-456",
+		"REPLICATIONMODEL": 2,
+		"SYNCHRONIZETYPE":  2,
+		"TIMINGVAL":        600,
+		"SPEED":            4,
+	}).Return(map[string]interface{}{"ID": "pair-1"}, nil)
+	cli.EXPECT().SyncReplicationPair(ctx, "pair-1").Return(nil)
+
+	// action
+	vol, err := san.Create(ctx, params)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, "k8s_test-lun", vol.GetVolumeName())
+}
+
+func TestSAN_Create_WithReplication_CreatePairFailedAndRevert(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	replicaCli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	san := NewSAN(cli, nil, replicaCli, constants.OceanStorDoradoV6)
+	params := map[string]interface{}{
+		"name":              "test-lun",
+		"storagepool":       "test-pool",
+		"remotestoragepool": "test-remote-pool",
+		"capacity":          int64(1073741824),
+		"replication":       true,
+	}
+	lun := map[string]interface{}{"ID": "lun-123", "WWN": "wwn-123", "CAPACITY": "1073741824"}
+	remoteLun := map[string]interface{}{"ID": "remote-lun-456", "WWN": "wwn-456", "CAPACITY": "1073741824"}
+	remoteDevice := map[string]interface{}{
+		"ID":            "device-1",
+		"HEALTHSTATUS":  remoteDeviceHealthStatus,
+		"RUNNINGSTATUS": remoteDeviceRunningStatusLinkUp,
+	}
+
+	// mock
+	cli.EXPECT().GetPoolByName(ctx, "test-pool").Return(map[string]interface{}{"ID": "pool-123"}, nil)
+	cli.EXPECT().MakeLunName("test-lun").Return("k8s_test-lun")
+	replicaCli.EXPECT().GetDeviceSN().Return("remote-sn")
+	cli.EXPECT().GetRemoteDeviceBySN(ctx, "remote-sn").Return(remoteDevice, nil)
+	replicaCli.EXPECT().GetPoolByName(ctx, "test-remote-pool").
+		Return(map[string]interface{}{"ID": "remote-pool-456"}, nil)
+	cli.EXPECT().GetLunByName(ctx, "k8s_test-lun").Return(nil, nil)
+	cli.EXPECT().CreateLun(ctx, gomock.Any()).Return(lun, nil)
+	replicaCli.EXPECT().GetLunByName(ctx, "k8s_test-lun").Return(nil, nil)
+	replicaCli.EXPECT().CreateLun(ctx, gomock.Any()).Return(remoteLun, nil)
+	cli.EXPECT().GetReplicationPairByResID(ctx, "lun-123", creator.ReplicationLunResType).Return(nil, nil)
+	cli.EXPECT().CreateReplicationPair(ctx, gomock.Any()).Return(nil, errors.New("create pair error"))
+	replicaCli.EXPECT().DeleteLun(ctx, "remote-lun-456").Return(nil)
+	cli.EXPECT().DeleteLun(ctx, "lun-123").Return(nil)
+
+	// action
+	vol, err := san.Create(ctx, params)
+
+	// assert
+	assert.Nil(t, vol)
+	assert.ErrorContains(t, err, "create pair error")
+}
+
+func TestSAN_Delete_WithReplication_Success(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	replicaCli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	san := NewSAN(cli, nil, replicaCli, constants.OceanStorDoradoV6)
+	lun := map[string]interface{}{
+		"ID":           "lun-123",
+		"HASRSSOBJECT": `{"RemoteReplication":"TRUE"}`,
+	}
+	pair := map[string]interface{}{
+		"ID":            "pair-1",
+		"ISPRIMARY":     "true",
+		"RUNNINGSTATUS": creator.ReplicationPairRunningStatusNormal,
+	}
+
+	// mock
+	var deletedClients []client.OceanstorClientInterface
+	p := gomonkey.NewPatches()
+	defer p.Reset()
+	p.ApplyPrivateMethod(&SAN{}, "deleteLun",
+		func(_ *SAN, _ context.Context, name string, cli client.OceanstorClientInterface) error {
+			deletedClients = append(deletedClients, cli)
+			return nil
+		})
+	cli.EXPECT().MakeLunName("test-lun").Return("k8s_test-lun")
+	cli.EXPECT().GetLunByName(ctx, "k8s_test-lun").Return(lun, nil)
+	cli.EXPECT().GetReplicationPairByResID(ctx, "lun-123", creator.ReplicationLunResType).
+		Return([]map[string]interface{}{pair}, nil)
+	cli.EXPECT().SplitReplicationPair(ctx, "pair-1").Return(nil)
+	cli.EXPECT().DeleteReplicationPair(ctx, "pair-1").Return(nil)
+
+	// action
+	err := san.Delete(ctx, "test-lun")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, []client.OceanstorClientInterface{replicaCli, cli}, deletedClients)
+}
+
+func TestSAN_Expand_WithReplication_Success(t *testing.T) {
+	// arrange
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	replicaCli := mock_client.NewMockOceanstorClientInterface(mockCtrl)
+	san := NewSAN(cli, nil, replicaCli, constants.OceanStorDoradoV6)
+	newSize := int64(2097152)
+	lun := map[string]interface{}{
+		"ID":           "lun-123",
+		"CAPACITY":     "1048576",
+		"PARENTNAME":   "test-pool",
+		"HASRSSOBJECT": `{"RemoteReplication":"TRUE"}`,
+	}
+	remoteLun := map[string]interface{}{"ID": "remote-lun-456", "CAPACITY": "1048576"}
+	pair := map[string]interface{}{
+		"ID":            "pair-1",
+		"ISPRIMARY":     "true",
+		"RUNNINGSTATUS": creator.ReplicationPairRunningStatusNormal,
+	}
+
+	// mock
+	cli.EXPECT().MakeLunName("test-lun").Return("k8s_test-lun").Times(2)
+	cli.EXPECT().GetLunByName(ctx, "k8s_test-lun").Return(lun, nil)
+	cli.EXPECT().GetPoolByName(ctx, "test-pool").Return(map[string]interface{}{"ID": "pool-123"}, nil)
+	replicaCli.EXPECT().GetLunByName(ctx, "k8s_test-lun").Return(remoteLun, nil)
+	cli.EXPECT().GetReplicationPairByResID(ctx, "lun-123", creator.ReplicationLunResType).
+		Return([]map[string]interface{}{pair}, nil)
+	cli.EXPECT().SplitReplicationPair(ctx, "pair-1").Return(nil)
+	replicaCli.EXPECT().ExtendLun(ctx, "remote-lun-456", newSize).Return(nil)
+	cli.EXPECT().ExtendLun(ctx, "lun-123", newSize).Return(nil)
+	cli.EXPECT().SyncReplicationPair(ctx, "pair-1").Return(nil)
+
+	// action
+	_, err := san.Expand(ctx, "test-lun", newSize)
+
+	// assert
+	assert.NoError(t, err)
+}
@@ -30,9 +30,14 @@ import (
 
 var (
 	// RequestSemaphoreMap stores the total connection num of each storage
-	RequestSemaphoreMap = map[string]*utils.Semaphore{UninitializedStorage: utils.NewSemaphore(MaxStorageThreads)}
+	RequestSemaphoreMap = map[string]*utils.Semaphore{UninitializedStorage: NewRequestSemaphore(UninitializedStorage)}
 )
 
+// NewRequestSemaphore returns the semaphore which limits the total connection num of the storage
+func NewRequestSemaphore(deviceID string) *utils.Semaphore {
+	return utils.NewNamedSemaphore("storage-"+deviceID, MaxStorageThreads)
+}
+
 // HTTP defines for http request process
 type HTTP interface {
 	Do(req *http.Request) (*http.Response, error)
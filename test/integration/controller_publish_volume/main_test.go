@@ -45,7 +45,8 @@ func TestMain(m *testing.M) {
 	defer log.MockStopLogging("test")
 
 	cfg := config.MockCompletedConfig()
-	p := gomonkey.NewPatches().ApplyFuncReturn(app.GetGlobalConfig, cfg)
+	p := gomonkey.NewPatches().ApplyFuncReturn(app.GetGlobalConfig, cfg).
+		ApplyMethodReturn(cfg.K8sUtils, "GetPublishFenceByVolumeId", "", nil)
 	defer p.Reset()
 
 	m.Run()
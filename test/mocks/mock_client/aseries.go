@@ -139,6 +139,23 @@ func (mr *MockOceanASeriesClientInterfaceMockRecorder) Call(ctx, method, url, da
 		reflect.TypeOf((*MockOceanASeriesClientInterface)(nil).Call), ctx, method, url, data)
 }
 
+// CloneFileSystem mocks base method.
+func (m *MockOceanASeriesClientInterface) CloneFileSystem(ctx context.Context,
+	params *client.CloneFilesystemParams) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloneFileSystem", ctx, params)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloneFileSystem indicates an expected call of CloneFileSystem.
+func (mr *MockOceanASeriesClientInterfaceMockRecorder) CloneFileSystem(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloneFileSystem",
+		reflect.TypeOf((*MockOceanASeriesClientInterface)(nil).CloneFileSystem), ctx, params)
+}
+
 // CreateDTree mocks base method.
 func (m *MockOceanASeriesClientInterface) CreateDTree(ctx context.Context,
 	req *client.DTreeCreateRequest) (map[string]any, error) {
@@ -190,6 +207,23 @@ func (mr *MockOceanASeriesClientInterfaceMockRecorder) CreateDataTurboShare(ctx,
 		reflect.TypeOf((*MockOceanASeriesClientInterface)(nil).CreateDataTurboShare), ctx, params)
 }
 
+// CreateFSSnapshot mocks base method.
+func (m *MockOceanASeriesClientInterface) CreateFSSnapshot(ctx context.Context, name, parentID,
+	vstoreId string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFSSnapshot", ctx, name, parentID, vstoreId)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFSSnapshot indicates an expected call of CreateFSSnapshot.
+func (mr *MockOceanASeriesClientInterfaceMockRecorder) CreateFSSnapshot(ctx, name, parentID, vstoreId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFSSnapshot",
+		reflect.TypeOf((*MockOceanASeriesClientInterface)(nil).CreateFSSnapshot), ctx, name, parentID, vstoreId)
+}
+
 // CreateFileSystem mocks base method.
 func (m *MockOceanASeriesClientInterface) CreateFileSystem(ctx context.Context, params *client.CreateFilesystemParams,
 	advancedOptions map[string]any) (map[string]any, error) {
@@ -367,6 +401,21 @@ func (mr *MockOceanASeriesClientInterfaceMockRecorder) DeleteDataTurboShare(ctx,
 		reflect.TypeOf((*MockOceanASeriesClientInterface)(nil).DeleteDataTurboShare), ctx, id, vstoreId)
 }
 
+// DeleteFSSnapshot mocks base method.
+func (m *MockOceanASeriesClientInterface) DeleteFSSnapshot(ctx context.Context, snapshotID, vstoreId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFSSnapshot", ctx, snapshotID, vstoreId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFSSnapshot indicates an expected call of DeleteFSSnapshot.
+func (mr *MockOceanASeriesClientInterfaceMockRecorder) DeleteFSSnapshot(ctx, snapshotID, vstoreId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFSSnapshot",
+		reflect.TypeOf((*MockOceanASeriesClientInterface)(nil).DeleteFSSnapshot), ctx, snapshotID, vstoreId)
+}
+
 // DeleteFileSystem mocks base method.
 func (m *MockOceanASeriesClientInterface) DeleteFileSystem(ctx context.Context, params map[string]any) error {
 	m.ctrl.T.Helper()
@@ -604,6 +653,21 @@ func (mr *MockOceanASeriesClientInterfaceMockRecorder) GetDTreeQuota(ctx, parent
 		reflect.TypeOf((*MockOceanASeriesClientInterface)(nil).GetDTreeQuota), ctx, parentID, vStoreID)
 }
 
+// GetDTreesByParentName mocks base method.
+func (m *MockOceanASeriesClientInterface) GetDTreesByParentName(ctx context.Context, parentName, vstoreId string) ([]map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDTreesByParentName", ctx, parentName, vstoreId)
+	ret0, _ := ret[0].([]map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDTreesByParentName indicates an expected call of GetDTreesByParentName.
+func (mr *MockOceanASeriesClientInterfaceMockRecorder) GetDTreesByParentName(ctx, parentName, vstoreId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDTreesByParentName", reflect.TypeOf((*MockOceanASeriesClientInterface)(nil).GetDTreesByParentName), ctx, parentName, vstoreId)
+}
+
 // GetDataTurboShareByPath mocks base method.
 func (m *MockOceanASeriesClientInterface) GetDataTurboShareByPath(ctx context.Context,
 	path, vstoreId string) (map[string]any, error) {
@@ -651,6 +715,24 @@ func (mr *MockOceanASeriesClientInterfaceMockRecorder) GetDeviceWWN() *gomock.Ca
 		reflect.TypeOf((*MockOceanASeriesClientInterface)(nil).GetDeviceWWN))
 }
 
+// GetFSSnapshotByName mocks base method.
+func (m *MockOceanASeriesClientInterface) GetFSSnapshotByName(ctx context.Context, parentID, snapshotName,
+	vstoreId string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFSSnapshotByName", ctx, parentID, snapshotName, vstoreId)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFSSnapshotByName indicates an expected call of GetFSSnapshotByName.
+func (mr *MockOceanASeriesClientInterfaceMockRecorder) GetFSSnapshotByName(ctx, parentID, snapshotName, vstoreId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFSSnapshotByName",
+		reflect.TypeOf((*MockOceanASeriesClientInterface)(nil).GetFSSnapshotByName), ctx, parentID, snapshotName,
+		vstoreId)
+}
+
 // GetFileSystemByID mocks base method.
 func (m *MockOceanASeriesClientInterface) GetFileSystemByID(ctx context.Context, id string) (map[string]any, error) {
 	m.ctrl.T.Helper()
@@ -700,6 +782,21 @@ func (mr *MockOceanASeriesClientInterfaceMockRecorder) GetLicenseFeature(ctx any
 		reflect.TypeOf((*MockOceanASeriesClientInterface)(nil).GetLicenseFeature), ctx)
 }
 
+// GetFileSystemsByNamePrefix mocks base method.
+func (m *MockOceanASeriesClientInterface) GetFileSystemsByNamePrefix(ctx context.Context, prefix, vstoreId string) ([]map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFileSystemsByNamePrefix", ctx, prefix, vstoreId)
+	ret0, _ := ret[0].([]map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFileSystemsByNamePrefix indicates an expected call of GetFileSystemsByNamePrefix.
+func (mr *MockOceanASeriesClientInterfaceMockRecorder) GetFileSystemsByNamePrefix(ctx, prefix, vstoreId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileSystemsByNamePrefix", reflect.TypeOf((*MockOceanASeriesClientInterface)(nil).GetFileSystemsByNamePrefix), ctx, prefix, vstoreId)
+}
+
 // GetNFSServiceSetting mocks base method.
 func (m *MockOceanASeriesClientInterface) GetNFSServiceSetting(ctx context.Context) (map[string]bool, error) {
 	m.ctrl.T.Helper()
@@ -1103,6 +1200,22 @@ func (mr *MockOceanASeriesClientInterfaceMockRecorder) SetSystemInfo(ctx any) *g
 		reflect.TypeOf((*MockOceanASeriesClientInterface)(nil).SetSystemInfo), ctx)
 }
 
+// SplitCloneFileSystem mocks base method.
+func (m *MockOceanASeriesClientInterface) SplitCloneFileSystem(ctx context.Context, fsID, vstoreId string,
+	splitSpeed int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SplitCloneFileSystem", ctx, fsID, vstoreId, splitSpeed)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SplitCloneFileSystem indicates an expected call of SplitCloneFileSystem.
+func (mr *MockOceanASeriesClientInterfaceMockRecorder) SplitCloneFileSystem(ctx, fsID, vstoreId, splitSpeed any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SplitCloneFileSystem",
+		reflect.TypeOf((*MockOceanASeriesClientInterface)(nil).SplitCloneFileSystem), ctx, fsID, vstoreId, splitSpeed)
+}
+
 // UpdateDTree mocks base method.
 func (m *MockOceanASeriesClientInterface) UpdateDTree(ctx context.Context, dtreeID string,
 	req *client.DTreeUpdateRequest) error {
@@ -72,8 +72,40 @@ func (mr *MockDMEASeriesClientInterfaceMockRecorder) Call(ctx, method, url, data
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Call", reflect.TypeOf((*MockDMEASeriesClientInterface)(nil).Call), ctx, method, url, data)
 }
 
+// CloneFileSystem mocks base method.
+func (m *MockDMEASeriesClientInterface) CloneFileSystem(ctx context.Context,
+	params *client.CloneFilesystemParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloneFileSystem", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloneFileSystem indicates an expected call of CloneFileSystem.
+func (mr *MockDMEASeriesClientInterfaceMockRecorder) CloneFileSystem(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloneFileSystem",
+		reflect.TypeOf((*MockDMEASeriesClientInterface)(nil).CloneFileSystem), ctx, params)
+}
+
+// CreateFSSnapshot mocks base method.
+func (m *MockDMEASeriesClientInterface) CreateFSSnapshot(ctx context.Context, fsID, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFSSnapshot", ctx, fsID, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateFSSnapshot indicates an expected call of CreateFSSnapshot.
+func (mr *MockDMEASeriesClientInterfaceMockRecorder) CreateFSSnapshot(ctx, fsID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFSSnapshot",
+		reflect.TypeOf((*MockDMEASeriesClientInterface)(nil).CreateFSSnapshot), ctx, fsID, name)
+}
+
 // CreateFileSystem mocks base method.
-func (m *MockDMEASeriesClientInterface) CreateFileSystem(ctx context.Context, params *client.CreateFilesystemParams) error {
+func (m *MockDMEASeriesClientInterface) CreateFileSystem(ctx context.Context,
+	params *client.CreateFilesystemParams) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateFileSystem", ctx, params)
 	ret0, _ := ret[0].(error)
@@ -100,6 +132,21 @@ func (mr *MockDMEASeriesClientInterfaceMockRecorder) DeleteDataTurboShare(ctx, i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDataTurboShare", reflect.TypeOf((*MockDMEASeriesClientInterface)(nil).DeleteDataTurboShare), ctx, id)
 }
 
+// DeleteFSSnapshot mocks base method.
+func (m *MockDMEASeriesClientInterface) DeleteFSSnapshot(ctx context.Context, snapshotID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFSSnapshot", ctx, snapshotID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFSSnapshot indicates an expected call of DeleteFSSnapshot.
+func (mr *MockDMEASeriesClientInterfaceMockRecorder) DeleteFSSnapshot(ctx, snapshotID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFSSnapshot",
+		reflect.TypeOf((*MockDMEASeriesClientInterface)(nil).DeleteFSSnapshot), ctx, snapshotID)
+}
+
 // DeleteFileSystem mocks base method.
 func (m *MockDMEASeriesClientInterface) DeleteFileSystem(ctx context.Context, fsID string) error {
 	m.ctrl.T.Helper()
@@ -143,7 +190,8 @@ func (mr *MockDMEASeriesClientInterfaceMockRecorder) GetBackendID() *gomock.Call
 }
 
 // GetDataTurboShareByPath mocks base method.
-func (m *MockDMEASeriesClientInterface) GetDataTurboShareByPath(ctx context.Context, path string) (*client.DataTurboShare, error) {
+func (m *MockDMEASeriesClientInterface) GetDataTurboShareByPath(ctx context.Context,
+	path string) (*client.DataTurboShare, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetDataTurboShareByPath", ctx, path)
 	ret0, _ := ret[0].(*client.DataTurboShare)
@@ -158,7 +206,8 @@ func (mr *MockDMEASeriesClientInterfaceMockRecorder) GetDataTurboShareByPath(ctx
 }
 
 // GetDataTurboUserByName mocks base method.
-func (m *MockDMEASeriesClientInterface) GetDataTurboUserByName(ctx context.Context, name string) (*client.DataTurboAdmin, error) {
+func (m *MockDMEASeriesClientInterface) GetDataTurboUserByName(ctx context.Context,
+	name string) (*client.DataTurboAdmin, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetDataTurboUserByName", ctx, name)
 	ret0, _ := ret[0].(*client.DataTurboAdmin)
@@ -186,8 +235,26 @@ func (mr *MockDMEASeriesClientInterfaceMockRecorder) GetDeviceSN() *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceSN", reflect.TypeOf((*MockDMEASeriesClientInterface)(nil).GetDeviceSN))
 }
 
+// GetFSSnapshotByName mocks base method.
+func (m *MockDMEASeriesClientInterface) GetFSSnapshotByName(ctx context.Context, fsID,
+	name string) (*client.FSSnapshotInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFSSnapshotByName", ctx, fsID, name)
+	ret0, _ := ret[0].(*client.FSSnapshotInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFSSnapshotByName indicates an expected call of GetFSSnapshotByName.
+func (mr *MockDMEASeriesClientInterfaceMockRecorder) GetFSSnapshotByName(ctx, fsID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFSSnapshotByName",
+		reflect.TypeOf((*MockDMEASeriesClientInterface)(nil).GetFSSnapshotByName), ctx, fsID, name)
+}
+
 // GetFileSystemByID mocks base method.
-func (m *MockDMEASeriesClientInterface) GetFileSystemByID(ctx context.Context, fsID string) (*client.FileSystemInfo, error) {
+func (m *MockDMEASeriesClientInterface) GetFileSystemByID(ctx context.Context, fsID string) (*client.FileSystemInfo,
+	error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetFileSystemByID", ctx, fsID)
 	ret0, _ := ret[0].(*client.FileSystemInfo)
@@ -202,7 +269,8 @@ func (mr *MockDMEASeriesClientInterfaceMockRecorder) GetFileSystemByID(ctx, fsID
 }
 
 // GetFileSystemByName mocks base method.
-func (m *MockDMEASeriesClientInterface) GetFileSystemByName(ctx context.Context, name string) (*client.FileSystemInfo, error) {
+func (m *MockDMEASeriesClientInterface) GetFileSystemByName(ctx context.Context,
+	name string) (*client.FileSystemInfo, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetFileSystemByName", ctx, name)
 	ret0, _ := ret[0].(*client.FileSystemInfo)
@@ -216,8 +284,24 @@ func (mr *MockDMEASeriesClientInterfaceMockRecorder) GetFileSystemByName(ctx, na
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileSystemByName", reflect.TypeOf((*MockDMEASeriesClientInterface)(nil).GetFileSystemByName), ctx, name)
 }
 
+// GetFileSystemsByNamePrefix mocks base method.
+func (m *MockDMEASeriesClientInterface) GetFileSystemsByNamePrefix(ctx context.Context, prefix string) ([]*client.FileSystemInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFileSystemsByNamePrefix", ctx, prefix)
+	ret0, _ := ret[0].([]*client.FileSystemInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFileSystemsByNamePrefix indicates an expected call of GetFileSystemsByNamePrefix.
+func (mr *MockDMEASeriesClientInterfaceMockRecorder) GetFileSystemsByNamePrefix(ctx, prefix any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileSystemsByNamePrefix", reflect.TypeOf((*MockDMEASeriesClientInterface)(nil).GetFileSystemsByNamePrefix), ctx, prefix)
+}
+
 // GetHyperScalePoolByName mocks base method.
-func (m *MockDMEASeriesClientInterface) GetHyperScalePoolByName(ctx context.Context, name string) (*client.HyperScalePool, error) {
+func (m *MockDMEASeriesClientInterface) GetHyperScalePoolByName(ctx context.Context,
+	name string) (*client.HyperScalePool, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetHyperScalePoolByName", ctx, name)
 	ret0, _ := ret[0].(*client.HyperScalePool)
@@ -247,7 +331,8 @@ func (mr *MockDMEASeriesClientInterfaceMockRecorder) GetHyperScalePools(ctx any)
 }
 
 // GetNfsShareByPath mocks base method.
-func (m *MockDMEASeriesClientInterface) GetNfsShareByPath(ctx context.Context, path string) (*client.NfsShareInfo, error) {
+func (m *MockDMEASeriesClientInterface) GetNfsShareByPath(ctx context.Context, path string) (*client.NfsShareInfo,
+	error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetNfsShareByPath", ctx, path)
 	ret0, _ := ret[0].(*client.NfsShareInfo)
@@ -345,7 +430,8 @@ func (mr *MockDMEASeriesClientInterfaceMockRecorder) SetSystemInfo(ctx, sn any)
 }
 
 // UpdateFileSystem mocks base method.
-func (m *MockDMEASeriesClientInterface) UpdateFileSystem(ctx context.Context, fsID string, params *client.UpdateFileSystemParams) error {
+func (m *MockDMEASeriesClientInterface) UpdateFileSystem(ctx context.Context, fsID string,
+	params *client.UpdateFileSystemParams) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdateFileSystem", ctx, fsID, params)
 	ret0, _ := ret[0].(error)
@@ -150,6 +150,21 @@ func (mr *MockIRestClientMockRecorder) AttachVolume(ctx, name, ip any) *gomock.C
 		reflect.TypeOf((*MockIRestClient)(nil).AttachVolume), ctx, name, ip)
 }
 
+// CloneFSFromSnapshot mocks base method.
+func (m *MockIRestClient) CloneFSFromSnapshot(ctx context.Context, req *client.CloneFSFromSnapshotRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloneFSFromSnapshot", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloneFSFromSnapshot indicates an expected call of CloneFSFromSnapshot.
+func (mr *MockIRestClientMockRecorder) CloneFSFromSnapshot(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloneFSFromSnapshot",
+		reflect.TypeOf((*MockIRestClient)(nil).CloneFSFromSnapshot), ctx, req)
+}
+
 // CreateConvergedQoS mocks base method.
 func (m *MockIRestClient) CreateConvergedQoS(ctx context.Context, req *types.CreateConvergedQoSReq) (int, error) {
 	m.ctrl.T.Helper()
@@ -217,6 +232,23 @@ func (mr *MockIRestClientMockRecorder) CreateDTreeQuota(ctx, dTreeId, capacity a
 		reflect.TypeOf((*MockIRestClient)(nil).CreateDTreeQuota), ctx, dTreeId, capacity)
 }
 
+// CreateFSSnapshot mocks base method.
+func (m *MockIRestClient) CreateFSSnapshot(ctx context.Context, fsName,
+	snapshotName string) (*client.FSSnapshotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFSSnapshot", ctx, fsName, snapshotName)
+	ret0, _ := ret[0].(*client.FSSnapshotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFSSnapshot indicates an expected call of CreateFSSnapshot.
+func (mr *MockIRestClientMockRecorder) CreateFSSnapshot(ctx, fsName, snapshotName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFSSnapshot",
+		reflect.TypeOf((*MockIRestClient)(nil).CreateFSSnapshot), ctx, fsName, snapshotName)
+}
+
 // CreateFileSystem mocks base method.
 func (m *MockIRestClient) CreateFileSystem(ctx context.Context, params map[string]any) (map[string]any, error) {
 	m.ctrl.T.Helper()
@@ -415,6 +447,21 @@ func (mr *MockIRestClientMockRecorder) DeleteDTreeQuota(ctx, quotaId any) *gomoc
 		reflect.TypeOf((*MockIRestClient)(nil).DeleteDTreeQuota), ctx, quotaId)
 }
 
+// DeleteFSSnapshot mocks base method.
+func (m *MockIRestClient) DeleteFSSnapshot(ctx context.Context, fsName, snapshotName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFSSnapshot", ctx, fsName, snapshotName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFSSnapshot indicates an expected call of DeleteFSSnapshot.
+func (mr *MockIRestClientMockRecorder) DeleteFSSnapshot(ctx, fsName, snapshotName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFSSnapshot",
+		reflect.TypeOf((*MockIRestClient)(nil).DeleteFSSnapshot), ctx, fsName, snapshotName)
+}
+
 // DeleteFileSystem mocks base method.
 func (m *MockIRestClient) DeleteFileSystem(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -708,6 +755,38 @@ func (mr *MockIRestClientMockRecorder) GetDTreeNfsShareByPath(ctx, sharePath any
 		reflect.TypeOf((*MockIRestClient)(nil).GetDTreeNfsShareByPath), ctx, sharePath)
 }
 
+// GetFSSnapshotByName mocks base method.
+func (m *MockIRestClient) GetFSSnapshotByName(ctx context.Context, fsName,
+	snapshotName string) (*client.FSSnapshotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFSSnapshotByName", ctx, fsName, snapshotName)
+	ret0, _ := ret[0].(*client.FSSnapshotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFSSnapshotByName indicates an expected call of GetFSSnapshotByName.
+func (mr *MockIRestClientMockRecorder) GetFSSnapshotByName(ctx, fsName, snapshotName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFSSnapshotByName",
+		reflect.TypeOf((*MockIRestClient)(nil).GetFSSnapshotByName), ctx, fsName, snapshotName)
+}
+
+// GetDTreesByParentName mocks base method.
+func (m *MockIRestClient) GetDTreesByParentName(ctx context.Context, parentName string) ([]*client.DTreeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDTreesByParentName", ctx, parentName)
+	ret0, _ := ret[0].([]*client.DTreeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDTreesByParentName indicates an expected call of GetDTreesByParentName.
+func (mr *MockIRestClientMockRecorder) GetDTreesByParentName(ctx, parentName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDTreesByParentName", reflect.TypeOf((*MockIRestClient)(nil).GetDTreesByParentName), ctx, parentName)
+}
+
 // GetFileSystemByName mocks base method.
 func (m *MockIRestClient) GetFileSystemByName(ctx context.Context, name string) (map[string]any, error) {
 	m.ctrl.T.Helper()
@@ -724,6 +803,21 @@ func (mr *MockIRestClientMockRecorder) GetFileSystemByName(ctx, name any) *gomoc
 		reflect.TypeOf((*MockIRestClient)(nil).GetFileSystemByName), ctx, name)
 }
 
+// GetFileSystemsByNamePrefix mocks base method.
+func (m *MockIRestClient) GetFileSystemsByNamePrefix(ctx context.Context, prefix string) ([]map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFileSystemsByNamePrefix", ctx, prefix)
+	ret0, _ := ret[0].([]map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFileSystemsByNamePrefix indicates an expected call of GetFileSystemsByNamePrefix.
+func (mr *MockIRestClientMockRecorder) GetFileSystemsByNamePrefix(ctx, prefix any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileSystemsByNamePrefix", reflect.TypeOf((*MockIRestClient)(nil).GetFileSystemsByNamePrefix), ctx, prefix)
+}
+
 // GetHostByName mocks base method.
 func (m *MockIRestClient) GetHostByName(ctx context.Context, hostName string) (map[string]any, error) {
 	m.ctrl.T.Helper()
@@ -804,6 +898,23 @@ func (mr *MockIRestClientMockRecorder) GetNfsShareAccess(ctx, shareID any) *gomo
 		reflect.TypeOf((*MockIRestClient)(nil).GetNfsShareAccess), ctx, shareID)
 }
 
+// GetNfsShareAuthClients mocks base method.
+func (m *MockIRestClient) GetNfsShareAuthClients(ctx context.Context,
+	shareID string) ([]*client.NfsShareAuthClientResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNfsShareAuthClients", ctx, shareID)
+	ret0, _ := ret[0].([]*client.NfsShareAuthClientResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNfsShareAuthClients indicates an expected call of GetNfsShareAuthClients.
+func (mr *MockIRestClientMockRecorder) GetNfsShareAuthClients(ctx, shareID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNfsShareAuthClients",
+		reflect.TypeOf((*MockIRestClient)(nil).GetNfsShareAuthClients), ctx, shareID)
+}
+
 // GetNfsShareByPath mocks base method.
 func (m *MockIRestClient) GetNfsShareByPath(ctx context.Context, path string) (map[string]any, error) {
 	m.ctrl.T.Helper()
@@ -965,6 +1076,22 @@ func (mr *MockIRestClientMockRecorder) GetSnapshotByName(ctx, snapshotName any)
 		reflect.TypeOf((*MockIRestClient)(nil).GetSnapshotByName), ctx, snapshotName)
 }
 
+// GetSnapshotsByVolumeName mocks base method.
+func (m *MockIRestClient) GetSnapshotsByVolumeName(ctx context.Context, volName string) ([]map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSnapshotsByVolumeName", ctx, volName)
+	ret0, _ := ret[0].([]map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSnapshotsByVolumeName indicates an expected call of GetSnapshotsByVolumeName.
+func (mr *MockIRestClientMockRecorder) GetSnapshotsByVolumeName(ctx, volName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshotsByVolumeName",
+		reflect.TypeOf((*MockIRestClient)(nil).GetSnapshotsByVolumeName), ctx, volName)
+}
+
 // GetVolumeByName mocks base method.
 func (m *MockIRestClient) GetVolumeByName(ctx context.Context, name string) (map[string]any, error) {
 	m.ctrl.T.Helper()
@@ -981,6 +1108,22 @@ func (mr *MockIRestClientMockRecorder) GetVolumeByName(ctx, name any) *gomock.Ca
 		reflect.TypeOf((*MockIRestClient)(nil).GetVolumeByName), ctx, name)
 }
 
+// GetVolumesByPoolID mocks base method.
+func (m *MockIRestClient) GetVolumesByPoolID(ctx context.Context, poolID int64) ([]map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolumesByPoolID", ctx, poolID)
+	ret0, _ := ret[0].([]map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolumesByPoolID indicates an expected call of GetVolumesByPoolID.
+func (mr *MockIRestClientMockRecorder) GetVolumesByPoolID(ctx, poolID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumesByPoolID",
+		reflect.TypeOf((*MockIRestClient)(nil).GetVolumesByPoolID), ctx, poolID)
+}
+
 // IsSupportDynamicLinks mocks base method.
 func (m *MockIRestClient) IsSupportDynamicLinks(ctx context.Context, hostname string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -1036,6 +1179,22 @@ func (mr *MockIRestClientMockRecorder) Logout(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockIRestClient)(nil).Logout), ctx)
 }
 
+// ModifyNfsShareAuthClient mocks base method.
+func (m *MockIRestClient) ModifyNfsShareAuthClient(ctx context.Context,
+	req *client.ModifyNfsShareAuthClientRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyNfsShareAuthClient", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ModifyNfsShareAuthClient indicates an expected call of ModifyNfsShareAuthClient.
+func (mr *MockIRestClientMockRecorder) ModifyNfsShareAuthClient(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyNfsShareAuthClient",
+		reflect.TypeOf((*MockIRestClient)(nil).ModifyNfsShareAuthClient), ctx, req)
+}
+
 // QueryDynamicLinks mocks base method.
 func (m *MockIRestClient) QueryDynamicLinks(ctx context.Context, poolName, hostname string,
 	amount int) ([]*client.IscsiLink, error) {
@@ -1178,6 +1337,21 @@ func (mr *MockIRestClientMockRecorder) UpdateDTreeQuota(ctx, quotaId, capacity a
 		reflect.TypeOf((*MockIRestClient)(nil).UpdateDTreeQuota), ctx, quotaId, capacity)
 }
 
+// UpdateFileSystem mocks base method.
+func (m *MockIRestClient) UpdateFileSystem(ctx context.Context, req *client.UpdateFileSystemRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFileSystem", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFileSystem indicates an expected call of UpdateFileSystem.
+func (mr *MockIRestClientMockRecorder) UpdateFileSystem(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFileSystem",
+		reflect.TypeOf((*MockIRestClient)(nil).UpdateFileSystem), ctx, req)
+}
+
 // UpdateHost mocks base method.
 func (m *MockIRestClient) UpdateHost(ctx context.Context, hostName string, alua map[string]any) error {
 	m.ctrl.T.Helper()
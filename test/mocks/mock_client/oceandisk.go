@@ -75,6 +75,21 @@ func (mr *MockOceandiskClientInterfaceMockRecorder) ActivateQos(ctx, qosID, vSto
 		reflect.TypeOf((*MockOceandiskClientInterface)(nil).ActivateQos), ctx, qosID, vStoreID)
 }
 
+// ActivateNamespaceSnapshot mocks base method.
+func (m *MockOceandiskClientInterface) ActivateNamespaceSnapshot(ctx context.Context, snapshotID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActivateNamespaceSnapshot", ctx, snapshotID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ActivateNamespaceSnapshot indicates an expected call of ActivateNamespaceSnapshot.
+func (mr *MockOceandiskClientInterfaceMockRecorder) ActivateNamespaceSnapshot(ctx, snapshotID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateNamespaceSnapshot",
+		reflect.TypeOf((*MockOceandiskClientInterface)(nil).ActivateNamespaceSnapshot), ctx, snapshotID)
+}
+
 // AddFCInitiatorToHost mocks base method.
 func (m *MockOceandiskClientInterface) AddFCInitiatorToHost(ctx context.Context, initiator, hostID string) error {
 	m.ctrl.T.Helper()
@@ -249,6 +264,23 @@ func (mr *MockOceandiskClientInterfaceMockRecorder) CreateHost(ctx, name any) *g
 		reflect.TypeOf((*MockOceandiskClientInterface)(nil).CreateHost), ctx, name)
 }
 
+// CreateClonePair mocks base method.
+func (m *MockOceandiskClientInterface) CreateClonePair(ctx context.Context, srcID, dstNamespaceID string,
+	cloneSpeed int) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateClonePair", ctx, srcID, dstNamespaceID, cloneSpeed)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateClonePair indicates an expected call of CreateClonePair.
+func (mr *MockOceandiskClientInterfaceMockRecorder) CreateClonePair(ctx, srcID, dstNamespaceID, cloneSpeed any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClonePair",
+		reflect.TypeOf((*MockOceandiskClientInterface)(nil).CreateClonePair), ctx, srcID, dstNamespaceID, cloneSpeed)
+}
+
 // CreateHostGroup mocks base method.
 func (m *MockOceandiskClientInterface) CreateHostGroup(ctx context.Context, name string) (map[string]any, error) {
 	m.ctrl.T.Helper()
@@ -314,6 +346,23 @@ func (mr *MockOceandiskClientInterfaceMockRecorder) CreateNamespaceGroup(ctx, na
 		reflect.TypeOf((*MockOceandiskClientInterface)(nil).CreateNamespaceGroup), ctx, name)
 }
 
+// CreateNamespaceSnapshot mocks base method.
+func (m *MockOceandiskClientInterface) CreateNamespaceSnapshot(ctx context.Context, name,
+	namespaceID string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNamespaceSnapshot", ctx, name, namespaceID)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNamespaceSnapshot indicates an expected call of CreateNamespaceSnapshot.
+func (mr *MockOceandiskClientInterfaceMockRecorder) CreateNamespaceSnapshot(ctx, name, namespaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNamespaceSnapshot",
+		reflect.TypeOf((*MockOceandiskClientInterface)(nil).CreateNamespaceSnapshot), ctx, name, namespaceID)
+}
+
 // CreateQos mocks base method.
 func (m *MockOceandiskClientInterface) CreateQos(ctx context.Context, args base.CreateQoSArgs) (map[string]any, error) {
 	m.ctrl.T.Helper()
@@ -345,6 +394,21 @@ func (mr *MockOceandiskClientInterfaceMockRecorder) DeactivateQos(ctx, qosID, vS
 		reflect.TypeOf((*MockOceandiskClientInterface)(nil).DeactivateQos), ctx, qosID, vStoreID)
 }
 
+// DeactivateNamespaceSnapshot mocks base method.
+func (m *MockOceandiskClientInterface) DeactivateNamespaceSnapshot(ctx context.Context, snapshotID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeactivateNamespaceSnapshot", ctx, snapshotID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeactivateNamespaceSnapshot indicates an expected call of DeactivateNamespaceSnapshot.
+func (mr *MockOceandiskClientInterfaceMockRecorder) DeactivateNamespaceSnapshot(ctx, snapshotID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivateNamespaceSnapshot",
+		reflect.TypeOf((*MockOceandiskClientInterface)(nil).DeactivateNamespaceSnapshot), ctx, snapshotID)
+}
+
 // Delete mocks base method.
 func (m *MockOceandiskClientInterface) Delete(ctx context.Context, url string, data map[string]any) (base.Response,
 	error) {
@@ -362,6 +426,21 @@ func (mr *MockOceandiskClientInterfaceMockRecorder) Delete(ctx, url, data any) *
 		reflect.TypeOf((*MockOceandiskClientInterface)(nil).Delete), ctx, url, data)
 }
 
+// DeleteClonePair mocks base method.
+func (m *MockOceandiskClientInterface) DeleteClonePair(ctx context.Context, clonePairID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteClonePair", ctx, clonePairID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteClonePair indicates an expected call of DeleteClonePair.
+func (mr *MockOceandiskClientInterfaceMockRecorder) DeleteClonePair(ctx, clonePairID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteClonePair",
+		reflect.TypeOf((*MockOceandiskClientInterface)(nil).DeleteClonePair), ctx, clonePairID)
+}
+
 // DeleteHost mocks base method.
 func (m *MockOceandiskClientInterface) DeleteHost(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -437,6 +516,21 @@ func (mr *MockOceandiskClientInterfaceMockRecorder) DeleteNamespaceGroup(ctx, id
 		reflect.TypeOf((*MockOceandiskClientInterface)(nil).DeleteNamespaceGroup), ctx, id)
 }
 
+// DeleteNamespaceSnapshot mocks base method.
+func (m *MockOceandiskClientInterface) DeleteNamespaceSnapshot(ctx context.Context, snapshotID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNamespaceSnapshot", ctx, snapshotID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNamespaceSnapshot indicates an expected call of DeleteNamespaceSnapshot.
+func (mr *MockOceandiskClientInterfaceMockRecorder) DeleteNamespaceSnapshot(ctx, snapshotID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNamespaceSnapshot",
+		reflect.TypeOf((*MockOceandiskClientInterface)(nil).DeleteNamespaceSnapshot), ctx, snapshotID)
+}
+
 // DeleteQos mocks base method.
 func (m *MockOceandiskClientInterface) DeleteQos(ctx context.Context, qosID, vStoreID string) error {
 	m.ctrl.T.Helper()
@@ -564,6 +658,23 @@ func (mr *MockOceandiskClientInterfaceMockRecorder) GetBackendID() *gomock.Call
 		reflect.TypeOf((*MockOceandiskClientInterface)(nil).GetBackendID))
 }
 
+// GetClonePairInfo mocks base method.
+func (m *MockOceandiskClientInterface) GetClonePairInfo(ctx context.Context, clonePairID string) (map[string]any,
+	error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClonePairInfo", ctx, clonePairID)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClonePairInfo indicates an expected call of GetClonePairInfo.
+func (mr *MockOceandiskClientInterfaceMockRecorder) GetClonePairInfo(ctx, clonePairID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClonePairInfo",
+		reflect.TypeOf((*MockOceandiskClientInterface)(nil).GetClonePairInfo), ctx, clonePairID)
+}
+
 // GetDeviceSN mocks base method.
 func (m *MockOceandiskClientInterface) GetDeviceSN() string {
 	m.ctrl.T.Helper()
@@ -675,6 +786,22 @@ func (mr *MockOceandiskClientInterfaceMockRecorder) GetHostGroupByName(ctx, name
 		reflect.TypeOf((*MockOceandiskClientInterface)(nil).GetHostGroupByName), ctx, name)
 }
 
+// GetHostByID mocks base method.
+func (m *MockOceandiskClientInterface) GetHostByID(ctx context.Context, id string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHostByID", ctx, id)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHostByID indicates an expected call of GetHostByID.
+func (mr *MockOceandiskClientInterfaceMockRecorder) GetHostByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostByID",
+		reflect.TypeOf((*MockOceandiskClientInterface)(nil).GetHostByID), ctx, id)
+}
+
 // GetHostNamespaceId mocks base method.
 func (m *MockOceandiskClientInterface) GetHostNamespaceId(ctx context.Context, hostID, namespaceID string) (string,
 	error) {
@@ -822,6 +949,23 @@ func (mr *MockOceandiskClientInterfaceMockRecorder) GetNamespaceByName(ctx, name
 		reflect.TypeOf((*MockOceandiskClientInterface)(nil).GetNamespaceByName), ctx, name)
 }
 
+// GetNamespacesByNamePrefix mocks base method.
+func (m *MockOceandiskClientInterface) GetNamespacesByNamePrefix(ctx context.Context,
+	prefix string) ([]map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNamespacesByNamePrefix", ctx, prefix)
+	ret0, _ := ret[0].([]map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNamespacesByNamePrefix indicates an expected call of GetNamespacesByNamePrefix.
+func (mr *MockOceandiskClientInterfaceMockRecorder) GetNamespacesByNamePrefix(ctx, prefix any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespacesByNamePrefix",
+		reflect.TypeOf((*MockOceandiskClientInterface)(nil).GetNamespacesByNamePrefix), ctx, prefix)
+}
+
 // GetNamespaceCountOfHost mocks base method.
 func (m *MockOceandiskClientInterface) GetNamespaceCountOfHost(ctx context.Context, hostID string) (int64, error) {
 	m.ctrl.T.Helper()
@@ -872,6 +1016,23 @@ func (mr *MockOceandiskClientInterfaceMockRecorder) GetNamespaceGroupByName(ctx,
 		reflect.TypeOf((*MockOceandiskClientInterface)(nil).GetNamespaceGroupByName), ctx, name)
 }
 
+// GetNamespaceSnapshotByName mocks base method.
+func (m *MockOceandiskClientInterface) GetNamespaceSnapshotByName(ctx context.Context, name string) (map[string]any,
+	error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNamespaceSnapshotByName", ctx, name)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNamespaceSnapshotByName indicates an expected call of GetNamespaceSnapshotByName.
+func (mr *MockOceandiskClientInterfaceMockRecorder) GetNamespaceSnapshotByName(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaceSnapshotByName",
+		reflect.TypeOf((*MockOceandiskClientInterface)(nil).GetNamespaceSnapshotByName), ctx, name)
+}
+
 // GetPoolByName mocks base method.
 func (m *MockOceandiskClientInterface) GetPoolByName(ctx context.Context, name string) (map[string]any, error) {
 	m.ctrl.T.Helper()
@@ -1240,6 +1401,21 @@ func (mr *MockOceandiskClientInterfaceMockRecorder) SetSystemInfo(ctx any) *gomo
 		reflect.TypeOf((*MockOceandiskClientInterface)(nil).SetSystemInfo), ctx)
 }
 
+// SyncClonePair mocks base method.
+func (m *MockOceandiskClientInterface) SyncClonePair(ctx context.Context, clonePairID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncClonePair", ctx, clonePairID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SyncClonePair indicates an expected call of SyncClonePair.
+func (mr *MockOceandiskClientInterfaceMockRecorder) SyncClonePair(ctx, clonePairID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncClonePair",
+		reflect.TypeOf((*MockOceandiskClientInterface)(nil).SyncClonePair), ctx, clonePairID)
+}
+
 // UpdateFCInitiator mocks base method.
 func (m *MockOceandiskClientInterface) UpdateFCInitiator(ctx context.Context, wwn string, alua map[string]any) error {
 	m.ctrl.T.Helper()
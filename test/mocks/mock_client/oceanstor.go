@@ -58,6 +58,20 @@ func (mr *MockOceanstorClientInterfaceMockRecorder) ActivateLunSnapshot(ctx, sna
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateLunSnapshot", reflect.TypeOf((*MockOceanstorClientInterface)(nil).ActivateLunSnapshot), ctx, snapshotID)
 }
 
+// ActivateLunSnapshots mocks base method.
+func (m *MockOceanstorClientInterface) ActivateLunSnapshots(ctx context.Context, snapshotIDs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActivateLunSnapshots", ctx, snapshotIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ActivateLunSnapshots indicates an expected call of ActivateLunSnapshots.
+func (mr *MockOceanstorClientInterfaceMockRecorder) ActivateLunSnapshots(ctx, snapshotIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateLunSnapshots", reflect.TypeOf((*MockOceanstorClientInterface)(nil).ActivateLunSnapshots), ctx, snapshotIDs)
+}
+
 // ActivateQos mocks base method.
 func (m *MockOceanstorClientInterface) ActivateQos(ctx context.Context, qosID, vStoreID string) error {
 	m.ctrl.T.Helper()
@@ -245,6 +259,20 @@ func (mr *MockOceanstorClientInterfaceMockRecorder) Call(ctx, method, url, data
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Call", reflect.TypeOf((*MockOceanstorClientInterface)(nil).Call), ctx, method, url, data)
 }
 
+// CancelReplicationSecondaryWriteLock mocks base method.
+func (m *MockOceanstorClientInterface) CancelReplicationSecondaryWriteLock(ctx context.Context, pairID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelReplicationSecondaryWriteLock", ctx, pairID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelReplicationSecondaryWriteLock indicates an expected call of CancelReplicationSecondaryWriteLock.
+func (mr *MockOceanstorClientInterfaceMockRecorder) CancelReplicationSecondaryWriteLock(ctx, pairID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelReplicationSecondaryWriteLock", reflect.TypeOf((*MockOceanstorClientInterface)(nil).CancelReplicationSecondaryWriteLock), ctx, pairID)
+}
+
 // CheckNfsShareAccessStatus mocks base method.
 func (m *MockOceanstorClientInterface) CheckNfsShareAccessStatus(ctx context.Context, sharePath, client, vStoreID string, accessVal constants.AuthClientAccessVal) (bool, error) {
 	m.ctrl.T.Helper()
@@ -440,6 +468,21 @@ func (mr *MockOceanstorClientInterfaceMockRecorder) CreateLunGroup(ctx, name any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLunGroup", reflect.TypeOf((*MockOceanstorClientInterface)(nil).CreateLunGroup), ctx, name)
 }
 
+// CreateLunMigration mocks base method.
+func (m *MockOceanstorClientInterface) CreateLunMigration(ctx context.Context, srcLunID, dstLunID string, speed int) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLunMigration", ctx, srcLunID, dstLunID, speed)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLunMigration indicates an expected call of CreateLunMigration.
+func (mr *MockOceanstorClientInterfaceMockRecorder) CreateLunMigration(ctx, srcLunID, dstLunID, speed any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLunMigration", reflect.TypeOf((*MockOceanstorClientInterface)(nil).CreateLunMigration), ctx, srcLunID, dstLunID, speed)
+}
+
 // CreateLunSnapshot mocks base method.
 func (m *MockOceanstorClientInterface) CreateLunSnapshot(ctx context.Context, name, lunID string) (map[string]any, error) {
 	m.ctrl.T.Helper()
@@ -727,6 +770,20 @@ func (mr *MockOceanstorClientInterfaceMockRecorder) DeleteLunGroup(ctx, id any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLunGroup", reflect.TypeOf((*MockOceanstorClientInterface)(nil).DeleteLunGroup), ctx, id)
 }
 
+// DeleteLunMigration mocks base method.
+func (m *MockOceanstorClientInterface) DeleteLunMigration(ctx context.Context, srcLunID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLunMigration", ctx, srcLunID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLunMigration indicates an expected call of DeleteLunMigration.
+func (mr *MockOceanstorClientInterfaceMockRecorder) DeleteLunMigration(ctx, srcLunID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLunMigration", reflect.TypeOf((*MockOceanstorClientInterface)(nil).DeleteLunMigration), ctx, srcLunID)
+}
+
 // DeleteLunSnapshot mocks base method.
 func (m *MockOceanstorClientInterface) DeleteLunSnapshot(ctx context.Context, snapshotID string) error {
 	m.ctrl.T.Helper()
@@ -867,6 +924,20 @@ func (mr *MockOceanstorClientInterfaceMockRecorder) ExtendLun(ctx, lunID, newCap
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtendLun", reflect.TypeOf((*MockOceanstorClientInterface)(nil).ExtendLun), ctx, lunID, newCapacity)
 }
 
+// ForceStartHyperMetroPair mocks base method.
+func (m *MockOceanstorClientInterface) ForceStartHyperMetroPair(ctx context.Context, pairID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForceStartHyperMetroPair", ctx, pairID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ForceStartHyperMetroPair indicates an expected call of ForceStartHyperMetroPair.
+func (mr *MockOceanstorClientInterfaceMockRecorder) ForceStartHyperMetroPair(ctx, pairID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForceStartHyperMetroPair", reflect.TypeOf((*MockOceanstorClientInterface)(nil).ForceStartHyperMetroPair), ctx, pairID)
+}
+
 // Get mocks base method.
 func (m *MockOceanstorClientInterface) Get(ctx context.Context, url string, data map[string]any) (base.Response, error) {
 	m.ctrl.T.Helper()
@@ -1028,6 +1099,21 @@ func (mr *MockOceanstorClientInterfaceMockRecorder) GetDTreeByName(ctx, parentID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDTreeByName", reflect.TypeOf((*MockOceanstorClientInterface)(nil).GetDTreeByName), ctx, parentID, parentName, vStoreID, name)
 }
 
+// GetDTreesByParentName mocks base method.
+func (m *MockOceanstorClientInterface) GetDTreesByParentName(ctx context.Context, parentName, vStoreID string) ([]map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDTreesByParentName", ctx, parentName, vStoreID)
+	ret0, _ := ret[0].([]map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDTreesByParentName indicates an expected call of GetDTreesByParentName.
+func (mr *MockOceanstorClientInterfaceMockRecorder) GetDTreesByParentName(ctx, parentName, vStoreID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDTreesByParentName", reflect.TypeOf((*MockOceanstorClientInterface)(nil).GetDTreesByParentName), ctx, parentName, vStoreID)
+}
+
 // GetDeviceSN mocks base method.
 func (m *MockOceanstorClientInterface) GetDeviceSN() string {
 	m.ctrl.T.Helper()
@@ -1147,6 +1233,21 @@ func (mr *MockOceanstorClientInterfaceMockRecorder) GetFSSnapshotCountByParentId
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFSSnapshotCountByParentId", reflect.TypeOf((*MockOceanstorClientInterface)(nil).GetFSSnapshotCountByParentId), ctx, ParentId)
 }
 
+// GetFSSnapshotsByParentID mocks base method.
+func (m *MockOceanstorClientInterface) GetFSSnapshotsByParentID(ctx context.Context, parentID string) ([]map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFSSnapshotsByParentID", ctx, parentID)
+	ret0, _ := ret[0].([]map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFSSnapshotsByParentID indicates an expected call of GetFSSnapshotsByParentID.
+func (mr *MockOceanstorClientInterfaceMockRecorder) GetFSSnapshotsByParentID(ctx, parentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFSSnapshotsByParentID", reflect.TypeOf((*MockOceanstorClientInterface)(nil).GetFSSnapshotsByParentID), ctx, parentID)
+}
+
 // GetFileSystemByID mocks base method.
 func (m *MockOceanstorClientInterface) GetFileSystemByID(ctx context.Context, id string) (map[string]any, error) {
 	m.ctrl.T.Helper()
@@ -1177,6 +1278,21 @@ func (mr *MockOceanstorClientInterfaceMockRecorder) GetFileSystemByName(ctx, nam
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileSystemByName", reflect.TypeOf((*MockOceanstorClientInterface)(nil).GetFileSystemByName), ctx, name)
 }
 
+// GetFileSystemsByNamePrefix mocks base method.
+func (m *MockOceanstorClientInterface) GetFileSystemsByNamePrefix(ctx context.Context, prefix string) ([]map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFileSystemsByNamePrefix", ctx, prefix)
+	ret0, _ := ret[0].([]map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFileSystemsByNamePrefix indicates an expected call of GetFileSystemsByNamePrefix.
+func (mr *MockOceanstorClientInterfaceMockRecorder) GetFileSystemsByNamePrefix(ctx, prefix any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileSystemsByNamePrefix", reflect.TypeOf((*MockOceanstorClientInterface)(nil).GetFileSystemsByNamePrefix), ctx, prefix)
+}
+
 // GetHostByID mocks base method.
 func (m *MockOceanstorClientInterface) GetHostByID(ctx context.Context, id string) (map[string]any, error) {
 	m.ctrl.T.Helper()
@@ -1507,6 +1623,21 @@ func (mr *MockOceanstorClientInterfaceMockRecorder) GetLunGroupByName(ctx, name
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLunGroupByName", reflect.TypeOf((*MockOceanstorClientInterface)(nil).GetLunGroupByName), ctx, name)
 }
 
+// GetLunMigration mocks base method.
+func (m *MockOceanstorClientInterface) GetLunMigration(ctx context.Context, srcLunID string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLunMigration", ctx, srcLunID)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLunMigration indicates an expected call of GetLunMigration.
+func (mr *MockOceanstorClientInterfaceMockRecorder) GetLunMigration(ctx, srcLunID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLunMigration", reflect.TypeOf((*MockOceanstorClientInterface)(nil).GetLunMigration), ctx, srcLunID)
+}
+
 // GetLunSnapshotByName mocks base method.
 func (m *MockOceanstorClientInterface) GetLunSnapshotByName(ctx context.Context, name string) (map[string]any, error) {
 	m.ctrl.T.Helper()
@@ -1522,6 +1653,36 @@ func (mr *MockOceanstorClientInterfaceMockRecorder) GetLunSnapshotByName(ctx, na
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLunSnapshotByName", reflect.TypeOf((*MockOceanstorClientInterface)(nil).GetLunSnapshotByName), ctx, name)
 }
 
+// GetLunSnapshotsByParentID mocks base method.
+func (m *MockOceanstorClientInterface) GetLunSnapshotsByParentID(ctx context.Context, parentID string) ([]map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLunSnapshotsByParentID", ctx, parentID)
+	ret0, _ := ret[0].([]map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLunSnapshotsByParentID indicates an expected call of GetLunSnapshotsByParentID.
+func (mr *MockOceanstorClientInterfaceMockRecorder) GetLunSnapshotsByParentID(ctx, parentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLunSnapshotsByParentID", reflect.TypeOf((*MockOceanstorClientInterface)(nil).GetLunSnapshotsByParentID), ctx, parentID)
+}
+
+// GetLunsByNamePrefix mocks base method.
+func (m *MockOceanstorClientInterface) GetLunsByNamePrefix(ctx context.Context, prefix string) ([]map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLunsByNamePrefix", ctx, prefix)
+	ret0, _ := ret[0].([]map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLunsByNamePrefix indicates an expected call of GetLunsByNamePrefix.
+func (mr *MockOceanstorClientInterfaceMockRecorder) GetLunsByNamePrefix(ctx, prefix any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLunsByNamePrefix", reflect.TypeOf((*MockOceanstorClientInterface)(nil).GetLunsByNamePrefix), ctx, prefix)
+}
+
 // GetMappingByName mocks base method.
 func (m *MockOceanstorClientInterface) GetMappingByName(ctx context.Context, name string) (map[string]any, error) {
 	m.ctrl.T.Helper()
@@ -1933,6 +2094,20 @@ func (mr *MockOceanstorClientInterfaceMockRecorder) ModifyNfsShareAccess(ctx, ac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyNfsShareAccess", reflect.TypeOf((*MockOceanstorClientInterface)(nil).ModifyNfsShareAccess), ctx, accessID, vStoreID, accessVal)
 }
 
+// ModifyNfsShareAccessSquash mocks base method.
+func (m *MockOceanstorClientInterface) ModifyNfsShareAccessSquash(ctx context.Context, accessID, vStoreID string, allSquash, rootSquash int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyNfsShareAccessSquash", ctx, accessID, vStoreID, allSquash, rootSquash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ModifyNfsShareAccessSquash indicates an expected call of ModifyNfsShareAccessSquash.
+func (mr *MockOceanstorClientInterfaceMockRecorder) ModifyNfsShareAccessSquash(ctx, accessID, vStoreID, allSquash, rootSquash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyNfsShareAccessSquash", reflect.TypeOf((*MockOceanstorClientInterface)(nil).ModifyNfsShareAccessSquash), ctx, accessID, vStoreID, allSquash, rootSquash)
+}
+
 // Post mocks base method.
 func (m *MockOceanstorClientInterface) Post(ctx context.Context, url string, data map[string]any) (base.Response, error) {
 	m.ctrl.T.Helper()
@@ -2064,6 +2239,34 @@ func (mr *MockOceanstorClientInterfaceMockRecorder) RemoveLunFromGroup(ctx, lunI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveLunFromGroup", reflect.TypeOf((*MockOceanstorClientInterface)(nil).RemoveLunFromGroup), ctx, lunID, groupID)
 }
 
+// RollbackFSSnapshot mocks base method.
+func (m *MockOceanstorClientInterface) RollbackFSSnapshot(ctx context.Context, snapshotID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackFSSnapshot", ctx, snapshotID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackFSSnapshot indicates an expected call of RollbackFSSnapshot.
+func (mr *MockOceanstorClientInterfaceMockRecorder) RollbackFSSnapshot(ctx, snapshotID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackFSSnapshot", reflect.TypeOf((*MockOceanstorClientInterface)(nil).RollbackFSSnapshot), ctx, snapshotID)
+}
+
+// RollbackLunSnapshot mocks base method.
+func (m *MockOceanstorClientInterface) RollbackLunSnapshot(ctx context.Context, snapshotID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackLunSnapshot", ctx, snapshotID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackLunSnapshot indicates an expected call of RollbackLunSnapshot.
+func (mr *MockOceanstorClientInterfaceMockRecorder) RollbackLunSnapshot(ctx, snapshotID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackLunSnapshot", reflect.TypeOf((*MockOceanstorClientInterface)(nil).RollbackLunSnapshot), ctx, snapshotID)
+}
+
 // SafeBaseCall mocks base method.
 func (m *MockOceanstorClientInterface) SafeBaseCall(ctx context.Context, method, url string, data map[string]any) (base.Response, error) {
 	m.ctrl.T.Helper()
@@ -2235,6 +2438,20 @@ func (mr *MockOceanstorClientInterfaceMockRecorder) StopLunCopy(ctx, lunCopyID a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopLunCopy", reflect.TypeOf((*MockOceanstorClientInterface)(nil).StopLunCopy), ctx, lunCopyID)
 }
 
+// SwitchReplicationPair mocks base method.
+func (m *MockOceanstorClientInterface) SwitchReplicationPair(ctx context.Context, pairID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SwitchReplicationPair", ctx, pairID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SwitchReplicationPair indicates an expected call of SwitchReplicationPair.
+func (mr *MockOceanstorClientInterfaceMockRecorder) SwitchReplicationPair(ctx, pairID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SwitchReplicationPair", reflect.TypeOf((*MockOceanstorClientInterface)(nil).SwitchReplicationPair), ctx, pairID)
+}
+
 // SyncClonePair mocks base method.
 func (m *MockOceanstorClientInterface) SyncClonePair(ctx context.Context, clonePairID string) error {
 	m.ctrl.T.Helper()
@@ -2291,6 +2508,20 @@ func (mr *MockOceanstorClientInterfaceMockRecorder) UpdateFCInitiator(ctx, wwn,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFCInitiator", reflect.TypeOf((*MockOceanstorClientInterface)(nil).UpdateFCInitiator), ctx, wwn, alua)
 }
 
+// UpdateFSSnapshot mocks base method.
+func (m *MockOceanstorClientInterface) UpdateFSSnapshot(ctx context.Context, snapshotID string, params map[string]any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFSSnapshot", ctx, snapshotID, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFSSnapshot indicates an expected call of UpdateFSSnapshot.
+func (mr *MockOceanstorClientInterfaceMockRecorder) UpdateFSSnapshot(ctx, snapshotID, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFSSnapshot", reflect.TypeOf((*MockOceanstorClientInterface)(nil).UpdateFSSnapshot), ctx, snapshotID, params)
+}
+
 // UpdateFileSystem mocks base method.
 func (m *MockOceanstorClientInterface) UpdateFileSystem(ctx context.Context, fsID string, params map[string]any) error {
 	m.ctrl.T.Helper()
@@ -2347,6 +2578,20 @@ func (mr *MockOceanstorClientInterfaceMockRecorder) UpdateLun(ctx, lunID, params
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLun", reflect.TypeOf((*MockOceanstorClientInterface)(nil).UpdateLun), ctx, lunID, params)
 }
 
+// UpdateLunSnapshot mocks base method.
+func (m *MockOceanstorClientInterface) UpdateLunSnapshot(ctx context.Context, snapshotID string, params map[string]any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLunSnapshot", ctx, snapshotID, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLunSnapshot indicates an expected call of UpdateLunSnapshot.
+func (mr *MockOceanstorClientInterfaceMockRecorder) UpdateLunSnapshot(ctx, snapshotID, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLunSnapshot", reflect.TypeOf((*MockOceanstorClientInterface)(nil).UpdateLunSnapshot), ctx, snapshotID, params)
+}
+
 // UpdateQos mocks base method.
 func (m *MockOceanstorClientInterface) UpdateQos(ctx context.Context, qosID, vStoreID string, params map[string]any) error {
 	m.ctrl.T.Helper()
@@ -16,7 +16,15 @@
 
 package utils
 
+import (
+	"time"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/metrics"
+)
+
 type Semaphore struct {
+	name    string
+	backend string
 	permits int
 	channel chan int
 }
@@ -28,12 +36,45 @@ func NewSemaphore(permits int) *Semaphore {
 	}
 }
 
+// NewNamedSemaphore returns a semaphore whose permits in use are exposed in metrics by the name
+func NewNamedSemaphore(name string, permits int) *Semaphore {
+	s := NewSemaphore(permits)
+	s.name = name
+	return s
+}
+
+// NewBackendSemaphore returns a named semaphore whose permits in use are exposed in metrics by the name and the backend
+func NewBackendSemaphore(name, backend string, permits int) *Semaphore {
+	s := NewNamedSemaphore(name, permits)
+	s.backend = backend
+	return s
+}
+
 func (s *Semaphore) Acquire() {
 	s.channel <- 0
+	if s.name != "" {
+		metrics.IncSemaphorePermits(s.name, s.backend)
+	}
+}
+
+// AcquireWithTimeout acquires a permit, returns false if no permit is available within the timeout
+func (s *Semaphore) AcquireWithTimeout(timeout time.Duration) bool {
+	select {
+	case s.channel <- 0:
+		if s.name != "" {
+			metrics.IncSemaphorePermits(s.name, s.backend)
+		}
+		return true
+	case <-time.After(timeout):
+		return false
+	}
 }
 
 func (s *Semaphore) Release() {
 	<-s.channel
+	if s.name != "" {
+		metrics.DecSemaphorePermits(s.name, s.backend)
+	}
 }
 
 func (s *Semaphore) GetChannel() chan int {
@@ -56,6 +56,12 @@ type Interface interface {
 	// GetPVByName get all pv info
 	GetPVByName(ctx context.Context, name string) (*corev1.PersistentVolume, error)
 
+	// GetPVCByName get pvc by namespace and name
+	GetPVCByName(ctx context.Context, namespace, name string) (*corev1.PersistentVolumeClaim, error)
+
+	// GetStorageClass get storage class by name
+	GetStorageClass(ctx context.Context, name string) (*storagev1.StorageClass, error)
+
 	// ListPods get pods by namespace
 	ListPods(ctx context.Context, namespace string) (*corev1.PodList, error)
 
@@ -74,15 +80,27 @@ type Interface interface {
 	// GetKvCacheStoreIdByVolumeId returns kvCacheStoreId field of PV by volume id
 	GetKvCacheStoreIdByVolumeId(volumeId string) (string, error)
 
+	// GetPublishFenceByVolumeId returns the owner of the publish fence recorded on the PV by volume id
+	GetPublishFenceByVolumeId(volumeId string) (string, error)
+
+	// ExistPVByVolumeId returns whether any PV uses the volume id
+	ExistPVByVolumeId(volumeId string) (bool, error)
+
 	// UpdateVAsWithHostMap updates VAs with the given host map
 	UpdateVAsWithHostMap(ctx context.Context, volumeId string, hostMap map[string]map[string]interface{}) error
 
 	// GetMappingHostsByVolumeId returns mapping hosts in VAs by volume id
 	GetMappingHostsByVolumeId(volumeId string) ([]string, error)
 
+	// GetVA returns the VA by name
+	GetVA(ctx context.Context, name string) (*storagev1.VolumeAttachment, error)
+
 	// GetVAsByPVName returns VAs by pv name
 	GetVAsByPVName(pvName string) ([]*storagev1.VolumeAttachment, error)
 
+	// GetPublishedNodeIdsByDriver returns the CSI node ids of attached VAs, grouped by volume handle
+	GetPublishedNodeIdsByDriver(ctx context.Context, driverName string) (map[string][]string, error)
+
 	// Activate the k8s helpers when start the service
 	Activate()
 	// Deactivate the k8s helpers when stop the service
@@ -329,6 +347,21 @@ func (k *KubeClient) GetPVByName(ctx context.Context, name string) (*corev1.Pers
 		Get(ctx, name, metav1.GetOptions{})
 }
 
+// GetPVCByName gets the pvc by namespace and pvc name
+func (k *KubeClient) GetPVCByName(ctx context.Context, namespace, name string) (*corev1.PersistentVolumeClaim,
+	error) {
+	return k.clientSet.CoreV1().
+		PersistentVolumeClaims(namespace).
+		Get(ctx, name, metav1.GetOptions{})
+}
+
+// GetStorageClass gets the storage class by name
+func (k *KubeClient) GetStorageClass(ctx context.Context, name string) (*storagev1.StorageClass, error) {
+	return k.clientSet.StorageV1().
+		StorageClasses().
+		Get(ctx, name, metav1.GetOptions{})
+}
+
 // ListPods lists all pods from this namespace
 func (k *KubeClient) ListPods(ctx context.Context, namespace string) (*corev1.PodList, error) {
 	return k.clientSet.CoreV1().
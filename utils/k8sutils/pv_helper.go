@@ -18,6 +18,7 @@
 package k8sutils
 
 import (
+	"errors"
 	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
@@ -91,6 +92,41 @@ func (k *KubeClient) GetKvCacheStoreIdByVolumeId(volumeId string) (string, error
 	return value, nil
 }
 
+// GetPublishFenceByVolumeId returns the owner of the publish fence recorded on the PV by volume id,
+// empty owner is returned if the volume is not fenced
+func (k *KubeClient) GetPublishFenceByVolumeId(volumeId string) (string, error) {
+	volumes, err := k.pvAccessor.GetByIndex(volumeIdIndex, volumeId)
+	var notFoundErr NotFoundError
+	if errors.As(err, &notFoundErr) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("get pv %s by index failed: %w", volumeId, err)
+	}
+
+	for _, volume := range volumes {
+		if owner := volume.Annotations[constants.PublishFenceAnnotationKey]; owner != "" {
+			return owner, nil
+		}
+	}
+
+	return "", nil
+}
+
+// ExistPVByVolumeId returns whether any PV uses the volume id
+func (k *KubeClient) ExistPVByVolumeId(volumeId string) (bool, error) {
+	volumes, err := k.pvAccessor.GetByIndex(volumeIdIndex, volumeId)
+	var notFoundErr NotFoundError
+	if errors.As(err, &notFoundErr) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("get pv %s by index failed: %w", volumeId, err)
+	}
+
+	return len(volumes) != 0, nil
+}
+
 // volumeIdKeyFunc is a default index function that indexes based on volume id
 func volumeIdKeyFunc(obj any) ([]string, error) {
 	volume, ok := obj.(*corev1.PersistentVolume)
@@ -125,6 +161,9 @@ func stripUnusedPvFields(obj any) (any, error) {
 	res.SetUID(pv.GetUID())
 	res.SetName(pv.Name)
 	res.Spec.CSI = pv.Spec.CSI
+	if owner, ok := pv.Annotations[constants.PublishFenceAnnotationKey]; ok {
+		res.SetAnnotations(map[string]string{constants.PublishFenceAnnotationKey: owner})
+	}
 
 	return res, nil
 }
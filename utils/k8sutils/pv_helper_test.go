@@ -250,3 +250,79 @@ func TestKubeClient_GetKvCacheStoreIdByVolumeId_GetVolumeAttrsByVolumeIdField(t
 	assert.EqualError(t, err, wantErr.Error())
 	assert.Equal(t, "", kvcacheStoreId)
 }
+
+func TestKubeClient_GetPublishFenceByVolumeId(t *testing.T) {
+	// arrange
+	pv := genFakePv(fakePv)
+	pv.Spec.CSI.VolumeHandle = fakePv
+	fencedPv := genFakePv("fenced-pv")
+	fencedPv.Spec.CSI.VolumeHandle = "fenced-pv"
+	fencedPv.Annotations = map[string]string{
+		constants.PublishFenceAnnotationKey: "VolumeMigration/migration-1",
+		"other-annotation":                  "value",
+	}
+	fakeClient := fake.NewSimpleClientset(pv, fencedPv)
+	client := &KubeClient{informerFactory: informers.NewSharedInformerFactory(fakeClient, 0)}
+	factoryCh := make(chan struct{})
+	defer close(factoryCh)
+
+	// mock
+	assert.NoError(t, initPVAccessor(client))
+	client.informerFactory.Start(factoryCh)
+	client.informerFactory.WaitForCacheSync(factoryCh)
+
+	// action
+	fencedOwner, fencedErr := client.GetPublishFenceByVolumeId("fenced-pv")
+	owner, err := client.GetPublishFenceByVolumeId(fakePv)
+	absentOwner, absentErr := client.GetPublishFenceByVolumeId("absent-pv")
+
+	// assert
+	assert.NoError(t, fencedErr)
+	assert.Equal(t, "VolumeMigration/migration-1", fencedOwner)
+	assert.NoError(t, err)
+	assert.Empty(t, owner)
+	assert.NoError(t, absentErr)
+	assert.Empty(t, absentOwner)
+}
+
+func TestKubeClient_ExistPVByVolumeId(t *testing.T) {
+	// arrange
+	pv := genFakePv(fakePv)
+	pv.Spec.CSI.VolumeHandle = fakePv
+	fakeClient := fake.NewSimpleClientset(pv)
+	client := &KubeClient{informerFactory: informers.NewSharedInformerFactory(fakeClient, 0)}
+	factoryCh := make(chan struct{})
+	defer close(factoryCh)
+
+	// mock
+	assert.NoError(t, initPVAccessor(client))
+	client.informerFactory.Start(factoryCh)
+	client.informerFactory.WaitForCacheSync(factoryCh)
+
+	// action
+	exist, err := client.ExistPVByVolumeId(fakePv)
+	absentExist, absentErr := client.ExistPVByVolumeId("absent-pv")
+
+	// assert
+	assert.NoError(t, err)
+	assert.True(t, exist)
+	assert.NoError(t, absentErr)
+	assert.False(t, absentExist)
+}
+
+func Test_stripUnusedPvFields_KeepPublishFence(t *testing.T) {
+	// arrange
+	pv := genFakePv(fakePv)
+	pv.Annotations = map[string]string{
+		constants.PublishFenceAnnotationKey: "VolumeMigration/migration-1",
+		"other-annotation":                  "value",
+	}
+
+	// action
+	got, err := stripUnusedPvFields(pv)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{constants.PublishFenceAnnotationKey: "VolumeMigration/migration-1"},
+		got.(*corev1.PersistentVolume).Annotations)
+}
@@ -103,6 +103,26 @@ func (rw *ResourceAccessor[T]) GetByIndex(indexName, indexValue string) ([]T, er
 	return value, nil
 }
 
+// GetByKey gets resource from caches by its key, which is the name of the cluster scoped resource.
+// The returned bool is false if the resource is not found in caches.
+func (rw *ResourceAccessor[T]) GetByKey(key string) (T, bool, error) {
+	var value T
+	item, exist, err := rw.informer.GetIndexer().GetByKey(key)
+	if err != nil {
+		return value, false, fmt.Errorf("could not search cache for %T by key %s: %w", value, key, err)
+	}
+	if !exist {
+		return value, false, nil
+	}
+
+	value, ok := item.(T)
+	if !ok {
+		return value, false, fmt.Errorf("convert %v to %T error", item, value)
+	}
+
+	return value, true, nil
+}
+
 func (rw *ResourceAccessor[T]) getByIndex(indexName, indexValue string) ([]T, error) {
 	var value T
 	items, err := rw.informer.GetIndexer().ByIndex(indexName, indexValue)
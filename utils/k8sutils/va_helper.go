@@ -24,6 +24,7 @@ import (
 	"fmt"
 
 	storagev1 "k8s.io/api/storage/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/retry"
@@ -210,3 +211,63 @@ func convertVAListToMap(list []*storagev1.VolumeAttachment) map[string]*storagev
 
 	return vaMap
 }
+
+// GetPublishedNodeIdsByDriver returns the CSI node ids of attached VAs, grouped by volume handle
+func (k *KubeClient) GetPublishedNodeIdsByDriver(ctx context.Context, driverName string) (map[string][]string, error) {
+	vaList, err := k.clientSet.StorageV1().VolumeAttachments().List(ctx, metaV1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list VolumeAttachments failed, err: %w", err)
+	}
+
+	published := make(map[string][]string)
+	nodeIds := make(map[string]string)
+	for _, va := range vaList.Items {
+		if va.Spec.Attacher != driverName || !va.Status.Attached || va.Spec.Source.PersistentVolumeName == nil {
+			continue
+		}
+
+		// the pv may be deleted while the VA is still being detached, such VA is skipped
+		pv, exist, err := k.pvAccessor.GetByKey(*va.Spec.Source.PersistentVolumeName)
+		if err != nil {
+			return nil, fmt.Errorf("get pv %s of VA %s failed, err: %w",
+				*va.Spec.Source.PersistentVolumeName, va.Name, err)
+		}
+		if !exist || pv.Spec.CSI == nil {
+			continue
+		}
+
+		nodeId, exist := nodeIds[va.Spec.NodeName]
+		if !exist {
+			nodeId, err = k.getCSINodeId(ctx, va.Spec.NodeName, driverName)
+			if err != nil {
+				return nil, err
+			}
+			nodeIds[va.Spec.NodeName] = nodeId
+		}
+		if nodeId == "" {
+			continue
+		}
+
+		published[pv.Spec.CSI.VolumeHandle] = append(published[pv.Spec.CSI.VolumeHandle], nodeId)
+	}
+
+	return published, nil
+}
+
+func (k *KubeClient) getCSINodeId(ctx context.Context, nodeName, driverName string) (string, error) {
+	csiNode, err := k.clientSet.StorageV1().CSINodes().Get(ctx, nodeName, metaV1.GetOptions{})
+	if apiErrors.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("get CSINode %s failed, err: %w", nodeName, err)
+	}
+
+	for _, driver := range csiNode.Spec.Drivers {
+		if driver.Name == driverName {
+			return driver.NodeID, nil
+		}
+	}
+
+	return "", nil
+}
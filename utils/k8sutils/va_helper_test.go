@@ -620,3 +620,63 @@ func TestGetMappingHostsByVolumeId_MultiplePVs(t *testing.T) {
 	assert.Contains(t, hosts, "node1")
 	assert.Contains(t, hosts, "node2")
 }
+
+func TestGetPublishedNodeIdsByDriver_Success(t *testing.T) {
+	// arrange
+	fakeClient := buildFakeKubeClientWithVA()
+	defer close(fakeClient.stopCh)
+	driverName := "csi.huawei.com"
+	createPVWithVolumeId(fakeClient.clientSet, "pv-1", "backend.pvc-1")
+	for name, attacher := range map[string]string{"va-1": driverName, "va-2": "other.csi.com"} {
+		pvName := "pv-1"
+		va := &storagev1.VolumeAttachment{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: storagev1.VolumeAttachmentSpec{
+				Attacher: attacher,
+				NodeName: "node-1",
+				Source:   storagev1.VolumeAttachmentSource{PersistentVolumeName: &pvName},
+			},
+			Status: storagev1.VolumeAttachmentStatus{Attached: true},
+		}
+		fakeClient.clientSet.StorageV1().VolumeAttachments().Create(context.Background(), va, metav1.CreateOptions{})
+	}
+	csiNode := &storagev1.CSINode{
+		ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+		Spec: storagev1.CSINodeSpec{Drivers: []storagev1.CSINodeDriver{
+			{Name: driverName, NodeID: `{"HostName":"node-1"}`},
+		}},
+	}
+	fakeClient.clientSet.StorageV1().CSINodes().Create(context.Background(), csiNode, metav1.CreateOptions{})
+
+	// action
+	published, err := fakeClient.client.GetPublishedNodeIdsByDriver(context.Background(), driverName)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]string{"backend.pvc-1": {`{"HostName":"node-1"}`}}, published)
+}
+
+func TestGetPublishedNodeIdsByDriver_SkipDeletedPV(t *testing.T) {
+	// arrange
+	fakeClient := buildFakeKubeClientWithVA()
+	defer close(fakeClient.stopCh)
+	driverName := "csi.huawei.com"
+	pvName := "pv-deleted"
+	va := &storagev1.VolumeAttachment{
+		ObjectMeta: metav1.ObjectMeta{Name: "va-1"},
+		Spec: storagev1.VolumeAttachmentSpec{
+			Attacher: driverName,
+			NodeName: "node-1",
+			Source:   storagev1.VolumeAttachmentSource{PersistentVolumeName: &pvName},
+		},
+		Status: storagev1.VolumeAttachmentStatus{Attached: true},
+	}
+	fakeClient.clientSet.StorageV1().VolumeAttachments().Create(context.Background(), va, metav1.CreateOptions{})
+
+	// action
+	published, err := fakeClient.client.GetPublishedNodeIdsByDriver(context.Background(), driverName)
+
+	// assert
+	assert.NoError(t, err)
+	assert.Empty(t, published)
+}
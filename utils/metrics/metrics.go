@@ -0,0 +1,260 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package metrics provides the prometheus metrics of the services and the server to expose them
+package metrics
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	namespace = "huawei_csi"
+
+	// CodeUnconnected is the code of rest call which fails to connect to the storage
+	CodeUnconnected = "unconnected"
+	// CodeInvalidResponse is the code of rest call whose response can not be parsed
+	CodeInvalidResponse = "invalid_response"
+
+	// StorageOceanStor is the storage label of OceanStor and OceanDisk rest calls
+	StorageOceanStor = "oceanstor"
+	// StorageFusionStorage is the storage label of FusionStorage rest calls
+	StorageFusionStorage = "fusionstorage"
+	// StorageDME is the storage label of DME rest calls
+	StorageDME = "dme"
+
+	rpcCodeOK            = "OK"
+	restCodeOK           = "0"
+	idSegmentPlaceholder = "{id}"
+	minIdSegmentLength   = 16
+)
+
+var (
+	rpcDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "rpc",
+		Name:      "duration_seconds",
+		Help:      "Latency of the CSI RPCs.",
+		Buckets:   prometheus.ExponentialBuckets(0.01, 2, 16),
+	}, []string{"method", "backend"})
+
+	rpcErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "rpc",
+		Name:      "errors_total",
+		Help:      "Number of the CSI RPCs which return an error, partitioned by the grpc code.",
+	}, []string{"method", "backend", "code"})
+
+	restDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "rest",
+		Name:      "duration_seconds",
+		Help:      "Latency of the rest calls to the storage.",
+		Buckets:   prometheus.ExponentialBuckets(0.005, 2, 14),
+	}, []string{"storage", "method", "url"})
+
+	restErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "rest",
+		Name:      "errors_total",
+		Help:      "Number of the rest calls to the storage which fail, partitioned by the error code.",
+	}, []string{"storage", "method", "url", "code"})
+
+	reLogins = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "rest",
+		Name:      "relogins_total",
+		Help:      "Number of the relogins to the storage.",
+	}, []string{"storage", "result"})
+
+	semaphorePermitsInUse = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "semaphore",
+		Name:      "permits_in_use",
+		Help:      "Number of the semaphore permits in use.",
+	}, []string{"name", "backend"})
+
+	lockWaitDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "connector",
+		Name:      "lock_wait_seconds",
+		Help:      "Time spent waiting for the connector lock.",
+		Buckets:   prometheus.ExponentialBuckets(0.01, 2, 14),
+	}, []string{"operation"})
+
+	spaceReclaimedBytes = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "space_reclaim",
+		Name:      "bytes_total",
+		Help:      "Number of the bytes reclaimed by fstrim on the volumes.",
+	}, []string{"volume"})
+
+	spaceReclaims = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "space_reclaim",
+		Name:      "runs_total",
+		Help:      "Number of the fstrim runs on the volumes, partitioned by the result.",
+	}, []string{"volume", "result"})
+
+	volumePaths = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "volume",
+		Name:      "paths",
+		Help:      "Number of the physical paths of the staged volumes, partitioned by the path state.",
+	}, []string{"volume", "state"})
+
+	pathRecoveries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "volume",
+		Name:      "path_recoveries_total",
+		Help:      "Number of the recoveries of the degraded volume paths, partitioned by the result.",
+	}, []string{"volume", "result"})
+)
+
+func init() {
+	prometheus.MustRegister(rpcDuration, rpcErrors, restDuration, restErrors, reLogins,
+		semaphorePermitsInUse, lockWaitDuration, spaceReclaimedBytes, spaceReclaims, volumePaths, pathRecoveries)
+}
+
+// NewDesc returns the description of the metric in the namespace of the driver,
+// it is used by the collectors which read the metric values at scrape time
+func NewDesc(subsystem, name, help string, labels []string) *prometheus.Desc {
+	return prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, name), help, labels, nil)
+}
+
+// Register registers the collector to the default registry, registering the same collector again is ignored
+func Register(collector prometheus.Collector) error {
+	err := prometheus.Register(collector)
+	if errors.As(err, &prometheus.AlreadyRegisteredError{}) {
+		return nil
+	}
+
+	return err
+}
+
+// ObserveRPC records the latency of the CSI RPC, the error is counted if the code is not OK
+func ObserveRPC(method, backend, code string, start time.Time) {
+	rpcDuration.WithLabelValues(method, backend).Observe(time.Since(start).Seconds())
+	if code != rpcCodeOK {
+		rpcErrors.WithLabelValues(method, backend, code).Inc()
+	}
+}
+
+// ObserveRestCall records the latency of the rest call, the error is counted if the code is not 0
+func ObserveRestCall(storage, method, url, code string, start time.Time) {
+	url = normalizeURL(url)
+	restDuration.WithLabelValues(storage, method, url).Observe(time.Since(start).Seconds())
+	if code != restCodeOK {
+		restErrors.WithLabelValues(storage, method, url, code).Inc()
+	}
+}
+
+// IncReLogin counts the relogin to the storage
+func IncReLogin(storage string, err error) {
+	result := "success"
+	if err != nil {
+		result = "failure"
+	}
+	reLogins.WithLabelValues(storage, result).Inc()
+}
+
+// IncSemaphorePermits increases the permits in use of the named semaphore of the backend
+func IncSemaphorePermits(name, backend string) {
+	semaphorePermitsInUse.WithLabelValues(name, backend).Inc()
+}
+
+// DecSemaphorePermits decreases the permits in use of the named semaphore of the backend
+func DecSemaphorePermits(name, backend string) {
+	semaphorePermitsInUse.WithLabelValues(name, backend).Dec()
+}
+
+// ObserveLockWait records the time spent waiting for the connector lock of the operation
+func ObserveLockWait(operation string, start time.Time) {
+	lockWaitDuration.WithLabelValues(operation).Observe(time.Since(start).Seconds())
+}
+
+// ObserveSpaceReclaim records the bytes reclaimed from the volume, the run is counted as failure if err is not nil
+func ObserveSpaceReclaim(volume string, reclaimedBytes int64, err error) {
+	result := "success"
+	if err != nil {
+		result = "failure"
+	}
+	spaceReclaims.WithLabelValues(volume, result).Inc()
+	if reclaimedBytes > 0 {
+		spaceReclaimedBytes.WithLabelValues(volume).Add(float64(reclaimedBytes))
+	}
+}
+
+// DeleteSpaceReclaim removes the space reclamation metrics of the volume which is no longer staged on the node
+func DeleteSpaceReclaim(volume string) {
+	spaceReclaims.DeleteLabelValues(volume, "success")
+	spaceReclaims.DeleteLabelValues(volume, "failure")
+	spaceReclaimedBytes.DeleteLabelValues(volume)
+}
+
+// SetVolumePaths records the number of the total and the faulty paths of the volume
+func SetVolumePaths(volume string, totalPaths, faultyPaths int) {
+	volumePaths.WithLabelValues(volume, "total").Set(float64(totalPaths))
+	volumePaths.WithLabelValues(volume, "faulty").Set(float64(faultyPaths))
+}
+
+// DeleteVolumePaths removes the path and the path recovery metrics of the volume which is no longer staged
+// on the node
+func DeleteVolumePaths(volume string) {
+	volumePaths.DeleteLabelValues(volume, "total")
+	volumePaths.DeleteLabelValues(volume, "faulty")
+	pathRecoveries.DeleteLabelValues(volume, "success")
+	pathRecoveries.DeleteLabelValues(volume, "failure")
+}
+
+// ObservePathRecovery counts the recovery of the degraded paths of the volume, it is failure if err is not nil
+func ObservePathRecovery(volume string, err error) {
+	result := "success"
+	if err != nil {
+		result = "failure"
+	}
+	pathRecoveries.WithLabelValues(volume, result).Inc()
+}
+
+// normalizeURL removes the query and the object ids of the url to keep the cardinality of labels bounded,
+// such as /lun/12?range=[0-100] is normalized to /lun/{id}
+func normalizeURL(url string) string {
+	url, _, _ = strings.Cut(url, "?")
+	segments := strings.Split(url, "/")
+	for i, segment := range segments {
+		if isIdSegment(segment) {
+			segments[i] = idSegmentPlaceholder
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+func isIdSegment(segment string) bool {
+	if segment == "" {
+		return false
+	}
+
+	if unicode.IsDigit(rune(segment[0])) {
+		return true
+	}
+
+	return len(segment) >= minIdSegmentLength && strings.IndexFunc(segment, unicode.IsDigit) >= 0
+}
@@ -0,0 +1,152 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package metrics
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeURL(t *testing.T) {
+	// arrange
+	cases := []struct {
+		name string
+		url  string
+		want string
+	}{
+		{name: "static url", url: "/lun", want: "/lun"},
+		{name: "numeric id", url: "/lun/12", want: "/lun/{id}"},
+		{name: "query removed", url: "/lun?range=[0-100]", want: "/lun"},
+		{name: "long id", url: "/api/v2/storages/2102353GTD10L9000006/pools",
+			want: "/api/v2/storages/{id}/pools"},
+		{name: "version kept", url: "/dsware/service/v1.3/volume/list", want: "/dsware/service/v1.3/volume/list"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			// action
+			got := normalizeURL(c.url)
+
+			// assert
+			assert.Equal(t, c.want, got)
+		})
+	}
+}
+
+func TestObserveRestCall_CountError(t *testing.T) {
+	// arrange
+	storage, method, url, code := "test-storage", "GET", "/lun/1", "1077948996"
+
+	// action
+	ObserveRestCall(storage, method, url, "0", time.Now())
+	ObserveRestCall(storage, method, url, code, time.Now())
+
+	// assert
+	assert.Equal(t, float64(1), testutil.ToFloat64(restErrors.WithLabelValues(storage, method, "/lun/{id}", code)))
+	assert.Equal(t, float64(0), testutil.ToFloat64(restErrors.WithLabelValues(storage, method, "/lun/{id}", "0")))
+}
+
+func TestObserveRPC_CountError(t *testing.T) {
+	// arrange
+	method, backend := "CreateVolume", "test-backend"
+
+	// action
+	ObserveRPC(method, backend, "OK", time.Now())
+	ObserveRPC(method, backend, "Internal", time.Now())
+
+	// assert
+	assert.Equal(t, float64(1), testutil.ToFloat64(rpcErrors.WithLabelValues(method, backend, "Internal")))
+	assert.Equal(t, float64(0), testutil.ToFloat64(rpcErrors.WithLabelValues(method, backend, "OK")))
+}
+
+func TestIncReLogin(t *testing.T) {
+	// arrange
+	storage := "test-relogin"
+
+	// action
+	IncReLogin(storage, nil)
+	IncReLogin(storage, errors.New("login failed"))
+	IncReLogin(storage, errors.New("login failed"))
+
+	// assert
+	assert.Equal(t, float64(1), testutil.ToFloat64(reLogins.WithLabelValues(storage, "success")))
+	assert.Equal(t, float64(2), testutil.ToFloat64(reLogins.WithLabelValues(storage, "failure")))
+}
+
+func TestSemaphorePermits(t *testing.T) {
+	// arrange
+	name := "test-semaphore"
+	busyBackend := "busy-backend"
+	idleBackend := "idle-backend"
+
+	// action
+	IncSemaphorePermits(name, busyBackend)
+	IncSemaphorePermits(name, busyBackend)
+	DecSemaphorePermits(name, busyBackend)
+	IncSemaphorePermits(name, idleBackend)
+	DecSemaphorePermits(name, idleBackend)
+
+	// assert
+	assert.Equal(t, float64(1), testutil.ToFloat64(semaphorePermitsInUse.WithLabelValues(name, busyBackend)))
+	assert.Equal(t, float64(0), testutil.ToFloat64(semaphorePermitsInUse.WithLabelValues(name, idleBackend)))
+}
+
+func TestObserveSpaceReclaim(t *testing.T) {
+	// arrange
+	volume := "pvc-reclaim"
+
+	// action
+	ObserveSpaceReclaim(volume, 1024, nil)
+	ObserveSpaceReclaim(volume, 0, errors.New("fstrim failed"))
+
+	// assert
+	assert.Equal(t, float64(1024), testutil.ToFloat64(spaceReclaimedBytes.WithLabelValues(volume)))
+	assert.Equal(t, float64(1), testutil.ToFloat64(spaceReclaims.WithLabelValues(volume, "success")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(spaceReclaims.WithLabelValues(volume, "failure")))
+
+	// action
+	DeleteSpaceReclaim(volume)
+
+	// assert
+	assert.Equal(t, 0, testutil.CollectAndCount(spaceReclaims))
+	assert.Equal(t, 0, testutil.CollectAndCount(spaceReclaimedBytes))
+}
+
+func TestSetVolumePaths(t *testing.T) {
+	// arrange
+	volume := "pvc-paths"
+
+	// action
+	SetVolumePaths(volume, 4, 1)
+	ObservePathRecovery(volume, nil)
+
+	// assert
+	assert.Equal(t, float64(4), testutil.ToFloat64(volumePaths.WithLabelValues(volume, "total")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(volumePaths.WithLabelValues(volume, "faulty")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(pathRecoveries.WithLabelValues(volume, "success")))
+
+	// action
+	DeleteVolumePaths(volume)
+
+	// assert
+	assert.Equal(t, 0, testutil.CollectAndCount(volumePaths))
+	assert.Equal(t, 0, testutil.CollectAndCount(pathRecoveries))
+}
@@ -0,0 +1,105 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package utils
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
+)
+
+const authClientSeparator = ";"
+
+// ShareSquash is the squash of the nfs share auth clients, nil means it is not changed
+type ShareSquash struct {
+	AllSquash  *int
+	RootSquash *int
+}
+
+// IsEmpty returns true if neither the all squash nor the root squash is changed
+func (s ShareSquash) IsEmpty() bool {
+	return s.AllSquash == nil && s.RootSquash == nil
+}
+
+// GetModifySquash parses the all squash and root squash of the mutable parameters
+func GetModifySquash(params map[string]string) (ShareSquash, error) {
+	var squash ShareSquash
+	if val, exist := params[constants.ModifyAllSquashKey]; exist {
+		var value int
+		switch {
+		case strings.EqualFold(val, constants.AllSquash):
+			value = constants.AllSquashValue
+		case strings.EqualFold(val, constants.NoAllSquash):
+			value = constants.NoAllSquashValue
+		default:
+			return squash, fmt.Errorf("%s must be %q or %q, %q is invalid",
+				constants.ModifyAllSquashKey, constants.AllSquash, constants.NoAllSquash, val)
+		}
+		squash.AllSquash = &value
+	}
+
+	if val, exist := params[constants.ModifyRootSquashKey]; exist {
+		var value int
+		switch {
+		case strings.EqualFold(val, constants.RootSquash):
+			value = constants.RootSquashValue
+		case strings.EqualFold(val, constants.NoRootSquash):
+			value = constants.NoRootSquashValue
+		default:
+			return squash, fmt.Errorf("%s must be %q or %q, %q is invalid",
+				constants.ModifyRootSquashKey, constants.RootSquash, constants.NoRootSquash, val)
+		}
+		squash.RootSquash = &value
+	}
+
+	return squash, nil
+}
+
+// SplitAuthClients splits the auth clients separated by ";", the blank ones are ignored
+func SplitAuthClients(authClient string) []string {
+	var clients []string
+	for _, name := range strings.Split(authClient, authClientSeparator) {
+		if name = strings.TrimSpace(name); name != "" {
+			clients = append(clients, name)
+		}
+	}
+
+	return clients
+}
+
+// GetShareOptions returns the export options of the nfs share in the format of the mutable parameters,
+// the auth clients are sorted so that the result is stable
+func GetShareOptions(clients []string, allSquash, rootSquash int) map[string]string {
+	sorted := append([]string(nil), clients...)
+	sort.Strings(sorted)
+
+	options := map[string]string{
+		constants.ModifyAuthClientKey: strings.Join(sorted, authClientSeparator),
+		constants.ModifyAllSquashKey:  constants.NoAllSquash,
+		constants.ModifyRootSquashKey: constants.NoRootSquash,
+	}
+	if allSquash == constants.AllSquashValue {
+		options[constants.ModifyAllSquashKey] = constants.AllSquash
+	}
+	if rootSquash == constants.RootSquashValue {
+		options[constants.ModifyRootSquashKey] = constants.RootSquash
+	}
+
+	return options
+}
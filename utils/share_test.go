@@ -0,0 +1,70 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
+)
+
+func TestGetModifySquash(t *testing.T) {
+	// act
+	got, err := GetModifySquash(map[string]string{"allSquash": "ALL_SQUASH", "rootSquash": "no_root_squash"})
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, constants.AllSquashValue, *got.AllSquash)
+	assert.Equal(t, constants.NoRootSquashValue, *got.RootSquash)
+}
+
+func TestGetModifySquash_Empty(t *testing.T) {
+	// act
+	got, err := GetModifySquash(map[string]string{"authClient": "*"})
+
+	// assert
+	assert.NoError(t, err)
+	assert.True(t, got.IsEmpty())
+}
+
+func TestGetModifySquash_Invalid(t *testing.T) {
+	// act
+	_, err := GetModifySquash(map[string]string{"rootSquash": "invalid"})
+
+	// assert
+	assert.ErrorContains(t, err, "rootSquash must be")
+}
+
+func TestSplitAuthClients(t *testing.T) {
+	// act
+	got := SplitAuthClients(" 192.168.1.1;; 192.168.1.2 ;")
+
+	// assert
+	assert.Equal(t, []string{"192.168.1.1", "192.168.1.2"}, got)
+}
+
+func TestGetShareOptions(t *testing.T) {
+	// act
+	got := GetShareOptions([]string{"192.168.1.2", "192.168.1.1"}, constants.AllSquashValue,
+		constants.NoRootSquashValue)
+
+	// assert
+	assert.Equal(t, map[string]string{"authClient": "192.168.1.1;192.168.1.2", "allSquash": "all_squash",
+		"rootSquash": "no_root_squash"}, got)
+}
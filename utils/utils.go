@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2020-2025. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2020-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -199,6 +199,16 @@ func GetFSSnapshotName(name string) string {
 	return strings.Replace(name, "-", "_", -1)
 }
 
+// GetSnapshotOriginName returns the original name of the snapshot, which is kept in the description of the
+// snapshot on storage because the name on storage may be truncated or converted by nameOnStorage.
+func GetSnapshotOriginName(name, description string, nameOnStorage func(string) string) string {
+	if description != "" && nameOnStorage(description) == name {
+		return description
+	}
+
+	return name
+}
+
 func GetSharePath(name string) string {
 	return "/" + strings.Replace(name, "-", "_", -1) + "/"
 }
@@ -415,7 +425,7 @@ func GetVolumeMetrics(path string) (*VolumeMetrics, error) {
 
 	unixFsInfo, err := fsInfo(path)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get FsInfo, error %v", err)
+		return nil, fmt.Errorf("failed to get FsInfo, error %w", err)
 	}
 	volumeMetrics.Inodes = resource.NewQuantity(unixFsInfo.inodes, resource.BinarySI)
 	volumeMetrics.InodesFree = resource.NewQuantity(unixFsInfo.inodesFree, resource.BinarySI)
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2025-2025. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2025-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -21,7 +21,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
 	"syscall"
 	"time"
@@ -29,6 +31,7 @@ import (
 	"golang.org/x/sys/unix"
 
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/csi/app"
+	"github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/constants"
 	"github.com/Huawei/eSDK_K8S_Plugin/v4/utils/log"
 )
 
@@ -89,6 +92,27 @@ func IsBlockDevice(path string) (bool, error) {
 	return (stat.Mode & unix.S_IFMT) == unix.S_IFBLK, nil
 }
 
+// GetBlockDeviceName returns the kernel name of the block device of the given path, such as dm-0 or nvme0n1.
+// The path can be the block device itself, or a file on the filesystem of the block device.
+func GetBlockDeviceName(path string) (string, error) {
+	var stat unix.Stat_t
+	if err := unix.Stat(path, &stat); err != nil {
+		return "", err
+	}
+
+	dev := stat.Dev
+	if (stat.Mode & unix.S_IFMT) == unix.S_IFBLK {
+		dev = stat.Rdev
+	}
+
+	link, err := os.Readlink(fmt.Sprintf("/sys/dev/block/%d:%d", unix.Major(dev), unix.Minor(dev)))
+	if err != nil {
+		return "", fmt.Errorf("path %s is not on a block device: %w", path, err)
+	}
+
+	return filepath.Base(link), nil
+}
+
 func execShellCmd(ctx context.Context, format string, logFilter bool, args ...any) (string, bool, error) {
 	cmd := fmt.Sprintf(format, args...)
 	log.AddContext(ctx).Infof(`Gonna run shell cmd %s.`, MaskSensitiveInfo(cmd))
@@ -99,8 +123,9 @@ func execShellCmd(ctx context.Context, format string, logFilter bool, args ...an
 
 	killProcess, killProcessAndSubprocess := true, false
 	timeoutDuration := time.Duration(app.GetGlobalConfig().ExecCommandTimeout) * time.Second
-	// Processes are not killed when formatting or capacity expansion commands time out.
-	if strings.Contains(cmd, "mkfs") || strings.Contains(cmd, "resize2fs") || strings.Contains(cmd, "xfs_growfs") {
+	// Processes are not killed when formatting, capacity expansion or trimming commands time out.
+	if strings.Contains(cmd, "mkfs") || strings.Contains(cmd, "resize2fs") || strings.Contains(cmd, "xfs_growfs") ||
+		strings.HasPrefix(cmd, "fstrim") {
 		timeoutDuration = longTimeout * time.Second
 		killProcess = false
 	} else if strings.Contains(cmd, "mount") {
@@ -148,3 +173,29 @@ func execShellCmd(ctx context.Context, format string, logFilter bool, args ...an
 
 	return string(output), timeout, nil
 }
+
+// ExecShellCmdWithStdin execs the command with the input written to its stdin, so that the sensitive input,
+// such as the passphrase of an encrypted device, appears neither in the command line nor in the log.
+var ExecShellCmdWithStdin = func(ctx context.Context, stdin, format string, args ...any) (string, error) {
+	cmd := fmt.Sprintf(format, args...)
+	log.AddContext(ctx).Infof(`Gonna run shell cmd %s.`, MaskSensitiveInfo(cmd))
+
+	// Deriving the key of an encrypted device is slow by design, so the long timeout is used.
+	timeoutCtx, cancel := context.WithTimeout(ctx, longTimeout*time.Second)
+	defer cancel()
+	shCmd := exec.CommandContext(timeoutCtx, "nsenter", "-i/proc/1/ns/ipc", "-m/proc/1/ns/mnt",
+		"-n/proc/1/ns/net", "-u/proc/1/ns/uts", "/bin/sh", "-c", cmd)
+	shCmd.Stdin = strings.NewReader(stdin)
+
+	output, err := shCmd.CombinedOutput()
+	if errors.Is(timeoutCtx.Err(), context.DeadlineExceeded) {
+		return "", constants.ErrTimeout
+	}
+	if err != nil {
+		log.AddContext(ctx).Warningf(`Run shell cmd %s output: [%s], error: [%v]`, MaskSensitiveInfo(cmd),
+			MaskSensitiveInfo(output), MaskSensitiveInfo(err))
+		return string(output), err
+	}
+
+	return string(output), nil
+}
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2025-2025. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2025-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -38,6 +38,16 @@ func IsBlockDevice(path string) (bool, error) {
 	return false, fmt.Errorf("not supported on windows platform")
 }
 
+// GetBlockDeviceName returns the kernel name of the block device of the given path.
+func GetBlockDeviceName(path string) (string, error) {
+	return "", fmt.Errorf("not supported on windows platform")
+}
+
 func execShellCmd(ctx context.Context, format string, logFilter bool, args ...interface{}) (string, bool, error) {
 	return "", false, fmt.Errorf("not supported on windows platform")
 }
+
+// ExecShellCmdWithStdin execs the command with the input written to its stdin
+var ExecShellCmdWithStdin = func(ctx context.Context, stdin, format string, args ...any) (string, error) {
+	return "", fmt.Errorf("not supported on windows platform")
+}
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2020-2023. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2020-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -128,3 +128,19 @@ type VolumeQuery struct {
 	Name       string
 	ParentName string
 }
+
+// SnapshotQuery represents the query parameters for listing snapshots.
+// Name and ParentID locate a single snapshot, VolumeName selects the snapshots of one volume,
+// otherwise the snapshots of all volumes whose name starts with VolumePrefix are selected.
+type SnapshotQuery struct {
+	Name         string
+	ParentID     string
+	VolumeName   string
+	VolumePrefix string
+}
+
+// GroupSnapshotMember represents the snapshot of one volume in a group snapshot
+type GroupSnapshotMember struct {
+	VolumeName   string
+	SnapshotName string
+}